@@ -0,0 +1,192 @@
+// Package api serves the pet assistant over an HTTP JSON API, so it can be embedded into websites and mobile apps.
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+const (
+	// idPrefix starts the user and chat IDs of API requests, keeping them apart from the IDs of Telegram users
+	idPrefix = "api"
+
+	requestTimeout  = 120 * time.Second
+	shutdownTimeout = 10 * time.Second
+	maxBodySize     = 20 << 20
+	maxImages       = 5
+)
+
+// AIProvider defines the operations of the AI service exposed by the API
+type AIProvider interface {
+	ProcessMessage(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	ProcessEditProfile(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	CancelQuestionnaire(ctx context.Context, chatID string) error
+	ResetUserConversation(ctx context.Context, userID, chatID string) error
+}
+
+// Config holds the configuration of the HTTP API.
+// Listen is the local address of the HTTP server, such as ":8081".
+// Keys are the API keys clients send in the Authorization header as "Bearer <key>".
+type Config struct {
+	Listen string `mapstructure:"listen"`
+	Keys   []Key  `mapstructure:"keys"`
+}
+
+// Key is the API key of a client, such as a website or a mobile app.
+// The user and chat IDs of its requests are prefixed with the Client name, as "api:<client>:<id>",
+// so a client can only access the conversations and pet profiles of its own users.
+// A client can have several keys, e.g. while rotating them.
+type Key struct {
+	Client string `mapstructure:"client"`
+	Key    string `mapstructure:"key"`
+}
+
+// clientKey is a configured API key together with the name of its client
+type clientKey struct {
+	client string
+	key    []byte
+}
+
+// clientContextKey is the context key of the client name of an authenticated request
+type clientContextKey struct{}
+
+// Server serves the operations of the AI service as JSON endpoints authenticated with API keys
+type Server struct {
+	ai     AIProvider
+	l10n   *i18n.Localizer
+	listen string
+	keys   []clientKey
+}
+
+// New creates a new Server for the AI service.
+// Returns an error if the listen address or API keys are not configured, or a key has no valid client name.
+func New(cfg Config, ai AIProvider) (*Server, error) {
+	if cfg.Listen == "" {
+		return nil, fmt.Errorf("api listen address cannot be empty")
+	}
+
+	keys := make([]clientKey, 0, len(cfg.Keys))
+
+	for _, key := range cfg.Keys {
+		if key.Key == "" {
+			continue
+		}
+
+		if key.Client == "" || strings.Contains(key.Client, ":") {
+			return nil, fmt.Errorf("api key client name must be non-empty and must not contain colons: %q", key.Client)
+		}
+
+		keys = append(keys, clientKey{client: key.Client, key: []byte(key.Key)})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one api key is required")
+	}
+
+	return &Server{
+		ai:     ai,
+		l10n:   i18n.NewLocalizer(),
+		listen: cfg.Listen,
+		keys:   keys,
+	}, nil
+}
+
+// Run serves the API until ctx is cancelled, then waits for in-flight requests to finish.
+// Returns an error if the listener can't be started or the server fails.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.listen, err)
+	}
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	slog.InfoContext(ctx, "API server started", slog.String("listen", listener.Addr().String()))
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("api server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to shutdown api server: %w", err)
+	}
+
+	return nil
+}
+
+// Handler returns the HTTP handler routing API requests, all endpoints require a valid API key.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/messages", s.handleMessage)
+	mux.HandleFunc("POST /v1/profile/edit", s.handleEditProfile)
+	mux.HandleFunc("POST /v1/questionnaire/cancel", s.handleCancel)
+	mux.HandleFunc("POST /v1/conversation/reset", s.handleReset)
+
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without one of the configured API keys in the Authorization header,
+// the client name of the key is added to the context of accepted requests.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var client string
+
+		key, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok {
+			client, ok = s.clientFor([]byte(key))
+		}
+
+		if !ok {
+			slog.WarnContext(r.Context(), "API request with invalid key", slog.String("remote_addr", r.RemoteAddr))
+			writeError(w, http.StatusUnauthorized, "invalid api key")
+
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientContextKey{}, client)))
+	})
+}
+
+// clientFor finds the client of the key among the configured API keys, comparing in constant time.
+// Returns the client name and true if the key is valid.
+func (s *Server) clientFor(key []byte) (string, bool) {
+	client, valid := "", false
+
+	for _, k := range s.keys {
+		if subtle.ConstantTimeCompare(key, k.key) == 1 {
+			client, valid = k.client, true
+		}
+	}
+
+	return client, valid
+}
+
+// scopedID returns the ID of a user or chat of the client as it is passed to the AI service, "api:<client>:<id>".
+// Telegram IDs are numbers, so clients can't access the data of Telegram users or of each other.
+func scopedID(client, id string) string {
+	return idPrefix + ":" + client + ":" + id
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+		cfg     Config
+	}{
+		{name: "valid config", cfg: Config{Listen: ":8081", Keys: []Key{{Client: "web", Key: "key"}}}},
+		{name: "missing listen address", cfg: Config{Keys: []Key{{Client: "web", Key: "key"}}}, wantErr: "api listen address cannot be empty"},
+		{name: "missing keys", cfg: Config{Listen: ":8081", Keys: []Key{{Client: "web"}}}, wantErr: "at least one api key is required"},
+		{
+			name:    "missing client",
+			cfg:     Config{Listen: ":8081", Keys: []Key{{Key: "key"}}},
+			wantErr: `api key client name must be non-empty and must not contain colons: ""`,
+		},
+		{
+			name:    "client with colon",
+			cfg:     Config{Listen: ":8081", Keys: []Key{{Client: "web:1", Key: "key"}}},
+			wantErr: `api key client name must be non-empty and must not contain colons: "web:1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := New(tt.cfg, NewMockAIProvider(t))
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, srv)
+		})
+	}
+}
+
+func TestServer_Authentication(t *testing.T) {
+	srv, err := New(Config{Listen: ":8081", Keys: []Key{{Client: "web", Key: "key1"}, {Client: "app", Key: "key2"}}}, NewMockAIProvider(t))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+	}{
+		{name: "missing key", wantStatus: http.StatusUnauthorized},
+		{name: "invalid key", header: "Bearer other", wantStatus: http.StatusUnauthorized},
+		{name: "key without scheme", header: "key1", wantStatus: http.StatusUnauthorized},
+		{name: "valid key", header: "Bearer key2", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader("{}"))
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	srv, err := New(Config{Listen: addr, Keys: []Key{{Client: "web", Key: "key"}}}, NewMockAIProvider(t))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- srv.Run(ctx) }()
+
+	require.Eventually(t, func() bool {
+		resp, err := http.Post("http://"+addr+"/v1/messages", "application/json", strings.NewReader("{}"))
+		if err != nil {
+			return false
+		}
+
+		_ = resp.Body.Close()
+
+		return resp.StatusCode == http.StatusUnauthorized
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("server did not stop")
+	}
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"golang.org/x/text/language"
+)
+
+// editProfileCommand is the text of the profile editing request, the same as the bot command
+const editProfileCommand = "/editprofile"
+
+// errBadRequest marks requests rejected because of invalid input
+var errBadRequest = errors.New("bad request")
+
+// Request is the body of all API requests, identifying the user and the chat of the conversation.
+// The IDs are scoped to the client of the API key, they can be chosen by the client freely.
+// Language selects the language of the messages, e.g. "es", it defaults to the Accept-Language header.
+// Text and Images are used only when sending a message, images are base64 encoded.
+type Request struct {
+	UserID   string  `json:"user_id"`
+	ChatID   string  `json:"chat_id"`
+	Text     string  `json:"text,omitempty"`
+	Language string  `json:"language,omitempty"`
+	Images   []Image `json:"images,omitempty"`
+}
+
+// Image is an image attached to a message
+type Image struct {
+	MIME string `json:"mime"`
+	Data string `json:"data"`
+}
+
+// ErrorResponse is the body of failed requests, Error is a message that can be shown to the user
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// handleMessage sends the message of the user to the AI service and responds with its answer.
+func (s *Server) handleMessage(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r, func(ctx context.Context, req *Request) (*message.Response, error) {
+		msg, err := newUserMessage(req.UserID, req.ChatID, req.Text)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg.Images, err = decodeImages(req.Images); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.ai.ProcessMessage(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process message: %w", err)
+		}
+
+		return resp, nil
+	})
+}
+
+// handleEditProfile starts the questionnaire editing the pet profile of the user and responds with its first question.
+func (s *Server) handleEditProfile(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r, func(ctx context.Context, req *Request) (*message.Response, error) {
+		msg, err := newUserMessage(req.UserID, req.ChatID, editProfileCommand)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.ai.ProcessEditProfile(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process edit profile request: %w", err)
+		}
+
+		return resp, nil
+	})
+}
+
+// handleCancel cancels the questionnaire in progress in the chat.
+func (s *Server) handleCancel(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r, func(ctx context.Context, req *Request) (*message.Response, error) {
+		if err := s.ai.CancelQuestionnaire(ctx, req.ChatID); err != nil {
+			return nil, fmt.Errorf("failed to reset conversation: %w", err)
+		}
+
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Questionary is cancelled"), nil), nil
+	})
+}
+
+// handleReset removes the conversation in the chat and all pet profiles of the user.
+func (s *Server) handleReset(w http.ResponseWriter, r *http.Request) {
+	s.handle(w, r, func(ctx context.Context, req *Request) (*message.Response, error) {
+		if err := s.ai.ResetUserConversation(ctx, req.UserID, req.ChatID); err != nil {
+			return nil, fmt.Errorf("failed to reset user conversation: %w", err)
+		}
+
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Your conversation and pet profiles have been removed."), nil), nil
+	})
+}
+
+// handle decodes the request, scopes its IDs to the client of the API key, localizes the context for the language
+// of the user, runs fn and writes its response.
+// Errors are mapped to status codes and localized messages the same way the bot reports them to Telegram users.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request, fn func(ctx context.Context, req *Request) (*message.Response, error)) {
+	var req Request
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := req.validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	client, _ := r.Context().Value(clientContextKey{}).(string)
+	req.UserID, req.ChatID = scopedID(client, req.UserID), scopedID(client, req.ChatID)
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	ctx = i18n.SetLocale(ctx, s.l10n, requestLanguage(r, &req))
+
+	resp, err := fn(ctx, &req)
+
+	switch {
+	case err == nil:
+		if resp.Answers == nil {
+			resp.Answers = []string{}
+		}
+
+		writeJSON(w, http.StatusOK, resp)
+	case errors.Is(err, errBadRequest):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, message.ErrTextTooLong):
+		writeError(w, http.StatusBadRequest, i18n.GetLocale(ctx).Sprintf("I apologize, but your message is too long for me to process. Please try to make it shorter and more concise."))
+	case errors.Is(err, core.ErrRateLimit):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitUser).Inc()
+		writeError(w, http.StatusTooManyRequests, i18n.GetLocale(ctx).Sprintf("You have reached the maximum number of requests per hour. Please try again later."))
+	case errors.Is(err, core.ErrUserBudget):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitUserBudget).Inc()
+		writeError(w, http.StatusTooManyRequests, i18n.GetLocale(ctx).Sprintf("You have used up your question allowance for now. Please try again later."))
+	case errors.Is(err, core.ErrGlobalLimit):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitGlobal).Inc()
+		writeError(w, http.StatusTooManyRequests, i18n.GetLocale(ctx).Sprintf("We have reached our daily request limit. Please come back tomorrow when our budget is refreshed."))
+	case errors.Is(err, core.ErrConversationConflict):
+		writeError(w, http.StatusConflict, i18n.GetLocale(ctx).Sprintf("Your conversation was changed by another message while I was processing this one. Please send it again."))
+	default:
+		slog.ErrorContext(ctx, "Failed to handle API request", slog.String("path", r.URL.Path), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, i18n.GetLocale(ctx).Sprintf("Sorry, I encountered an error while processing your request. Please try again later."))
+	}
+}
+
+// validate checks that the request identifies the user and the chat.
+func (r *Request) validate() error {
+	if r.UserID == "" {
+		return fmt.Errorf("%w: %w", errBadRequest, message.ErrEmptyUserID)
+	}
+
+	if r.ChatID == "" {
+		return fmt.Errorf("%w: %w", errBadRequest, message.ErrEmptyChatID)
+	}
+
+	return nil
+}
+
+// newUserMessage creates the message of the user, invalid identifiers and empty text are reported as bad requests.
+func newUserMessage(userID, chatID, text string) (*message.UserMessage, error) {
+	msg, err := message.NewUserMessage(userID, chatID, text)
+
+	switch {
+	case errors.Is(err, message.ErrTextTooLong):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+
+	return msg, nil
+}
+
+// decodeImages validates and converts the images of the request, at most maxImages images are accepted.
+func decodeImages(images []Image) ([]*message.Image, error) {
+	if len(images) > maxImages {
+		return nil, fmt.Errorf("%w: no more than %d images are allowed", errBadRequest, maxImages)
+	}
+
+	result := make([]*message.Image, 0, len(images))
+
+	for i, img := range images {
+		if img.MIME == "" {
+			return nil, fmt.Errorf("%w: image %d has no mime type", errBadRequest, i+1)
+		}
+
+		if _, err := base64.StdEncoding.DecodeString(img.Data); err != nil || img.Data == "" {
+			return nil, fmt.Errorf("%w: image %d is not valid base64", errBadRequest, i+1)
+		}
+
+		result = append(result, &message.Image{MIME: img.MIME, Data: img.Data})
+	}
+
+	return result, nil
+}
+
+// requestLanguage returns the language of the request, or the preferred language of the Accept-Language header.
+func requestLanguage(r *http.Request, req *Request) string {
+	if req.Language != "" {
+		return req.Language
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return i18n.DefaultLanguage
+	}
+
+	base, _ := tags[0].Base()
+
+	return base.String()
+}
+
+// writeError writes the error message as JSON with the status code.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, ErrorResponse{Error: msg})
+}
+
+// writeJSON writes the value as JSON with the status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("Failed to write API response", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Handlers(t *testing.T) {
+	tests := []struct {
+		setup      func(ai *MockAIProvider)
+		name       string
+		path       string
+		body       string
+		language   string
+		wantBody   string
+		wantStatus int
+	}{
+		{
+			name: "message with image",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"What is this rash?","images":[{"mime":"image/jpeg","data":"aGVsbG8="}]}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+					UserID: "api:web:u1",
+					ChatID: "api:web:c1",
+					Text:   "What is this rash?",
+					Images: []*message.Image{{MIME: "image/jpeg", Data: "aGVsbG8="}},
+				}).Return(&message.Response{Message: "Since when?", Answers: []string{"Today", "Last week"}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Since when?","answers":["Today","Last week"]}`,
+		},
+		{
+			name: "final answer",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"My dog ate chocolate"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).
+					Return(&message.Response{Message: "Go to a vet now.", Urgency: message.UrgencyEmergency}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Go to a vet now.","answers":[],"urgency":"emergency"}`,
+		},
+		{
+			name:       "missing chat",
+			path:       "/v1/messages",
+			body:       `{"user_id":"u1","text":"Hi"}`,
+			setup:      func(_ *MockAIProvider) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"bad request: empty chat id"}`,
+		},
+		{
+			name:       "empty text",
+			path:       "/v1/messages",
+			body:       `{"user_id":"u1","chat_id":"c1"}`,
+			setup:      func(_ *MockAIProvider) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"bad request: empty text"}`,
+		},
+		{
+			name:       "invalid image",
+			path:       "/v1/messages",
+			body:       `{"user_id":"u1","chat_id":"c1","text":"Hi","images":[{"mime":"image/jpeg","data":"not base64!"}]}`,
+			setup:      func(_ *MockAIProvider) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"bad request: image 1 is not valid base64"}`,
+		},
+		{
+			name:       "invalid body",
+			path:       "/v1/messages",
+			body:       `{`,
+			setup:      func(_ *MockAIProvider) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+		{
+			name:       "text too long",
+			path:       "/v1/messages",
+			body:       `{"user_id":"u1","chat_id":"c1","text":"` + strings.Repeat("a", message.MaxTextLength+1) + `"}`,
+			setup:      func(_ *MockAIProvider) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"I apologize, but your message is too long for me to process. Please try to make it shorter and more concise."}`,
+		},
+		{
+			name: "rate limited",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, core.ErrRateLimit)
+			},
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   `{"error":"You have reached the maximum number of requests per hour. Please try again later."}`,
+		},
+		{
+			name: "user budget exhausted",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, core.ErrUserBudget)
+			},
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   `{"error":"You have used up your question allowance for now. Please try again later."}`,
+		},
+		{
+			name: "global limit",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, core.ErrGlobalLimit)
+			},
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   `{"error":"We have reached our daily request limit. Please come back tomorrow when our budget is refreshed."}`,
+		},
+		{
+			name: "conversation conflict",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, core.ErrConversationConflict)
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error":"Your conversation was changed by another message while I was processing this one. Please send it again."}`,
+		},
+		{
+			name: "service fails",
+			path: "/v1/messages",
+			body: `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"Sorry, I encountered an error while processing your request. Please try again later."}`,
+		},
+		{
+			name:     "localized error",
+			path:     "/v1/messages",
+			body:     `{"user_id":"u1","chat_id":"c1","text":"Hi"}`,
+			language: "es-ES,es;q=0.9,en;q=0.8",
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"Lo siento, encontré un error al procesar su solicitud. Por favor, inténtelo de nuevo más tarde."}`,
+		},
+		{
+			name: "edit profile",
+			path: "/v1/profile/edit",
+			body: `{"user_id":"u1","chat_id":"c1"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ProcessEditProfile(mock.Anything, &message.UserMessage{UserID: "api:web:u1", ChatID: "api:web:c1", Text: "/editprofile"}).
+					Return(&message.Response{Message: "What is your pet's name?"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"What is your pet's name?","answers":[]}`,
+		},
+		{
+			name: "cancel questionnaire",
+			path: "/v1/questionnaire/cancel",
+			body: `{"user_id":"u1","chat_id":"c1"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().CancelQuestionnaire(mock.Anything, "api:web:c1").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Questionary is cancelled","answers":[]}`,
+		},
+		{
+			name: "reset",
+			path: "/v1/conversation/reset",
+			body: `{"user_id":"u1","chat_id":"c1"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ResetUserConversation(mock.Anything, "api:web:u1", "api:web:c1").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Your conversation and pet profiles have been removed.","answers":[]}`,
+		},
+		{
+			name: "reset fails",
+			path: "/v1/conversation/reset",
+			body: `{"user_id":"u1","chat_id":"c1"}`,
+			setup: func(ai *MockAIProvider) {
+				ai.EXPECT().ResetUserConversation(mock.Anything, "api:web:u1", "api:web:c1").Return(assert.AnError)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"Sorry, I encountered an error while processing your request. Please try again later."}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai := NewMockAIProvider(t)
+			tt.setup(ai)
+
+			srv, err := New(Config{Listen: ":8081", Keys: []Key{{Client: "web", Key: "key"}}}, ai)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer key")
+
+			if tt.language != "" {
+				req.Header.Set("Accept-Language", tt.language)
+			}
+
+			rec := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestRequestLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		language string
+		want     string
+	}{
+		{name: "request language", language: "fr", header: "es", want: "fr"},
+		{name: "accept language", header: "de-DE,de;q=0.9", want: "de"},
+		{name: "default", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.Header.Set("Accept-Language", tt.header)
+
+			assert.Equal(t, tt.want, requestLanguage(r, &Request{Language: tt.language}))
+		})
+	}
+}
+
+func TestServer_ClientIsolation(t *testing.T) {
+	ctx := context.Background()
+	convRepo := memory.NewConversationRepository()
+	profileRepo := memory.NewPetProfileRepository()
+
+	// Pet profiles of the Telegram user 42 and of the user 42 of the mobile app
+	require.NoError(t, profileRepo.AddProfile(ctx, "42", &pet.Profile{Name: "Rex"}))
+	require.NoError(t, profileRepo.AddProfile(ctx, "api:app:42", &pet.Profile{Name: "Luna"}))
+
+	srv, err := New(Config{Listen: ":8081", Keys: []Key{{Client: "web", Key: "web-key"}, {Client: "app", Key: "app-key"}}},
+		core.NewAIService(nil, convRepo, profileRepo, nil))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/conversation/reset", strings.NewReader(`{"user_id":"42","chat_id":"42"}`))
+	req.Header.Set("Authorization", "Bearer web-key")
+
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	profiles, err := profileRepo.GetProfiles(ctx, "42")
+	require.NoError(t, err, "pet profiles of the Telegram user are kept")
+	assert.Equal(t, "Rex", profiles.Profiles[0].Name)
+
+	profiles, err = profileRepo.GetProfiles(ctx, "api:app:42")
+	require.NoError(t, err, "pet profiles of another client are kept")
+	assert.Equal(t, "Luna", profiles.Profiles[0].Name)
+}
@@ -0,0 +1,141 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+)
+
+// quotasDisabledText is the reply to admin commands not supported by the configured rate limiter
+const quotasDisabledText = "The configured rate limiter doesn't support this command."
+
+// handleStats reports today's usage of the bot, errors since the start and the global spend, it is an admin command.
+// Returns the report message or an error if fetching the statistics fails.
+func (s *ServiceImpl) handleStats(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	stats, err := s.AISvc.GetStats(ctx)
+	if errors.Is(err, core.ErrQuotasDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, quotasDisabledText), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to get stats: %w", err)
+	}
+
+	var b strings.Builder
+
+	b.WriteString("Bot stats\n")
+	fmt.Fprintf(&b, "Active users today: %d\n", stats.ActiveUsers)
+	fmt.Fprintf(&b, "Questions today: %d\n", stats.QuestionsAsked)
+	fmt.Fprintf(&b, "Errors since start: %.0f", metrics.Total(metrics.HandlerErrors))
+
+	if stats.Spend != nil {
+		fmt.Fprintf(&b, "\nSpend today: %s", spendAmount(stats.Spend.GlobalDaily, stats.Spend.GlobalDailyLimit))
+		fmt.Fprintf(&b, "\nSpend this month: %s", spendAmount(stats.Spend.GlobalMonthly, stats.Spend.GlobalMonthlyLimit))
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, b.String()), nil
+}
+
+// handleWhitelist adds a user to the whitelist exempt from rate limits or removes the user from it,
+// e.g. /whitelist add 123456, it is an admin command.
+// Returns the confirmation message or an error if updating the whitelist fails.
+func (s *ServiceImpl) handleWhitelist(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	const usage = "Usage: /whitelist add|remove <user id>"
+
+	args := strings.Fields(msg.CommandArguments())
+	if len(args) != 2 || !isUserID(args[1]) {
+		return tgbotapi.NewMessage(msg.Chat.ID, usage), nil
+	}
+
+	var whitelisted bool
+
+	switch args[0] {
+	case "add":
+		whitelisted = true
+	case "remove":
+	default:
+		return tgbotapi.NewMessage(msg.Chat.ID, usage), nil
+	}
+
+	err := s.AISvc.SetWhitelisted(ctx, args[1], whitelisted)
+	if errors.Is(err, core.ErrQuotasDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, quotasDisabledText), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to update whitelist: %w", err)
+	}
+
+	if whitelisted {
+		return tgbotapi.NewMessage(msg.Chat.ID, fmt.Sprintf("User %s is whitelisted.", args[1])), nil
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, fmt.Sprintf("User %s is removed from the whitelist.", args[1])), nil
+}
+
+// handleUser reports pet profiles and the conversation state of the user, e.g. /user 123456, it is an admin command.
+// Returns the report message or an error if fetching the user's data fails.
+func (s *ServiceImpl) handleUser(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	userID := strings.TrimSpace(msg.CommandArguments())
+	if !isUserID(userID) {
+		return tgbotapi.NewMessage(msg.Chat.ID, "Usage: /user <user id>"), nil
+	}
+
+	info, err := s.AISvc.GetUserInfo(ctx, userID)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "User %s\n", userID)
+
+	if info.Profiles == nil || len(info.Profiles.Profiles) == 0 {
+		b.WriteString("Pets: none\n")
+	} else {
+		b.WriteString("Pets:\n")
+
+		for i, p := range info.Profiles.Profiles {
+			marker := "•"
+			if i == info.Profiles.Active {
+				marker = "✅"
+			}
+
+			fmt.Fprintf(&b, "%s %s (%s)\n", marker, p.Name, p.Species)
+		}
+	}
+
+	if info.State == "" {
+		b.WriteString("Conversation: none")
+	} else {
+		fmt.Fprintf(&b, "Conversation: %s, %d messages", info.State, info.Turns)
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, b.String()), nil
+}
+
+// handleResetQuota starts the rate limits and the spend of the user over, e.g. /resetquota 123456, it is an admin command.
+// Returns the confirmation message or an error if resetting fails.
+func (s *ServiceImpl) handleResetQuota(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	userID := strings.TrimSpace(msg.CommandArguments())
+	if !isUserID(userID) {
+		return tgbotapi.NewMessage(msg.Chat.ID, "Usage: /resetquota <user id>"), nil
+	}
+
+	err := s.AISvc.ResetQuota(ctx, userID)
+	if errors.Is(err, core.ErrQuotasDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, quotasDisabledText), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to reset quota: %w", err)
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, fmt.Sprintf("Quota of user %s is reset.", userID)), nil
+}
+
+// isUserID reports whether the command argument is a valid Telegram user ID.
+func isUserID(arg string) bool {
+	_, err := strconv.ParseInt(arg, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,188 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Admin(t *testing.T) {
+	tests := []struct {
+		mockSetup     func(ai *MockAIProvider)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "stats",
+			command: "/stats",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetStats(mock.Anything).Return(&core.Stats{ActiveUsers: 3, QuestionsAsked: 7}, nil)
+			},
+			expectedMsg: "Bot stats\nActive users today: 3\nQuestions today: 7\nErrors since start: ",
+		},
+		{
+			name:    "stats with spend",
+			command: "/stats",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetStats(mock.Anything).Return(&core.Stats{Spend: &budget.Spend{GlobalDaily: 2, GlobalDailyLimit: 8}}, nil)
+			},
+			expectedMsg: "Spend today: $2.00 of $8.00 (25%)\nSpend this month: $0.00 (no limit)",
+		},
+		{
+			name:    "stats not supported",
+			command: "/stats",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetStats(mock.Anything).Return(nil, core.ErrQuotasDisabled)
+			},
+			expectedMsg: quotasDisabledText,
+		},
+		{
+			name:    "stats fail",
+			command: "/stats",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetStats(mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedError: "failed to get stats: " + assert.AnError.Error(),
+		},
+		{
+			name:    "whitelist add",
+			command: "/whitelist add 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().SetWhitelisted(mock.Anything, "789", true).Return(nil)
+			},
+			expectedMsg: "User 789 is whitelisted.",
+		},
+		{
+			name:    "whitelist remove",
+			command: "/whitelist remove 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().SetWhitelisted(mock.Anything, "789", false).Return(nil)
+			},
+			expectedMsg: "User 789 is removed from the whitelist.",
+		},
+		{
+			name:        "whitelist invalid action",
+			command:     "/whitelist toggle 789",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Usage: /whitelist add|remove <user id>",
+		},
+		{
+			name:        "whitelist invalid user id",
+			command:     "/whitelist add bob",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Usage: /whitelist add|remove <user id>",
+		},
+		{
+			name:    "whitelist fails",
+			command: "/whitelist add 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().SetWhitelisted(mock.Anything, "789", true).Return(assert.AnError)
+			},
+			expectedError: "failed to update whitelist: " + assert.AnError.Error(),
+		},
+		{
+			name:    "user info",
+			command: "/user 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetUserInfo(mock.Anything, "789").Return(&core.UserInfo{
+					Profiles: &pet.Profiles{Profiles: []pet.Profile{{Name: "Max", Species: "dog"}, {Name: "Bella", Species: "cat"}}, Active: 1},
+					State:    conversation.StateFollowUpQuestioning,
+					Turns:    4,
+				}, nil)
+			},
+			expectedMsg: "User 789\nPets:\n• Max (dog)\n✅ Bella (cat)\nConversation: questioning, 4 messages",
+		},
+		{
+			name:    "unknown user info",
+			command: "/user 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetUserInfo(mock.Anything, "789").Return(&core.UserInfo{}, nil)
+			},
+			expectedMsg: "User 789\nPets: none\nConversation: none",
+		},
+		{
+			name:        "user info without id",
+			command:     "/user",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Usage: /user <user id>",
+		},
+		{
+			name:    "user info fails",
+			command: "/user 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetUserInfo(mock.Anything, "789").Return(nil, assert.AnError)
+			},
+			expectedError: "failed to get user info: " + assert.AnError.Error(),
+		},
+		{
+			name:    "reset quota",
+			command: "/resetquota 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().ResetQuota(mock.Anything, "789").Return(nil)
+			},
+			expectedMsg: "Quota of user 789 is reset.",
+		},
+		{
+			name:        "reset quota without id",
+			command:     "/resetquota",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Usage: /resetquota <user id>",
+		},
+		{
+			name:    "reset quota not supported",
+			command: "/resetquota 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().ResetQuota(mock.Anything, "789").Return(core.ErrQuotasDisabled)
+			},
+			expectedMsg: quotasDisabledText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+		})
+	}
+}
+
+func TestSetupHandler_AdminCommands(t *testing.T) {
+	mockAI := NewMockAIProvider(t)
+	mockAI.EXPECT().GetStats(mock.Anything).Return(&core.Stats{ActiveUsers: 1}, nil).Once()
+	mockAI.EXPECT().TrackChat(mock.Anything, "123", "en").Return(nil).Once()
+
+	svc := &ServiceImpl{AISvc: mockAI, adminIDs: []int64{456}}
+	handler := svc.setupHandler()
+
+	resp, err := handler.Handle(context.Background(), newCommandMessage("/stats"))
+	require.NoError(t, err)
+	assert.Contains(t, resp.Text, "Active users today: 1")
+
+	msg := newCommandMessage("/stats")
+	msg.From.ID = 789
+
+	resp, err = handler.Handle(context.Background(), msg)
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown command", resp.Text)
+}
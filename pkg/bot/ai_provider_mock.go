@@ -5,10 +5,24 @@
 package bot
 
 import (
+	broadcast "github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	budget "github.com/ksysoev/help-my-pet/pkg/core/budget"
+
 	context "context"
 
+	core "github.com/ksysoev/help-my-pet/pkg/core"
+
+	feedback "github.com/ksysoev/help-my-pet/pkg/core/feedback"
+
 	message "github.com/ksysoev/help-my-pet/pkg/core/message"
+
 	mock "github.com/stretchr/testify/mock"
+
+	pet "github.com/ksysoev/help-my-pet/pkg/core/pet"
+
+	reminder "github.com/ksysoev/help-my-pet/pkg/core/reminder"
+
+	time "time"
 )
 
 // MockAIProvider is an autogenerated mock type for the AIProvider type
@@ -24,6 +38,184 @@ func (_m *MockAIProvider) EXPECT() *MockAIProvider_Expecter {
 	return &MockAIProvider_Expecter{mock: &_m.Mock}
 }
 
+// AddFeedbackReason provides a mock function with given fields: ctx, userID, reason
+func (_m *MockAIProvider) AddFeedbackReason(ctx context.Context, userID string, reason string) (bool, error) {
+	ret := _m.Called(ctx, userID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeedbackReason")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, userID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, userID, reason)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_AddFeedbackReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeedbackReason'
+type MockAIProvider_AddFeedbackReason_Call struct {
+	*mock.Call
+}
+
+// AddFeedbackReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - reason string
+func (_e *MockAIProvider_Expecter) AddFeedbackReason(ctx interface{}, userID interface{}, reason interface{}) *MockAIProvider_AddFeedbackReason_Call {
+	return &MockAIProvider_AddFeedbackReason_Call{Call: _e.mock.On("AddFeedbackReason", ctx, userID, reason)}
+}
+
+func (_c *MockAIProvider_AddFeedbackReason_Call) Run(run func(ctx context.Context, userID string, reason string)) *MockAIProvider_AddFeedbackReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_AddFeedbackReason_Call) Return(_a0 bool, _a1 error) *MockAIProvider_AddFeedbackReason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_AddFeedbackReason_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockAIProvider_AddFeedbackReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReminder provides a mock function with given fields: ctx, request, language
+func (_m *MockAIProvider) AddReminder(ctx context.Context, request *message.UserMessage, language string) (*reminder.Reminder, error) {
+	ret := _m.Called(ctx, request, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReminder")
+	}
+
+	var r0 *reminder.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage, string) (*reminder.Reminder, error)); ok {
+		return rf(ctx, request, language)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage, string) *reminder.Reminder); ok {
+		r0 = rf(ctx, request, language)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reminder.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage, string) error); ok {
+		r1 = rf(ctx, request, language)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_AddReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReminder'
+type MockAIProvider_AddReminder_Call struct {
+	*mock.Call
+}
+
+// AddReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+//   - language string
+func (_e *MockAIProvider_Expecter) AddReminder(ctx interface{}, request interface{}, language interface{}) *MockAIProvider_AddReminder_Call {
+	return &MockAIProvider_AddReminder_Call{Call: _e.mock.On("AddReminder", ctx, request, language)}
+}
+
+func (_c *MockAIProvider_AddReminder_Call) Run(run func(ctx context.Context, request *message.UserMessage, language string)) *MockAIProvider_AddReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_AddReminder_Call) Return(_a0 *reminder.Reminder, _a1 error) *MockAIProvider_AddReminder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_AddReminder_Call) RunAndReturn(run func(context.Context, *message.UserMessage, string) (*reminder.Reminder, error)) *MockAIProvider_AddReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddWeight provides a mock function with given fields: ctx, userID, weight
+func (_m *MockAIProvider) AddWeight(ctx context.Context, userID string, weight string) (*pet.Profile, error) {
+	ret := _m.Called(ctx, userID, weight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWeight")
+	}
+
+	var r0 *pet.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*pet.Profile, error)); ok {
+		return rf(ctx, userID, weight)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *pet.Profile); ok {
+		r0 = rf(ctx, userID, weight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pet.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, weight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_AddWeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWeight'
+type MockAIProvider_AddWeight_Call struct {
+	*mock.Call
+}
+
+// AddWeight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - weight string
+func (_e *MockAIProvider_Expecter) AddWeight(ctx interface{}, userID interface{}, weight interface{}) *MockAIProvider_AddWeight_Call {
+	return &MockAIProvider_AddWeight_Call{Call: _e.mock.On("AddWeight", ctx, userID, weight)}
+}
+
+func (_c *MockAIProvider_AddWeight_Call) Run(run func(ctx context.Context, userID string, weight string)) *MockAIProvider_AddWeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_AddWeight_Call) Return(_a0 *pet.Profile, _a1 error) *MockAIProvider_AddWeight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_AddWeight_Call) RunAndReturn(run func(context.Context, string, string) (*pet.Profile, error)) *MockAIProvider_AddWeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelQuestionnaire provides a mock function with given fields: ctx, chatID
 func (_m *MockAIProvider) CancelQuestionnaire(ctx context.Context, chatID string) error {
 	ret := _m.Called(ctx, chatID)
@@ -71,88 +263,125 @@ func (_c *MockAIProvider_CancelQuestionnaire_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// ProcessEditProfile provides a mock function with given fields: ctx, request
-func (_m *MockAIProvider) ProcessEditProfile(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
-	ret := _m.Called(ctx, request)
+// CompleteReminder provides a mock function with given fields: ctx, userID, id
+func (_m *MockAIProvider) CompleteReminder(ctx context.Context, userID string, id string) error {
+	ret := _m.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProcessEditProfile")
+		panic("no return value specified for CompleteReminder")
 	}
 
-	var r0 *message.Response
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
-		return rf(ctx, request)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
-		r0 = rf(ctx, request)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*message.Response)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
-		r1 = rf(ctx, request)
+	return r0
+}
+
+// MockAIProvider_CompleteReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteReminder'
+type MockAIProvider_CompleteReminder_Call struct {
+	*mock.Call
+}
+
+// CompleteReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *MockAIProvider_Expecter) CompleteReminder(ctx interface{}, userID interface{}, id interface{}) *MockAIProvider_CompleteReminder_Call {
+	return &MockAIProvider_CompleteReminder_Call{Call: _e.mock.On("CompleteReminder", ctx, userID, id)}
+}
+
+func (_c *MockAIProvider_CompleteReminder_Call) Run(run func(ctx context.Context, userID string, id string)) *MockAIProvider_CompleteReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_CompleteReminder_Call) Return(_a0 error) *MockAIProvider_CompleteReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_CompleteReminder_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_CompleteReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReminder provides a mock function with given fields: ctx, userID, id
+func (_m *MockAIProvider) DeleteReminder(ctx context.Context, userID string, id string) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockAIProvider_ProcessEditProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessEditProfile'
-type MockAIProvider_ProcessEditProfile_Call struct {
+// MockAIProvider_DeleteReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReminder'
+type MockAIProvider_DeleteReminder_Call struct {
 	*mock.Call
 }
 
-// ProcessEditProfile is a helper method to define mock.On call
+// DeleteReminder is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *message.UserMessage
-func (_e *MockAIProvider_Expecter) ProcessEditProfile(ctx interface{}, request interface{}) *MockAIProvider_ProcessEditProfile_Call {
-	return &MockAIProvider_ProcessEditProfile_Call{Call: _e.mock.On("ProcessEditProfile", ctx, request)}
+//   - userID string
+//   - id string
+func (_e *MockAIProvider_Expecter) DeleteReminder(ctx interface{}, userID interface{}, id interface{}) *MockAIProvider_DeleteReminder_Call {
+	return &MockAIProvider_DeleteReminder_Call{Call: _e.mock.On("DeleteReminder", ctx, userID, id)}
 }
 
-func (_c *MockAIProvider_ProcessEditProfile_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessEditProfile_Call {
+func (_c *MockAIProvider_DeleteReminder_Call) Run(run func(ctx context.Context, userID string, id string)) *MockAIProvider_DeleteReminder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*message.UserMessage))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockAIProvider_ProcessEditProfile_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessEditProfile_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockAIProvider_DeleteReminder_Call) Return(_a0 error) *MockAIProvider_DeleteReminder_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAIProvider_ProcessEditProfile_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessEditProfile_Call {
+func (_c *MockAIProvider_DeleteReminder_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_DeleteReminder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ProcessMessage provides a mock function with given fields: ctx, request
-func (_m *MockAIProvider) ProcessMessage(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
-	ret := _m.Called(ctx, request)
+// GetSpend provides a mock function with given fields: ctx, userID
+func (_m *MockAIProvider) GetSpend(ctx context.Context, userID string) (*budget.Spend, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ProcessMessage")
+		panic("no return value specified for GetSpend")
 	}
 
-	var r0 *message.Response
+	var r0 *budget.Spend
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
-		return rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*budget.Spend, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
-		r0 = rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *budget.Spend); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*message.Response)
+			r0 = ret.Get(0).(*budget.Spend)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
-		r1 = rf(ctx, request)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,79 +389,1100 @@ func (_m *MockAIProvider) ProcessMessage(ctx context.Context, request *message.U
 	return r0, r1
 }
 
-// MockAIProvider_ProcessMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessMessage'
-type MockAIProvider_ProcessMessage_Call struct {
+// MockAIProvider_GetSpend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpend'
+type MockAIProvider_GetSpend_Call struct {
 	*mock.Call
 }
 
-// ProcessMessage is a helper method to define mock.On call
+// GetSpend is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *message.UserMessage
-func (_e *MockAIProvider_Expecter) ProcessMessage(ctx interface{}, request interface{}) *MockAIProvider_ProcessMessage_Call {
-	return &MockAIProvider_ProcessMessage_Call{Call: _e.mock.On("ProcessMessage", ctx, request)}
+//   - userID string
+func (_e *MockAIProvider_Expecter) GetSpend(ctx interface{}, userID interface{}) *MockAIProvider_GetSpend_Call {
+	return &MockAIProvider_GetSpend_Call{Call: _e.mock.On("GetSpend", ctx, userID)}
 }
 
-func (_c *MockAIProvider_ProcessMessage_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessMessage_Call {
+func (_c *MockAIProvider_GetSpend_Call) Run(run func(ctx context.Context, userID string)) *MockAIProvider_GetSpend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*message.UserMessage))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockAIProvider_ProcessMessage_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessMessage_Call {
+func (_c *MockAIProvider_GetSpend_Call) Return(_a0 *budget.Spend, _a1 error) *MockAIProvider_GetSpend_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAIProvider_ProcessMessage_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessMessage_Call {
+func (_c *MockAIProvider_GetSpend_Call) RunAndReturn(run func(context.Context, string) (*budget.Spend, error)) *MockAIProvider_GetSpend_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResetUserConversation provides a mock function with given fields: ctx, userID, chatID
-func (_m *MockAIProvider) ResetUserConversation(ctx context.Context, userID string, chatID string) error {
-	ret := _m.Called(ctx, userID, chatID)
+// GetStats provides a mock function with given fields: ctx
+func (_m *MockAIProvider) GetStats(ctx context.Context) (*core.Stats, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetUserConversation")
+		panic("no return value specified for GetStats")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, userID, chatID)
+	var r0 *core.Stats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*core.Stats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *core.Stats); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Stats)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockAIProvider_ResetUserConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetUserConversation'
-type MockAIProvider_ResetUserConversation_Call struct {
+// MockAIProvider_GetStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStats'
+type MockAIProvider_GetStats_Call struct {
 	*mock.Call
 }
 
-// ResetUserConversation is a helper method to define mock.On call
+// GetStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - chatID string
-func (_e *MockAIProvider_Expecter) ResetUserConversation(ctx interface{}, userID interface{}, chatID interface{}) *MockAIProvider_ResetUserConversation_Call {
-	return &MockAIProvider_ResetUserConversation_Call{Call: _e.mock.On("ResetUserConversation", ctx, userID, chatID)}
+func (_e *MockAIProvider_Expecter) GetStats(ctx interface{}) *MockAIProvider_GetStats_Call {
+	return &MockAIProvider_GetStats_Call{Call: _e.mock.On("GetStats", ctx)}
 }
 
-func (_c *MockAIProvider_ResetUserConversation_Call) Run(run func(ctx context.Context, userID string, chatID string)) *MockAIProvider_ResetUserConversation_Call {
+func (_c *MockAIProvider_GetStats_Call) Run(run func(ctx context.Context)) *MockAIProvider_GetStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockAIProvider_ResetUserConversation_Call) Return(_a0 error) *MockAIProvider_ResetUserConversation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockAIProvider_GetStats_Call) Return(_a0 *core.Stats, _a1 error) *MockAIProvider_GetStats_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAIProvider_ResetUserConversation_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_ResetUserConversation_Call {
+func (_c *MockAIProvider_GetStats_Call) RunAndReturn(run func(context.Context) (*core.Stats, error)) *MockAIProvider_GetStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserInfo provides a mock function with given fields: ctx, userID
+func (_m *MockAIProvider) GetUserInfo(ctx context.Context, userID string) (*core.UserInfo, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserInfo")
+	}
+
+	var r0 *core.UserInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*core.UserInfo, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *core.UserInfo); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.UserInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_GetUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInfo'
+type MockAIProvider_GetUserInfo_Call struct {
+	*mock.Call
+}
+
+// GetUserInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAIProvider_Expecter) GetUserInfo(ctx interface{}, userID interface{}) *MockAIProvider_GetUserInfo_Call {
+	return &MockAIProvider_GetUserInfo_Call{Call: _e.mock.On("GetUserInfo", ctx, userID)}
+}
+
+func (_c *MockAIProvider_GetUserInfo_Call) Run(run func(ctx context.Context, userID string)) *MockAIProvider_GetUserInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_GetUserInfo_Call) Return(_a0 *core.UserInfo, _a1 error) *MockAIProvider_GetUserInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_GetUserInfo_Call) RunAndReturn(run func(context.Context, string) (*core.UserInfo, error)) *MockAIProvider_GetUserInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPets provides a mock function with given fields: ctx, userID
+func (_m *MockAIProvider) ListPets(ctx context.Context, userID string) (*pet.Profiles, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPets")
+	}
+
+	var r0 *pet.Profiles
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pet.Profiles, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pet.Profiles); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pet.Profiles)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ListPets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPets'
+type MockAIProvider_ListPets_Call struct {
+	*mock.Call
+}
+
+// ListPets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAIProvider_Expecter) ListPets(ctx interface{}, userID interface{}) *MockAIProvider_ListPets_Call {
+	return &MockAIProvider_ListPets_Call{Call: _e.mock.On("ListPets", ctx, userID)}
+}
+
+func (_c *MockAIProvider_ListPets_Call) Run(run func(ctx context.Context, userID string)) *MockAIProvider_ListPets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ListPets_Call) Return(_a0 *pet.Profiles, _a1 error) *MockAIProvider_ListPets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ListPets_Call) RunAndReturn(run func(context.Context, string) (*pet.Profiles, error)) *MockAIProvider_ListPets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReminders provides a mock function with given fields: ctx, userID
+func (_m *MockAIProvider) ListReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReminders")
+	}
+
+	var r0 []*reminder.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*reminder.Reminder, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*reminder.Reminder); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reminder.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ListReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReminders'
+type MockAIProvider_ListReminders_Call struct {
+	*mock.Call
+}
+
+// ListReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAIProvider_Expecter) ListReminders(ctx interface{}, userID interface{}) *MockAIProvider_ListReminders_Call {
+	return &MockAIProvider_ListReminders_Call{Call: _e.mock.On("ListReminders", ctx, userID)}
+}
+
+func (_c *MockAIProvider_ListReminders_Call) Run(run func(ctx context.Context, userID string)) *MockAIProvider_ListReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ListReminders_Call) Return(_a0 []*reminder.Reminder, _a1 error) *MockAIProvider_ListReminders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ListReminders_Call) RunAndReturn(run func(context.Context, string) ([]*reminder.Reminder, error)) *MockAIProvider_ListReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewBroadcast provides a mock function with given fields: ctx, b
+func (_m *MockAIProvider) PreviewBroadcast(ctx context.Context, b *broadcast.Broadcast) (map[string]int, error) {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewBroadcast")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *broadcast.Broadcast) (map[string]int, error)); ok {
+		return rf(ctx, b)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *broadcast.Broadcast) map[string]int); ok {
+		r0 = rf(ctx, b)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *broadcast.Broadcast) error); ok {
+		r1 = rf(ctx, b)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_PreviewBroadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewBroadcast'
+type MockAIProvider_PreviewBroadcast_Call struct {
+	*mock.Call
+}
+
+// PreviewBroadcast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b *broadcast.Broadcast
+func (_e *MockAIProvider_Expecter) PreviewBroadcast(ctx interface{}, b interface{}) *MockAIProvider_PreviewBroadcast_Call {
+	return &MockAIProvider_PreviewBroadcast_Call{Call: _e.mock.On("PreviewBroadcast", ctx, b)}
+}
+
+func (_c *MockAIProvider_PreviewBroadcast_Call) Run(run func(ctx context.Context, b *broadcast.Broadcast)) *MockAIProvider_PreviewBroadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*broadcast.Broadcast))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_PreviewBroadcast_Call) Return(_a0 map[string]int, _a1 error) *MockAIProvider_PreviewBroadcast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_PreviewBroadcast_Call) RunAndReturn(run func(context.Context, *broadcast.Broadcast) (map[string]int, error)) *MockAIProvider_PreviewBroadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessAddPet provides a mock function with given fields: ctx, request
+func (_m *MockAIProvider) ProcessAddPet(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessAddPet")
+	}
+
+	var r0 *message.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ProcessAddPet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessAddPet'
+type MockAIProvider_ProcessAddPet_Call struct {
+	*mock.Call
+}
+
+// ProcessAddPet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+func (_e *MockAIProvider_Expecter) ProcessAddPet(ctx interface{}, request interface{}) *MockAIProvider_ProcessAddPet_Call {
+	return &MockAIProvider_ProcessAddPet_Call{Call: _e.mock.On("ProcessAddPet", ctx, request)}
+}
+
+func (_c *MockAIProvider_ProcessAddPet_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessAddPet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessAddPet_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessAddPet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessAddPet_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessAddPet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessAddVaccination provides a mock function with given fields: ctx, request
+func (_m *MockAIProvider) ProcessAddVaccination(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessAddVaccination")
+	}
+
+	var r0 *message.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ProcessAddVaccination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessAddVaccination'
+type MockAIProvider_ProcessAddVaccination_Call struct {
+	*mock.Call
+}
+
+// ProcessAddVaccination is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+func (_e *MockAIProvider_Expecter) ProcessAddVaccination(ctx interface{}, request interface{}) *MockAIProvider_ProcessAddVaccination_Call {
+	return &MockAIProvider_ProcessAddVaccination_Call{Call: _e.mock.On("ProcessAddVaccination", ctx, request)}
+}
+
+func (_c *MockAIProvider_ProcessAddVaccination_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessAddVaccination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessAddVaccination_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessAddVaccination_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessAddVaccination_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessAddVaccination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessEditProfile provides a mock function with given fields: ctx, request
+func (_m *MockAIProvider) ProcessEditProfile(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessEditProfile")
+	}
+
+	var r0 *message.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ProcessEditProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessEditProfile'
+type MockAIProvider_ProcessEditProfile_Call struct {
+	*mock.Call
+}
+
+// ProcessEditProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+func (_e *MockAIProvider_Expecter) ProcessEditProfile(ctx interface{}, request interface{}) *MockAIProvider_ProcessEditProfile_Call {
+	return &MockAIProvider_ProcessEditProfile_Call{Call: _e.mock.On("ProcessEditProfile", ctx, request)}
+}
+
+func (_c *MockAIProvider_ProcessEditProfile_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessEditProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessEditProfile_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessEditProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessEditProfile_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessEditProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessMessage provides a mock function with given fields: ctx, request
+func (_m *MockAIProvider) ProcessMessage(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessMessage")
+	}
+
+	var r0 *message.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) (*message.Response, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage) *message.Response); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ProcessMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessMessage'
+type MockAIProvider_ProcessMessage_Call struct {
+	*mock.Call
+}
+
+// ProcessMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+func (_e *MockAIProvider_Expecter) ProcessMessage(ctx interface{}, request interface{}) *MockAIProvider_ProcessMessage_Call {
+	return &MockAIProvider_ProcessMessage_Call{Call: _e.mock.On("ProcessMessage", ctx, request)}
+}
+
+func (_c *MockAIProvider_ProcessMessage_Call) Run(run func(ctx context.Context, request *message.UserMessage)) *MockAIProvider_ProcessMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessMessage_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessMessage_Call) RunAndReturn(run func(context.Context, *message.UserMessage) (*message.Response, error)) *MockAIProvider_ProcessMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessMessageStream provides a mock function with given fields: ctx, request, onText
+func (_m *MockAIProvider) ProcessMessageStream(ctx context.Context, request *message.UserMessage, onText func(string)) (*message.Response, error) {
+	ret := _m.Called(ctx, request, onText)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessMessageStream")
+	}
+
+	var r0 *message.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage, func(string)) (*message.Response, error)); ok {
+		return rf(ctx, request, onText)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *message.UserMessage, func(string)) *message.Response); ok {
+		r0 = rf(ctx, request, onText)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*message.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *message.UserMessage, func(string)) error); ok {
+		r1 = rf(ctx, request, onText)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAIProvider_ProcessMessageStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessMessageStream'
+type MockAIProvider_ProcessMessageStream_Call struct {
+	*mock.Call
+}
+
+// ProcessMessageStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *message.UserMessage
+//   - onText func(string)
+func (_e *MockAIProvider_Expecter) ProcessMessageStream(ctx interface{}, request interface{}, onText interface{}) *MockAIProvider_ProcessMessageStream_Call {
+	return &MockAIProvider_ProcessMessageStream_Call{Call: _e.mock.On("ProcessMessageStream", ctx, request, onText)}
+}
+
+func (_c *MockAIProvider_ProcessMessageStream_Call) Run(run func(ctx context.Context, request *message.UserMessage, onText func(string))) *MockAIProvider_ProcessMessageStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*message.UserMessage), args[2].(func(string)))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessMessageStream_Call) Return(_a0 *message.Response, _a1 error) *MockAIProvider_ProcessMessageStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAIProvider_ProcessMessageStream_Call) RunAndReturn(run func(context.Context, *message.UserMessage, func(string)) (*message.Response, error)) *MockAIProvider_ProcessMessageStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RateAnswer provides a mock function with given fields: ctx, userID, answerID, rating
+func (_m *MockAIProvider) RateAnswer(ctx context.Context, userID string, answerID string, rating feedback.Rating) error {
+	ret := _m.Called(ctx, userID, answerID, rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateAnswer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, feedback.Rating) error); ok {
+		r0 = rf(ctx, userID, answerID, rating)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_RateAnswer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RateAnswer'
+type MockAIProvider_RateAnswer_Call struct {
+	*mock.Call
+}
+
+// RateAnswer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - answerID string
+//   - rating feedback.Rating
+func (_e *MockAIProvider_Expecter) RateAnswer(ctx interface{}, userID interface{}, answerID interface{}, rating interface{}) *MockAIProvider_RateAnswer_Call {
+	return &MockAIProvider_RateAnswer_Call{Call: _e.mock.On("RateAnswer", ctx, userID, answerID, rating)}
+}
+
+func (_c *MockAIProvider_RateAnswer_Call) Run(run func(ctx context.Context, userID string, answerID string, rating feedback.Rating)) *MockAIProvider_RateAnswer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(feedback.Rating))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_RateAnswer_Call) Return(_a0 error) *MockAIProvider_RateAnswer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_RateAnswer_Call) RunAndReturn(run func(context.Context, string, string, feedback.Rating) error) *MockAIProvider_RateAnswer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveChat provides a mock function with given fields: ctx, chatID
+func (_m *MockAIProvider) RemoveChat(ctx context.Context, chatID string) error {
+	ret := _m.Called(ctx, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, chatID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_RemoveChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveChat'
+type MockAIProvider_RemoveChat_Call struct {
+	*mock.Call
+}
+
+// RemoveChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID string
+func (_e *MockAIProvider_Expecter) RemoveChat(ctx interface{}, chatID interface{}) *MockAIProvider_RemoveChat_Call {
+	return &MockAIProvider_RemoveChat_Call{Call: _e.mock.On("RemoveChat", ctx, chatID)}
+}
+
+func (_c *MockAIProvider_RemoveChat_Call) Run(run func(ctx context.Context, chatID string)) *MockAIProvider_RemoveChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_RemoveChat_Call) Return(_a0 error) *MockAIProvider_RemoveChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_RemoveChat_Call) RunAndReturn(run func(context.Context, string) error) *MockAIProvider_RemoveChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePet provides a mock function with given fields: ctx, userID, name
+func (_m *MockAIProvider) RemovePet(ctx context.Context, userID string, name string) error {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_RemovePet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePet'
+type MockAIProvider_RemovePet_Call struct {
+	*mock.Call
+}
+
+// RemovePet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockAIProvider_Expecter) RemovePet(ctx interface{}, userID interface{}, name interface{}) *MockAIProvider_RemovePet_Call {
+	return &MockAIProvider_RemovePet_Call{Call: _e.mock.On("RemovePet", ctx, userID, name)}
+}
+
+func (_c *MockAIProvider_RemovePet_Call) Run(run func(ctx context.Context, userID string, name string)) *MockAIProvider_RemovePet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_RemovePet_Call) Return(_a0 error) *MockAIProvider_RemovePet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_RemovePet_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_RemovePet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetQuota provides a mock function with given fields: ctx, userID
+func (_m *MockAIProvider) ResetQuota(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_ResetQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetQuota'
+type MockAIProvider_ResetQuota_Call struct {
+	*mock.Call
+}
+
+// ResetQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAIProvider_Expecter) ResetQuota(ctx interface{}, userID interface{}) *MockAIProvider_ResetQuota_Call {
+	return &MockAIProvider_ResetQuota_Call{Call: _e.mock.On("ResetQuota", ctx, userID)}
+}
+
+func (_c *MockAIProvider_ResetQuota_Call) Run(run func(ctx context.Context, userID string)) *MockAIProvider_ResetQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ResetQuota_Call) Return(_a0 error) *MockAIProvider_ResetQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_ResetQuota_Call) RunAndReturn(run func(context.Context, string) error) *MockAIProvider_ResetQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetUserConversation provides a mock function with given fields: ctx, userID, chatID
+func (_m *MockAIProvider) ResetUserConversation(ctx context.Context, userID string, chatID string) error {
+	ret := _m.Called(ctx, userID, chatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetUserConversation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, chatID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_ResetUserConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetUserConversation'
+type MockAIProvider_ResetUserConversation_Call struct {
+	*mock.Call
+}
+
+// ResetUserConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - chatID string
+func (_e *MockAIProvider_Expecter) ResetUserConversation(ctx interface{}, userID interface{}, chatID interface{}) *MockAIProvider_ResetUserConversation_Call {
+	return &MockAIProvider_ResetUserConversation_Call{Call: _e.mock.On("ResetUserConversation", ctx, userID, chatID)}
+}
+
+func (_c *MockAIProvider_ResetUserConversation_Call) Run(run func(ctx context.Context, userID string, chatID string)) *MockAIProvider_ResetUserConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_ResetUserConversation_Call) Return(_a0 error) *MockAIProvider_ResetUserConversation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_ResetUserConversation_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_ResetUserConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWhitelisted provides a mock function with given fields: ctx, userID, whitelisted
+func (_m *MockAIProvider) SetWhitelisted(ctx context.Context, userID string, whitelisted bool) error {
+	ret := _m.Called(ctx, userID, whitelisted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWhitelisted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, userID, whitelisted)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_SetWhitelisted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWhitelisted'
+type MockAIProvider_SetWhitelisted_Call struct {
+	*mock.Call
+}
+
+// SetWhitelisted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - whitelisted bool
+func (_e *MockAIProvider_Expecter) SetWhitelisted(ctx interface{}, userID interface{}, whitelisted interface{}) *MockAIProvider_SetWhitelisted_Call {
+	return &MockAIProvider_SetWhitelisted_Call{Call: _e.mock.On("SetWhitelisted", ctx, userID, whitelisted)}
+}
+
+func (_c *MockAIProvider_SetWhitelisted_Call) Run(run func(ctx context.Context, userID string, whitelisted bool)) *MockAIProvider_SetWhitelisted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_SetWhitelisted_Call) Return(_a0 error) *MockAIProvider_SetWhitelisted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_SetWhitelisted_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockAIProvider_SetWhitelisted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnoozeReminder provides a mock function with given fields: ctx, userID, id, d
+func (_m *MockAIProvider) SnoozeReminder(ctx context.Context, userID string, id string, d time.Duration) error {
+	ret := _m.Called(ctx, userID, id, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnoozeReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, userID, id, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_SnoozeReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnoozeReminder'
+type MockAIProvider_SnoozeReminder_Call struct {
+	*mock.Call
+}
+
+// SnoozeReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+//   - d time.Duration
+func (_e *MockAIProvider_Expecter) SnoozeReminder(ctx interface{}, userID interface{}, id interface{}, d interface{}) *MockAIProvider_SnoozeReminder_Call {
+	return &MockAIProvider_SnoozeReminder_Call{Call: _e.mock.On("SnoozeReminder", ctx, userID, id, d)}
+}
+
+func (_c *MockAIProvider_SnoozeReminder_Call) Run(run func(ctx context.Context, userID string, id string, d time.Duration)) *MockAIProvider_SnoozeReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_SnoozeReminder_Call) Return(_a0 error) *MockAIProvider_SnoozeReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_SnoozeReminder_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) error) *MockAIProvider_SnoozeReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartBroadcast provides a mock function with given fields: ctx, b
+func (_m *MockAIProvider) StartBroadcast(ctx context.Context, b *broadcast.Broadcast) error {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartBroadcast")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *broadcast.Broadcast) error); ok {
+		r0 = rf(ctx, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_StartBroadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartBroadcast'
+type MockAIProvider_StartBroadcast_Call struct {
+	*mock.Call
+}
+
+// StartBroadcast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b *broadcast.Broadcast
+func (_e *MockAIProvider_Expecter) StartBroadcast(ctx interface{}, b interface{}) *MockAIProvider_StartBroadcast_Call {
+	return &MockAIProvider_StartBroadcast_Call{Call: _e.mock.On("StartBroadcast", ctx, b)}
+}
+
+func (_c *MockAIProvider_StartBroadcast_Call) Run(run func(ctx context.Context, b *broadcast.Broadcast)) *MockAIProvider_StartBroadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*broadcast.Broadcast))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_StartBroadcast_Call) Return(_a0 error) *MockAIProvider_StartBroadcast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_StartBroadcast_Call) RunAndReturn(run func(context.Context, *broadcast.Broadcast) error) *MockAIProvider_StartBroadcast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchPet provides a mock function with given fields: ctx, userID, name
+func (_m *MockAIProvider) SwitchPet(ctx context.Context, userID string, name string) error {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwitchPet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_SwitchPet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchPet'
+type MockAIProvider_SwitchPet_Call struct {
+	*mock.Call
+}
+
+// SwitchPet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockAIProvider_Expecter) SwitchPet(ctx interface{}, userID interface{}, name interface{}) *MockAIProvider_SwitchPet_Call {
+	return &MockAIProvider_SwitchPet_Call{Call: _e.mock.On("SwitchPet", ctx, userID, name)}
+}
+
+func (_c *MockAIProvider_SwitchPet_Call) Run(run func(ctx context.Context, userID string, name string)) *MockAIProvider_SwitchPet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_SwitchPet_Call) Return(_a0 error) *MockAIProvider_SwitchPet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_SwitchPet_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_SwitchPet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrackChat provides a mock function with given fields: ctx, chatID, language
+func (_m *MockAIProvider) TrackChat(ctx context.Context, chatID string, language string) error {
+	ret := _m.Called(ctx, chatID, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, chatID, language)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAIProvider_TrackChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackChat'
+type MockAIProvider_TrackChat_Call struct {
+	*mock.Call
+}
+
+// TrackChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID string
+//   - language string
+func (_e *MockAIProvider_Expecter) TrackChat(ctx interface{}, chatID interface{}, language interface{}) *MockAIProvider_TrackChat_Call {
+	return &MockAIProvider_TrackChat_Call{Call: _e.mock.On("TrackChat", ctx, chatID, language)}
+}
+
+func (_c *MockAIProvider_TrackChat_Call) Run(run func(ctx context.Context, chatID string, language string)) *MockAIProvider_TrackChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAIProvider_TrackChat_Call) Return(_a0 error) *MockAIProvider_TrackChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAIProvider_TrackChat_Call) RunAndReturn(run func(context.Context, string, string) error) *MockAIProvider_TrackChat_Call {
 	_c.Call.Return(run)
 	return _c
 }
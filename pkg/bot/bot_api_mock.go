@@ -126,6 +126,65 @@ func (_c *MockBotAPI_GetUpdatesChan_Call) RunAndReturn(run func(tgbotapi.UpdateC
 	return _c
 }
 
+// MakeRequest provides a mock function with given fields: endpoint, params
+func (_m *MockBotAPI) MakeRequest(endpoint string, params tgbotapi.Params) (*tgbotapi.APIResponse, error) {
+	ret := _m.Called(endpoint, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeRequest")
+	}
+
+	var r0 *tgbotapi.APIResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, tgbotapi.Params) (*tgbotapi.APIResponse, error)); ok {
+		return rf(endpoint, params)
+	}
+	if rf, ok := ret.Get(0).(func(string, tgbotapi.Params) *tgbotapi.APIResponse); ok {
+		r0 = rf(endpoint, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tgbotapi.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, tgbotapi.Params) error); ok {
+		r1 = rf(endpoint, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBotAPI_MakeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeRequest'
+type MockBotAPI_MakeRequest_Call struct {
+	*mock.Call
+}
+
+// MakeRequest is a helper method to define mock.On call
+//   - endpoint string
+//   - params tgbotapi.Params
+func (_e *MockBotAPI_Expecter) MakeRequest(endpoint interface{}, params interface{}) *MockBotAPI_MakeRequest_Call {
+	return &MockBotAPI_MakeRequest_Call{Call: _e.mock.On("MakeRequest", endpoint, params)}
+}
+
+func (_c *MockBotAPI_MakeRequest_Call) Run(run func(endpoint string, params tgbotapi.Params)) *MockBotAPI_MakeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(tgbotapi.Params))
+	})
+	return _c
+}
+
+func (_c *MockBotAPI_MakeRequest_Call) Return(_a0 *tgbotapi.APIResponse, _a1 error) *MockBotAPI_MakeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBotAPI_MakeRequest_Call) RunAndReturn(run func(string, tgbotapi.Params) (*tgbotapi.APIResponse, error)) *MockBotAPI_MakeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Request provides a mock function with given fields: c
 func (_m *MockBotAPI) Request(c tgbotapi.Chattable) (*tgbotapi.APIResponse, error) {
 	ret := _m.Called(c)
@@ -0,0 +1,129 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+)
+
+const (
+	// dryRunFlag is the argument of the broadcast command reporting recipients without sending the message
+	dryRunFlag = "--dry-run"
+
+	broadcastUsage = `Usage: /broadcast [--dry-run] <message>
+Add language variants on the following lines, each starting with a line like [es]:
+/broadcast We are back online!
+[es]
+¡Volvemos a estar en línea!`
+)
+
+// handleBroadcast queues the message for delivery to all known chats, it is an admin command.
+// With --dry-run it only reports how many chats would receive each language variant.
+// Returns the confirmation message or an error if queueing the broadcast fails.
+func (s *ServiceImpl) handleBroadcast(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	definition, dryRun := parseBroadcastArgs(msg.CommandArguments())
+
+	b, err := broadcast.New(definition, time.Now())
+	switch {
+	case errors.Is(err, broadcast.ErrEmptyText):
+		return tgbotapi.NewMessage(msg.Chat.ID, broadcastUsage), nil
+	case errors.Is(err, broadcast.ErrTextTooLong):
+		return tgbotapi.NewMessage(msg.Chat.ID, "The message is too long, Telegram messages are limited to 4096 characters."), nil
+	case err != nil:
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to create broadcast: %w", err)
+	}
+
+	if dryRun {
+		recipients, err := s.AISvc.PreviewBroadcast(ctx, b)
+		if errors.Is(err, core.ErrBroadcastsDisabled) {
+			return tgbotapi.NewMessage(msg.Chat.ID, "Broadcasts are not configured."), nil
+		} else if err != nil {
+			return tgbotapi.MessageConfig{}, fmt.Errorf("failed to preview broadcast: %w", err)
+		}
+
+		return tgbotapi.NewMessage(msg.Chat.ID, BroadcastPreview(b, recipients)), nil
+	}
+
+	err = s.AISvc.StartBroadcast(ctx, b)
+	if errors.Is(err, core.ErrBroadcastsDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, "Broadcasts are not configured."), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to start broadcast: %w", err)
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, fmt.Sprintf("Broadcast %s is queued for %d chats.", b.ID, b.Total)), nil
+}
+
+// SendBroadcast sends the broadcast message to the chat.
+// Returns core.ErrChatUnavailable if the bot was blocked or the chat doesn't exist anymore,
+// core.FloodError if Telegram asks to retry later, or an error if sending the message fails otherwise.
+func (s *ServiceImpl) SendBroadcast(_ context.Context, chat broadcast.Chat, text string) error {
+	chatID, err := strconv.ParseInt(chat.ID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid broadcast chat id %q: %w", chat.ID, err)
+	}
+
+	_, err = s.Bot.Send(tgbotapi.NewMessage(chatID, text))
+
+	var apiErr *tgbotapi.Error
+
+	switch {
+	case err == nil:
+		metrics.BroadcastMessages.WithLabelValues(metrics.BroadcastSent).Inc()
+		return nil
+	case errors.As(err, &apiErr) && apiErr.Code == http.StatusTooManyRequests:
+		return &core.FloodError{RetryAfter: time.Duration(apiErr.RetryAfter) * time.Second}
+	case errors.As(err, &apiErr) && (apiErr.Code == http.StatusForbidden || strings.Contains(apiErr.Message, "chat not found")):
+		metrics.BroadcastMessages.WithLabelValues(metrics.BroadcastFailed).Inc()
+		return fmt.Errorf("%w: %s", core.ErrChatUnavailable, apiErr.Message)
+	default:
+		metrics.BroadcastMessages.WithLabelValues(metrics.BroadcastFailed).Inc()
+		return fmt.Errorf("failed to send broadcast: %w", err)
+	}
+}
+
+// BroadcastPreview formats the dry run report of the broadcast: the total number of recipients
+// and the number of chats receiving each language variant.
+func BroadcastPreview(b *broadcast.Broadcast, recipients map[string]int) string {
+	languages := make([]string, 0, len(b.Variants))
+	total := recipients[""]
+
+	for language := range b.Variants {
+		languages = append(languages, language)
+		total += recipients[language]
+	}
+
+	slices.Sort(languages)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Dry run, nothing is sent.\nRecipients: %d\n- default: %d", total, recipients[""])
+
+	for _, language := range languages {
+		fmt.Fprintf(&sb, "\n- %s: %d", language, recipients[language])
+	}
+
+	return sb.String()
+}
+
+// parseBroadcastArgs splits the arguments of the broadcast command into the message definition and the dry run flag.
+func parseBroadcastArgs(args string) (definition string, dryRun bool) {
+	args = strings.TrimSpace(args)
+
+	fields := strings.Fields(args)
+	if len(fields) == 0 || fields[0] != dryRunFlag {
+		return args, false
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(args, dryRunFlag)), true
+}
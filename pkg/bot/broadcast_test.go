@@ -0,0 +1,168 @@
+package bot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Broadcast(t *testing.T) {
+	tests := []struct {
+		mockSetup     func(ai *MockAIProvider)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "queue broadcast",
+			command: "/broadcast We are back online!\n[es]\n¡Volvemos!",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().StartBroadcast(mock.Anything, mock.MatchedBy(func(b *broadcast.Broadcast) bool {
+					return b.Text == "We are back online!" && b.Variants["es"] == "¡Volvemos!"
+				})).RunAndReturn(func(_ context.Context, b *broadcast.Broadcast) error {
+					b.Total = 5
+					return nil
+				})
+			},
+			expectedMsg: "is queued for 5 chats.",
+		},
+		{
+			name:    "dry run",
+			command: "/broadcast --dry-run We are back online!\n[es]\n¡Volvemos!\n[ru]\nМы снова онлайн!",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().PreviewBroadcast(mock.Anything, mock.Anything).Return(map[string]int{"": 7, "es": 3}, nil)
+			},
+			expectedMsg: "Dry run, nothing is sent.\nRecipients: 10\n- default: 7\n- es: 3\n- ru: 0",
+		},
+		{
+			name:        "without message",
+			command:     "/broadcast --dry-run",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Usage: /broadcast [--dry-run] <message>",
+		},
+		{
+			name:    "broadcasts not configured",
+			command: "/broadcast Hello!",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().StartBroadcast(mock.Anything, mock.Anything).Return(core.ErrBroadcastsDisabled)
+			},
+			expectedMsg: "Broadcasts are not configured.",
+		},
+		{
+			name:    "queueing fails",
+			command: "/broadcast Hello!",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().StartBroadcast(mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			expectedError: "failed to start broadcast: " + assert.AnError.Error(),
+		},
+		{
+			name:    "dry run fails",
+			command: "/broadcast --dry-run Hello!",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().PreviewBroadcast(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedError: "failed to preview broadcast: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+		})
+	}
+}
+
+func TestService_SendBroadcast(t *testing.T) {
+	tests := []struct {
+		sendErr   error
+		checkErr  func(t *testing.T, err error)
+		name      string
+		chatID    string
+		wantSends int
+	}{
+		{
+			name:      "sent",
+			chatID:    "123",
+			wantSends: 1,
+			checkErr:  func(t *testing.T, err error) { assert.NoError(t, err) },
+		},
+		{
+			name:      "flood limit",
+			chatID:    "123",
+			sendErr:   &tgbotapi.Error{Code: http.StatusTooManyRequests, ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 3}},
+			wantSends: 1,
+			checkErr: func(t *testing.T, err error) {
+				var flood *core.FloodError
+				require.ErrorAs(t, err, &flood)
+				assert.Equal(t, "3s", flood.RetryAfter.String())
+			},
+		},
+		{
+			name:      "bot blocked",
+			chatID:    "123",
+			sendErr:   &tgbotapi.Error{Code: http.StatusForbidden, Message: "Forbidden: bot was blocked by the user"},
+			wantSends: 1,
+			checkErr:  func(t *testing.T, err error) { assert.ErrorIs(t, err, core.ErrChatUnavailable) },
+		},
+		{
+			name:      "chat not found",
+			chatID:    "123",
+			sendErr:   &tgbotapi.Error{Code: http.StatusBadRequest, Message: "Bad Request: chat not found"},
+			wantSends: 1,
+			checkErr:  func(t *testing.T, err error) { assert.ErrorIs(t, err, core.ErrChatUnavailable) },
+		},
+		{
+			name:      "send fails",
+			chatID:    "123",
+			sendErr:   assert.AnError,
+			wantSends: 1,
+			checkErr:  func(t *testing.T, err error) { assert.ErrorIs(t, err, assert.AnError) },
+		},
+		{
+			name:     "invalid chat id",
+			chatID:   "abc",
+			checkErr: func(t *testing.T, err error) { assert.ErrorContains(t, err, "invalid broadcast chat id") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBot := NewMockBotAPI(t)
+
+			if tt.wantSends > 0 {
+				mockBot.EXPECT().Send(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+					msg, ok := c.(tgbotapi.MessageConfig)
+					return ok && msg.ChatID == 123 && msg.Text == "Hello!"
+				})).Return(tgbotapi.Message{}, tt.sendErr).Times(tt.wantSends)
+			}
+
+			svc := &ServiceImpl{Bot: mockBot}
+
+			err := svc.SendBroadcast(context.Background(), broadcast.Chat{ID: tt.chatID, Language: "en"}, "Hello!")
+
+			tt.checkErr(t, err)
+		})
+	}
+}
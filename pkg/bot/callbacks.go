@@ -0,0 +1,74 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// callbackLocalizer provides localized printers for callback queries and scheduled notifications,
+// which are not processed by the message handler middleware.
+var callbackLocalizer = i18n.NewLocalizer()
+
+// handleCallback processes a press of an inline keyboard button.
+// The callback data has the form "<kind>:<payload>", where kind selects the handler of the payload.
+// The query is always answered to stop the loading indicator on the button, with the handler's notification text if any.
+func (s *ServiceImpl) handleCallback(ctx context.Context, query *tgbotapi.CallbackQuery) {
+	if query.From == nil {
+		return
+	}
+
+	ctx = i18n.SetLocale(ctx, callbackLocalizer, query.From.LanguageCode)
+
+	if query.Message != nil {
+		// nolint:staticcheck // don't want to have dependecy on cmd package here for now
+		ctx = context.WithValue(ctx, "chat_id", fmt.Sprintf("%d", query.Message.Chat.ID))
+	}
+
+	kind, payload, _ := strings.Cut(query.Data, ":")
+
+	var (
+		text string
+		err  error
+	)
+
+	switch kind {
+	case reminderCallback:
+		text, err = s.handleReminderCallback(ctx, query, payload)
+	case feedbackCallback:
+		text, err = s.handleFeedbackCallback(ctx, query, payload)
+	default:
+		err = fmt.Errorf("unknown callback: %s", query.Data)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to handle callback", slog.Any("error", err))
+		text = i18n.GetLocale(ctx).Sprintf("Sorry, I encountered an error while processing your request. Please try again later.")
+	}
+
+	if _, err := s.Bot.Request(tgbotapi.NewCallback(query.ID, text)); err != nil {
+		slog.ErrorContext(ctx, "Failed to answer callback query", slog.Any("error", err))
+	}
+}
+
+// removeInlineKeyboard removes the inline keyboard from the message the callback query came from,
+// so the same button can't be pressed twice.
+func (s *ServiceImpl) removeInlineKeyboard(ctx context.Context, query *tgbotapi.CallbackQuery) {
+	if query.Message == nil {
+		return
+	}
+
+	edit := tgbotapi.NewEditMessageReplyMarkup(
+		query.Message.Chat.ID,
+		query.Message.MessageID,
+		tgbotapi.InlineKeyboardMarkup{InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{}},
+	)
+
+	if _, err := s.Bot.Request(edit); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove inline keyboard", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,212 @@
+// Package chart renders simple charts as PNG images in pure Go, without any external tools.
+package chart
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"strconv"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// ErrNoData is returned when a chart is requested without any points.
+var ErrNoData = errors.New("no data to plot")
+
+const (
+	width        = 800
+	height       = 400
+	marginLeft   = 70
+	marginRight  = 30
+	marginTop    = 40
+	marginBottom = 40
+	gridLines    = 5
+	pointRadius  = 4
+	dateLayout   = "2006-01-02"
+)
+
+var (
+	background = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	axisColor  = color.RGBA{R: 90, G: 90, B: 90, A: 255}
+	gridColor  = color.RGBA{R: 225, G: 225, B: 225, A: 255}
+	lineColor  = color.RGBA{R: 33, G: 118, B: 210, A: 255}
+	textColor  = color.RGBA{R: 40, G: 40, B: 40, A: 255}
+)
+
+// Point represents a value measured at a point in time.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Line renders points ordered by time as a line chart with the given title and returns it encoded as PNG.
+// The time axis shows the dates of the first and the last points.
+// Returns ErrNoData if there are no points, or an error if encoding the image fails.
+func Line(title string, points []Point) ([]byte, error) {
+	if len(points) == 0 {
+		return nil, ErrNoData
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: background}, image.Point{}, draw.Src)
+
+	minV, maxV := valueRange(points)
+	start, end := points[0].Time, points[len(points)-1].Time
+
+	if !end.After(start) {
+		start, end = start.Add(-12*time.Hour), end.Add(12*time.Hour)
+	}
+
+	plot := image.Rect(marginLeft, marginTop, width-marginRight, height-marginBottom)
+
+	x := func(t time.Time) int {
+		return plot.Min.X + int(float64(plot.Dx())*float64(t.Sub(start))/float64(end.Sub(start)))
+	}
+
+	y := func(v float64) int {
+		return plot.Max.Y - int(float64(plot.Dy())*(v-minV)/(maxV-minV))
+	}
+
+	for i := 0; i <= gridLines; i++ {
+		v := minV + (maxV-minV)*float64(i)/gridLines
+		gy := y(v)
+
+		drawLine(img, plot.Min.X, gy, plot.Max.X, gy, gridColor, 1)
+		drawText(img, marginLeft-8-textWidth(formatValue(v)), gy+4, formatValue(v))
+	}
+
+	drawLine(img, plot.Min.X, plot.Min.Y, plot.Min.X, plot.Max.Y, axisColor, 1)
+	drawLine(img, plot.Min.X, plot.Max.Y, plot.Max.X, plot.Max.Y, axisColor, 1)
+
+	drawText(img, plot.Min.X, plot.Max.Y+20, points[0].Time.Format(dateLayout))
+
+	if len(points) > 1 {
+		last := points[len(points)-1].Time.Format(dateLayout)
+		drawText(img, plot.Max.X-textWidth(last), plot.Max.Y+20, last)
+	}
+
+	drawText(img, marginLeft, marginTop-16, title)
+
+	for i := 1; i < len(points); i++ {
+		drawLine(img, x(points[i-1].Time), y(points[i-1].Value), x(points[i].Time), y(points[i].Value), lineColor, 2)
+	}
+
+	for _, p := range points {
+		drawDot(img, x(p.Time), y(p.Value), pointRadius, lineColor)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode chart: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// valueRange returns the range of the value axis covering all points with a margin above and below.
+func valueRange(points []Point) (float64, float64) {
+	minV, maxV := math.Inf(1), math.Inf(-1)
+
+	for _, p := range points {
+		minV = math.Min(minV, p.Value)
+		maxV = math.Max(maxV, p.Value)
+	}
+
+	pad := (maxV - minV) * 0.1
+	if pad == 0 {
+		pad = math.Max(math.Abs(maxV)*0.1, 1)
+	}
+
+	return math.Max(minV-pad, 0), maxV + pad
+}
+
+// drawLine draws a straight line of the given thickness using Bresenham's algorithm.
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.Color, thickness int) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := sign(x1-x0), sign(y1-y0)
+	e := dx + dy
+
+	for {
+		for ox := 0; ox < thickness; ox++ {
+			for oy := 0; oy < thickness; oy++ {
+				img.Set(x0+ox, y0+oy, c)
+			}
+		}
+
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+
+		e2 := 2 * e
+
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+// drawDot draws a filled circle centered at (cx, cy).
+func drawDot(img *image.RGBA, cx, cy, r int, c color.Color) {
+	for x := -r; x <= r; x++ {
+		for y := -r; y <= r; y++ {
+			if x*x+y*y <= r*r {
+				img.Set(cx+x, cy+y, c)
+			}
+		}
+	}
+}
+
+// drawText draws the text with its baseline starting at (x, y).
+func drawText(img *image.RGBA, x, y int, text string) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(textColor),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+
+	d.DrawString(text)
+}
+
+// textWidth returns the width of the text in pixels when drawn with the chart font.
+func textWidth(text string) int {
+	return font.MeasureString(basicfont.Face7x13, text).Ceil()
+}
+
+// formatValue formats an axis value with up to one decimal digit.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
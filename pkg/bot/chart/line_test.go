@@ -0,0 +1,64 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLine(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		points  []Point
+		wantErr error
+	}{
+		{name: "no points", wantErr: ErrNoData},
+		{name: "single point", points: []Point{{Time: now, Value: 12.4}}},
+		{
+			name: "several points",
+			points: []Point{
+				{Time: now.AddDate(0, -2, 0), Value: 13.5},
+				{Time: now.AddDate(0, -1, 0), Value: 13},
+				{Time: now, Value: 12.4},
+			},
+		},
+		{
+			name:   "equal values",
+			points: []Point{{Time: now.AddDate(0, 0, -1), Value: 4}, {Time: now, Value: 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Line("Max, kg", tt.points)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			img, err := png.Decode(bytes.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, width, img.Bounds().Dx())
+			assert.Equal(t, height, img.Bounds().Dy())
+
+			last := tt.points[len(tt.points)-1]
+			minV, maxV := valueRange(tt.points)
+			x := width - marginRight
+			if len(tt.points) == 1 {
+				x = marginLeft + (width-marginLeft-marginRight)/2
+			}
+			y := height - marginBottom - int(float64(height-marginTop-marginBottom)*(last.Value-minV)/(maxV-minV))
+
+			assert.Equal(t, lineColor, img.At(x, y), "last point should be drawn")
+		})
+	}
+}
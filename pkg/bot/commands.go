@@ -10,6 +10,14 @@ import (
 	"github.com/ksysoev/help-my-pet/pkg/i18n"
 )
 
+var (
+	// adminCommands lists the names of commands available to admins only.
+	adminCommands = []string{"stats", "spend", "whitelist", "user", "resetquota", "broadcast"}
+
+	// commands lists the names of all supported bot commands, it is used to label handler metrics.
+	commands = append([]string{"start", "terms", "editprofile", "addpet", "pets", "switchpet", "removepet", "weight", "weightchart", "vaccines", "addvaccine", "remind", "reminders", "cancel", "help"}, adminCommands...)
+)
+
 func (s *ServiceImpl) HandleCommand(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
 	switch msg.Command() {
 	case "start":
@@ -32,6 +40,26 @@ func (s *ServiceImpl) HandleCommand(ctx context.Context, msg *tgbotapi.Message)
 		}
 
 		return tgbotapi.NewMessage(msg.Chat.ID, resp.Message), nil
+	case "addpet":
+		return s.handleAddPet(ctx, msg)
+	case "pets":
+		return s.handlePets(ctx, msg)
+	case "switchpet":
+		return s.handleSwitchPet(ctx, msg)
+	case "removepet":
+		return s.handleRemovePet(ctx, msg)
+	case "weight":
+		return s.handleWeight(ctx, msg)
+	case "weightchart":
+		return s.handleWeightChart(ctx, msg)
+	case "vaccines":
+		return s.handleVaccines(ctx, msg)
+	case "addvaccine":
+		return s.handleAddVaccine(ctx, msg)
+	case "remind":
+		return s.handleRemind(ctx, msg)
+	case "reminders":
+		return s.handleReminders(ctx, msg)
 	case "cancel":
 		if err := s.AISvc.CancelQuestionnaire(ctx, fmt.Sprintf("%d", msg.Chat.ID)); err != nil {
 			return tgbotapi.MessageConfig{}, fmt.Errorf("failed to reset conversation: %w", err)
@@ -47,6 +75,18 @@ func (s *ServiceImpl) HandleCommand(ctx context.Context, msg *tgbotapi.Message)
 		return resp, nil
 	case "help":
 		return handleHelp(ctx, msg)
+	case "stats":
+		return s.handleStats(ctx, msg)
+	case "spend":
+		return s.handleSpend(ctx, msg)
+	case "whitelist":
+		return s.handleWhitelist(ctx, msg)
+	case "user":
+		return s.handleUser(ctx, msg)
+	case "resetquota":
+		return s.handleResetQuota(ctx, msg)
+	case "broadcast":
+		return s.handleBroadcast(ctx, msg)
 	default:
 		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Unknown command")), nil
 	}
@@ -130,6 +170,16 @@ func handleHelp(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageCon
 /start - Start the conversation with the bot
 /terms - View the Terms and Conditions of the service
 /editprofile - Update your pet's profile information, such as name, age, breed, etc. This information helps the bot provide more accurate advice.
+/addpet - Add profile of another pet, if you have more than one
+/pets - List your pets and see which one is currently selected
+/switchpet - Select the pet your next questions are about
+/removepet - Remove a pet profile
+/weight - Record your pet's current weight, e.g. /weight 12.4kg
+/weightchart - See a chart of your pet's weight over time
+/vaccines - List overdue vaccinations and preventive treatments of your pets
+/addvaccine - Add a vaccination or preventive treatment record for your pet
+/remind - Set a recurring reminder, e.g. /remind give Rimadyl every 12h for 7 days
+/reminders - List your reminders and delete the ones you don't need
 /cancel - Cancel the current questionnaire, if any is in progress (e.g., when you want to start over or change your question)
 /help - View this help message`)
 
@@ -0,0 +1,134 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// feedbackCallback is the callback data kind of answer rating buttons
+const feedbackCallback = "feedback"
+
+// feedbackButtons returns the row of buttons rating the answer with the ID.
+func feedbackButtons(answerID string) []tgbotapi.InlineKeyboardButton {
+	return tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("👍", feedbackCallbackData(feedback.RatingUp, answerID)),
+		tgbotapi.NewInlineKeyboardButtonData("👎", feedbackCallbackData(feedback.RatingDown, answerID)),
+	)
+}
+
+// feedbackCallbackData builds the callback data of an answer rating button.
+func feedbackCallbackData(rating feedback.Rating, answerID string) string {
+	return feedbackCallback + ":" + string(rating) + ":" + answerID
+}
+
+// handleFeedbackCallback handles answer rating buttons with payload "<rating>:<answer id>".
+// The rating buttons are removed once the answer is rated, after a negative rating the user is asked for the reason.
+// Returns the notification text to show to the user or an error if rating fails.
+func (s *ServiceImpl) handleFeedbackCallback(ctx context.Context, query *tgbotapi.CallbackQuery, payload string) (string, error) {
+	value, answerID, _ := strings.Cut(payload, ":")
+
+	rating, err := feedback.ParseRating(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid feedback callback %q: %w", payload, err)
+	}
+
+	err = s.AISvc.RateAnswer(ctx, fmt.Sprintf("%d", query.From.ID), answerID, rating)
+	if errors.Is(err, core.ErrAnswerNotFound) {
+		s.removeFeedbackButtons(ctx, query)
+		return i18n.GetLocale(ctx).Sprintf("This answer can no longer be rated."), nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to rate answer: %w", err)
+	}
+
+	s.removeFeedbackButtons(ctx, query)
+
+	if rating == feedback.RatingDown {
+		s.askFeedbackReason(ctx, query)
+	}
+
+	return i18n.GetLocale(ctx).Sprintf("Thank you for your feedback!"), nil
+}
+
+// removeFeedbackButtons removes the rating buttons from the message the callback query came from,
+// other buttons of the message, like the emergency clinic search, are kept.
+func (s *ServiceImpl) removeFeedbackButtons(ctx context.Context, query *tgbotapi.CallbackQuery) {
+	if query.Message == nil || query.Message.ReplyMarkup == nil {
+		s.removeInlineKeyboard(ctx, query)
+		return
+	}
+
+	rows := [][]tgbotapi.InlineKeyboardButton{}
+
+	for _, row := range query.Message.ReplyMarkup.InlineKeyboard {
+		if !slices.ContainsFunc(row, isFeedbackButton) {
+			rows = append(rows, row)
+		}
+	}
+
+	edit := tgbotapi.NewEditMessageReplyMarkup(
+		query.Message.Chat.ID,
+		query.Message.MessageID,
+		tgbotapi.InlineKeyboardMarkup{InlineKeyboard: rows},
+	)
+
+	if _, err := s.Bot.Request(edit); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove feedback buttons", slog.Any("error", err))
+	}
+}
+
+// isFeedbackButton reports whether the button rates an answer.
+func isFeedbackButton(button tgbotapi.InlineKeyboardButton) bool {
+	return button.CallbackData != nil && strings.HasPrefix(*button.CallbackData, feedbackCallback+":")
+}
+
+// askFeedbackReason asks the user to reply with the reason of the negative rating of the answer
+// the callback query came from. Failures are logged, as the reason is optional.
+func (s *ServiceImpl) askFeedbackReason(ctx context.Context, query *tgbotapi.CallbackQuery) {
+	if query.Message == nil {
+		return
+	}
+
+	msg := tgbotapi.NewMessage(
+		query.Message.Chat.ID,
+		i18n.GetLocale(ctx).Sprintf("Sorry the answer didn't help. What was wrong with it? Reply to this message with a short comment, or just ignore it."),
+	)
+	msg.ReplyToMessageID = query.Message.MessageID
+	msg.ReplyMarkup = tgbotapi.ForceReply{
+		ForceReply:            true,
+		InputFieldPlaceholder: i18n.GetLocale(ctx).Sprintf("What was wrong?"),
+		Selective:             true,
+	}
+
+	if _, err := s.Bot.Send(msg); err != nil {
+		slog.ErrorContext(ctx, "Failed to ask for feedback reason", slog.Any("error", err))
+	}
+}
+
+// handleFeedbackReason attaches the text of a reply to the bot as the reason of the user's last negative rating,
+// if the reason is awaited.
+// Returns the confirmation message and true if the reply was used as the reason, or an error if storing it fails.
+func (s *ServiceImpl) handleFeedbackReason(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, bool, error) {
+	if msg.ReplyToMessage == nil || msg.ReplyToMessage.From == nil || !msg.ReplyToMessage.From.IsBot {
+		return tgbotapi.MessageConfig{}, false, nil
+	}
+
+	added, err := s.AISvc.AddFeedbackReason(ctx, fmt.Sprintf("%d", msg.From.ID), msg.Text)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, false, fmt.Errorf("failed to add feedback reason: %w", err)
+	}
+
+	if !added {
+		return tgbotapi.MessageConfig{}, false, nil
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Thank you, your feedback helps us improve the answers.")), true, nil
+}
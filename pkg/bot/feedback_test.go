@@ -0,0 +1,177 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_handleCallback_Feedback(t *testing.T) {
+	emergencyRow := tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonURL("🏥", emergencyVetSearchURL))
+
+	// keepsEmergencyRow matches the edit removing the rating buttons and keeping the emergency clinic search
+	keepsEmergencyRow := mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+		edit, ok := c.(tgbotapi.EditMessageReplyMarkupConfig)
+		return ok && edit.ReplyMarkup != nil && len(edit.ReplyMarkup.InlineKeyboard) == 1 &&
+			edit.ReplyMarkup.InlineKeyboard[0][0].URL != nil
+	})
+
+	tests := []struct {
+		mockSetup    func(ai *MockAIProvider, bot *MockBotAPI)
+		name         string
+		data         string
+		expectedText string
+	}{
+		{
+			name: "positive rating",
+			data: "feedback:up:a1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().RateAnswer(mock.Anything, "456", "a1", feedback.RatingUp).Return(nil)
+				bot.EXPECT().Request(keepsEmergencyRow).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "Thank you for your feedback!",
+		},
+		{
+			name: "negative rating asks for the reason",
+			data: "feedback:down:a1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().RateAnswer(mock.Anything, "456", "a1", feedback.RatingDown).Return(nil)
+				bot.EXPECT().Request(keepsEmergencyRow).Return(&tgbotapi.APIResponse{}, nil)
+				bot.EXPECT().Send(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+					msg, ok := c.(tgbotapi.MessageConfig)
+					_, forced := msg.ReplyMarkup.(tgbotapi.ForceReply)
+
+					return ok && forced && msg.ChatID == 123 && msg.ReplyToMessageID == 789
+				})).Return(tgbotapi.Message{}, nil)
+			},
+			expectedText: "Thank you for your feedback!",
+		},
+		{
+			name: "answer expired",
+			data: "feedback:up:a1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().RateAnswer(mock.Anything, "456", "a1", feedback.RatingUp).Return(core.ErrAnswerNotFound)
+				bot.EXPECT().Request(keepsEmergencyRow).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "This answer can no longer be rated.",
+		},
+		{
+			name: "rating fails",
+			data: "feedback:down:a1",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().RateAnswer(mock.Anything, "456", "a1", feedback.RatingDown).Return(assert.AnError)
+			},
+			expectedText: "Sorry, I encountered an error while processing your request. Please try again later.",
+		},
+		{
+			name:         "invalid rating",
+			data:         "feedback:meh:a1",
+			mockSetup:    func(_ *MockAIProvider, _ *MockBotAPI) {},
+			expectedText: "Sorry, I encountered an error while processing your request. Please try again later.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			mockBot := NewMockBotAPI(t)
+			tt.mockSetup(mockAI, mockBot)
+
+			mockBot.EXPECT().Request(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+				cb, ok := c.(tgbotapi.CallbackConfig)
+				return ok && cb.CallbackQueryID == "cb1" && cb.Text == tt.expectedText
+			})).Return(&tgbotapi.APIResponse{}, nil)
+
+			svc := &ServiceImpl{Bot: mockBot, AISvc: mockAI}
+
+			markup := tgbotapi.NewInlineKeyboardMarkup(emergencyRow, feedbackButtons("a1"))
+
+			svc.processUpdate(context.Background(), &tgbotapi.Update{
+				CallbackQuery: &tgbotapi.CallbackQuery{
+					ID:      "cb1",
+					From:    &tgbotapi.User{ID: 456, LanguageCode: "en"},
+					Message: &tgbotapi.Message{MessageID: 789, Chat: &tgbotapi.Chat{ID: 123}, ReplyMarkup: &markup},
+					Data:    tt.data,
+				},
+			})
+		})
+	}
+}
+
+func TestService_handleFeedbackReason(t *testing.T) {
+	tests := []struct {
+		mockSetup     func(ai *MockAIProvider)
+		replyTo       *tgbotapi.Message
+		name          string
+		expectedMsg   string
+		expectedError string
+		wantHandled   bool
+	}{
+		{
+			name:    "reason is attached",
+			replyTo: &tgbotapi.Message{From: &tgbotapi.User{ID: 1, IsBot: true}},
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().AddFeedbackReason(mock.Anything, "456", "Too vague").Return(true, nil)
+			},
+			wantHandled: true,
+			expectedMsg: "Thank you, your feedback helps us improve the answers.",
+		},
+		{
+			name:    "reason is not awaited",
+			replyTo: &tgbotapi.Message{From: &tgbotapi.User{ID: 1, IsBot: true}},
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().AddFeedbackReason(mock.Anything, "456", "Too vague").Return(false, nil)
+			},
+		},
+		{
+			name:      "not a reply",
+			mockSetup: func(_ *MockAIProvider) {},
+		},
+		{
+			name:      "reply to another user",
+			replyTo:   &tgbotapi.Message{From: &tgbotapi.User{ID: 2}},
+			mockSetup: func(_ *MockAIProvider) {},
+		},
+		{
+			name:    "storing the reason fails",
+			replyTo: &tgbotapi.Message{From: &tgbotapi.User{ID: 1, IsBot: true}},
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().AddFeedbackReason(mock.Anything, "456", "Too vague").Return(false, assert.AnError)
+			},
+			expectedError: "failed to add feedback reason: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			msg := &tgbotapi.Message{
+				Text:           "Too vague",
+				From:           &tgbotapi.User{ID: 456, LanguageCode: "en"},
+				Chat:           &tgbotapi.Chat{ID: 123},
+				ReplyToMessage: tt.replyTo,
+			}
+
+			resp, handled, err := svc.handleFeedbackReason(context.Background(), msg)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHandled, handled)
+			assert.Equal(t, tt.expectedMsg, resp.Text)
+		})
+	}
+}
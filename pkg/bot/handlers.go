@@ -11,6 +11,7 @@ import (
 	"github.com/ksysoev/help-my-pet/pkg/core"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
 	"github.com/ksysoev/help-my-pet/pkg/i18n"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 )
 
 // Handler defines the interface for processing and responding to incoming messages in a Telegram bot context.
@@ -29,9 +30,11 @@ type Handler interface {
 func (s *ServiceImpl) setupHandler() Handler {
 	h := middleware.Use(
 		s,
+		middleware.WithAdminCommands(s.adminIDs, adminCommands...),
+		middleware.WithChatTracking(s.AISvc),
 		middleware.WithRequestReducer(),
 		middleware.WithThrottler(30),
-		middleware.WithMetrics(),
+		middleware.WithMetrics(commands...),
 		middleware.WithErrorHandling(),
 		middleware.WithLocalization(),
 	)
@@ -84,6 +87,14 @@ func (s *ServiceImpl) Handle(ctx context.Context, msg *tgbotapi.Message) (tgbota
 		return resp, nil
 	}
 
+	// A reply to the bot may be the reason of a negative rating of an answer
+	thanks, ok, err := s.handleFeedbackReason(ctx, msg)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, err
+	} else if ok {
+		return thanks, nil
+	}
+
 	request, err := message.NewUserMessage(
 		fmt.Sprintf("%d", msg.From.ID),
 		fmt.Sprintf("%d", msg.Chat.ID),
@@ -99,35 +110,17 @@ func (s *ServiceImpl) Handle(ctx context.Context, msg *tgbotapi.Message) (tgbota
 		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to create user message: %w", err)
 	}
 
-	response, err := s.AISvc.ProcessMessage(ctx, request)
+	stream := s.newMessageStream(ctx, msg.Chat.ID)
+
+	response, err := s.AISvc.ProcessMessageStream(ctx, request, stream.Update)
 	if err != nil {
+		stream.Discard()
 		return s.handleProcessingError(ctx, err, msg)
 	}
 
-	// Create msg with buttons if available
-	resp := tgbotapi.NewMessage(msg.Chat.ID, response.Message)
+	resp := s.newResponseMessage(ctx, msg.Chat.ID, response)
 
-	// Handle keyboard markup based on answers
-	if len(response.Answers) > 0 {
-		keyboard := make([][]tgbotapi.KeyboardButton, len(response.Answers))
-		for i, answer := range response.Answers {
-			keyboard[i] = []tgbotapi.KeyboardButton{
-				{Text: answer},
-			}
-		}
-		resp.ReplyMarkup = tgbotapi.ReplyKeyboardMarkup{
-			Keyboard:        keyboard,
-			OneTimeKeyboard: true,
-			ResizeKeyboard:  true,
-		}
-	} else {
-		resp.ReplyMarkup = tgbotapi.ReplyKeyboardRemove{
-			RemoveKeyboard: true,
-			Selective:      false,
-		}
-	}
-
-	return resp, nil
+	return stream.Finish(resp), nil
 }
 
 // handleProcessingError maps specific processing errors to localized user-facing messages or provides a default error response.
@@ -136,18 +129,29 @@ func (s *ServiceImpl) Handle(ctx context.Context, msg *tgbotapi.Message) (tgbota
 func (s *ServiceImpl) handleProcessingError(ctx context.Context, err error, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
 	switch {
 	case errors.Is(err, core.ErrRateLimit):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitUser).Inc()
 		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("You have reached the maximum number of requests per hour. Please try again later.")), nil
+	case errors.Is(err, core.ErrUserBudget):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitUserBudget).Inc()
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("You have used up your question allowance for now. Please try again later.")), nil
 	case errors.Is(err, core.ErrGlobalLimit):
+		metrics.RateLimitRejections.WithLabelValues(metrics.RateLimitGlobal).Inc()
 		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("We have reached our daily request limit. Please come back tomorrow when our budget is refreshed.")), nil
+	case errors.Is(err, core.ErrConversationConflict):
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Your conversation was changed by another message while I was processing this one. Please send it again.")), nil
 	default:
 		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to get AI response: %w", err)
 	}
 }
 
-// HandleRemovingBot resets the conversation context for a user in a chat upon bot removal.
+// HandleRemovingBot stops broadcasts to the chat and resets the conversation context for a user in a chat upon bot removal.
 // It ensures the conversation state is cleared for the given userID and chatID.
-// Returns error if the reset operation fails, including details about the failure.
+// Returns error if the chat removal or the reset operation fails, including details about the failure.
 func (s *ServiceImpl) HandleRemovingBot(ctx context.Context, userID, chatID string) error {
+	if err := s.AISvc.RemoveChat(ctx, chatID); err != nil {
+		return fmt.Errorf("failed to remove chat: %w", err)
+	}
+
 	if err := s.AISvc.ResetUserConversation(ctx, userID, chatID); err != nil {
 		return fmt.Errorf("failed to reset user conversation: %w", err)
 	}
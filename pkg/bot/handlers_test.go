@@ -175,7 +175,7 @@ func TestService_handleMessage(t *testing.T) {
 					ChatID: "123",
 					Text:   tt.message,
 				}
-				mockAI.EXPECT().ProcessMessage(mock.Anything, expectedRequest).Return(tt.aiResponse, tt.aiErr)
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, expectedRequest, mock.Anything).Return(tt.aiResponse, tt.aiErr)
 			}
 
 			msgConfig, err := svc.Handle(context.Background(), msg)
@@ -217,6 +217,8 @@ func TestService_Run_SuccessfulMessageHandling(t *testing.T) {
 
 	svc.handler = svc.setupHandler()
 
+	mockAI.EXPECT().TrackChat(mock.Anything, "123", mock.Anything).Return(nil)
+
 	updates := make(chan tgbotapi.Update)
 	mockBot.EXPECT().
 		GetUpdatesChan(tgbotapi.UpdateConfig{Offset: 0, Timeout: 30}).
@@ -232,11 +234,11 @@ func TestService_Run_SuccessfulMessageHandling(t *testing.T) {
 
 	// Expect AI request
 	mockAI.EXPECT().
-		ProcessMessage(mock.Anything, &message.UserMessage{
+		ProcessMessageStream(mock.Anything, &message.UserMessage{
 			UserID: "123",
 			ChatID: "123",
 			Text:   "test message",
-		}).
+		}, mock.Anything).
 		Return(message.NewResponse("test response", []string{}), nil)
 
 	// Expect message send
@@ -291,12 +293,24 @@ func TestService_handleProcessingError(t *testing.T) {
 			langCode:     "en",
 			expectedText: "You have reached the maximum number of requests per hour. Please try again later.",
 		},
+		{
+			name:         "user budget error",
+			err:          fmt.Errorf("failed to check budget: %w", core.ErrUserBudget),
+			langCode:     "en",
+			expectedText: "You have used up your question allowance for now. Please try again later.",
+		},
 		{
 			name:         "global limit error",
 			err:          core.ErrGlobalLimit,
 			langCode:     "en",
 			expectedText: "We have reached our daily request limit. Please come back tomorrow when our budget is refreshed.",
 		},
+		{
+			name:         "conversation conflict",
+			err:          fmt.Errorf("failed to save conversation: %w", core.ErrConversationConflict),
+			langCode:     "en",
+			expectedText: "Your conversation was changed by another message while I was processing this one. Please send it again.",
+		},
 		{
 			name:         "unhandled error",
 			err:          fmt.Errorf("unknown error"),
@@ -337,10 +351,11 @@ func TestService_handleProcessingError(t *testing.T) {
 
 func TestService_HandleRemovingBot(t *testing.T) {
 	tests := []struct {
+		removeErr error
+		resetErr  error
 		name      string
 		userID    string
 		chatID    string
-		resetErr  error
 		expectErr bool
 	}{
 		{
@@ -357,6 +372,13 @@ func TestService_HandleRemovingBot(t *testing.T) {
 			resetErr:  fmt.Errorf("AI service failure"),
 			expectErr: true,
 		},
+		{
+			name:      "chat removal fails",
+			userID:    "12345",
+			chatID:    "54321",
+			removeErr: fmt.Errorf("storage failure"),
+			expectErr: true,
+		},
 		{
 			name:      "invalid user and chat IDs",
 			userID:    "",
@@ -370,8 +392,12 @@ func TestService_HandleRemovingBot(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockAI := NewMockAIProvider(t)
 
-			// Mock the ResetUserConversation method for the test case
-			mockAI.EXPECT().ResetUserConversation(mock.Anything, tt.userID, tt.chatID).Return(tt.resetErr)
+			// Mock the RemoveChat and ResetUserConversation methods for the test case
+			mockAI.EXPECT().RemoveChat(mock.Anything, tt.chatID).Return(tt.removeErr)
+
+			if tt.removeErr == nil {
+				mockAI.EXPECT().ResetUserConversation(mock.Anything, tt.userID, tt.chatID).Return(tt.resetErr)
+			}
 
 			// Arrange
 			svc := &ServiceImpl{
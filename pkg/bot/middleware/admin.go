@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// WithAdminCommands restricts the given commands to the admin users.
+// Other users get the same response as for an unknown command, so admin commands are not revealed to them.
+// adminIDs lists Telegram user IDs of the admins; commands lists the names of the admin-only commands.
+// Returns a Middleware passing admin-only commands to the next Handler only if they are sent by an admin.
+func WithAdminCommands(adminIDs []int64, commands ...string) Middleware {
+	admins := make(map[int64]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+			if !msg.IsCommand() || !slices.Contains(commands, msg.Command()) {
+				return next.Handle(ctx, msg)
+			}
+
+			if msg.From != nil {
+				if _, ok := admins[msg.From.ID]; ok {
+					slog.InfoContext(ctx, "Admin command", slog.String("command", msg.Command()), slog.Int64("admin_id", msg.From.ID))
+					return next.Handle(ctx, msg)
+				}
+			}
+
+			var chatID int64
+			if msg.Chat != nil {
+				chatID = msg.Chat.ID
+			}
+
+			return tgbotapi.NewMessage(chatID, i18n.GetLocale(ctx).Sprintf("Unknown command")), nil
+		})
+	}
+}
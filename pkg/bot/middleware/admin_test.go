@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAdminCommands(t *testing.T) {
+	command := func(userID int64, text string) *tgbotapi.Message {
+		msg := &tgbotapi.Message{
+			Chat: &tgbotapi.Chat{ID: 123},
+			From: &tgbotapi.User{ID: userID},
+			Text: text,
+		}
+
+		if strings.HasPrefix(text, "/") {
+			end := strings.IndexByte(text, ' ')
+			if end < 0 {
+				end = len(text)
+			}
+
+			msg.Entities = []tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: end}}
+		}
+
+		return msg
+	}
+
+	tests := []struct {
+		message     *tgbotapi.Message
+		name        string
+		expectedMsg string
+		called      bool
+	}{
+		{
+			name:        "admin command from admin",
+			message:     command(1, "/stats"),
+			called:      true,
+			expectedMsg: "handled",
+		},
+		{
+			name:        "admin command with arguments from admin",
+			message:     command(1, "/user 42"),
+			called:      true,
+			expectedMsg: "handled",
+		},
+		{
+			name:        "admin command from another user",
+			message:     command(2, "/stats"),
+			expectedMsg: "Unknown command",
+		},
+		{
+			name:        "admin command without sender",
+			message:     &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: 123}, Text: "/stats", Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Length: 6}}},
+			expectedMsg: "Unknown command",
+		},
+		{
+			name:        "regular command from another user",
+			message:     command(2, "/help"),
+			called:      true,
+			expectedMsg: "handled",
+		},
+		{
+			name:        "text message from another user",
+			message:     command(2, "stats"),
+			called:      true,
+			expectedMsg: "handled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := HandlerFunc(func(_ context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+				called = true
+				return tgbotapi.NewMessage(msg.Chat.ID, "handled"), nil
+			})
+
+			resp, err := WithAdminCommands([]int64{1}, "stats", "user")(handler).Handle(context.Background(), tt.message)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.called, called)
+			assert.Equal(t, tt.expectedMsg, resp.Text)
+			assert.Equal(t, int64(123), resp.ChatID)
+		})
+	}
+}
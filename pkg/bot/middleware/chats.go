@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// ChatTracker remembers chats the bot talks to, so announcements can be broadcast to them.
+type ChatTracker interface {
+	TrackChat(ctx context.Context, chatID, language string) error
+}
+
+// WithChatTracking records the chat and the language of the sender of every incoming message with the tracker.
+// Chats already recorded with the same language are skipped, so the tracker is called once per chat
+// and language change during the lifetime of the process. Tracking failures are logged and don't fail the message.
+// Returns a Middleware tracking the chat before passing the message to the next Handler.
+func WithChatTracking(tracker ChatTracker) Middleware {
+	var tracked sync.Map
+
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+			if msg.Chat == nil {
+				return next.Handle(ctx, msg)
+			}
+
+			language := ""
+			if msg.From != nil {
+				language = msg.From.LanguageCode
+			}
+
+			chatID := fmt.Sprintf("%d", msg.Chat.ID)
+
+			if prev, ok := tracked.Load(chatID); !ok || prev != language {
+				if err := tracker.TrackChat(ctx, chatID, language); err != nil {
+					slog.ErrorContext(ctx, "Failed to track chat", slog.Any("error", err))
+				} else {
+					tracked.Store(chatID, language)
+				}
+			}
+
+			return next.Handle(ctx, msg)
+		})
+	}
+}
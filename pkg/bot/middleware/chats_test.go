@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type chatTrackerFunc func(ctx context.Context, chatID, language string) error
+
+func (f chatTrackerFunc) TrackChat(ctx context.Context, chatID, language string) error {
+	return f(ctx, chatID, language)
+}
+
+func TestWithChatTracking(t *testing.T) {
+	var tracked []string
+
+	fail := false
+	tracker := chatTrackerFunc(func(_ context.Context, chatID, language string) error {
+		if fail {
+			return assert.AnError
+		}
+
+		tracked = append(tracked, chatID+":"+language)
+
+		return nil
+	})
+
+	handled := 0
+	handler := WithChatTracking(tracker)(HandlerFunc(func(_ context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+		handled++
+		return tgbotapi.NewMessage(msg.Chat.ID, "handled"), nil
+	}))
+
+	message := func(chatID int64, language string) *tgbotapi.Message {
+		return &tgbotapi.Message{
+			Chat: &tgbotapi.Chat{ID: chatID},
+			From: &tgbotapi.User{ID: 1, LanguageCode: language},
+			Text: "hello",
+		}
+	}
+
+	for _, msg := range []*tgbotapi.Message{
+		message(123, "en"),
+		message(123, "en"),
+		message(456, "es"),
+		message(123, "ru"),
+	} {
+		resp, err := handler.Handle(context.Background(), msg)
+		require.NoError(t, err)
+		assert.Equal(t, "handled", resp.Text)
+	}
+
+	assert.Equal(t, []string{"123:en", "456:es", "123:ru"}, tracked)
+
+	// Failed tracking doesn't fail the message and is retried with the next one
+	fail = true
+	_, err := handler.Handle(context.Background(), message(789, "en"))
+	require.NoError(t, err)
+
+	fail = false
+	_, err = handler.Handle(context.Background(), message(789, "en"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"123:en", "456:es", "123:ru", "789:en"}, tracked)
+	assert.Equal(t, 6, handled)
+}
@@ -6,20 +6,64 @@ import (
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+)
+
+const (
+	messageTypeText           = "text"
+	messageTypePhoto          = "photo"
+	messageTypeUnknown        = "unknown"
+	messageTypeUnknownCommand = "unknown_command"
 )
 
 // WithMetrics wraps a Handler to record processing time and error occurrence metrics for each message processed.
-// It logs the duration of message processing and whether an error occurred during execution.
-// Returns a Middleware that measures and logs performance metrics for the wrapped Handler.
-func WithMetrics() Middleware {
+// Latency and errors are labelled by the message type: the command name for known commands, "text" or "photo".
+// Accepts commands, the list of supported command names, other commands are labelled "unknown_command"
+// to keep the number of label values bounded.
+// Returns a Middleware that measures and records performance metrics for the wrapped Handler.
+func WithMetrics(commands ...string) Middleware {
+	known := make(map[string]struct{}, len(commands))
+	for _, cmd := range commands {
+		known[cmd] = struct{}{}
+	}
+
 	return func(next Handler) Handler {
 		return HandlerFunc(func(ctx context.Context, message *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
 			start := time.Now()
 			resp, err := next.Handle(ctx, message)
+			duration := time.Since(start)
+
+			msgType := messageType(message, known)
+
+			metrics.HandlerDuration.WithLabelValues(msgType).Observe(duration.Seconds())
 
-			slog.InfoContext(ctx, "Message processing time", slog.Duration("duration", time.Since(start)), slog.Bool("error", err != nil))
+			if err != nil {
+				metrics.HandlerErrors.WithLabelValues(msgType).Inc()
+			}
+
+			slog.InfoContext(ctx, "Message processing time", slog.Duration("duration", duration), slog.Bool("error", err != nil))
 
 			return resp, err
 		})
 	}
 }
+
+// messageType determines the metrics label for the message based on its content.
+// Returns the command name for known commands, "unknown_command" for other commands,
+// "photo" for messages with images and "text" otherwise.
+func messageType(message *tgbotapi.Message, known map[string]struct{}) string {
+	switch {
+	case message == nil:
+		return messageTypeUnknown
+	case message.IsCommand():
+		if _, ok := known[message.Command()]; ok {
+			return message.Command()
+		}
+
+		return messageTypeUnknownCommand
+	case len(message.Photo) > 0:
+		return messageTypePhoto
+	default:
+		return messageTypeText
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +67,49 @@ func TestWithMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestWithMetrics_RecordsByMessageType(t *testing.T) {
+	failing := HandlerFunc(func(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+		return tgbotapi.MessageConfig{}, assert.AnError
+	})
+
+	handler := WithMetrics("start")(failing)
+
+	tests := []struct {
+		message *tgbotapi.Message
+		name    string
+		label   string
+	}{
+		{
+			name:    "known command",
+			message: &tgbotapi.Message{Text: "/start", Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Length: 6}}},
+			label:   "start",
+		},
+		{
+			name:    "unknown command",
+			message: &tgbotapi.Message{Text: "/random", Entities: []tgbotapi.MessageEntity{{Type: "bot_command", Length: 7}}},
+			label:   messageTypeUnknownCommand,
+		},
+		{
+			name:    "photo",
+			message: &tgbotapi.Message{Photo: []tgbotapi.PhotoSize{{FileID: "file"}}},
+			label:   messageTypePhoto,
+		},
+		{
+			name:    "text",
+			message: &tgbotapi.Message{Text: "hello"},
+			label:   messageTypeText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := testutil.ToFloat64(metrics.HandlerErrors.WithLabelValues(tt.label))
+
+			_, err := handler.Handle(context.Background(), tt.message)
+
+			assert.ErrorIs(t, err, assert.AnError)
+			assert.Equal(t, before+1, testutil.ToFloat64(metrics.HandlerErrors.WithLabelValues(tt.label)))
+		})
+	}
+}
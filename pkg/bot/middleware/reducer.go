@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 )
 
 // requestState holds the context cancel function and message id for a specific request to manage concurrency.
@@ -38,6 +39,7 @@ func WithRequestReducer() Middleware {
 			mu.Lock()
 			if existing, exists := activeRequests[chatID]; exists {
 				existing.cancel()
+				metrics.ReducerCancellations.Inc()
 				delete(activeRequests, chatID)
 			}
 			activeRequests[chatID] = requestState{
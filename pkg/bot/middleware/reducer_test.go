@@ -7,6 +7,8 @@ import (
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,6 +42,7 @@ func TestWithRequestReducerCancelsPreviousRequest(t *testing.T) {
 
 	middleware := WithRequestReducer()
 	wrapped := middleware(handler)
+	cancelledBefore := testutil.ToFloat64(metrics.ReducerCancellations)
 
 	// Start first request
 	wg.Add(1)
@@ -58,6 +61,7 @@ func TestWithRequestReducerCancelsPreviousRequest(t *testing.T) {
 
 	wg.Wait()
 	assert.True(t, firstCtxCancelled, "first request should have been cancelled")
+	assert.Equal(t, cancelledBefore+1, testutil.ToFloat64(metrics.ReducerCancellations))
 }
 
 func TestWithRequestReducerAllowsConcurrentRequestsFromDifferentChats(t *testing.T) {
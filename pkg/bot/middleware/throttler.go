@@ -6,11 +6,13 @@ import (
 	"fmt"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 )
 
 // WithThrottler limits the number of concurrent handler executions by ensuring no more than maxConcurrent routines run.
 // It uses a buffered channel as a semaphore to manage concurrency, blocking excess requests until a slot is available.
 // Accepts maxConcurrent, the maximum number of concurrent executions allowed.
+// The number of requests waiting for a slot is reported as the throttler queue depth metric.
 // Returns a Middleware that enforces the concurrency limit and an error if context is cancelled or message is nil.
 func WithThrottler(maxConcurrent int) Middleware {
 	// Create a buffered channel with capacity of maxConcurrent to act as a semaphore
@@ -22,14 +24,18 @@ func WithThrottler(maxConcurrent int) Middleware {
 				return tgbotapi.MessageConfig{}, errors.New("message is nil")
 			}
 
+			metrics.ThrottlerQueueDepth.Inc()
+
 			// Try to acquire a slot or wait for context cancellation
 			select {
 			case throttler <- struct{}{}: // Acquire slot
+				metrics.ThrottlerQueueDepth.Dec()
 				// Ensure we release the slot after processing
 				defer func() { <-throttler }()
 				// Process the message
 				return next.Handle(ctx, message)
 			case <-ctx.Done():
+				metrics.ThrottlerQueueDepth.Dec()
 				// Context was cancelled while waiting for a slot
 				return tgbotapi.MessageConfig{}, fmt.Errorf("context cancelled while waiting for throttler: %w", ctx.Err())
 			}
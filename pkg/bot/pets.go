@@ -0,0 +1,147 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// handleAddPet starts the pet profile questionnaire for adding another pet to the user's profiles.
+// It returns the first question of the questionnaire or an error if the request cannot be processed.
+func (s *ServiceImpl) handleAddPet(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	req, err := message.NewUserMessage(
+		fmt.Sprintf("%d", msg.From.ID),
+		fmt.Sprintf("%d", msg.Chat.ID),
+		msg.Text,
+	)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to create user message: %w", err)
+	}
+
+	resp, err := s.AISvc.ProcessAddPet(ctx, req)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to process add pet request: %w", err)
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, resp.Message), nil
+}
+
+// handlePets lists the user's pets, marking the one currently used as the context for questions.
+// Returns a message with the list of pets or a hint to create a profile if the user has none.
+func (s *ServiceImpl) handlePets(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	profiles, err := s.AISvc.ListPets(ctx, fmt.Sprintf("%d", msg.From.ID))
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return noPetsMessage(ctx, msg), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to list pets: %w", err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(i18n.GetLocale(ctx).Sprintf("Your pets:"))
+	sb.WriteString("\n")
+
+	for i, p := range profiles.Profiles {
+		marker := "•"
+		if i == profiles.Active {
+			marker = "✅"
+		}
+
+		fmt.Fprintf(&sb, "%s %s (%s)\n", marker, p.Name, p.Species)
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(i18n.GetLocale(ctx).Sprintf("Use /switchpet to select the pet your questions are about."))
+
+	return tgbotapi.NewMessage(msg.Chat.ID, sb.String()), nil
+}
+
+// handleSwitchPet makes the pet named in the command arguments the active one.
+// Without arguments it offers a keyboard with the user's pets to choose from.
+func (s *ServiceImpl) handleSwitchPet(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	userID := fmt.Sprintf("%d", msg.From.ID)
+
+	name := strings.TrimSpace(msg.CommandArguments())
+	if name == "" {
+		return s.petChoice(ctx, msg, "switchpet", i18n.GetLocale(ctx).Sprintf("Which pet would you like to ask about?"))
+	}
+
+	err := s.AISvc.SwitchPet(ctx, userID, name)
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("I couldn't find a pet named %s. Use /pets to see your pets.", name)), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to switch pet: %w", err)
+	}
+
+	resp := tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Your questions are now about %s.", name))
+	resp.ReplyMarkup = tgbotapi.ReplyKeyboardRemove{RemoveKeyboard: true}
+
+	return resp, nil
+}
+
+// handleRemovePet removes the pet named in the command arguments from the user's profiles.
+// Without arguments it offers a keyboard with the user's pets to choose from.
+func (s *ServiceImpl) handleRemovePet(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	userID := fmt.Sprintf("%d", msg.From.ID)
+
+	name := strings.TrimSpace(msg.CommandArguments())
+	if name == "" {
+		return s.petChoice(ctx, msg, "removepet", i18n.GetLocale(ctx).Sprintf("Which pet profile would you like to remove?"))
+	}
+
+	err := s.AISvc.RemovePet(ctx, userID, name)
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("I couldn't find a pet named %s. Use /pets to see your pets.", name)), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to remove pet: %w", err)
+	}
+
+	resp := tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Profile of %s has been removed.", name))
+	resp.ReplyMarkup = tgbotapi.ReplyKeyboardRemove{RemoveKeyboard: true}
+
+	return resp, nil
+}
+
+// petChoice builds a message with a keyboard listing the user's pets, each button invoking the given command with the pet name.
+// Returns a hint to create a profile if the user has no pets, or an error if listing pets fails.
+func (s *ServiceImpl) petChoice(ctx context.Context, msg *tgbotapi.Message, command, text string) (tgbotapi.MessageConfig, error) {
+	profiles, err := s.AISvc.ListPets(ctx, fmt.Sprintf("%d", msg.From.ID))
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return noPetsMessage(ctx, msg), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to list pets: %w", err)
+	}
+
+	resp := tgbotapi.NewMessage(msg.Chat.ID, text)
+	resp.ReplyMarkup = petKeyboard(command, profiles)
+
+	return resp, nil
+}
+
+// petKeyboard creates a one-time reply keyboard with a button per pet that sends the given command with the pet's name.
+func petKeyboard(command string, profiles *pet.Profiles) tgbotapi.ReplyKeyboardMarkup {
+	keyboard := make([][]tgbotapi.KeyboardButton, len(profiles.Profiles))
+	for i, p := range profiles.Profiles {
+		keyboard[i] = []tgbotapi.KeyboardButton{
+			{Text: fmt.Sprintf("/%s %s", command, p.Name)},
+		}
+	}
+
+	return tgbotapi.ReplyKeyboardMarkup{
+		Keyboard:        keyboard,
+		OneTimeKeyboard: true,
+		ResizeKeyboard:  true,
+	}
+}
+
+// noPetsMessage returns a hint for users without any pet profiles.
+func noPetsMessage(ctx context.Context, msg *tgbotapi.Message) tgbotapi.MessageConfig {
+	return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("You don't have any pet profiles yet. Use /editprofile or /addpet to create one."))
+}
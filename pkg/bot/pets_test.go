@@ -0,0 +1,162 @@
+package bot
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newCommandMessage builds a Telegram message carrying the given command text for chat 123 from user 456.
+func newCommandMessage(text string) *tgbotapi.Message {
+	command, _, _ := strings.Cut(text, " ")
+
+	return &tgbotapi.Message{
+		Text: text,
+		From: &tgbotapi.User{ID: 456, LanguageCode: "en"},
+		Chat: &tgbotapi.Chat{ID: 123},
+		Entities: []tgbotapi.MessageEntity{
+			{Type: "bot_command", Offset: 0, Length: len(command)},
+		},
+	}
+}
+
+func TestHandleCommand_Pets(t *testing.T) {
+	profiles := &pet.Profiles{
+		Profiles: []pet.Profile{{Name: "Max", Species: "dog"}, {Name: "Bella", Species: "cat"}},
+		Active:   1,
+	}
+
+	tests := []struct {
+		name          string
+		command       string
+		mockSetup     func(m *MockAIProvider)
+		expectedMsg   string
+		expectedError string
+		checkMarkup   func(t *testing.T, markup interface{})
+	}{
+		{
+			name:    "add pet",
+			command: "/addpet",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ProcessAddPet(mock.Anything, mock.MatchedBy(func(req *message.UserMessage) bool {
+					return req.UserID == "456" && req.ChatID == "123"
+				})).Return(&message.Response{Message: "What is your pet's name?"}, nil)
+			},
+			expectedMsg: "What is your pet's name?",
+		},
+		{
+			name:    "add pet error",
+			command: "/addpet",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ProcessAddPet(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedError: "failed to process add pet request: " + assert.AnError.Error(),
+		},
+		{
+			name:    "list pets",
+			command: "/pets",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(profiles, nil)
+			},
+			expectedMsg: "• Max (dog)\n✅ Bella (cat)",
+		},
+		{
+			name:    "list pets without profiles",
+			command: "/pets",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(nil, core.ErrProfileNotFound)
+			},
+			expectedMsg: "You don't have any pet profiles yet",
+		},
+		{
+			name:    "switch pet without name offers choice",
+			command: "/switchpet",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(profiles, nil)
+			},
+			expectedMsg: "Which pet would you like to ask about?",
+			checkMarkup: func(t *testing.T, markup interface{}) {
+				keyboard, ok := markup.(tgbotapi.ReplyKeyboardMarkup)
+				assert.True(t, ok)
+				assert.Equal(t, "/switchpet Max", keyboard.Keyboard[0][0].Text)
+				assert.Equal(t, "/switchpet Bella", keyboard.Keyboard[1][0].Text)
+			},
+		},
+		{
+			name:    "switch pet",
+			command: "/switchpet Max",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().SwitchPet(mock.Anything, "456", "Max").Return(nil)
+			},
+			expectedMsg: "Your questions are now about Max.",
+		},
+		{
+			name:    "switch to unknown pet",
+			command: "/switchpet Rex",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().SwitchPet(mock.Anything, "456", "Rex").Return(core.ErrProfileNotFound)
+			},
+			expectedMsg: "I couldn't find a pet named Rex.",
+		},
+		{
+			name:    "switch pet error",
+			command: "/switchpet Max",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().SwitchPet(mock.Anything, "456", "Max").Return(assert.AnError)
+			},
+			expectedError: "failed to switch pet: " + assert.AnError.Error(),
+		},
+		{
+			name:    "remove pet",
+			command: "/removepet Bella",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().RemovePet(mock.Anything, "456", "Bella").Return(nil)
+			},
+			expectedMsg: "Profile of Bella has been removed.",
+		},
+		{
+			name:    "remove pet without name offers choice",
+			command: "/removepet",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(profiles, nil)
+			},
+			expectedMsg: "Which pet profile would you like to remove?",
+			checkMarkup: func(t *testing.T, markup interface{}) {
+				keyboard, ok := markup.(tgbotapi.ReplyKeyboardMarkup)
+				assert.True(t, ok)
+				assert.Equal(t, "/removepet Max", keyboard.Keyboard[0][0].Text)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(123), resp.ChatID)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+
+			if tt.checkMarkup != nil {
+				tt.checkMarkup(t, resp.ReplyMarkup)
+			}
+		})
+	}
+}
@@ -74,28 +74,7 @@ func (s *ServiceImpl) handlePhoto(ctx context.Context, msg *tgbotapi.Message) (t
 		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to process user message: %w", err)
 	}
 
-	// Create msg with buttons if available
-	resp := tgbotapi.NewMessage(msg.Chat.ID, response.Message)
-
-	// Handle keyboard markup based on answers
-	if len(response.Answers) > 0 {
-		keyboard := make([][]tgbotapi.KeyboardButton, len(response.Answers))
-		for i, answer := range response.Answers {
-			keyboard[i] = []tgbotapi.KeyboardButton{
-				{Text: answer},
-			}
-		}
-		resp.ReplyMarkup = tgbotapi.ReplyKeyboardMarkup{
-			Keyboard:        keyboard,
-			OneTimeKeyboard: true,
-			ResizeKeyboard:  true,
-		}
-	} else {
-		resp.ReplyMarkup = tgbotapi.ReplyKeyboardRemove{
-			RemoveKeyboard: true,
-			Selective:      false,
-		}
-	}
+	resp := s.newResponseMessage(ctx, msg.Chat.ID, response)
 
 	return resp, nil
 }
@@ -0,0 +1,223 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+const (
+	// reminderCallback is the callback data kind of reminder buttons
+	reminderCallback = "reminder"
+	// reminderSnooze defines how long a snoozed reminder is postponed
+	reminderSnooze = time.Hour
+
+	reminderActionDone   = "done"
+	reminderActionSnooze = "snooze"
+	reminderActionDelete = "delete"
+
+	reminderTimeFormat = "2006-01-02 15:04 MST"
+)
+
+// handleRemind creates a recurring reminder from the command arguments, e.g. "/remind give Rimadyl every 12h for 7 days".
+// Without arguments or with a definition that can't be parsed it replies with usage instructions.
+func (s *ServiceImpl) handleRemind(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	definition := strings.TrimSpace(msg.CommandArguments())
+	if definition == "" {
+		return remindUsageMessage(ctx, msg), nil
+	}
+
+	req, err := message.NewUserMessage(
+		fmt.Sprintf("%d", msg.From.ID),
+		fmt.Sprintf("%d", msg.Chat.ID),
+		definition,
+	)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to create user message: %w", err)
+	}
+
+	r, err := s.AISvc.AddReminder(ctx, req, msg.From.LanguageCode)
+
+	switch {
+	case errors.Is(err, reminder.ErrInvalidSchedule), errors.Is(err, reminder.ErrEmptyText):
+		return remindUsageMessage(ctx, msg), nil
+	case errors.Is(err, core.ErrTooManyReminders):
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("You have too many reminders. Use /reminders to delete the ones you don't need.")), nil
+	case errors.Is(err, core.ErrRemindersDisabled):
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Reminders are not available right now.")), nil
+	case err != nil:
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to add reminder: %w", err)
+	}
+
+	text := i18n.GetLocale(ctx).Sprintf("Reminder set: %s, %s.\nNext reminder: %s", reminderSubject(r), r.Schedule, r.NextAt.UTC().Format(reminderTimeFormat))
+
+	return tgbotapi.NewMessage(msg.Chat.ID, text), nil
+}
+
+// handleReminders lists the user's reminders with buttons to delete them.
+func (s *ServiceImpl) handleReminders(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	reminders, err := s.AISvc.ListReminders(ctx, fmt.Sprintf("%d", msg.From.ID))
+	if errors.Is(err, core.ErrRemindersDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Reminders are not available right now.")), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to list reminders: %w", err)
+	}
+
+	text, keyboard := reminderList(ctx, reminders)
+
+	resp := tgbotapi.NewMessage(msg.Chat.ID, text)
+	if keyboard != nil {
+		resp.ReplyMarkup = *keyboard
+	}
+
+	return resp, nil
+}
+
+// NotifyReminder sends the due reminder to the chat it was created in, with buttons to mark it done or snooze it.
+// The notification is localized to the language the reminder was created in.
+// Returns an error if the chat ID is invalid or sending the message fails.
+func (s *ServiceImpl) NotifyReminder(ctx context.Context, r *reminder.Reminder) error {
+	chatID, err := strconv.ParseInt(r.ChatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid reminder chat id %q: %w", r.ChatID, err)
+	}
+
+	ctx = i18n.SetLocale(ctx, callbackLocalizer, r.Language)
+
+	msg := tgbotapi.NewMessage(chatID, "⏰ "+i18n.GetLocale(ctx).Sprintf("Reminder: %s", reminderSubject(r)))
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("✅ "+i18n.GetLocale(ctx).Sprintf("Done"), reminderCallbackData(reminderActionDone, r.ID)),
+			tgbotapi.NewInlineKeyboardButtonData("💤 "+i18n.GetLocale(ctx).Sprintf("Snooze 1h"), reminderCallbackData(reminderActionSnooze, r.ID)),
+		),
+	)
+
+	if _, err := s.Bot.Send(msg); err != nil {
+		return fmt.Errorf("failed to send reminder: %w", err)
+	}
+
+	return nil
+}
+
+// handleReminderCallback handles reminder buttons with payload "<action>:<reminder id>".
+// Returns the notification text to show to the user or an error if the action fails.
+func (s *ServiceImpl) handleReminderCallback(ctx context.Context, query *tgbotapi.CallbackQuery, payload string) (string, error) {
+	userID := fmt.Sprintf("%d", query.From.ID)
+	action, id, _ := strings.Cut(payload, ":")
+
+	var err error
+
+	switch action {
+	case reminderActionDone:
+		err = s.AISvc.CompleteReminder(ctx, userID, id)
+	case reminderActionSnooze:
+		err = s.AISvc.SnoozeReminder(ctx, userID, id, reminderSnooze)
+	case reminderActionDelete:
+		err = s.AISvc.DeleteReminder(ctx, userID, id)
+	default:
+		return "", fmt.Errorf("unknown reminder action: %s", action)
+	}
+
+	if errors.Is(err, core.ErrReminderNotFound) {
+		s.removeInlineKeyboard(ctx, query)
+		return i18n.GetLocale(ctx).Sprintf("This reminder no longer exists."), nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to %s reminder: %w", action, err)
+	}
+
+	switch action {
+	case reminderActionDone:
+		s.removeInlineKeyboard(ctx, query)
+		return i18n.GetLocale(ctx).Sprintf("Marked as done"), nil
+	case reminderActionSnooze:
+		s.removeInlineKeyboard(ctx, query)
+		return i18n.GetLocale(ctx).Sprintf("I'll remind you again in an hour"), nil
+	default:
+		if err := s.refreshReminderList(ctx, query); err != nil {
+			return "", err
+		}
+
+		return i18n.GetLocale(ctx).Sprintf("Reminder deleted"), nil
+	}
+}
+
+// refreshReminderList replaces the reminder list the callback query came from with the current list of the user's reminders.
+func (s *ServiceImpl) refreshReminderList(ctx context.Context, query *tgbotapi.CallbackQuery) error {
+	if query.Message == nil {
+		return nil
+	}
+
+	reminders, err := s.AISvc.ListReminders(ctx, fmt.Sprintf("%d", query.From.ID))
+	if err != nil {
+		return fmt.Errorf("failed to list reminders: %w", err)
+	}
+
+	text, keyboard := reminderList(ctx, reminders)
+
+	edit := tgbotapi.NewEditMessageText(query.Message.Chat.ID, query.Message.MessageID, text)
+	edit.ReplyMarkup = keyboard
+
+	if _, err := s.Bot.Request(edit); err != nil {
+		return fmt.Errorf("failed to update reminder list: %w", err)
+	}
+
+	return nil
+}
+
+// reminderList formats the list of reminders with a delete button for each of them.
+// Returns the text of the list and the keyboard, which is nil if there are no reminders.
+func reminderList(ctx context.Context, reminders []*reminder.Reminder) (string, *tgbotapi.InlineKeyboardMarkup) {
+	if len(reminders) == 0 {
+		return i18n.GetLocale(ctx).Sprintf("You don't have any reminders. Use /remind to create one, e.g. /remind give Rimadyl every 12h for 7 days"), nil
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(i18n.GetLocale(ctx).Sprintf("Your reminders:"))
+	sb.WriteString("\n")
+
+	rows := make([][]tgbotapi.InlineKeyboardButton, len(reminders))
+
+	for i, r := range reminders {
+		fmt.Fprintf(&sb, "%d. %s, %s\n   %s\n", i+1, reminderSubject(r), r.Schedule, i18n.GetLocale(ctx).Sprintf("Next: %s", r.DueAt().UTC().Format(reminderTimeFormat)))
+
+		rows[i] = tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("🗑 %d", i+1), reminderCallbackData(reminderActionDelete, r.ID)),
+		)
+	}
+
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
+
+	return sb.String(), &keyboard
+}
+
+// reminderSubject returns the reminder text prefixed with the name of the pet it is about, if any.
+func reminderSubject(r *reminder.Reminder) string {
+	if r.PetName == "" {
+		return r.Text
+	}
+
+	return r.PetName + ": " + r.Text
+}
+
+// reminderCallbackData builds the callback data of a reminder button.
+func reminderCallbackData(action, id string) string {
+	return reminderCallback + ":" + action + ":" + id
+}
+
+// remindUsageMessage returns instructions on how to define a reminder.
+func remindUsageMessage(ctx context.Context, msg *tgbotapi.Message) tgbotapi.MessageConfig {
+	return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf(`Tell me what to remind you about and how often, for example:
+/remind give Rimadyl every 12h for 7 days
+/remind flea treatment monthly
+/remind brush teeth twice a day`))
+}
@@ -0,0 +1,233 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Reminders(t *testing.T) {
+	nextAt := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	rem := &reminder.Reminder{
+		ID:       "r1",
+		PetName:  "Max",
+		Text:     "give Rimadyl",
+		NextAt:   nextAt,
+		Schedule: reminder.Schedule{Unit: reminder.UnitHour, Every: 12},
+	}
+
+	tests := []struct {
+		mockSetup     func(m *MockAIProvider)
+		checkMarkup   func(t *testing.T, markup any)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "add reminder",
+			command: "/remind give Rimadyl every 12h",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().AddReminder(mock.Anything, mock.MatchedBy(func(req *message.UserMessage) bool {
+					return req.UserID == "456" && req.ChatID == "123" && req.Text == "give Rimadyl every 12h"
+				}), "en").Return(rem, nil)
+			},
+			expectedMsg: "Reminder set: Max: give Rimadyl, every 12 hours.\nNext reminder: 2026-01-02 09:00 UTC",
+		},
+		{
+			name:        "add reminder without definition",
+			command:     "/remind",
+			mockSetup:   func(_ *MockAIProvider) {},
+			expectedMsg: "Tell me what to remind you about",
+		},
+		{
+			name:    "add reminder with invalid schedule",
+			command: "/remind give Rimadyl sometimes",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().AddReminder(mock.Anything, mock.Anything, "en").Return(nil, reminder.ErrInvalidSchedule)
+			},
+			expectedMsg: "Tell me what to remind you about",
+		},
+		{
+			name:    "add reminder over the limit",
+			command: "/remind give Rimadyl daily",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().AddReminder(mock.Anything, mock.Anything, "en").Return(nil, core.ErrTooManyReminders)
+			},
+			expectedMsg: "You have too many reminders",
+		},
+		{
+			name:    "add reminder error",
+			command: "/remind give Rimadyl daily",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().AddReminder(mock.Anything, mock.Anything, "en").Return(nil, assert.AnError)
+			},
+			expectedError: "failed to add reminder: " + assert.AnError.Error(),
+		},
+		{
+			name:    "list reminders",
+			command: "/reminders",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListReminders(mock.Anything, "456").Return([]*reminder.Reminder{rem}, nil)
+			},
+			expectedMsg: "1. Max: give Rimadyl, every 12 hours",
+			checkMarkup: func(t *testing.T, markup any) {
+				keyboard, ok := markup.(tgbotapi.InlineKeyboardMarkup)
+				require.True(t, ok)
+				require.Len(t, keyboard.InlineKeyboard, 1)
+				assert.Equal(t, "reminder:delete:r1", *keyboard.InlineKeyboard[0][0].CallbackData)
+			},
+		},
+		{
+			name:    "list without reminders",
+			command: "/reminders",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListReminders(mock.Anything, "456").Return(nil, nil)
+			},
+			expectedMsg: "You don't have any reminders",
+			checkMarkup: func(t *testing.T, markup any) {
+				assert.Nil(t, markup)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+
+			if tt.checkMarkup != nil {
+				tt.checkMarkup(t, resp.ReplyMarkup)
+			}
+		})
+	}
+}
+
+func TestService_NotifyReminder(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	svc := &ServiceImpl{Bot: mockBot}
+
+	rem := &reminder.Reminder{ID: "r1", ChatID: "123", PetName: "Max", Text: "give Rimadyl", Language: "en"}
+
+	mockBot.EXPECT().Send(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+		msg, ok := c.(tgbotapi.MessageConfig)
+		if !ok || msg.ChatID != 123 || msg.Text != "⏰ Reminder: Max: give Rimadyl" {
+			return false
+		}
+
+		keyboard, ok := msg.ReplyMarkup.(tgbotapi.InlineKeyboardMarkup)
+
+		return ok && *keyboard.InlineKeyboard[0][0].CallbackData == "reminder:done:r1" &&
+			*keyboard.InlineKeyboard[0][1].CallbackData == "reminder:snooze:r1"
+	})).Return(tgbotapi.Message{}, nil)
+
+	assert.NoError(t, svc.NotifyReminder(context.Background(), rem))
+
+	rem.ChatID = "invalid"
+	assert.Error(t, svc.NotifyReminder(context.Background(), rem))
+}
+
+func TestService_handleCallback_Reminder(t *testing.T) {
+	tests := []struct {
+		mockSetup    func(ai *MockAIProvider, bot *MockBotAPI)
+		name         string
+		data         string
+		expectedText string
+	}{
+		{
+			name: "mark done",
+			data: "reminder:done:r1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().CompleteReminder(mock.Anything, "456", "r1").Return(nil)
+				bot.EXPECT().Request(mock.AnythingOfType("tgbotapi.EditMessageReplyMarkupConfig")).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "Marked as done",
+		},
+		{
+			name: "snooze",
+			data: "reminder:snooze:r1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().SnoozeReminder(mock.Anything, "456", "r1", reminderSnooze).Return(nil)
+				bot.EXPECT().Request(mock.AnythingOfType("tgbotapi.EditMessageReplyMarkupConfig")).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "I'll remind you again in an hour",
+		},
+		{
+			name: "delete refreshes the list",
+			data: "reminder:delete:r1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().DeleteReminder(mock.Anything, "456", "r1").Return(nil)
+				ai.EXPECT().ListReminders(mock.Anything, "456").Return(nil, nil)
+				bot.EXPECT().Request(mock.AnythingOfType("tgbotapi.EditMessageTextConfig")).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "Reminder deleted",
+		},
+		{
+			name: "reminder not found",
+			data: "reminder:done:r1",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().CompleteReminder(mock.Anything, "456", "r1").Return(core.ErrReminderNotFound)
+				bot.EXPECT().Request(mock.AnythingOfType("tgbotapi.EditMessageReplyMarkupConfig")).Return(&tgbotapi.APIResponse{}, nil)
+			},
+			expectedText: "This reminder no longer exists.",
+		},
+		{
+			name: "action error",
+			data: "reminder:snooze:r1",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().SnoozeReminder(mock.Anything, "456", "r1", reminderSnooze).Return(assert.AnError)
+			},
+			expectedText: "Sorry, I encountered an error while processing your request. Please try again later.",
+		},
+		{
+			name:         "unknown callback",
+			data:         "unknown:payload",
+			mockSetup:    func(_ *MockAIProvider, _ *MockBotAPI) {},
+			expectedText: "Sorry, I encountered an error while processing your request. Please try again later.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			mockBot := NewMockBotAPI(t)
+			tt.mockSetup(mockAI, mockBot)
+
+			mockBot.EXPECT().Request(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+				cb, ok := c.(tgbotapi.CallbackConfig)
+				return ok && cb.CallbackQueryID == "cb1" && cb.Text == tt.expectedText
+			})).Return(&tgbotapi.APIResponse{}, nil)
+
+			svc := &ServiceImpl{Bot: mockBot, AISvc: mockAI}
+
+			svc.processUpdate(context.Background(), &tgbotapi.Update{
+				CallbackQuery: &tgbotapi.CallbackQuery{
+					ID:      "cb1",
+					From:    &tgbotapi.User{ID: 456, LanguageCode: "en"},
+					Message: &tgbotapi.Message{MessageID: 789, Chat: &tgbotapi.Chat{ID: 123}},
+					Data:    tt.data,
+				},
+			})
+		})
+	}
+}
@@ -12,7 +12,13 @@ import (
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/google/uuid"
 	"github.com/ksysoev/help-my-pet/pkg/bot/media"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
 )
 
 const (
@@ -26,13 +32,37 @@ type BotAPI interface {
 	StopReceivingUpdates()
 	GetUpdatesChan(config tgbotapi.UpdateConfig) tgbotapi.UpdatesChannel
 	GetFile(config tgbotapi.FileConfig) (tgbotapi.File, error)
+	MakeRequest(endpoint string, params tgbotapi.Params) (*tgbotapi.APIResponse, error)
 }
 
 type AIProvider interface {
 	ProcessMessage(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	ProcessMessageStream(ctx context.Context, request *message.UserMessage, onText func(string)) (*message.Response, error)
 	ProcessEditProfile(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	ProcessAddPet(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	ProcessAddVaccination(ctx context.Context, request *message.UserMessage) (*message.Response, error)
+	ListPets(ctx context.Context, userID string) (*pet.Profiles, error)
+	SwitchPet(ctx context.Context, userID, name string) error
+	RemovePet(ctx context.Context, userID, name string) error
+	AddWeight(ctx context.Context, userID, weight string) (*pet.Profile, error)
 	CancelQuestionnaire(ctx context.Context, chatID string) error
 	ResetUserConversation(ctx context.Context, userID, chatID string) error
+	AddReminder(ctx context.Context, request *message.UserMessage, language string) (*reminder.Reminder, error)
+	ListReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error)
+	CompleteReminder(ctx context.Context, userID, id string) error
+	SnoozeReminder(ctx context.Context, userID, id string, d time.Duration) error
+	DeleteReminder(ctx context.Context, userID, id string) error
+	GetSpend(ctx context.Context, userID string) (*budget.Spend, error)
+	GetStats(ctx context.Context) (*core.Stats, error)
+	GetUserInfo(ctx context.Context, userID string) (*core.UserInfo, error)
+	SetWhitelisted(ctx context.Context, userID string, whitelisted bool) error
+	ResetQuota(ctx context.Context, userID string) error
+	TrackChat(ctx context.Context, chatID, language string) error
+	RemoveChat(ctx context.Context, chatID string) error
+	PreviewBroadcast(ctx context.Context, b *broadcast.Broadcast) (map[string]int, error)
+	StartBroadcast(ctx context.Context, b *broadcast.Broadcast) error
+	RateAnswer(ctx context.Context, userID, answerID string, rating feedback.Rating) error
+	AddFeedbackReason(ctx context.Context, userID, reason string) (bool, error)
 }
 
 type httpClient interface {
@@ -40,17 +70,25 @@ type httpClient interface {
 }
 
 // Config holds the configuration for the Telegram bot
+// Mode selects how updates are received: "polling" (default) or "webhook".
+// AdminIDs lists Telegram user IDs allowed to use admin commands, such as /stats or /broadcast.
 type Config struct {
-	TelegramToken string `mapstructure:"telegram_token"`
+	TelegramToken string        `mapstructure:"telegram_token"`
+	Mode          string        `mapstructure:"mode"`
+	Webhook       WebhookConfig `mapstructure:"webhook"`
+	AdminIDs      []int64       `mapstructure:"admin_ids"`
 }
 
 type ServiceImpl struct {
 	token      string
+	mode       string
+	webhook    WebhookConfig
 	Bot        BotAPI
 	AISvc      AIProvider
 	handler    Handler
 	collector  *media.Collector
 	httpClient httpClient
+	adminIDs   []int64
 }
 
 // NewService creates a new bot service with the given configuration and AI provider
@@ -67,13 +105,26 @@ func NewService(cfg *Config, aiSvc AIProvider) (*ServiceImpl, error) {
 		return nil, fmt.Errorf("telegram token cannot be empty")
 	}
 
+	switch cfg.Mode {
+	case "", ModePolling:
+	case ModeWebhook:
+		if err := cfg.Webhook.validate(); err != nil {
+			return nil, fmt.Errorf("invalid webhook config: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bot mode: %s", cfg.Mode)
+	}
+
 	bot, err := tgbotapi.NewBotAPI(cfg.TelegramToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Telegram bot: %w", err)
 	}
 
 	s := &ServiceImpl{
+		adminIDs:  cfg.AdminIDs,
 		token:     cfg.TelegramToken,
+		mode:      cfg.Mode,
+		webhook:   cfg.Webhook,
 		Bot:       bot,
 		AISvc:     aiSvc,
 		collector: media.NewCollector(),
@@ -104,6 +155,11 @@ func (s *ServiceImpl) processUpdate(ctx context.Context, update *tgbotapi.Update
 		return
 	}
 
+	if update.CallbackQuery != nil {
+		s.handleCallback(ctx, update.CallbackQuery)
+		return
+	}
+
 	if update.Message == nil {
 		return
 	}
@@ -156,7 +212,18 @@ func (s *ServiceImpl) processUpdate(ctx context.Context, update *tgbotapi.Update
 	}
 }
 
+// Run starts receiving updates from Telegram in the configured mode and processes them until ctx is cancelled.
+// Returns an error if the service fails to start receiving updates.
 func (s *ServiceImpl) Run(ctx context.Context) error {
+	if s.mode == ModeWebhook {
+		return s.runWebhook(ctx)
+	}
+
+	return s.runPolling(ctx)
+}
+
+// runPolling receives updates from Telegram using long polling and processes them until ctx is cancelled.
+func (s *ServiceImpl) runPolling(ctx context.Context) error {
 	slog.InfoContext(ctx, "Starting Telegram bot")
 
 	updateConfig := tgbotapi.NewUpdate(0)
@@ -164,6 +231,12 @@ func (s *ServiceImpl) Run(ctx context.Context) error {
 
 	updates := s.Bot.GetUpdatesChan(updateConfig)
 
+	return s.serve(ctx, updates, s.Bot.StopReceivingUpdates)
+}
+
+// serve processes updates from the provided channel concurrently until the channel is closed or ctx is cancelled.
+// On cancellation it calls stop to stop receiving new updates and waits for ongoing message processors to finish.
+func (s *ServiceImpl) serve(ctx context.Context, updates <-chan tgbotapi.Update, stop func()) error {
 	var wg sync.WaitGroup
 
 	for {
@@ -190,7 +263,7 @@ func (s *ServiceImpl) Run(ctx context.Context) error {
 
 		case <-ctx.Done():
 			slog.Info("Starting graceful shutdown")
-			s.Bot.StopReceivingUpdates()
+			stop()
 
 			// Wait for ongoing message processors with a timeout
 			done := make(chan struct{})
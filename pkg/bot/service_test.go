@@ -85,11 +85,11 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(&tgbotapi.APIResponse{}, nil)
-				mockAI.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, &message.UserMessage{
 					ChatID: "123",
 					UserID: "456",
 					Text:   "test message",
-				}).Return(&message.Response{
+				}, mock.Anything).Return(&message.Response{
 					Message: "AI response",
 				}, nil)
 				mockBot.EXPECT().Send(mock.MatchedBy(func(msg tgbotapi.MessageConfig) bool {
@@ -113,11 +113,11 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(nil, assert.AnError)
-				mockAI.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, &message.UserMessage{
 					ChatID: "123",
 					UserID: "456",
 					Text:   "test message",
-				}).Return(&message.Response{
+				}, mock.Anything).Return(&message.Response{
 					Message: "AI response",
 				}, nil)
 				mockBot.EXPECT().Send(mock.MatchedBy(func(msg tgbotapi.MessageConfig) bool {
@@ -141,11 +141,11 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(&tgbotapi.APIResponse{}, nil)
-				mockAI.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, &message.UserMessage{
 					ChatID: "123",
 					UserID: "456",
 					Text:   "test message",
-				}).Return(nil, context.Canceled)
+				}, mock.Anything).Return(nil, context.Canceled)
 				mockBot.EXPECT().Send(mock.MatchedBy(func(msg tgbotapi.MessageConfig) bool {
 					return msg.ChatID == 123 && msg.Text != ""
 				})).Return(tgbotapi.Message{}, nil)
@@ -167,11 +167,11 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(&tgbotapi.APIResponse{}, nil)
-				mockAI.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, &message.UserMessage{
 					ChatID: "123",
 					UserID: "456",
 					Text:   "test message",
-				}).Return(&message.Response{
+				}, mock.Anything).Return(&message.Response{
 					Message: "AI response",
 				}, nil)
 				mockBot.EXPECT().Send(mock.MatchedBy(func(msg tgbotapi.MessageConfig) bool {
@@ -195,11 +195,11 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(&tgbotapi.APIResponse{}, nil)
-				mockAI.EXPECT().ProcessMessage(mock.Anything, &message.UserMessage{
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, &message.UserMessage{
 					ChatID: "123",
 					UserID: "456",
 					Text:   "test message",
-				}).Return(&message.Response{
+				}, mock.Anything).Return(&message.Response{
 					Message: "",
 				}, nil)
 			},
@@ -254,7 +254,7 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
 				mockBot.EXPECT().Request(mock.Anything).Return(&tgbotapi.APIResponse{}, nil)
 				mockBot.EXPECT().Send(mock.Anything).Return(tgbotapi.Message{}, nil).Maybe()
-				mockAI.EXPECT().ProcessMessage(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+				mockAI.EXPECT().ProcessMessageStream(mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
 			},
 			expectError: false,
 		},
@@ -282,6 +282,7 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 				},
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
+				mockAI.EXPECT().RemoveChat(mock.Anything, "123").Return(nil)
 				mockAI.EXPECT().ResetUserConversation(mock.Anything, "456", "123").Return(nil)
 			},
 			expectError: false,
@@ -299,6 +300,7 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 				},
 			},
 			setupMocks: func(mockBot *MockBotAPI, mockAI *MockAIProvider) {
+				mockAI.EXPECT().RemoveChat(mock.Anything, "123").Return(nil)
 				mockAI.EXPECT().ResetUserConversation(mock.Anything, "456", "123").Return(assert.AnError)
 			},
 			expectError: false,
@@ -317,6 +319,7 @@ func TestServiceImpl_ProcessUpdate(t *testing.T) {
 
 			service.handler = service.setupHandler()
 
+			mockAI.EXPECT().TrackChat(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 			tt.setupMocks(mockBot, mockAI)
 
 			service.processUpdate(tt.ctx, tt.update)
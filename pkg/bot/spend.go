@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+)
+
+// handleSpend reports the current LLM spend against the budget ceilings, it is an admin command.
+// The spend of the user whose ID is given as the command argument is reported, or of the admin if it is omitted.
+// Returns the report message or an error if fetching the spend fails.
+func (s *ServiceImpl) handleSpend(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	userID := strings.TrimSpace(msg.CommandArguments())
+	if userID == "" {
+		userID = fmt.Sprintf("%d", msg.From.ID)
+	}
+
+	spend, err := s.AISvc.GetSpend(ctx, userID)
+	if errors.Is(err, core.ErrBudgetDisabled) {
+		return tgbotapi.NewMessage(msg.Chat.ID, "Budget tracking is not configured, set model prices in the budget config."), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to get spend: %w", err)
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, spendReport(userID, spend)), nil
+}
+
+// spendReport formats the spend of all users and of the user against the configured ceilings.
+func spendReport(userID string, spend *budget.Spend) string {
+	var b strings.Builder
+
+	b.WriteString("LLM spend\n")
+	fmt.Fprintf(&b, "Global today: %s\n", spendAmount(spend.GlobalDaily, spend.GlobalDailyLimit))
+	fmt.Fprintf(&b, "Global this month: %s\n", spendAmount(spend.GlobalMonthly, spend.GlobalMonthlyLimit))
+	fmt.Fprintf(&b, "User %s today: %s\n", userID, spendAmount(spend.UserDaily, spend.UserDailyLimit))
+	fmt.Fprintf(&b, "User %s this month: %s", userID, spendAmount(spend.UserMonthly, spend.UserMonthlyLimit))
+
+	return b.String()
+}
+
+// spendAmount formats the spend in USD together with its ceiling, zero ceilings are reported as no limit.
+func spendAmount(spend, limit float64) string {
+	if limit <= 0 {
+		return fmt.Sprintf("$%.2f (no limit)", spend)
+	}
+
+	return fmt.Sprintf("$%.2f of $%.2f (%.0f%%)", spend, limit, spend/limit*100)
+}
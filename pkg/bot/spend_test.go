@@ -0,0 +1,80 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Spend(t *testing.T) {
+	tests := []struct {
+		mockSetup     func(ai *MockAIProvider)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "own spend",
+			command: "/spend",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetSpend(mock.Anything, "456").Return(&budget.Spend{
+					GlobalDaily:      2.5,
+					GlobalDailyLimit: 10,
+					GlobalMonthly:    40,
+					UserDaily:        0.126,
+				}, nil)
+			},
+			expectedMsg: "LLM spend\nGlobal today: $2.50 of $10.00 (25%)\nGlobal this month: $40.00 (no limit)\n" +
+				"User 456 today: $0.13 (no limit)\nUser 456 this month: $0.00 (no limit)",
+		},
+		{
+			name:    "spend of another user",
+			command: "/spend 789",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetSpend(mock.Anything, "789").Return(&budget.Spend{UserMonthly: 1, UserMonthlyLimit: 4}, nil)
+			},
+			expectedMsg: "User 789 this month: $1.00 of $4.00 (25%)",
+		},
+		{
+			name:    "budget disabled",
+			command: "/spend",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetSpend(mock.Anything, "456").Return(nil, core.ErrBudgetDisabled)
+			},
+			expectedMsg: "Budget tracking is not configured",
+		},
+		{
+			name:    "spend lookup fails",
+			command: "/spend",
+			mockSetup: func(ai *MockAIProvider) {
+				ai.EXPECT().GetSpend(mock.Anything, "456").Return(nil, assert.AnError)
+			},
+			expectedError: "failed to get spend: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const (
+	// streamEditInterval is the minimal interval between edits of a streamed message,
+	// it keeps the bot within Telegram's limit of about one message per second per chat.
+	streamEditInterval = time.Second
+	// streamCursor is appended to the streamed text to show that the answer is still being generated.
+	streamCursor = " ▌"
+)
+
+// messageStream shows an answer to the user while it is being generated.
+// The first chunk of text is sent as a placeholder message that is then edited as the answer grows.
+// Edits are throttled and paused when Telegram asks to retry later; if the placeholder can't be sent,
+// streaming is disabled and the answer is delivered with a single message.
+type messageStream struct {
+	ctx          context.Context
+	lastEdit     time.Time
+	blockedUntil time.Time
+	bot          BotAPI
+	now          func() time.Time
+	lastText     string
+	chatID       int64
+	messageID    int
+	disabled     bool
+}
+
+// newMessageStream creates a messageStream delivering streamed text to the given chat.
+func (s *ServiceImpl) newMessageStream(ctx context.Context, chatID int64) *messageStream {
+	return &messageStream{
+		ctx:    ctx,
+		bot:    s.Bot,
+		chatID: chatID,
+		now:    time.Now,
+	}
+}
+
+// Update shows the answer text generated so far to the user.
+// The first call sends the placeholder message, following calls edit it unless the previous edit was too recent
+// or Telegram has rate limited the bot.
+func (m *messageStream) Update(text string) {
+	if m.disabled || text == "" || text == m.lastText {
+		return
+	}
+
+	now := m.now()
+	if now.Before(m.blockedUntil) || (m.messageID != 0 && now.Sub(m.lastEdit) < streamEditInterval) {
+		return
+	}
+
+	if m.messageID == 0 {
+		sent, err := m.bot.Send(tgbotapi.NewMessage(m.chatID, text+streamCursor))
+		if err != nil {
+			slog.WarnContext(m.ctx, "Failed to send streamed message, streaming disabled", slog.Any("error", err))
+			m.disabled = true
+
+			return
+		}
+
+		m.messageID = sent.MessageID
+	} else if _, err := m.bot.Send(tgbotapi.NewEditMessageText(m.chatID, m.messageID, text+streamCursor)); err != nil {
+		if !m.handleRateLimit(err) {
+			slog.WarnContext(m.ctx, "Failed to edit streamed message", slog.Any("error", err))
+		}
+
+		return
+	}
+
+	m.lastText = text
+	m.lastEdit = now
+}
+
+// Finish delivers the final response to the user.
+// If a placeholder was sent, it is edited to contain the final text and inline keyboard; when the response needs a reply keyboard,
+// which can't be attached by editing, or the edit fails, the placeholder is removed and the response is returned for sending.
+// Returns the message that still has to be sent, or an empty message if the response was delivered by the edit.
+func (m *messageStream) Finish(resp tgbotapi.MessageConfig) tgbotapi.MessageConfig {
+	if m.messageID == 0 {
+		return resp
+	}
+
+	if _, ok := resp.ReplyMarkup.(tgbotapi.ReplyKeyboardMarkup); !ok {
+		edit := tgbotapi.NewEditMessageText(m.chatID, m.messageID, resp.Text)
+
+		if inline, ok := resp.ReplyMarkup.(tgbotapi.InlineKeyboardMarkup); ok {
+			edit.ReplyMarkup = &inline
+		}
+
+		_, err := m.bot.Send(edit)
+		if err == nil {
+			return tgbotapi.MessageConfig{}
+		}
+
+		slog.WarnContext(m.ctx, "Failed to edit streamed message with final response", slog.Any("error", err))
+	}
+
+	m.Discard()
+
+	return resp
+}
+
+// Discard removes the placeholder message, it is used when the answer can't be completed.
+func (m *messageStream) Discard() {
+	if m.messageID == 0 {
+		return
+	}
+
+	if _, err := m.bot.Request(tgbotapi.NewDeleteMessage(m.chatID, m.messageID)); err != nil {
+		slog.WarnContext(m.ctx, "Failed to delete streamed message", slog.Any("error", err))
+	}
+
+	m.messageID = 0
+}
+
+// handleRateLimit pauses edits for the time requested by Telegram if the error is caused by rate limiting.
+// Returns true if the error is a rate limit error.
+func (m *messageStream) handleRateLimit(err error) bool {
+	var tgErr *tgbotapi.Error
+	if !errors.As(err, &tgErr) || tgErr.RetryAfter == 0 {
+		return false
+	}
+
+	m.blockedUntil = m.now().Add(time.Duration(tgErr.RetryAfter) * time.Second)
+
+	return true
+}
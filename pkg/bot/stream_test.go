@@ -0,0 +1,172 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestMessageStream creates a messageStream with a controllable clock.
+func newTestMessageStream(mockBot *MockBotAPI, now *time.Time) *messageStream {
+	return &messageStream{
+		ctx:    context.Background(),
+		bot:    mockBot,
+		chatID: 123,
+		now:    func() time.Time { return *now },
+	}
+}
+
+func TestMessageStream_Update(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := newTestMessageStream(mockBot, &now)
+
+	mockBot.EXPECT().Send(tgbotapi.NewMessage(123, "Hello"+streamCursor)).Return(tgbotapi.Message{MessageID: 42}, nil).Once()
+	stream.Update("Hello")
+	assert.Equal(t, 42, stream.messageID)
+
+	// Too early for the next edit
+	now = now.Add(streamEditInterval / 2)
+	stream.Update("Hello, wor")
+
+	now = now.Add(streamEditInterval)
+	mockBot.EXPECT().Send(tgbotapi.NewEditMessageText(123, 42, "Hello, world"+streamCursor)).Return(tgbotapi.Message{}, nil).Once()
+	stream.Update("Hello, world")
+
+	// Same text is not sent again
+	now = now.Add(streamEditInterval)
+	stream.Update("Hello, world")
+}
+
+func TestMessageStream_UpdateRateLimited(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := newTestMessageStream(mockBot, &now)
+	stream.messageID = 42
+
+	mockBot.EXPECT().Send(tgbotapi.NewEditMessageText(123, 42, "Hello"+streamCursor)).
+		Return(tgbotapi.Message{}, &tgbotapi.Error{Code: 429, ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 5}}).Once()
+	stream.Update("Hello")
+	assert.Equal(t, now.Add(5*time.Second), stream.blockedUntil)
+
+	// Edits are paused until Telegram allows them again
+	now = now.Add(3 * time.Second)
+	stream.Update("Hello, world")
+
+	now = now.Add(3 * time.Second)
+	mockBot.EXPECT().Send(tgbotapi.NewEditMessageText(123, 42, "Hello, world"+streamCursor)).Return(tgbotapi.Message{}, nil).Once()
+	stream.Update("Hello, world")
+}
+
+func TestMessageStream_UpdatePlaceholderFails(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := newTestMessageStream(mockBot, &now)
+
+	mockBot.EXPECT().Send(tgbotapi.NewMessage(123, "Hello"+streamCursor)).Return(tgbotapi.Message{}, assert.AnError).Once()
+	stream.Update("Hello")
+
+	now = now.Add(streamEditInterval)
+	stream.Update("Hello, world")
+
+	assert.True(t, stream.disabled)
+
+	resp := tgbotapi.NewMessage(123, "Final")
+	assert.Equal(t, resp, stream.Finish(resp))
+}
+
+func TestMessageStream_Finish(t *testing.T) {
+	keyboard := tgbotapi.ReplyKeyboardMarkup{Keyboard: [][]tgbotapi.KeyboardButton{{{Text: "Yes"}}}}
+	inlineKeyboard := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonURL("Vet", "https://example.com")))
+
+	tests := []struct {
+		setupMocks func(mockBot *MockBotAPI)
+		markup     any
+		name       string
+		messageID  int
+		wantSend   bool
+	}{
+		{
+			name:     "nothing streamed",
+			wantSend: true,
+		},
+		{
+			name:      "edits streamed message",
+			messageID: 42,
+			markup:    tgbotapi.ReplyKeyboardRemove{RemoveKeyboard: true},
+			setupMocks: func(mockBot *MockBotAPI) {
+				mockBot.EXPECT().Send(tgbotapi.NewEditMessageText(123, 42, "Final")).Return(tgbotapi.Message{}, nil)
+			},
+		},
+		{
+			name:      "edits streamed message with inline keyboard",
+			messageID: 42,
+			markup:    inlineKeyboard,
+			setupMocks: func(mockBot *MockBotAPI) {
+				edit := tgbotapi.NewEditMessageText(123, 42, "Final")
+				edit.ReplyMarkup = &inlineKeyboard
+				mockBot.EXPECT().Send(edit).Return(tgbotapi.Message{}, nil)
+			},
+		},
+		{
+			name:      "edit fails",
+			messageID: 42,
+			setupMocks: func(mockBot *MockBotAPI) {
+				mockBot.EXPECT().Send(tgbotapi.NewEditMessageText(123, 42, "Final")).Return(tgbotapi.Message{}, assert.AnError)
+				mockBot.EXPECT().Request(tgbotapi.NewDeleteMessage(123, 42)).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+			},
+			wantSend: true,
+		},
+		{
+			name:      "reply keyboard requires new message",
+			messageID: 42,
+			markup:    keyboard,
+			setupMocks: func(mockBot *MockBotAPI) {
+				mockBot.EXPECT().Request(tgbotapi.NewDeleteMessage(123, 42)).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+			},
+			wantSend: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBot := NewMockBotAPI(t)
+			now := time.Now()
+			stream := newTestMessageStream(mockBot, &now)
+			stream.messageID = tt.messageID
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockBot)
+			}
+
+			resp := tgbotapi.NewMessage(123, "Final")
+			resp.ReplyMarkup = tt.markup
+
+			got := stream.Finish(resp)
+
+			if tt.wantSend {
+				assert.Equal(t, resp, got)
+			} else {
+				assert.Equal(t, tgbotapi.MessageConfig{}, got)
+			}
+		})
+	}
+}
+
+func TestMessageStream_Discard(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	now := time.Now()
+	stream := newTestMessageStream(mockBot, &now)
+
+	// Nothing to delete before the placeholder is sent
+	stream.Discard()
+
+	stream.messageID = 42
+	mockBot.EXPECT().Request(tgbotapi.NewDeleteMessage(123, 42)).Return(nil, assert.AnError).Once()
+	stream.Discard()
+
+	assert.Zero(t, stream.messageID)
+}
@@ -0,0 +1,77 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+)
+
+// emergencyVetSearchURL opens a map search for emergency veterinary clinics near the user.
+const emergencyVetSearchURL = "https://www.google.com/maps/search/?api=1&query=emergency+veterinary+clinic+near+me"
+
+// newResponseMessage converts the AI service response into a Telegram message for the chat.
+// Follow-up answers are offered as a one-time reply keyboard. Emergencies are rendered with a prominent banner
+// and, when no answers are expected, an inline keyboard helping to find an emergency clinic;
+// answers that need a vet visit soon get a short warning. Final answers which can be rated get 👍/👎 buttons.
+// The triage level is logged and counted.
+// Returns the message ready to be sent.
+func (s *ServiceImpl) newResponseMessage(ctx context.Context, chatID int64, response *message.Response) tgbotapi.MessageConfig {
+	text := response.Message
+
+	if response.Urgency != "" {
+		slog.InfoContext(ctx, "Triage level assessed", slog.String("urgency", string(response.Urgency)))
+		metrics.TriageLevels.WithLabelValues(string(response.Urgency)).Inc()
+	}
+
+	switch response.Urgency {
+	case message.UrgencyEmergency:
+		text = i18n.GetLocale(ctx).Sprintf("🚨 EMERGENCY: your pet may need immediate veterinary care. Contact your veterinarian or the nearest emergency clinic now.") + "\n\n" + text
+	case message.UrgencySeeVetSoon:
+		text = i18n.GetLocale(ctx).Sprintf("⚠️ We recommend a visit to your veterinarian within the next day or two.") + "\n\n" + text
+	}
+
+	resp := tgbotapi.NewMessage(chatID, text)
+
+	switch {
+	case len(response.Answers) > 0:
+		keyboard := make([][]tgbotapi.KeyboardButton, len(response.Answers))
+		for i, answer := range response.Answers {
+			keyboard[i] = []tgbotapi.KeyboardButton{
+				{Text: answer},
+			}
+		}
+
+		resp.ReplyMarkup = tgbotapi.ReplyKeyboardMarkup{
+			Keyboard:        keyboard,
+			OneTimeKeyboard: true,
+			ResizeKeyboard:  true,
+		}
+	case response.Urgency == message.UrgencyEmergency:
+		rows := [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonURL(i18n.GetLocale(ctx).Sprintf("🏥 Find an emergency vet nearby"), emergencyVetSearchURL),
+			),
+		}
+
+		if response.AnswerID != "" {
+			rows = append(rows, feedbackButtons(response.AnswerID))
+		}
+
+		resp.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(rows...)
+	case response.AnswerID != "":
+		// A message has a single reply markup, the one-time keyboard of follow-up questions is already hidden
+		// after the last answer, so the rating buttons take the place of its removal
+		resp.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(feedbackButtons(response.AnswerID))
+	default:
+		resp.ReplyMarkup = tgbotapi.ReplyKeyboardRemove{
+			RemoveKeyboard: true,
+			Selective:      false,
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,117 @@
+package bot
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceImpl_newResponseMessage(t *testing.T) {
+	tests := []struct {
+		response   *message.Response
+		wantMarkup func(t *testing.T, markup any)
+		name       string
+		wantPrefix string
+	}{
+		{
+			name:       "emergency without answers",
+			response:   &message.Response{Message: "Go to the vet now", Urgency: message.UrgencyEmergency},
+			wantPrefix: "🚨 EMERGENCY",
+			wantMarkup: func(t *testing.T, markup any) {
+				inline, ok := markup.(tgbotapi.InlineKeyboardMarkup)
+				require.True(t, ok)
+				require.Len(t, inline.InlineKeyboard, 1)
+				require.NotNil(t, inline.InlineKeyboard[0][0].URL)
+				assert.Equal(t, emergencyVetSearchURL, *inline.InlineKeyboard[0][0].URL)
+			},
+		},
+		{
+			name:       "rated emergency",
+			response:   &message.Response{Message: "Go to the vet now", Urgency: message.UrgencyEmergency, AnswerID: "a1"},
+			wantPrefix: "🚨 EMERGENCY",
+			wantMarkup: func(t *testing.T, markup any) {
+				inline, ok := markup.(tgbotapi.InlineKeyboardMarkup)
+				require.True(t, ok)
+				require.Len(t, inline.InlineKeyboard, 2)
+				require.NotNil(t, inline.InlineKeyboard[0][0].URL)
+				assert.Equal(t, feedbackButtons("a1"), inline.InlineKeyboard[1])
+			},
+		},
+		{
+			name:       "rated answer",
+			response:   &message.Response{Message: "Treats are fine", Urgency: message.UrgencyInformational, AnswerID: "a1"},
+			wantPrefix: "Treats are fine",
+			wantMarkup: func(t *testing.T, markup any) {
+				inline, ok := markup.(tgbotapi.InlineKeyboardMarkup)
+				require.True(t, ok)
+				require.Len(t, inline.InlineKeyboard, 1)
+				require.Len(t, inline.InlineKeyboard[0], 2)
+				assert.Equal(t, "feedback:up:a1", *inline.InlineKeyboard[0][0].CallbackData)
+				assert.Equal(t, "feedback:down:a1", *inline.InlineKeyboard[0][1].CallbackData)
+			},
+		},
+		{
+			name:       "emergency with follow-up answers",
+			response:   &message.Response{Message: "Is your dog breathing?", Answers: []string{"Yes", "No"}, Urgency: message.UrgencyEmergency},
+			wantPrefix: "🚨 EMERGENCY",
+			wantMarkup: func(t *testing.T, markup any) {
+				keyboard, ok := markup.(tgbotapi.ReplyKeyboardMarkup)
+				require.True(t, ok)
+				assert.Len(t, keyboard.Keyboard, 2)
+			},
+		},
+		{
+			name:       "see vet soon",
+			response:   &message.Response{Message: "Book an appointment", Urgency: message.UrgencySeeVetSoon},
+			wantPrefix: "⚠️",
+			wantMarkup: func(t *testing.T, markup any) {
+				assert.IsType(t, tgbotapi.ReplyKeyboardRemove{}, markup)
+			},
+		},
+		{
+			name:       "informational",
+			response:   &message.Response{Message: "Treats are fine", Urgency: message.UrgencyInformational},
+			wantPrefix: "Treats are fine",
+			wantMarkup: func(t *testing.T, markup any) {
+				assert.IsType(t, tgbotapi.ReplyKeyboardRemove{}, markup)
+			},
+		},
+		{
+			name:       "not assessed",
+			response:   &message.Response{Message: "Treats are fine"},
+			wantPrefix: "Treats are fine",
+			wantMarkup: func(t *testing.T, markup any) {
+				assert.IsType(t, tgbotapi.ReplyKeyboardRemove{}, markup)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &ServiceImpl{}
+
+			var before float64
+			if tt.response.Urgency != "" {
+				before = testutil.ToFloat64(metrics.TriageLevels.WithLabelValues(string(tt.response.Urgency)))
+			}
+
+			resp := svc.newResponseMessage(context.Background(), 123, tt.response)
+
+			assert.Equal(t, int64(123), resp.ChatID)
+			assert.True(t, strings.HasPrefix(resp.Text, tt.wantPrefix), resp.Text)
+			assert.True(t, strings.HasSuffix(resp.Text, tt.response.Message), resp.Text)
+			tt.wantMarkup(t, resp.ReplyMarkup)
+
+			if tt.response.Urgency != "" {
+				assert.Equal(t, before+1, testutil.ToFloat64(metrics.TriageLevels.WithLabelValues(string(tt.response.Urgency))))
+			}
+		})
+	}
+}
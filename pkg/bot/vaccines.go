@@ -0,0 +1,68 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// handleAddVaccine starts the questionnaire for adding a vaccination or preventive treatment record to the active pet.
+// Returns the first question of the questionnaire, a hint to create a profile if the user has no pets,
+// or an error if the request cannot be processed.
+func (s *ServiceImpl) handleAddVaccine(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	req, err := message.NewUserMessage(
+		fmt.Sprintf("%d", msg.From.ID),
+		fmt.Sprintf("%d", msg.Chat.ID),
+		msg.Text,
+	)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to create user message: %w", err)
+	}
+
+	resp, err := s.AISvc.ProcessAddVaccination(ctx, req)
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return noPetsMessage(ctx, msg), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to process add vaccination request: %w", err)
+	}
+
+	return s.newResponseMessage(ctx, msg.Chat.ID, resp), nil
+}
+
+// handleVaccines lists overdue vaccinations and preventive treatments of all the user's pets.
+// Returns a message with the overdue items, a confirmation that nothing is overdue,
+// or a hint to create a profile if the user has no pets.
+func (s *ServiceImpl) handleVaccines(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	profiles, err := s.AISvc.ListPets(ctx, fmt.Sprintf("%d", msg.From.ID))
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return noPetsMessage(ctx, msg), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to list pets: %w", err)
+	}
+
+	now := time.Now()
+
+	var sb strings.Builder
+
+	for _, p := range profiles.Profiles {
+		for _, v := range p.Overdue(now) {
+			fmt.Fprintf(&sb, "• %s: %s\n", p.Name, i18n.GetLocale(ctx).Sprintf("%s was due on %s", v.Name, v.NextDue))
+		}
+	}
+
+	if sb.Len() == 0 {
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("No vaccinations or preventive treatments are overdue. Use /addvaccine to add a new record.")), nil
+	}
+
+	text := i18n.GetLocale(ctx).Sprintf("Overdue vaccinations and preventive treatments:") + "\n" + sb.String() + "\n" +
+		i18n.GetLocale(ctx).Sprintf("Please contact your veterinarian to schedule them, then use /addvaccine to record them.")
+
+	return tgbotapi.NewMessage(msg.Chat.ID, text), nil
+}
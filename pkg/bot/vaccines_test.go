@@ -0,0 +1,111 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Vaccines(t *testing.T) {
+	profiles := &pet.Profiles{
+		Profiles: []pet.Profile{
+			{Name: "Max", Vaccinations: []pet.Vaccination{{Name: "Rabies", Date: "2020-01-01", NextDue: "2021-01-01"}}},
+			{Name: "Bella", Vaccinations: []pet.Vaccination{{Name: "Deworming", Date: "2020-01-01", NextDue: "2999-01-01"}}},
+		},
+	}
+
+	tests := []struct {
+		mockSetup     func(m *MockAIProvider)
+		checkMarkup   func(t *testing.T, markup any)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "list overdue items",
+			command: "/vaccines",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(profiles, nil)
+			},
+			expectedMsg: "Overdue vaccinations and preventive treatments:\n• Max: Rabies was due on 2021-01-01\n",
+		},
+		{
+			name:    "nothing overdue",
+			command: "/vaccines",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}}, nil)
+			},
+			expectedMsg: "No vaccinations or preventive treatments are overdue.",
+		},
+		{
+			name:    "list without pets",
+			command: "/vaccines",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ListPets(mock.Anything, "456").Return(nil, core.ErrProfileNotFound)
+			},
+			expectedMsg: "You don't have any pet profiles yet",
+		},
+		{
+			name:    "add vaccine",
+			command: "/addvaccine",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ProcessAddVaccination(mock.Anything, mock.MatchedBy(func(req *message.UserMessage) bool {
+					return req.UserID == "456" && req.ChatID == "123"
+				})).Return(message.NewResponse("Which vaccine?", []string{"skip"}), nil)
+			},
+			expectedMsg: "Which vaccine?",
+			checkMarkup: func(t *testing.T, markup any) {
+				keyboard, ok := markup.(tgbotapi.ReplyKeyboardMarkup)
+				require.True(t, ok)
+				assert.Equal(t, "skip", keyboard.Keyboard[0][0].Text)
+			},
+		},
+		{
+			name:    "add vaccine without pets",
+			command: "/addvaccine",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ProcessAddVaccination(mock.Anything, mock.Anything).Return(nil, core.ErrProfileNotFound)
+			},
+			expectedMsg: "You don't have any pet profiles yet",
+		},
+		{
+			name:    "add vaccine error",
+			command: "/addvaccine",
+			mockSetup: func(m *MockAIProvider) {
+				m.EXPECT().ProcessAddVaccination(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedError: "failed to process add vaccination request: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			tt.mockSetup(mockAI)
+
+			svc := &ServiceImpl{AISvc: mockAI}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+
+			if tt.checkMarkup != nil {
+				tt.checkMarkup(t, resp.ReplyMarkup)
+			}
+		})
+	}
+}
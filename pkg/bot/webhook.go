@@ -0,0 +1,172 @@
+package bot
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const (
+	// ModePolling receives updates by long polling Telegram API
+	ModePolling = "polling"
+	// ModeWebhook receives updates pushed by Telegram to the bot's HTTP endpoint
+	ModeWebhook = "webhook"
+
+	secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+	webhookQueueSize  = 100
+	shutdownTimeout   = 10 * time.Second
+)
+
+// WebhookConfig holds the settings for receiving updates through a webhook.
+// URL is the public HTTPS address Telegram delivers updates to, its path is used for the local handler.
+// Listen is the local address of the HTTP server, such as ":8080".
+// SecretToken is sent by Telegram in every request and is used to verify that updates come from Telegram.
+type WebhookConfig struct {
+	URL         string `mapstructure:"url"`
+	Listen      string `mapstructure:"listen"`
+	SecretToken string `mapstructure:"secret_token"`
+}
+
+// validate checks that all settings required for webhook mode are provided.
+func (c WebhookConfig) validate() error {
+	if c.URL == "" {
+		return fmt.Errorf("webhook url cannot be empty")
+	}
+
+	if _, err := url.Parse(c.URL); err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+
+	if c.Listen == "" {
+		return fmt.Errorf("webhook listen address cannot be empty")
+	}
+
+	if c.SecretToken == "" {
+		return fmt.Errorf("webhook secret token cannot be empty")
+	}
+
+	return nil
+}
+
+// runWebhook registers the webhook in Telegram and serves incoming updates over HTTP until ctx is cancelled.
+// On shutdown it stops the HTTP server and unregisters the webhook.
+// Returns an error if the HTTP listener cannot be started or the webhook registration fails.
+func (s *ServiceImpl) runWebhook(ctx context.Context) error {
+	slog.InfoContext(ctx, "Starting Telegram bot in webhook mode", slog.String("listen", s.webhook.Listen))
+
+	hookURL, err := url.Parse(s.webhook.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", s.webhook.Listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.webhook.Listen, err)
+	}
+
+	updates := make(chan tgbotapi.Update, webhookQueueSize)
+
+	path := hookURL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, s.webhookHandler(ctx, updates))
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.ErrorContext(ctx, "Webhook server failed", slog.Any("error", err))
+		}
+	}()
+
+	if err := s.setWebhook(); err != nil {
+		_ = server.Close()
+		return err
+	}
+
+	stop := func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to shutdown webhook server", slog.Any("error", err))
+		}
+
+		if _, err := s.Bot.Request(tgbotapi.DeleteWebhookConfig{}); err != nil {
+			slog.Error("Failed to delete webhook", slog.Any("error", err))
+		}
+	}
+
+	return s.serve(ctx, updates, stop)
+}
+
+// setWebhook registers the configured webhook URL and secret token in Telegram.
+// Returns an error if Telegram rejects the registration.
+func (s *ServiceImpl) setWebhook() error {
+	resp, err := s.Bot.MakeRequest("setWebhook", tgbotapi.Params{
+		"url":          s.webhook.URL,
+		"secret_token": s.webhook.SecretToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set webhook: %w", err)
+	}
+
+	if !resp.Ok {
+		return fmt.Errorf("failed to set webhook: %s", resp.Description)
+	}
+
+	return nil
+}
+
+// webhookHandler returns an HTTP handler that verifies the secret token of incoming requests,
+// decodes Telegram updates and passes them to the updates channel.
+// It responds with 503 if the queue is full until the request is cancelled, so Telegram retries delivery later.
+func (s *ServiceImpl) webhookHandler(ctx context.Context, updates chan<- tgbotapi.Update) http.Handler {
+	secret := []byte(s.webhook.SecretToken)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(secretTokenHeader)), secret) != 1 {
+			slog.WarnContext(ctx, "Webhook request with invalid secret token", slog.String("remote_addr", r.RemoteAddr))
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var update tgbotapi.Update
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			slog.WarnContext(ctx, "Failed to decode webhook update", slog.Any("error", err))
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		select {
+		case updates <- update:
+			w.WriteHeader(http.StatusOK)
+		case <-r.Context().Done():
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case <-ctx.Done():
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     WebhookConfig
+		wantErr bool
+	}{
+		{
+			name: "valid config",
+			cfg:  WebhookConfig{URL: "https://example.com/hook", Listen: ":8080", SecretToken: "secret"},
+		},
+		{
+			name:    "empty url",
+			cfg:     WebhookConfig{Listen: ":8080", SecretToken: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "empty listen address",
+			cfg:     WebhookConfig{URL: "https://example.com/hook", SecretToken: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "empty secret token",
+			cfg:     WebhookConfig{URL: "https://example.com/hook", Listen: ":8080"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewService_InvalidMode(t *testing.T) {
+	_, err := NewService(&Config{TelegramToken: "test-token", Mode: "unknown"}, NewMockAIProvider(t))
+	assert.ErrorContains(t, err, "unsupported bot mode")
+
+	_, err = NewService(&Config{TelegramToken: "test-token", Mode: ModeWebhook}, NewMockAIProvider(t))
+	assert.ErrorContains(t, err, "invalid webhook config")
+}
+
+func TestServiceImpl_WebhookHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		secret     string
+		body       string
+		wantStatus int
+		wantUpdate bool
+	}{
+		{
+			name:       "valid update",
+			method:     http.MethodPost,
+			secret:     "secret",
+			body:       `{"update_id": 1, "message": {"message_id": 2, "text": "hello", "chat": {"id": 3}}}`,
+			wantStatus: http.StatusOK,
+			wantUpdate: true,
+		},
+		{
+			name:       "invalid secret token",
+			method:     http.MethodPost,
+			secret:     "wrong",
+			body:       `{"update_id": 1}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing secret token",
+			method:     http.MethodPost,
+			body:       `{"update_id": 1}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPost,
+			secret:     "secret",
+			body:       `not json`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			secret:     "secret",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &ServiceImpl{webhook: WebhookConfig{SecretToken: "secret"}}
+			updates := make(chan tgbotapi.Update, 1)
+
+			req := httptest.NewRequest(tt.method, "/hook", strings.NewReader(tt.body))
+			if tt.secret != "" {
+				req.Header.Set(secretTokenHeader, tt.secret)
+			}
+
+			rec := httptest.NewRecorder()
+			svc.webhookHandler(context.Background(), updates).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantUpdate {
+				require.Len(t, updates, 1)
+				update := <-updates
+				assert.Equal(t, 1, update.UpdateID)
+				assert.Equal(t, "hello", update.Message.Text)
+			} else {
+				assert.Empty(t, updates)
+			}
+		})
+	}
+}
+
+func TestServiceImpl_WebhookHandler_ContextCancelled(t *testing.T) {
+	svc := &ServiceImpl{webhook: WebhookConfig{SecretToken: "secret"}}
+	updates := make(chan tgbotapi.Update)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"update_id": 1}`))
+	req.Header.Set(secretTokenHeader, "secret")
+
+	rec := httptest.NewRecorder()
+	svc.webhookHandler(ctx, updates).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestServiceImpl_RunWebhook(t *testing.T) {
+	mockBot := NewMockBotAPI(t)
+	svc := &ServiceImpl{
+		Bot:  mockBot,
+		mode: ModeWebhook,
+		webhook: WebhookConfig{
+			URL:         "https://example.com/hook",
+			Listen:      "127.0.0.1:0",
+			SecretToken: "secret",
+		},
+	}
+
+	mockBot.EXPECT().MakeRequest("setWebhook", tgbotapi.Params{
+		"url":          "https://example.com/hook",
+		"secret_token": "secret",
+	}).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+	mockBot.EXPECT().Request(tgbotapi.DeleteWebhookConfig{}).Return(&tgbotapi.APIResponse{Ok: true}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := svc.Run(ctx)
+	assert.NoError(t, err)
+}
+
+func TestServiceImpl_RunWebhook_SetWebhookFails(t *testing.T) {
+	tests := []struct {
+		resp    *tgbotapi.APIResponse
+		err     error
+		name    string
+		wantErr string
+	}{
+		{
+			name:    "request error",
+			err:     errors.New("network error"),
+			wantErr: "network error",
+		},
+		{
+			name:    "rejected by telegram",
+			resp:    &tgbotapi.APIResponse{Ok: false, Description: "bad webhook"},
+			wantErr: "bad webhook",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBot := NewMockBotAPI(t)
+			svc := &ServiceImpl{
+				Bot:  mockBot,
+				mode: ModeWebhook,
+				webhook: WebhookConfig{
+					URL:         "https://example.com/hook",
+					Listen:      "127.0.0.1:0",
+					SecretToken: "secret",
+				},
+			}
+
+			mockBot.EXPECT().MakeRequest("setWebhook", tgbotapi.Params{
+				"url":          "https://example.com/hook",
+				"secret_token": "secret",
+			}).Return(tt.resp, tt.err)
+
+			err := svc.Run(context.Background())
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
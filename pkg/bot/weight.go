@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/bot/chart"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// handleWeight records the weight given in the command arguments, e.g. "/weight 12.4kg", for the active pet.
+// Without arguments or with a weight that can't be parsed it replies with usage instructions.
+func (s *ServiceImpl) handleWeight(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	weight := strings.TrimSpace(msg.CommandArguments())
+	if weight == "" {
+		return weightUsageMessage(ctx, msg), nil
+	}
+
+	profile, err := s.AISvc.AddWeight(ctx, fmt.Sprintf("%d", msg.From.ID), weight)
+
+	switch {
+	case errors.Is(err, pet.ErrInvalidWeight):
+		return weightUsageMessage(ctx, msg), nil
+	case errors.Is(err, core.ErrProfileNotFound):
+		return noPetsMessage(ctx, msg), nil
+	case err != nil:
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to add weight: %w", err)
+	}
+
+	text := i18n.GetLocale(ctx).Sprintf("Weight of %s recorded: %s.", profile.Name, profile.Weight)
+	if len(profile.WeightHistory) > 1 {
+		text += "\n" + i18n.GetLocale(ctx).Sprintf("Use /weightchart to see how it changes over time.")
+	}
+
+	return tgbotapi.NewMessage(msg.Chat.ID, text), nil
+}
+
+// handleWeightChart sends a PNG chart with the weight history of the active pet.
+// The chart is sent directly as a photo, so an empty message is returned on success.
+func (s *ServiceImpl) handleWeightChart(ctx context.Context, msg *tgbotapi.Message) (tgbotapi.MessageConfig, error) {
+	profiles, err := s.AISvc.ListPets(ctx, fmt.Sprintf("%d", msg.From.ID))
+	if errors.Is(err, core.ErrProfileNotFound) {
+		return noPetsMessage(ctx, msg), nil
+	} else if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to list pets: %w", err)
+	}
+
+	profile := profiles.Current()
+	if len(profile.WeightHistory) == 0 {
+		return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("There are no weight entries for %s yet. Use /weight to add one, e.g. /weight 12.4kg", profile.Name)), nil
+	}
+
+	unit := profile.WeightHistory[len(profile.WeightHistory)-1].Unit
+
+	points := make([]chart.Point, len(profile.WeightHistory))
+	for i, entry := range profile.WeightHistory {
+		points[i] = chart.Point{Time: entry.Time, Value: entry.In(unit)}
+	}
+
+	img, err := chart.Line(fmt.Sprintf("%s, %s", profile.Name, unit), points)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to render weight chart: %w", err)
+	}
+
+	photo := tgbotapi.NewPhoto(msg.Chat.ID, tgbotapi.FileBytes{Name: "weight.png", Bytes: img})
+	photo.Caption = i18n.GetLocale(ctx).Sprintf("Weight history of %s", profile.Name)
+
+	if _, err := s.Bot.Send(photo); err != nil {
+		return tgbotapi.MessageConfig{}, fmt.Errorf("failed to send weight chart: %w", err)
+	}
+
+	return tgbotapi.MessageConfig{}, nil
+}
+
+// weightUsageMessage returns instructions on how to record a weight.
+func weightUsageMessage(ctx context.Context, msg *tgbotapi.Message) tgbotapi.MessageConfig {
+	return tgbotapi.NewMessage(msg.Chat.ID, i18n.GetLocale(ctx).Sprintf("Please send the weight with its unit, e.g. /weight 12.4kg or /weight 9 lbs"))
+}
@@ -0,0 +1,121 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCommand_Weight(t *testing.T) {
+	now := time.Now()
+	history := []pet.WeightEntry{
+		{Time: now.AddDate(0, -1, 0), Value: 13, Unit: "kg"},
+		{Time: now, Value: 12.4, Unit: "kg"},
+	}
+
+	tests := []struct {
+		mockSetup     func(ai *MockAIProvider, bot *MockBotAPI)
+		name          string
+		command       string
+		expectedMsg   string
+		expectedError string
+	}{
+		{
+			name:    "record weight",
+			command: "/weight 12.4kg",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().AddWeight(mock.Anything, "456", "12.4kg").Return(&pet.Profile{Name: "Max", Weight: "12.4 kg", WeightHistory: history}, nil)
+			},
+			expectedMsg: "Weight of Max recorded: 12.4 kg.\nUse /weightchart",
+		},
+		{
+			name:        "record weight without value",
+			command:     "/weight",
+			mockSetup:   func(_ *MockAIProvider, _ *MockBotAPI) {},
+			expectedMsg: "Please send the weight with its unit",
+		},
+		{
+			name:    "record invalid weight",
+			command: "/weight heavy",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().AddWeight(mock.Anything, "456", "heavy").Return(nil, pet.ErrInvalidWeight)
+			},
+			expectedMsg: "Please send the weight with its unit",
+		},
+		{
+			name:    "record weight without pets",
+			command: "/weight 5kg",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().AddWeight(mock.Anything, "456", "5kg").Return(nil, core.ErrProfileNotFound)
+			},
+			expectedMsg: "You don't have any pet profiles yet",
+		},
+		{
+			name:    "weight chart",
+			command: "/weightchart",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().ListPets(mock.Anything, "456").Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max", WeightHistory: history}}}, nil)
+				bot.EXPECT().Send(mock.MatchedBy(func(c tgbotapi.Chattable) bool {
+					photo, ok := c.(tgbotapi.PhotoConfig)
+					if !ok {
+						return false
+					}
+
+					file, ok := photo.File.(tgbotapi.FileBytes)
+
+					return ok && photo.ChatID == 123 && photo.Caption == "Weight history of Max" && len(file.Bytes) > 0
+				})).Return(tgbotapi.Message{}, nil)
+			},
+		},
+		{
+			name:    "weight chart without entries",
+			command: "/weightchart",
+			mockSetup: func(ai *MockAIProvider, _ *MockBotAPI) {
+				ai.EXPECT().ListPets(mock.Anything, "456").Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}}, nil)
+			},
+			expectedMsg: "There are no weight entries for Max yet.",
+		},
+		{
+			name:    "weight chart send error",
+			command: "/weightchart",
+			mockSetup: func(ai *MockAIProvider, bot *MockBotAPI) {
+				ai.EXPECT().ListPets(mock.Anything, "456").Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max", WeightHistory: history}}}, nil)
+				bot.EXPECT().Send(mock.Anything).Return(tgbotapi.Message{}, assert.AnError)
+			},
+			expectedError: "failed to send weight chart: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAI := NewMockAIProvider(t)
+			mockBot := NewMockBotAPI(t)
+			tt.mockSetup(mockAI, mockBot)
+
+			svc := &ServiceImpl{AISvc: mockAI, Bot: mockBot}
+
+			resp, err := svc.HandleCommand(context.Background(), newCommandMessage(tt.command))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.expectedMsg == "" {
+				assert.Empty(t, resp.Text)
+				return
+			}
+
+			assert.Contains(t, resp.Text, tt.expectedMsg)
+		})
+	}
+}
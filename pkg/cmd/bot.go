@@ -2,17 +2,43 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/ksysoev/help-my-pet/pkg/bot"
 	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+	"github.com/ksysoev/help-my-pet/pkg/prov/openai"
+	"github.com/ksysoev/help-my-pet/pkg/repo/bolt"
 	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
+	"github.com/ksysoev/help-my-pet/pkg/repo/postgres"
 	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
 	"github.com/redis/go-redis/v9"
+	bbolt "go.etcd.io/bbolt"
 )
 
+const (
+	providerAnthropic = "anthropic"
+	providerOpenAI    = "openai"
+
+	storageRedis    = "redis"
+	storageMemory   = "memory"
+	storageBolt     = "bolt"
+	storagePostgres = "postgres"
+
+	defaultBoltPath = "help-my-pet.db"
+
+	// expirySweepInterval defines how often expired records are removed from storages without native expiration
+	expirySweepInterval = 10 * time.Minute
+)
+
+// errMemoryStorage is returned by commands which can't reach the data kept by the "memory" storage in the running bot
+var errMemoryStorage = errors.New("data of the memory storage is available only to the running bot")
+
 // BotService represents the interface for bot service operations
 type BotService interface {
 	Run(ctx context.Context) error
@@ -45,37 +71,303 @@ func (r *BotRunner) RunBot(ctx context.Context, cfg *Config) error {
 		return r.botService.Run(ctx)
 	}
 
-	llmProvider, err := anthropic.New(cfg.AI)
+	llmProvider, err := newLLM(&cfg.AI)
 	if err != nil {
-		return fmt.Errorf("failed to initialize Anthropic provider: %w", err)
+		return fmt.Errorf("failed to initialize LLM provider: %w", err)
 	}
 
-	// Initialize Redis client
-	redisClient := redis.NewClient(&redis.Options{
-		Addr:     cfg.Redis.URL,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
+	// Redis client is created only if a configured storage keeps data in Redis
+	redisClient, closeRedis := openRedis(cfg)
+	defer closeRedis()
 
-	// Ensure Redis client is closed
-	defer func() {
-		if err := redisClient.Close(); err != nil {
-			slog.Error("failed to close Redis connection", slog.Any("error", err))
-		}
-	}()
+	aiService, store, err := newAIService(cfg, llmProvider, redisClient)
+	if err != nil {
+		return err
+	}
 
-	// Create AI service with conversation support and rate limiting
-	aiService := core.NewAIService(
-		llmProvider,
-		redisrepo.NewConversationRepository(redisClient),
-		redisrepo.NewPetProfileRepository(redisClient),
-		memory.NewRateLimiter(&cfg.RateLimit),
-	)
+	defer store.close()
+
+	aiService.WithReminderRepository(store.reminders).
+		WithFeedbackRepository(store.feedback).
+		WithBroadcastRepository(store.broadcasts)
 
 	serviceImpl, err := r.createService(&cfg.Bot, aiService)
 	if err != nil {
 		return fmt.Errorf("failed to create bot service: %w", err)
 	}
 
+	go sweepExpired(ctx, store)
+
+	go func() {
+		if err := metrics.Serve(ctx, cfg.Metrics); err != nil {
+			slog.ErrorContext(ctx, "Metrics server stopped", slog.Any("error", err))
+		}
+	}()
+
+	go func() {
+		if err := aiService.RunReminders(ctx, serviceImpl); err != nil && !errors.Is(err, core.ErrRemindersDisabled) {
+			slog.ErrorContext(ctx, "Reminder scheduler stopped", slog.Any("error", err))
+		}
+	}()
+
+	go func() {
+		if err := aiService.RunBroadcasts(ctx, serviceImpl); err != nil && !errors.Is(err, core.ErrBroadcastsDisabled) {
+			slog.ErrorContext(ctx, "Broadcast worker stopped", slog.Any("error", err))
+		}
+	}()
+
 	return serviceImpl.Run(ctx)
 }
+
+// storage holds the repositories of the storage selected in the configuration
+type storage struct {
+	conversations core.ConversationRepository
+	profiles      core.PetProfileRepository
+	reminders     core.ReminderRepository
+	feedback      core.FeedbackRepository
+	// broadcasts are kept in Redis by the "postgres" storage, which doesn't support them
+	broadcasts core.BroadcastRepository
+	// removeExpired deletes records expired at now, it is nil if the storage expires records by itself
+	removeExpired func(ctx context.Context, now time.Time) error
+	// close releases the storage
+	close func()
+}
+
+// sweepExpired periodically removes expired records from the storage until ctx is cancelled,
+// it returns immediately if the storage expires records by itself. Failures are logged and retried on the next run.
+func sweepExpired(ctx context.Context, store *storage) {
+	if store.removeExpired == nil {
+		return
+	}
+
+	ticker := time.NewTicker(expirySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := store.removeExpired(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Failed to remove expired records", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newAIService creates the AI service keeping conversations and pet profiles in the storage selected in the configuration,
+// with rate limits and spend ceilings backed by their configured storages.
+// Returns the service, the storage to release when the service stops, or an error if any of the dependencies fails to initialize.
+func newAIService(cfg *Config, llm core.LLM, redisClient *redis.Client) (*core.AIService, *storage, error) {
+	rateLimiter, err := newRateLimiter(&cfg.RateLimit, redisClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize rate limiter: %w", err)
+	}
+
+	budgetTracker, err := newBudgetTracker(&cfg.Budget, redisClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize budget tracker: %w", err)
+	}
+
+	store, err := newStorage(&cfg.Storage, redisClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+
+	aiService := core.NewAIService(llm, store.conversations, store.profiles, rateLimiter)
+
+	if budgetTracker != nil {
+		aiService.WithBudgetTracker(budgetTracker)
+	}
+
+	return aiService, store, nil
+}
+
+// newStorage creates the repositories of the storage selected in the configuration.
+// It supports "redis" (default), "memory" for a process-local storage lost on restart
+// "bolt" for a single database file and "postgres" for a PostgreSQL database migrated with the migrate command.
+// Broadcasts of the "postgres" storage are kept in Redis, redisClient is used only by the "redis" and "postgres" storages.
+// Returns the storage, or an error if the storage is not supported or can't be opened.
+func newStorage(cfg *StorageConfig, redisClient *redis.Client) (*storage, error) {
+	switch cfg.Driver {
+	case "", storageRedis:
+		return &storage{
+			conversations: redisrepo.NewConversationRepository(redisClient),
+			profiles:      redisrepo.NewPetProfileRepository(redisClient),
+			reminders:     redisrepo.NewReminderRepository(redisClient),
+			feedback:      redisrepo.NewFeedbackRepository(redisClient),
+			broadcasts:    redisrepo.NewBroadcastRepository(redisClient),
+			close:         func() {},
+		}, nil
+	case storageMemory:
+		conversations := memory.NewConversationRepository()
+		feedback := memory.NewFeedbackRepository()
+
+		return &storage{
+			conversations: conversations,
+			profiles:      memory.NewPetProfileRepository(),
+			reminders:     memory.NewReminderRepository(),
+			feedback:      feedback,
+			broadcasts:    memory.NewBroadcastRepository(),
+			removeExpired: removeExpiredAll(conversations.RemoveExpired, feedback.RemoveExpired),
+			close:         func() {},
+		}, nil
+	case storageBolt:
+		db, closeDB, err := openBolt(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		conversations := bolt.NewConversationRepository(db)
+		feedback := bolt.NewFeedbackRepository(db)
+
+		return &storage{
+			conversations: conversations,
+			profiles:      bolt.NewPetProfileRepository(db),
+			reminders:     bolt.NewReminderRepository(db),
+			feedback:      feedback,
+			broadcasts:    bolt.NewBroadcastRepository(db),
+			removeExpired: removeExpiredAll(conversations.RemoveExpired, feedback.RemoveExpired),
+			close:         closeDB,
+		}, nil
+	case storagePostgres:
+		pool, err := postgres.Connect(context.Background(), cfg.DSN)
+		if err != nil {
+			return nil, err
+		}
+
+		return &storage{
+			conversations: postgres.NewConversationRepository(pool),
+			profiles:      postgres.NewPetProfileRepository(pool),
+			reminders:     postgres.NewReminderRepository(pool),
+			feedback:      postgres.NewFeedbackRepository(pool),
+			broadcasts:    redisrepo.NewBroadcastRepository(redisClient),
+			removeExpired: func(ctx context.Context, now time.Time) error {
+				return postgres.RemoveExpired(ctx, pool, now)
+			},
+			close: pool.Close,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", cfg.Driver)
+	}
+}
+
+// openBolt opens the database file of the "bolt" storage, "help-my-pet.db" in the working directory by default.
+// Returns the database, a function closing it, or an error if the file can't be opened.
+func openBolt(cfg *StorageConfig) (*bbolt.DB, func(), error) {
+	path := cfg.Path
+	if path == "" {
+		path = defaultBoltPath
+	}
+
+	db, err := bolt.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return db, func() {
+		if err := db.Close(); err != nil {
+			slog.Error("failed to close database", slog.Any("error", err))
+		}
+	}, nil
+}
+
+// removeExpiredAll combines the functions removing expired records of several repositories into one,
+// all of them are called and their errors are joined.
+func removeExpiredAll(fns ...func(ctx context.Context, now time.Time) error) func(ctx context.Context, now time.Time) error {
+	return func(ctx context.Context, now time.Time) error {
+		var errs []error
+
+		for _, fn := range fns {
+			errs = append(errs, fn(ctx, now))
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// usesRedis reports whether any storage selected in the configuration keeps data in Redis.
+func usesRedis(cfg *Config) bool {
+	switch {
+	case cfg.Storage.Driver == "", cfg.Storage.Driver == storageRedis, cfg.Storage.Driver == storagePostgres:
+		return true
+	case cfg.RateLimit.Storage == storageRedis:
+		return true
+	default:
+		return len(cfg.Budget.Prices) > 0 && cfg.Budget.Storage == storageRedis
+	}
+}
+
+// openRedis creates the Redis client if any storage selected in the configuration keeps data in Redis,
+// so deployments with the "memory" and "bolt" storages run without a Redis server.
+// Returns the client, or nil if Redis is not used, and a function closing it.
+func openRedis(cfg *Config) (*redis.Client, func()) {
+	if !usesRedis(cfg) {
+		return nil, func() {}
+	}
+
+	redisClient := newRedisClient(&cfg.Redis)
+
+	return redisClient, func() {
+		if err := redisClient.Close(); err != nil {
+			slog.Error("failed to close Redis connection", slog.Any("error", err))
+		}
+	}
+}
+
+// newRedisClient creates a Redis client connected to the configured server.
+func newRedisClient(cfg *RedisConfig) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     cfg.URL,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+}
+
+// newRateLimiter creates a rate limiter backed by the storage selected in the configuration.
+// It supports "memory" (default) for a process-local limiter and "redis" for limits shared between instances.
+// Returns an error if the configured storage is not supported.
+func newRateLimiter(cfg *memory.RateLimitConfig, redisClient *redis.Client) (core.RateLimiter, error) {
+	switch cfg.Storage {
+	case "", "memory":
+		return memory.NewRateLimiter(cfg), nil
+	case "redis":
+		return redisrepo.NewRateLimiter(redisClient, cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported rate limit storage: %s", cfg.Storage)
+	}
+}
+
+// newBudgetTracker creates a budget tracker backed by the storage selected in the configuration.
+// It supports "memory" (default) for process-local spend and "redis" for spend shared between instances.
+// Returns nil if no prices are configured, as spend can't be accounted without them,
+// or an error if the configured storage is not supported.
+func newBudgetTracker(cfg *budget.Config, redisClient *redis.Client) (core.BudgetTracker, error) {
+	if len(cfg.Prices) == 0 {
+		return nil, nil
+	}
+
+	switch cfg.Storage {
+	case "", "memory":
+		return memory.NewBudgetTracker(cfg), nil
+	case "redis":
+		return redisrepo.NewBudgetTracker(redisClient, cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported budget storage: %s", cfg.Storage)
+	}
+}
+
+// newLLM creates the LLM provider selected in the configuration.
+// It supports "anthropic" (default) and "openai" for any OpenAI-compatible chat completions API.
+// Returns an error if the provider is not supported or fails to initialize.
+func newLLM(cfg *AIConfig) (core.LLM, error) {
+	switch cfg.Provider {
+	case "", providerAnthropic:
+		return anthropic.New(cfg.Config)
+	case providerOpenAI:
+		return openai.New(cfg.OpenAI)
+	default:
+		return nil, fmt.Errorf("unsupported AI provider: %s", cfg.Provider)
+	}
+}
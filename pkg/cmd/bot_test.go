@@ -3,14 +3,22 @@ package cmd
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
 	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+	"github.com/ksysoev/help-my-pet/pkg/prov/openai"
+	"github.com/ksysoev/help-my-pet/pkg/repo/bolt"
+	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBotRunner(t *testing.T) {
@@ -70,8 +78,8 @@ func TestBotRunner_RunBot(t *testing.T) {
 			},
 			cfg: &Config{
 				Bot: bot.Config{},
-				AI: anthropic.Config{
-					APIKey: "test",
+				AI: AIConfig{
+					Config: anthropic.Config{APIKey: "test"},
 				},
 			},
 			wantErr: false,
@@ -86,8 +94,8 @@ func TestBotRunner_RunBot(t *testing.T) {
 				return runner
 			},
 			cfg: &Config{
-				AI: anthropic.Config{
-					APIKey: "test",
+				AI: AIConfig{
+					Config: anthropic.Config{APIKey: "test"},
 				},
 			},
 			wantErr: true,
@@ -119,3 +127,202 @@ func TestBotRunner_RunBot(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRateLimiter(t *testing.T) {
+	redisClient := redis.NewClient(&redis.Options{})
+	defer func() { _ = redisClient.Close() }()
+
+	tests := []struct {
+		name     string
+		storage  string
+		wantType any
+		wantErr  string
+	}{
+		{name: "default storage", storage: "", wantType: &memory.RateLimiter{}},
+		{name: "memory storage", storage: "memory", wantType: &memory.RateLimiter{}},
+		{name: "redis storage", storage: "redis", wantType: &redisrepo.RateLimiter{}},
+		{name: "unsupported storage", storage: "etcd", wantErr: "unsupported rate limit storage: etcd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rl, err := newRateLimiter(&memory.RateLimitConfig{Storage: tt.storage}, redisClient)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.IsType(t, tt.wantType, rl)
+		})
+	}
+}
+
+func TestNewStorage(t *testing.T) {
+	redisClient := redis.NewClient(&redis.Options{})
+	defer func() { _ = redisClient.Close() }()
+
+	tests := []struct {
+		wantConversations any
+		wantProfiles      any
+		wantReminders     any
+		wantFeedback      any
+		wantBroadcasts    any
+		name              string
+		wantErr           string
+		cfg               StorageConfig
+	}{
+		{
+			name:              "default driver",
+			wantConversations: &redisrepo.ConversationRepository{},
+			wantProfiles:      &redisrepo.PetProfileRepository{},
+			wantReminders:     &redisrepo.ReminderRepository{},
+			wantFeedback:      &redisrepo.FeedbackRepository{},
+			wantBroadcasts:    &redisrepo.BroadcastRepository{},
+		},
+		{
+			name:              "redis driver",
+			cfg:               StorageConfig{Driver: "redis"},
+			wantConversations: &redisrepo.ConversationRepository{},
+			wantProfiles:      &redisrepo.PetProfileRepository{},
+			wantReminders:     &redisrepo.ReminderRepository{},
+			wantFeedback:      &redisrepo.FeedbackRepository{},
+			wantBroadcasts:    &redisrepo.BroadcastRepository{},
+		},
+		{
+			name:              "memory driver",
+			cfg:               StorageConfig{Driver: "memory"},
+			wantConversations: &memory.ConversationRepository{},
+			wantProfiles:      &memory.PetProfileRepository{},
+			wantReminders:     &memory.ReminderRepository{},
+			wantFeedback:      &memory.FeedbackRepository{},
+			wantBroadcasts:    &memory.BroadcastRepository{},
+		},
+		{
+			name:              "bolt driver",
+			cfg:               StorageConfig{Driver: "bolt", Path: filepath.Join(t.TempDir(), "test.db")},
+			wantConversations: &bolt.ConversationRepository{},
+			wantProfiles:      &bolt.PetProfileRepository{},
+			wantReminders:     &bolt.ReminderRepository{},
+			wantFeedback:      &bolt.FeedbackRepository{},
+			wantBroadcasts:    &bolt.BroadcastRepository{},
+		},
+		{name: "bolt file can't be opened", cfg: StorageConfig{Driver: "bolt", Path: filepath.Join(t.TempDir(), "missing", "test.db")}, wantErr: "failed to open database"},
+		{name: "postgres without dsn", cfg: StorageConfig{Driver: "postgres"}, wantErr: "postgres connection string cannot be empty"},
+		{name: "unsupported driver", cfg: StorageConfig{Driver: "etcd"}, wantErr: "unsupported storage driver: etcd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Storages which don't keep data in Redis work without a Redis client
+			client := redisClient
+			if !usesRedis(&Config{Storage: tt.cfg}) {
+				client = nil
+			}
+
+			store, err := newStorage(&tt.cfg, client)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			defer store.close()
+
+			assert.IsType(t, tt.wantConversations, store.conversations)
+			assert.IsType(t, tt.wantProfiles, store.profiles)
+			assert.IsType(t, tt.wantReminders, store.reminders)
+			assert.IsType(t, tt.wantFeedback, store.feedback)
+			assert.IsType(t, tt.wantBroadcasts, store.broadcasts)
+		})
+	}
+}
+
+func TestOpenRedis(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want bool
+	}{
+		{name: "default storage", want: true},
+		{name: "postgres storage keeping broadcasts in redis", cfg: Config{Storage: StorageConfig{Driver: "postgres"}}, want: true},
+		{name: "memory storage", cfg: Config{Storage: StorageConfig{Driver: "memory"}}},
+		{name: "bolt storage", cfg: Config{Storage: StorageConfig{Driver: "bolt"}}},
+		{
+			name: "bolt storage with redis rate limits",
+			cfg:  Config{Storage: StorageConfig{Driver: "bolt"}, RateLimit: memory.RateLimitConfig{Storage: "redis"}},
+			want: true,
+		},
+		{
+			name: "memory storage with redis spend",
+			cfg:  Config{Storage: StorageConfig{Driver: "memory"}, Budget: budget.Config{Storage: "redis", Prices: []budget.Price{{}}}},
+			want: true,
+		},
+		{
+			name: "memory storage with redis spend without prices",
+			cfg:  Config{Storage: StorageConfig{Driver: "memory"}, Budget: budget.Config{Storage: "redis"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closeRedis := openRedis(&tt.cfg)
+			defer closeRedis()
+
+			assert.Equal(t, tt.want, client != nil)
+		})
+	}
+}
+
+func TestSweepExpired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	store := &storage{
+		removeExpired: func(context.Context, time.Time) error {
+			calls++
+			cancel()
+
+			return assert.AnError
+		},
+	}
+
+	// Expired records are removed on start, a failure doesn't stop the sweeper
+	sweepExpired(ctx, store)
+	assert.Equal(t, 1, calls)
+
+	// Storages expiring records by themselves are not swept
+	sweepExpired(context.Background(), &storage{})
+}
+
+func TestNewLLM(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     AIConfig
+		wantErr string
+	}{
+		{name: "default provider", cfg: AIConfig{Config: anthropic.Config{APIKey: "test"}}},
+		{name: "anthropic provider", cfg: AIConfig{Provider: "anthropic", Config: anthropic.Config{APIKey: "test"}}},
+		{name: "openai provider", cfg: AIConfig{Provider: "openai", OpenAI: openai.Config{BaseURL: "http://localhost/v1", Model: "llama3"}}},
+		{name: "openai provider without model", cfg: AIConfig{Provider: "openai", OpenAI: openai.Config{BaseURL: "http://localhost/v1"}}, wantErr: "model is required"},
+		{name: "unsupported provider", cfg: AIConfig{Provider: "unknown"}, wantErr: "unsupported AI provider: unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm, err := newLLM(&tt.cfg)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, llm)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	boltrepo "github.com/ksysoev/help-my-pet/pkg/repo/bolt"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/spf13/cobra"
+)
+
+// BroadcastCommand creates a new cobra.Command queueing an announcement for all chats known to the bot.
+// The message is delivered by running bot instances, it is taken from the arguments or from the file set with --file.
+func BroadcastCommand(arg *args) *cobra.Command {
+	var (
+		file   string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "broadcast [message]",
+		Short: "Send an announcement to all users of the bot",
+		Long: `Queue an announcement for delivery to all chats known to the bot, it is sent by the running bot.
+Language variants follow the default text, each starting with a line like [es].`,
+		RunE: func(cmd *cobra.Command, posArgs []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			cfg, err := initConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			definition, err := broadcastDefinition(file, posArgs)
+			if err != nil {
+				return err
+			}
+
+			repo, closeRepo, err := newBroadcastRepository(cfg)
+			if err != nil {
+				return err
+			}
+
+			defer closeRepo()
+
+			return runBroadcast(cmd.Context(), repo, definition, dryRun, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "read the message from the file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report the number of recipients without sending the message")
+
+	return cmd
+}
+
+// newBroadcastRepository creates the repository keeping broadcasts with the storage selected in the configuration,
+// broadcasts are kept in the database file with the "bolt" driver and in Redis with the "redis" and "postgres" drivers.
+// The database file of the "bolt" driver is held by the running bot, so broadcasts are queued with it while the bot is stopped.
+// Returns the repository, a function releasing it, or an error if the storage keeps broadcasts only in the memory
+// of the running bot or the database file can't be opened.
+func newBroadcastRepository(cfg *Config) (core.BroadcastRepository, func(), error) {
+	switch cfg.Storage.Driver {
+	case storageMemory:
+		return nil, nil, errMemoryStorage
+	case storageBolt:
+		db, closeDB, err := openBolt(&cfg.Storage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return boltrepo.NewBroadcastRepository(db), closeDB, nil
+	}
+
+	redisClient, closeRedis := openRedis(cfg)
+
+	return redisrepo.NewBroadcastRepository(redisClient), closeRedis, nil
+}
+
+// broadcastDefinition returns the message read from the file, or the message passed as arguments if no file is set.
+// Returns an error if the file can't be read.
+func broadcastDefinition(file string, posArgs []string) (string, error) {
+	if file == "" {
+		return strings.Join(posArgs, " "), nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read message file: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// runBroadcast queues the broadcast in the repository and reports it to out.
+// In dry run mode it only reports the number of chats receiving each language variant.
+// Returns an error if the message is invalid or the repository fails.
+func runBroadcast(ctx context.Context, repo core.BroadcastRepository, definition string, dryRun bool, out io.Writer) error {
+	b, err := broadcast.New(definition, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid broadcast message: %w", err)
+	}
+
+	if dryRun {
+		chats, err := repo.CountChats(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count chats: %w", err)
+		}
+
+		_, err = fmt.Fprintln(out, bot.BroadcastPreview(b, b.Recipients(chats)))
+
+		return err
+	}
+
+	if err := repo.CreateBroadcast(ctx, b); err != nil {
+		return fmt.Errorf("failed to create broadcast: %w", err)
+	}
+
+	_, err = fmt.Fprintf(out, "Broadcast %s is queued for %d chats.\n", b.ID, b.Total)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	boltrepo "github.com/ksysoev/help-my-pet/pkg/repo/bolt"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBroadcast(t *testing.T) {
+	tests := []struct {
+		setup      func(repo *core.MockBroadcastRepository)
+		name       string
+		definition string
+		wantOut    string
+		wantErr    string
+		dryRun     bool
+	}{
+		{
+			name:       "queue broadcast",
+			definition: "We are back online!\n[es]\n¡Volvemos!",
+			setup: func(repo *core.MockBroadcastRepository) {
+				repo.EXPECT().CreateBroadcast(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, b *broadcast.Broadcast) error {
+						b.Total = 42
+						return nil
+					})
+			},
+			wantOut: "is queued for 42 chats.\n",
+		},
+		{
+			name:       "dry run",
+			definition: "We are back online!\n[es]\n¡Volvemos!",
+			dryRun:     true,
+			setup: func(repo *core.MockBroadcastRepository) {
+				repo.EXPECT().CountChats(mock.Anything).Return(map[string]int{"en": 5, "es": 2}, nil)
+			},
+			wantOut: "Dry run, nothing is sent.\nRecipients: 7\n- default: 5\n- es: 2\n",
+		},
+		{
+			name:       "empty message",
+			definition: "  ",
+			setup:      func(_ *core.MockBroadcastRepository) {},
+			wantErr:    "invalid broadcast message: broadcast text is empty",
+		},
+		{
+			name:       "repository fails",
+			definition: "Hello!",
+			setup: func(repo *core.MockBroadcastRepository) {
+				repo.EXPECT().CreateBroadcast(mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: "failed to create broadcast: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := core.NewMockBroadcastRepository(t)
+			tt.setup(repo)
+
+			var out bytes.Buffer
+
+			err := runBroadcast(context.Background(), repo, tt.definition, tt.dryRun, &out)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+		})
+	}
+}
+
+func TestBroadcastDefinition(t *testing.T) {
+	got, err := broadcastDefinition("", []string{"We", "are", "back!"})
+	require.NoError(t, err)
+	assert.Equal(t, "We are back!", got)
+
+	file := filepath.Join(t.TempDir(), "message.txt")
+	require.NoError(t, os.WriteFile(file, []byte("Hello!\n[es]\n¡Hola!\n"), 0o600))
+
+	got, err = broadcastDefinition(file, []string{"ignored"})
+	require.NoError(t, err)
+	assert.Equal(t, "Hello!\n[es]\n¡Hola!\n", got)
+
+	_, err = broadcastDefinition(filepath.Join(t.TempDir(), "missing.txt"), nil)
+	assert.ErrorContains(t, err, "failed to read message file")
+}
+
+func TestNewBroadcastRepository(t *testing.T) {
+	repo, closeRepo, err := newBroadcastRepository(&Config{})
+	require.NoError(t, err)
+
+	defer closeRepo()
+
+	assert.IsType(t, &redisrepo.BroadcastRepository{}, repo)
+
+	_, _, err = newBroadcastRepository(&Config{Storage: StorageConfig{Driver: storageMemory}})
+	assert.ErrorIs(t, err, errMemoryStorage)
+
+	repo, closeRepo, err = newBroadcastRepository(&Config{Storage: StorageConfig{Driver: storageBolt, Path: filepath.Join(t.TempDir(), "test.db")}})
+	require.NoError(t, err)
+
+	defer closeRepo()
+
+	assert.IsType(t, &boltrepo.BroadcastRepository{}, repo)
+}
@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+	"github.com/spf13/cobra"
+)
+
+// imageFlag attaches the image file following it to the typed message
+const imageFlag = "--image"
+
+// chatHelp describes the commands of the chat session
+const chatHelp = `Type a question about your pet, attach images with --image path.jpg.
+Answer suggested choices with their number or text.
+Commands: /editprofile, /cancel, /reset, /help, /quit`
+
+// errQuit is returned by the chat session command ending the session
+var errQuit = errors.New("quit")
+
+// chatFlags holds the flags of the chat command
+type chatFlags struct {
+	storage  string
+	userID   string
+	language string
+}
+
+// chatSession is an interactive conversation with the AI service of a single user in the terminal
+type chatSession struct {
+	ai      bot.AIProvider
+	out     io.Writer
+	userID  string
+	answers []string // suggested answers of the last response
+}
+
+// ChatCommand creates a new cobra.Command starting an interactive chat with the AI service in the terminal.
+// It builds the same AI service as the bot, so prompts and conversation flows can be tried without Telegram.
+func ChatCommand(arg *args) *cobra.Command {
+	var flags chatFlags
+
+	cmd := &cobra.Command{
+		Use:   "chat",
+		Short: "Chat with the pet assistant in the terminal",
+		Long: `Start an interactive chat with the pet assistant in the terminal, without Telegram.
+Conversations and pet profiles are kept in memory by default, or in the configured storage with --storage redis, bolt or postgres.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			llm, err := newLLM(&cfg.AI)
+			if err != nil {
+				return fmt.Errorf("failed to initialize LLM provider: %w", err)
+			}
+
+			aiService, closeStorage, err := newChatService(cfg, llm, flags.storage)
+			if err != nil {
+				return err
+			}
+
+			defer closeStorage()
+
+			ctx := i18n.SetLocale(cmd.Context(), i18n.NewLocalizer(), flags.language)
+
+			return runChat(ctx, aiService, flags.userID, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.storage, "storage", storageMemory, "storage of conversations and pet profiles (memory, redis, bolt, postgres)")
+	cmd.Flags().StringVar(&flags.userID, "user", "cli", "ID of the user and chat the messages are sent from")
+	cmd.Flags().StringVar(&flags.language, "language", "en", "language of the messages of the assistant")
+
+	return cmd
+}
+
+// newChatService creates the AI service for the chat with conversations and pet profiles kept in the selected storage.
+// Returns the service, a function releasing the storage, or an error if the storage is not supported.
+func newChatService(cfg *Config, llm core.LLM, storage string) (*core.AIService, func(), error) {
+	cfg.Storage.Driver = storage
+
+	redisClient, closeRedis := openRedis(cfg)
+
+	aiService, store, err := newAIService(cfg, llm, redisClient)
+	if err != nil {
+		closeRedis()
+		return nil, nil, err
+	}
+
+	return aiService, func() {
+		store.close()
+		closeRedis()
+	}, nil
+}
+
+// runChat reads messages of the user line by line from in and writes the responses of the AI service to out,
+// until the input ends or the user quits. Errors of the AI service are reported to the user without ending the chat.
+// Returns an error if reading the input or writing the output fails.
+func runChat(ctx context.Context, ai bot.AIProvider, userID string, in io.Reader, out io.Writer) error {
+	s := &chatSession{ai: ai, out: out, userID: userID}
+
+	if _, err := fmt.Fprintln(out, chatHelp); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for {
+		if _, err := fmt.Fprint(out, "\n> "); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+
+		if !scanner.Scan() {
+			break
+		}
+
+		err := s.handle(ctx, strings.TrimSpace(scanner.Text()))
+
+		switch {
+		case errors.Is(err, errQuit):
+			return nil
+		case err != nil:
+			if _, err := fmt.Fprintf(out, "Error: %v\n", err); err != nil {
+				return fmt.Errorf("failed to write output: %w", err)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read input: %w", err)
+	}
+
+	return nil
+}
+
+// handle executes the command of the line or sends it to the AI service as a message and prints the response.
+// Returns errQuit if the user ends the session, or an error if the line can't be processed.
+func (s *chatSession) handle(ctx context.Context, line string) error {
+	switch line {
+	case "":
+		return nil
+	case "/quit", "/exit":
+		return errQuit
+	case "/help":
+		return s.print(chatHelp)
+	case "/cancel":
+		if err := s.ai.CancelQuestionnaire(ctx, s.userID); err != nil {
+			return fmt.Errorf("failed to reset conversation: %w", err)
+		}
+
+		s.answers = nil
+
+		return s.print(i18n.GetLocale(ctx).Sprintf("Questionary is cancelled"))
+	case "/reset":
+		if err := s.ai.ResetUserConversation(ctx, s.userID, s.userID); err != nil {
+			return fmt.Errorf("failed to reset user conversation: %w", err)
+		}
+
+		s.answers = nil
+
+		return s.print("Conversation and pet profiles are removed")
+	}
+
+	text, images, err := parseChatLine(line)
+	if err != nil {
+		return err
+	}
+
+	// A number selects one of the suggested answers
+	if n, err := strconv.Atoi(text); err == nil && n >= 1 && n <= len(s.answers) && len(images) == 0 {
+		text = s.answers[n-1]
+	}
+
+	req, err := message.NewUserMessage(s.userID, s.userID, text)
+	if err != nil {
+		return fmt.Errorf("failed to create user message: %w", err)
+	}
+
+	req.Images = images
+
+	var resp *message.Response
+
+	if text == "/editprofile" {
+		resp, err = s.ai.ProcessEditProfile(ctx, req)
+	} else {
+		resp, err = s.ai.ProcessMessage(ctx, req)
+	}
+
+	switch {
+	case errors.Is(err, core.ErrRateLimit), errors.Is(err, core.ErrUserBudget), errors.Is(err, core.ErrGlobalLimit):
+		return s.print(err.Error())
+	case err != nil:
+		return fmt.Errorf("failed to get AI response: %w", err)
+	}
+
+	return s.render(resp)
+}
+
+// render prints the response with its triage level and the suggested answers as numbered choices.
+func (s *chatSession) render(resp *message.Response) error {
+	var sb strings.Builder
+
+	if resp.Urgency != "" {
+		fmt.Fprintf(&sb, "[%s] ", resp.Urgency)
+	}
+
+	sb.WriteString(resp.Message)
+
+	for i, answer := range resp.Answers {
+		fmt.Fprintf(&sb, "\n  %d. %s", i+1, answer)
+	}
+
+	s.answers = resp.Answers
+
+	return s.print(sb.String())
+}
+
+// print writes the text followed by a new line to the output of the session.
+func (s *chatSession) print(text string) error {
+	if _, err := fmt.Fprintln(s.out, text); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	return nil
+}
+
+// parseChatLine splits the line into the text of the message and the images attached with --image path.
+// Returns the text, the loaded images or an error if an image path is missing or the file can't be read.
+func parseChatLine(line string) (string, []*message.Image, error) {
+	fields := strings.Fields(line)
+	if !slices.Contains(fields, imageFlag) {
+		return line, nil, nil
+	}
+
+	words := make([]string, 0, len(fields))
+
+	var images []*message.Image
+
+	for i := 0; i < len(fields); i++ {
+		if fields[i] != imageFlag {
+			words = append(words, fields[i])
+			continue
+		}
+
+		if i+1 == len(fields) {
+			return "", nil, fmt.Errorf("missing image path after %s", imageFlag)
+		}
+
+		i++
+
+		data, err := os.ReadFile(fields[i])
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read image: %w", err)
+		}
+
+		images = append(images, &message.Image{
+			MIME: http.DetectContentType(data),
+			Data: base64.StdEncoding.EncodeToString(data),
+		})
+	}
+
+	return strings.Join(words, " "), images, nil
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunChat(t *testing.T) {
+	dir := t.TempDir()
+	photo := filepath.Join(dir, "photo.png")
+
+	require.NoError(t, os.WriteFile(photo, []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}, 0o600))
+
+	// withText matches the request of the user with the given text
+	withText := func(text string) any {
+		return mock.MatchedBy(func(req *message.UserMessage) bool {
+			return req.UserID == "cli" && req.ChatID == "cli" && req.Text == text
+		})
+	}
+
+	tests := []struct {
+		setup   func(ai *bot.MockAIProvider)
+		name    string
+		input   string
+		wantOut []string
+	}{
+		{
+			name:  "numbered answers are selected by number",
+			input: "My dog is limping\n2\n",
+			setup: func(ai *bot.MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, withText("My dog is limping")).
+					Return(&message.Response{Message: "Since when?", Answers: []string{"Today", "A week ago"}}, nil)
+				ai.EXPECT().ProcessMessage(mock.Anything, withText("A week ago")).
+					Return(&message.Response{Message: "Please see a vet.", Urgency: message.UrgencySeeVetSoon}, nil)
+			},
+			wantOut: []string{"Since when?\n  1. Today\n  2. A week ago\n", "[see_vet_soon] Please see a vet.\n"},
+		},
+		{
+			name:  "image is attached",
+			input: "What is this rash? --image " + photo + "\n",
+			setup: func(ai *bot.MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, mock.MatchedBy(func(req *message.UserMessage) bool {
+					return req.Text == "What is this rash?" && len(req.Images) == 1 && req.Images[0].MIME == "image/png"
+				})).Return(&message.Response{Message: "It looks like an allergy."}, nil)
+			},
+			wantOut: []string{"It looks like an allergy.\n"},
+		},
+		{
+			name:    "missing image",
+			input:   "What is this? --image " + filepath.Join(dir, "missing.png") + "\n",
+			setup:   func(_ *bot.MockAIProvider) {},
+			wantOut: []string{"Error: failed to read image: "},
+		},
+		{
+			name:  "profile editing and cancellation",
+			input: "/editprofile\n/cancel\n",
+			setup: func(ai *bot.MockAIProvider) {
+				ai.EXPECT().ProcessEditProfile(mock.Anything, withText("/editprofile")).
+					Return(&message.Response{Message: "What is your pet's name?"}, nil)
+				ai.EXPECT().CancelQuestionnaire(mock.Anything, "cli").Return(nil)
+			},
+			wantOut: []string{"What is your pet's name?\n", "Questionary is cancelled\n"},
+		},
+		{
+			name:  "errors don't end the chat",
+			input: "Hello\nHello again\n",
+			setup: func(ai *bot.MockAIProvider) {
+				ai.EXPECT().ProcessMessage(mock.Anything, withText("Hello")).Return(nil, errors.New("LLM is down"))
+				ai.EXPECT().ProcessMessage(mock.Anything, withText("Hello again")).Return(nil, core.ErrRateLimit)
+			},
+			wantOut: []string{"Error: failed to get AI response: LLM is down\n", core.ErrRateLimit.Error() + "\n"},
+		},
+		{
+			name:  "quit ends the chat",
+			input: "/quit\nHello\n",
+			setup: func(_ *bot.MockAIProvider) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai := bot.NewMockAIProvider(t)
+			tt.setup(ai)
+
+			var out bytes.Buffer
+
+			err := runChat(context.Background(), ai, "cli", strings.NewReader(tt.input), &out)
+
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
+
+func TestNewChatService(t *testing.T) {
+	cfg := &Config{}
+
+	svc, closeStorage, err := newChatService(cfg, core.NewMockLLM(t), storageMemory)
+	require.NoError(t, err)
+	assert.NotNil(t, svc)
+	closeStorage()
+
+	_, _, err = newChatService(cfg, core.NewMockLLM(t), "sqlite")
+	assert.EqualError(t, err, "failed to initialize storage: unsupported storage driver: sqlite")
+}
@@ -3,10 +3,15 @@ package cmd
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
 	"strings"
 
+	"github.com/ksysoev/help-my-pet/pkg/api"
 	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+	"github.com/ksysoev/help-my-pet/pkg/prov/openai"
 	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
 	"github.com/spf13/viper"
 )
@@ -18,15 +23,110 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// AIConfig holds LLM settings, Provider selects the backend: "anthropic" (default) or "openai".
+// Anthropic settings are kept at the top level of the section, OpenAI-compatible settings are nested under "openai".
+type AIConfig struct {
+	OpenAI           openai.Config `mapstructure:"openai"`
+	Provider         string        `mapstructure:"provider"`
+	anthropic.Config `mapstructure:",squash"`
+}
+
+// StorageConfig selects where conversations and pet profiles are kept.
+// Driver is "redis" (default), "memory", "bolt" or "postgres", Path is the database file of the "bolt" driver
+// and DSN is the connection string of the "postgres" driver.
+type StorageConfig struct {
+	Driver string `mapstructure:"driver"`
+	Path   string `mapstructure:"path"`
+	DSN    string `mapstructure:"dsn"`
+}
+
+// redacted replaces secrets in the logged configuration
+const redacted = "[REDACTED]"
+
 type Config struct {
 	Bot       bot.Config             `mapstructure:"bot"`
-	AI        anthropic.Config       `mapstructure:"ai"`
+	AI        AIConfig               `mapstructure:"ai"`
 	Redis     RedisConfig            `mapstructure:"redis"`
+	Storage   StorageConfig          `mapstructure:"storage"`
 	RateLimit memory.RateLimitConfig `mapstructure:"rate_limit"`
+	Budget    budget.Config          `mapstructure:"budget"`
+	Metrics   metrics.Config         `mapstructure:"metrics"`
+	API       api.Config             `mapstructure:"api"`
+}
+
+// LogValue implements slog.LogValuer, so tokens, API keys and passwords are redacted when the configuration is logged.
+// Empty secrets are kept empty to show they are missing.
+func (c Config) LogValue() slog.Value {
+	// config drops LogValue, so the redacted copy is logged as is
+	type config Config
+
+	c.Bot.TelegramToken = redact(c.Bot.TelegramToken)
+	c.Bot.Webhook.SecretToken = redact(c.Bot.Webhook.SecretToken)
+	c.AI.APIKey = redact(c.AI.APIKey)
+	c.AI.OpenAI.APIKey = redact(c.AI.OpenAI.APIKey)
+	c.Redis.URL = redactURL(c.Redis.URL)
+	c.Redis.Password = redact(c.Redis.Password)
+	c.Storage.DSN = redactURL(c.Storage.DSN)
+
+	if c.AI.OpenAI.Headers != nil {
+		headers := make(map[string]string, len(c.AI.OpenAI.Headers))
+		for name, value := range c.AI.OpenAI.Headers {
+			headers[name] = redact(value)
+		}
+
+		c.AI.OpenAI.Headers = headers
+	}
+
+	if c.API.Keys != nil {
+		keys := make([]api.Key, 0, len(c.API.Keys))
+		for _, key := range c.API.Keys {
+			keys = append(keys, api.Key{Client: key.Client, Key: redact(key.Key)})
+		}
+
+		c.API.Keys = keys
+	}
+
+	return slog.AnyValue(config(c))
+}
+
+// redact hides the secret unless it is empty.
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+// redactURL hides the password of the connection URL, a value which isn't a URL is hidden completely.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" {
+		return redact(rawURL)
+	}
+
+	return u.Redacted()
 }
 
-// initConfig initializes the configuration by reading from the specified config file.
+// initConfig initializes the configuration of the Telegram bot by reading from the specified config file.
+// Returns an error if the configuration can't be loaded or the Telegram token is missing.
 func initConfig(arg *args) (*Config, error) {
+	cfg, err := loadConfig(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Bot.TelegramToken == "" {
+		return nil, fmt.Errorf("telegram token is required")
+	}
+
+	return cfg, nil
+}
+
+// loadConfig reads the configuration from the specified config file and the environment and validates the AI settings.
+// It is used directly by commands working without Telegram.
+// Returns the configuration or an error if it can't be read or the AI provider is misconfigured.
+func loadConfig(arg *args) (*Config, error) {
 	v := viper.New()
 
 	// Set default values
@@ -51,12 +151,17 @@ func initConfig(arg *args) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	// Validate required fields
-	if cfg.Bot.TelegramToken == "" {
-		return nil, fmt.Errorf("telegram token is required")
-	}
-	if cfg.AI.APIKey == "" {
-		return nil, fmt.Errorf("anthropic API key is required")
+	switch cfg.AI.Provider {
+	case "", providerAnthropic:
+		if cfg.AI.APIKey == "" {
+			return nil, fmt.Errorf("anthropic API key is required")
+		}
+	case providerOpenAI:
+		if cfg.AI.OpenAI.BaseURL == "" {
+			return nil, fmt.Errorf("openai base URL is required")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported AI provider: %s", cfg.AI.Provider)
 	}
 
 	slog.Debug("Config loaded", slog.Any("config", cfg))
@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"log/slog"
 	"os"
 	"testing"
 
+	"github.com/ksysoev/help-my-pet/pkg/api"
+	"github.com/ksysoev/help-my-pet/pkg/bot"
+	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+	"github.com/ksysoev/help-my-pet/pkg/prov/openai"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +55,42 @@ ai:
 			wantErr:     true,
 			errContains: "anthropic API key is required",
 		},
+		{
+			name: "openai provider without anthropic key",
+			configData: `
+bot:
+  telegram_token: "test-token"
+ai:
+  provider: "openai"
+  openai:
+    base_url: "http://localhost:11434/v1"
+    model: "llama3"
+`,
+			wantErr: false,
+		},
+		{
+			name: "openai provider without base url",
+			configData: `
+bot:
+  telegram_token: "test-token"
+ai:
+  provider: "openai"
+`,
+			wantErr:     true,
+			errContains: "openai base URL is required",
+		},
+		{
+			name: "unsupported provider",
+			configData: `
+bot:
+  telegram_token: "test-token"
+ai:
+  provider: "unknown"
+  api_key: "test-key"
+`,
+			wantErr:     true,
+			errContains: "unsupported AI provider: unknown",
+		},
 		{
 			name: "env vars override",
 			configData: `
@@ -111,3 +153,70 @@ ai:
 		})
 	}
 }
+
+func TestLoadConfig_WithoutTelegram(t *testing.T) {
+	configPath := t.TempDir() + "/config.yaml"
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+ai:
+  api_key: "test-key"
+api:
+  listen: ":8081"
+  keys:
+    - client: "web"
+      key: "key1"
+    - client: "app"
+      key: "key2"
+`), 0o600))
+
+	cfg, err := loadConfig(&args{ConfigPath: configPath})
+	require.NoError(t, err)
+	assert.Equal(t, ":8081", cfg.API.Listen)
+	assert.Equal(t, []api.Key{{Client: "web", Key: "key1"}, {Client: "app", Key: "key2"}}, cfg.API.Keys)
+
+	_, err = initConfig(&args{ConfigPath: configPath})
+	assert.ErrorContains(t, err, "telegram token is required")
+}
+
+func TestConfig_LogValue(t *testing.T) {
+	cfg := Config{
+		Bot: bot.Config{
+			TelegramToken: "telegram-secret",
+			Mode:          "webhook",
+			Webhook:       bot.WebhookConfig{URL: "https://example.com/hook", SecretToken: "webhook-secret"},
+		},
+		AI: AIConfig{
+			Config: anthropic.Config{APIKey: "anthropic-secret", Model: "claude"},
+			OpenAI: openai.Config{APIKey: "openai-secret", Headers: map[string]string{"api-key": "header-secret"}},
+		},
+		Redis:   RedisConfig{URL: "redis://:url-secret@localhost:6379", Password: "redis-secret"},
+		Storage: StorageConfig{Driver: "postgres", DSN: "postgres://pet:dsn-secret@db:5432/pets"},
+		API:     api.Config{Listen: ":8081", Keys: []api.Key{{Client: "web", Key: "api-secret"}}},
+	}
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger.Debug("Config loaded", slog.Any("config", cfg))
+
+	out := buf.String()
+
+	for _, secret := range []string{"telegram-secret", "webhook-secret", "anthropic-secret", "openai-secret", "header-secret", "url-secret", "redis-secret", "api-secret", "dsn-secret"} {
+		assert.NotContains(t, out, secret)
+	}
+
+	assert.Contains(t, out, "https://example.com/hook")
+	assert.Contains(t, out, "localhost:6379")
+	assert.Contains(t, out, "claude")
+	assert.Contains(t, out, "Client:web")
+	assert.Contains(t, out, "db:5432/pets")
+	assert.Contains(t, out, redacted)
+	assert.Equal(t, "telegram-secret", cfg.Bot.TelegramToken, "the configuration isn't changed")
+	assert.Equal(t, "api-secret", cfg.API.Keys[0].Key, "the configuration isn't changed")
+}
+
+func TestRedactURL(t *testing.T) {
+	assert.Equal(t, "postgres://pet:xxxxx@db:5432/pets", redactURL("postgres://pet:secret@db:5432/pets"))
+	assert.Equal(t, "redis://localhost:6379", redactURL("redis://localhost:6379"))
+	assert.Equal(t, redacted, redactURL("host=db user=pet password=secret"))
+	assert.Empty(t, redactURL(""))
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/eval"
+	"github.com/spf13/cobra"
+)
+
+// evalFlags holds the flags of the eval command
+type evalFlags struct {
+	dataset string
+	output  string
+	record  string
+	replay  string
+}
+
+// EvalCommand creates a new cobra.Command evaluating the prompts of the configured LLM against a dataset of cases.
+// Responses can be recorded with --record and replayed with --replay, so evaluation runs offline and reproducibly.
+func EvalCommand(arg *args) *cobra.Command {
+	var flags evalFlags
+
+	cmd := &cobra.Command{
+		Use:   "eval",
+		Short: "Evaluate answers of the LLM against a dataset of cases",
+		Long: `Ask the LLM the question of every case of the dataset the same way the bot does and check the answers
+against the assertions of the cases. Prints a pass/fail report and optionally writes the results as JSON
+for diffing them between prompt and model versions. Fails if any case fails.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			dataset, err := eval.LoadDataset(flags.dataset)
+			if err != nil {
+				return err
+			}
+
+			llm, err := newEvalLLM(arg, &flags)
+			if err != nil {
+				return err
+			}
+
+			runErr := runEval(cmd.Context(), llm, dataset, cmd.OutOrStdout(), flags.output)
+
+			if rec, ok := llm.(*eval.Recording); ok && flags.record != "" {
+				if err := rec.Save(flags.record); err != nil {
+					return err
+				}
+			}
+
+			return runErr
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.dataset, "dataset", "", "YAML file with the cases to evaluate")
+	cmd.Flags().StringVar(&flags.output, "output", "", "write the results as JSON to the file")
+	cmd.Flags().StringVar(&flags.record, "record", "", "record the responses of the LLM to the file")
+	cmd.Flags().StringVar(&flags.replay, "replay", "", "replay the responses recorded to the file instead of calling the LLM")
+
+	_ = cmd.MarkFlagRequired("dataset")
+	cmd.MarkFlagsMutuallyExclusive("record", "replay")
+
+	return cmd
+}
+
+// newEvalLLM creates the LLM answering the cases: the replayed recording, or the configured LLM
+// wrapped into a recording when responses are recorded.
+// Returns the LLM or an error if the recording or the configuration can't be loaded.
+func newEvalLLM(arg *args, flags *evalFlags) (core.LLM, error) {
+	if flags.replay != "" {
+		return eval.LoadRecording(flags.replay)
+	}
+
+	cfg, err := loadConfig(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	llm, err := newLLM(&cfg.AI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize LLM provider: %w", err)
+	}
+
+	if flags.record != "" {
+		return eval.NewRecording(llm), nil
+	}
+
+	return llm, nil
+}
+
+// runEval evaluates the dataset with the LLM, writes the summary to out and the JSON results to the output file if set.
+// Returns an error if writing the results fails or any case fails.
+func runEval(ctx context.Context, llm core.LLM, dataset *eval.Dataset, out io.Writer, output string) error {
+	report := eval.Run(ctx, llm, dataset)
+
+	if err := report.WriteSummary(out); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+
+		if err := report.WriteJSON(file); err != nil {
+			_ = file.Close()
+			return err
+		}
+
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("failed to close output file: %w", err)
+		}
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d cases failed", report.Failed, len(report.Results))
+	}
+
+	return nil
+}
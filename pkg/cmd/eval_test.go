@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const evalDataset = `cases:
+  - name: chocolate
+    question: Mi perro comió chocolate
+    expect:
+      recommends_vet: true
+      urgency: emergency
+      language: es
+  - name: food
+    question: What should I feed my kitten?
+    expect:
+      asks_questions: true
+`
+
+const evalRecording = `{
+  "prompt_version": "abc123",
+  "responses": {
+    "chocolate": {"text": "Lleva a tu perro al veterinario de urgencias ahora.", "questions": null, "urgency": "emergency"},
+    "food": {"text": "", "questions": [{"text": "How old is your kitten?"}], "urgency": "informational"}
+  }
+}`
+
+func TestEvalCommand(t *testing.T) {
+	tests := []struct {
+		name        string
+		recording   string
+		wantOut     string
+		errContains string
+	}{
+		{
+			name:      "all cases pass",
+			recording: evalRecording,
+			wantOut:   "PASS chocolate\nPASS food\n\n2 passed, 0 failed (prompt version abc123)\n",
+		},
+		{
+			name:        "case fails",
+			recording:   `{"responses": {"chocolate": {"text": "It is fine.", "questions": null}}}`,
+			wantOut:     "FAIL chocolate: recommends vet: expected true, got false; urgency: expected emergency, got none; language: expected es, got en\nFAIL food: response is not recorded: food\n\n0 passed, 2 failed\n",
+			errContains: "2 of 2 cases failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			dataset := filepath.Join(dir, "cases.yaml")
+			recording := filepath.Join(dir, "recording.json")
+			output := filepath.Join(dir, "results.json")
+
+			require.NoError(t, os.WriteFile(dataset, []byte(evalDataset), 0o600))
+			require.NoError(t, os.WriteFile(recording, []byte(tt.recording), 0o600))
+
+			var out bytes.Buffer
+
+			cmd := EvalCommand(&args{LogLevel: "error"})
+			cmd.SetOut(&out)
+			cmd.SetArgs([]string{"--dataset", dataset, "--replay", recording, "--output", output})
+			cmd.SetContext(context.Background())
+
+			err := cmd.Execute()
+
+			if tt.errContains != "" {
+				assert.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Contains(t, out.String(), tt.wantOut)
+			assert.FileExists(t, output)
+		})
+	}
+}
+
+func TestEvalCommand_Flags(t *testing.T) {
+	cmd := EvalCommand(&args{LogLevel: "error"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--dataset", "cases.yaml", "--record", "a.json", "--replay", "b.json"})
+
+	assert.ErrorContains(t, cmd.Execute(), "none of the others can be")
+
+	cmd = EvalCommand(&args{LogLevel: "error"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{})
+
+	assert.ErrorContains(t, cmd.Execute(), `required flag(s) "dataset" not set`)
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	boltrepo "github.com/ksysoev/help-my-pet/pkg/repo/bolt"
+	"github.com/ksysoev/help-my-pet/pkg/repo/postgres"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/spf13/cobra"
+)
+
+// ExportFeedbackCommand creates a new cobra.Command exporting answers rated by users for quality reviews.
+// Every rated answer is written as a JSON object on its own line, to stdout or to the file set with --output.
+func ExportFeedbackCommand(arg *args) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export-feedback",
+		Short: "Export answers rated by users as JSON Lines",
+		Long: `Export all answers rated by users with the question, answer, model, prompt version, rating and reason,
+one JSON object per line, ordered by the time of rating.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			cfg, err := initConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			repo, closeRepo, err := newFeedbackRepository(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+
+			defer closeRepo()
+
+			if output == "" {
+				return runExportFeedback(cmd.Context(), repo, cmd.OutOrStdout())
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+
+			if err := runExportFeedback(cmd.Context(), repo, file); err != nil {
+				_ = file.Close()
+				return err
+			}
+
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("failed to close output file: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&output, "output", "", "write the feedback to the file instead of stdout")
+
+	return cmd
+}
+
+// newFeedbackRepository creates the repository keeping feedback with the storage selected in the configuration,
+// feedback is kept in PostgreSQL with the "postgres" driver, in the database file with the "bolt" driver
+// and in Redis with the "redis" driver. The database file of the "bolt" driver is held by the running bot,
+// so feedback is exported from it while the bot is stopped.
+// Returns the repository, a function releasing its connection, or an error if the storage keeps feedback only
+// in the memory of the running bot or the database can't be reached.
+func newFeedbackRepository(ctx context.Context, cfg *Config) (core.FeedbackRepository, func(), error) {
+	switch cfg.Storage.Driver {
+	case storagePostgres:
+		pool, err := postgres.Connect(ctx, cfg.Storage.DSN)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return postgres.NewFeedbackRepository(pool), pool.Close, nil
+	case storageMemory:
+		return nil, nil, errMemoryStorage
+	case storageBolt:
+		db, closeDB, err := openBolt(&cfg.Storage)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return boltrepo.NewFeedbackRepository(db), closeDB, nil
+	}
+
+	redisClient, closeRedis := openRedis(cfg)
+
+	return redisrepo.NewFeedbackRepository(redisClient), closeRedis, nil
+}
+
+// runExportFeedback writes all rated answers from the repository to out as JSON Lines.
+// Returns an error if the repository fails or writing fails.
+func runExportFeedback(ctx context.Context, repo core.FeedbackRepository, out io.Writer) error {
+	records, err := repo.ListFeedback(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list feedback: %w", err)
+	}
+
+	enc := json.NewEncoder(out)
+
+	for _, f := range records {
+		if err := enc.Encode(f); err != nil {
+			return fmt.Errorf("failed to write feedback: %w", err)
+		}
+	}
+
+	return nil
+}
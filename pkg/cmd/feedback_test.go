@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	boltrepo "github.com/ksysoev/help-my-pet/pkg/repo/bolt"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExportFeedback(t *testing.T) {
+	ratedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		setup   func(repo *core.MockFeedbackRepository)
+		name    string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "rated answers",
+			setup: func(repo *core.MockFeedbackRepository) {
+				repo.EXPECT().ListFeedback(mock.Anything).Return([]*feedback.Feedback{
+					{ID: "a1", Question: "Q1", Answer: "A1", Rating: feedback.RatingUp, CreatedAt: ratedAt, RatedAt: ratedAt},
+					{ID: "a2", Question: "Q2", Answer: "A2", Model: "sonnet", PromptVersion: "v1", Rating: feedback.RatingDown, Reason: "Too vague", CreatedAt: ratedAt, RatedAt: ratedAt},
+				}, nil)
+			},
+			wantOut: `{"created_at":"2026-03-01T10:00:00Z","rated_at":"2026-03-01T10:00:00Z","id":"a1","user_id":"","chat_id":"","question":"Q1","answer":"A1","rating":"up"}
+{"created_at":"2026-03-01T10:00:00Z","rated_at":"2026-03-01T10:00:00Z","id":"a2","user_id":"","chat_id":"","question":"Q2","answer":"A2","model":"sonnet","prompt_version":"v1","rating":"down","reason":"Too vague"}
+`,
+		},
+		{
+			name: "no feedback",
+			setup: func(repo *core.MockFeedbackRepository) {
+				repo.EXPECT().ListFeedback(mock.Anything).Return(nil, nil)
+			},
+		},
+		{
+			name: "repository fails",
+			setup: func(repo *core.MockFeedbackRepository) {
+				repo.EXPECT().ListFeedback(mock.Anything).Return(nil, assert.AnError)
+			},
+			wantErr: "failed to list feedback: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := core.NewMockFeedbackRepository(t)
+			tt.setup(repo)
+
+			var out bytes.Buffer
+
+			err := runExportFeedback(context.Background(), repo, &out)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
+
+func TestNewFeedbackRepository(t *testing.T) {
+	repo, closeRepo, err := newFeedbackRepository(context.Background(), &Config{})
+	require.NoError(t, err)
+
+	defer closeRepo()
+
+	assert.IsType(t, &redisrepo.FeedbackRepository{}, repo)
+
+	_, _, err = newFeedbackRepository(context.Background(), &Config{Storage: StorageConfig{Driver: storagePostgres}})
+	assert.ErrorContains(t, err, "postgres connection string cannot be empty")
+
+	_, _, err = newFeedbackRepository(context.Background(), &Config{Storage: StorageConfig{Driver: storageMemory}})
+	assert.ErrorIs(t, err, errMemoryStorage)
+
+	repo, closeRepo, err = newFeedbackRepository(context.Background(), &Config{Storage: StorageConfig{Driver: storageBolt, Path: filepath.Join(t.TempDir(), "test.db")}})
+	require.NoError(t, err)
+
+	defer closeRepo()
+
+	assert.IsType(t, &boltrepo.FeedbackRepository{}, repo)
+}
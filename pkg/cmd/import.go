@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/ksysoev/help-my-pet/pkg/repo/postgres"
+	redisrepo "github.com/ksysoev/help-my-pet/pkg/repo/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/cobra"
+)
+
+// ImportFromRedisCommand creates a new cobra.Command copying conversations, pet profiles, reminders and feedback
+// from Redis to PostgreSQL.
+// It is run once when moving a deployment to the "postgres" storage, after the migrate command.
+func ImportFromRedisCommand(arg *args) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-from-redis",
+		Short: "Copy conversations, pet profiles, reminders and feedback from Redis to PostgreSQL",
+		Long: `Copy all conversations, pet profiles, reminders and rated or ratable answers from the configured Redis server
+to the PostgreSQL database configured with storage.dsn. Conversations and answers keep their remaining TTL,
+existing records with the same IDs are replaced, so the import can be repeated. Run the migrate command first.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			redisClient := newRedisClient(&cfg.Redis)
+
+			defer func() {
+				if err := redisClient.Close(); err != nil {
+					slog.Error("failed to close Redis connection", slog.Any("error", err))
+				}
+			}()
+
+			pool, err := postgres.Connect(cmd.Context(), cfg.Storage.DSN)
+			if err != nil {
+				return err
+			}
+
+			defer pool.Close()
+
+			return runImportFromRedis(cmd.Context(), redisClient, pool, cmd.OutOrStdout())
+		},
+	}
+}
+
+// runImportFromRedis copies the conversations, pet profiles, reminders and feedback stored in Redis to the database
+// and writes the totals to out. Conversations without expiration get the full conversation.TTL.
+// Answers which can still be rated keep their remaining TTL, rated answers which can't be rated anymore are kept expired.
+// Returns an error if reading from Redis, writing to the database or writing the output fails.
+func runImportFromRedis(ctx context.Context, redisClient *redis.Client, db postgres.DB, out io.Writer) error {
+	conversations := postgres.NewConversationRepository(db)
+	profiles := postgres.NewPetProfileRepository(db)
+	reminders := postgres.NewReminderRepository(db)
+	answers := postgres.NewFeedbackRepository(db)
+
+	var convCount, profileCount, reminderCount int
+
+	// Rated answers are stored in Redis twice while they can be rated, they are counted once
+	importedAnswers := make(map[string]struct{})
+
+	err := redisrepo.NewConversationRepository(redisClient).ForEach(ctx, func(id string, data []byte, ttl time.Duration) error {
+		if ttl < 0 {
+			ttl = conversation.TTL
+		}
+
+		if err := conversations.Import(ctx, id, data, time.Now().Add(ttl)); err != nil {
+			return fmt.Errorf("failed to import conversation %s: %w", id, err)
+		}
+
+		convCount++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = redisrepo.NewPetProfileRepository(redisClient).ForEach(ctx, func(userID string, data []byte) error {
+		if err := profiles.Import(ctx, userID, data); err != nil {
+			return fmt.Errorf("failed to import pet profiles of user %s: %w", userID, err)
+		}
+
+		profileCount++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = redisrepo.NewReminderRepository(redisClient).ForEach(ctx, func(rem *reminder.Reminder) error {
+		if err := reminders.Import(ctx, rem); err != nil {
+			return fmt.Errorf("failed to import reminder %s: %w", rem.ID, err)
+		}
+
+		reminderCount++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	redisFeedback := redisrepo.NewFeedbackRepository(redisClient)
+
+	// Rated answers are imported first, so the answers which can still be rated replace them with their remaining TTL
+	err = redisFeedback.ForEachFeedback(ctx, func(f *feedback.Feedback) error {
+		if err := answers.Import(ctx, f, f.CreatedAt.Add(feedback.AnswerTTL)); err != nil {
+			return fmt.Errorf("failed to import feedback %s: %w", f.ID, err)
+		}
+
+		importedAnswers[f.ID] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = redisFeedback.ForEachAnswer(ctx, func(f *feedback.Feedback, ttl time.Duration) error {
+		if ttl < 0 {
+			ttl = feedback.AnswerTTL
+		}
+
+		if err := answers.Import(ctx, f, time.Now().Add(ttl)); err != nil {
+			return fmt.Errorf("failed to import answer %s: %w", f.ID, err)
+		}
+
+		importedAnswers[f.ID] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "Imported %d conversations, pet profiles of %d users, %d reminders and %d answers\n",
+		convCount, profileCount, reminderCount, len(importedAnswers))
+	if err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	return nil
+}
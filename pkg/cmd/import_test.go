@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunImportFromRedis(t *testing.T) {
+	const (
+		reminderData = `{"id":"rem1","user_id":"user1","next_at":"2026-03-01T09:00:00Z","schedule":{"unit":"day","every":1}}`
+		answerData   = `{"id":"a2","user_id":"user1","created_at":"2026-03-01T09:00:00Z"}`
+		ratedData    = `{"id":"a1","user_id":"user1","created_at":"2026-03-01T09:00:00Z","rated_at":"2026-03-01T10:00:00Z","rating":"up"}`
+	)
+
+	tests := []struct {
+		setup   func(redisMock redismock.ClientMock, pgMock pgxmock.PgxPoolIface)
+		name    string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "conversations and profiles",
+			setup: func(redisMock redismock.ClientMock, pgMock pgxmock.PgxPoolIface) {
+				redisMock.ExpectScan(0, "conversation:*", 100).SetVal([]string{"conversation:chat1", "conversation:chat2"}, 0)
+				redisMock.ExpectGet("conversation:chat1").SetVal(`{"id":"chat1"}`)
+				redisMock.ExpectPTTL("conversation:chat1").SetVal(time.Hour)
+				redisMock.ExpectGet("conversation:chat2").SetVal(`{"id":"chat2"}`)
+				redisMock.ExpectPTTL("conversation:chat2").SetVal(-1)
+				redisMock.ExpectScan(0, "pet_profiles:*", 100).SetVal([]string{"pet_profiles:user1"}, 0)
+				redisMock.ExpectGet("pet_profiles:user1").SetVal(`{"profiles":[{"name":"Max"}]}`)
+				redisMock.ExpectHScan("pet_profiles", 0, "", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("reminders", 0, "", 100).SetVal([]string{"rem1", reminderData}, 0)
+				redisMock.ExpectHScan("feedback", 0, "", 100).SetVal([]string{"a1", ratedData}, 0)
+				redisMock.ExpectScan(0, "feedback:answer:*", 100).SetVal([]string{"feedback:answer:a1", "feedback:answer:a2"}, 0)
+				redisMock.ExpectGet("feedback:answer:a1").SetVal(ratedData)
+				redisMock.ExpectPTTL("feedback:answer:a1").SetVal(time.Hour)
+				redisMock.ExpectGet("feedback:answer:a2").SetVal(answerData)
+				redisMock.ExpectPTTL("feedback:answer:a2").SetVal(time.Hour)
+
+				pgMock.ExpectExec("INSERT INTO conversations").WithArgs("chat1", []byte(`{"id":"chat1"}`), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO conversations").WithArgs("chat2", []byte(`{"id":"chat2"}`), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO pet_profiles").WithArgs("user1", []byte(`{"profiles":[{"name":"Max"}]}`)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO reminders").WithArgs("rem1", "user1", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO feedback").WithArgs("a1", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO feedback").WithArgs("a1", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				pgMock.ExpectExec("INSERT INTO feedback").WithArgs("a2", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			wantOut: "Imported 2 conversations, pet profiles of 1 users, 1 reminders and 2 answers\n",
+		},
+		{
+			name: "empty redis",
+			setup: func(redisMock redismock.ClientMock, _ pgxmock.PgxPoolIface) {
+				redisMock.ExpectScan(0, "conversation:*", 100).SetVal(nil, 0)
+				redisMock.ExpectScan(0, "pet_profiles:*", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("pet_profiles", 0, "", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("reminders", 0, "", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("feedback", 0, "", 100).SetVal(nil, 0)
+				redisMock.ExpectScan(0, "feedback:answer:*", 100).SetVal(nil, 0)
+			},
+			wantOut: "Imported 0 conversations, pet profiles of 0 users, 0 reminders and 0 answers\n",
+		},
+		{
+			name: "conversation import fails",
+			setup: func(redisMock redismock.ClientMock, pgMock pgxmock.PgxPoolIface) {
+				redisMock.ExpectScan(0, "conversation:*", 100).SetVal([]string{"conversation:chat1"}, 0)
+				redisMock.ExpectGet("conversation:chat1").SetVal(`{"id":"chat1"}`)
+				redisMock.ExpectPTTL("conversation:chat1").SetVal(time.Hour)
+
+				pgMock.ExpectExec("INSERT INTO conversations").WithArgs("chat1", []byte(`{"id":"chat1"}`), pgxmock.AnyArg()).WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to import conversation chat1: failed to save conversation: " + assert.AnError.Error(),
+		},
+		{
+			name: "profile import fails",
+			setup: func(redisMock redismock.ClientMock, pgMock pgxmock.PgxPoolIface) {
+				redisMock.ExpectScan(0, "conversation:*", 100).SetVal(nil, 0)
+				redisMock.ExpectScan(0, "pet_profiles:*", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("pet_profiles", 0, "", 100).SetVal([]string{"user1", "{}"}, 0)
+				redisMock.ExpectExists("pet_profiles:user1").SetVal(0)
+
+				pgMock.ExpectExec("INSERT INTO pet_profiles").WithArgs("user1", []byte("{}")).WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to import pet profiles of user user1: failed to save pet profiles: " + assert.AnError.Error(),
+		},
+		{
+			name: "reminder import fails",
+			setup: func(redisMock redismock.ClientMock, pgMock pgxmock.PgxPoolIface) {
+				redisMock.ExpectScan(0, "conversation:*", 100).SetVal(nil, 0)
+				redisMock.ExpectScan(0, "pet_profiles:*", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("pet_profiles", 0, "", 100).SetVal(nil, 0)
+				redisMock.ExpectHScan("reminders", 0, "", 100).SetVal([]string{"rem1", reminderData}, 0)
+
+				pgMock.ExpectExec("INSERT INTO reminders").WithArgs("rem1", "user1", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to import reminder rem1: failed to save reminder: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redisClient, redisMock := redismock.NewClientMock()
+
+			pgMock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+
+			defer pgMock.Close()
+
+			tt.setup(redisMock, pgMock)
+
+			var out bytes.Buffer
+
+			err = runImportFromRedis(context.Background(), redisClient, pgMock, &out)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantOut, out.String())
+			}
+
+			assert.NoError(t, redisMock.ExpectationsWereMet())
+			assert.NoError(t, pgMock.ExpectationsWereMet())
+		})
+	}
+}
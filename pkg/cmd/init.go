@@ -26,6 +26,13 @@ func InitCommands(version string) *cobra.Command {
 	}
 
 	cmd.AddCommand(BotCommand(args))
+	cmd.AddCommand(ServeCommand(args))
+	cmd.AddCommand(BroadcastCommand(args))
+	cmd.AddCommand(ExportFeedbackCommand(args))
+	cmd.AddCommand(EvalCommand(args))
+	cmd.AddCommand(ChatCommand(args))
+	cmd.AddCommand(MigrateCommand(args))
+	cmd.AddCommand(ImportFromRedisCommand(args))
 
 	cmd.PersistentFlags().StringVar(&args.ConfigPath, "config", "", "config file path")
 	cmd.PersistentFlags().StringVar(&args.LogLevel, "loglevel", "info", "log level (debug, info, warn, error)")
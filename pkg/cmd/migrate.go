@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ksysoev/help-my-pet/pkg/repo/postgres"
+	"github.com/spf13/cobra"
+)
+
+// MigrateCommand creates a new cobra.Command creating or upgrading the schema of the PostgreSQL storage.
+// It applies the migrations embedded into the binary that are missing in the database configured with storage.dsn.
+func MigrateCommand(arg *args) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply database migrations of the PostgreSQL storage",
+		Long: `Create or upgrade the schema of the PostgreSQL database configured with storage.dsn.
+Migrations already applied are skipped, so the command is safe to run on every deployment.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			cfg, err := loadConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			pool, err := postgres.Connect(cmd.Context(), cfg.Storage.DSN)
+			if err != nil {
+				return err
+			}
+
+			defer pool.Close()
+
+			return runMigrate(cmd.Context(), pool, cmd.OutOrStdout())
+		},
+	}
+}
+
+// runMigrate applies the missing migrations to the database and writes the applied ones to out.
+// Returns an error if a migration fails or writing fails.
+func runMigrate(ctx context.Context, db postgres.DB, out io.Writer) error {
+	applied, err := postgres.Migrate(ctx, db)
+
+	for _, m := range applied {
+		if _, err := fmt.Fprintf(out, "Applied migration %d %s\n", m.Version, m.Name); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	if len(applied) == 0 {
+		if _, err := fmt.Fprintln(out, "Database is up to date"); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMigrate(t *testing.T) {
+	tests := []struct {
+		setup   func(mock pgxmock.PgxPoolIface)
+		name    string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "new database",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+
+				migrations := []struct{ name, sql string }{
+					{name: "create_conversations", sql: "CREATE TABLE"},
+					{name: "create_pet_profiles", sql: "CREATE TABLE"},
+					{name: "add_conversation_version", sql: "ALTER TABLE"},
+					{name: "create_reminders", sql: "CREATE TABLE"},
+					{name: "create_feedback", sql: "CREATE TABLE"},
+				}
+
+				for version, m := range migrations {
+					mock.ExpectBegin()
+					mock.ExpectExec("pg_advisory_xact_lock").WithArgs(pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+					mock.ExpectQuery("SELECT EXISTS").WithArgs(version + 1).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+					mock.ExpectExec(m.sql).WillReturnResult(pgxmock.NewResult(m.sql, 0))
+					mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(version+1, m.name).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+					mock.ExpectCommit()
+				}
+			},
+			wantOut: "Applied migration 1 create_conversations\nApplied migration 2 create_pet_profiles\nApplied migration 3 add_conversation_version\n" +
+				"Applied migration 4 create_reminders\nApplied migration 5 create_feedback\n",
+		},
+		{
+			name: "up to date database",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+
+				for version := range 5 {
+					mock.ExpectBegin()
+					mock.ExpectExec("pg_advisory_xact_lock").WithArgs(pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+					mock.ExpectQuery("SELECT EXISTS").WithArgs(version + 1).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+					mock.ExpectRollback()
+				}
+			},
+			wantOut: "Database is up to date\n",
+		},
+		{
+			name: "migration fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to migrate database: failed to create migrations table: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+
+			defer mock.Close()
+
+			tt.setup(mock)
+
+			var out bytes.Buffer
+
+			err = runMigrate(context.Background(), mock, &out)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantOut, out.String())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/ksysoev/help-my-pet/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// ServeCommand creates a new cobra.Command starting the HTTP API server for websites and mobile apps.
+// The API is served by the same AI service as the Telegram bot, sharing its storage, rate limits and spend ceilings.
+func ServeCommand(arg *args) *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Start the HTTP API server",
+		Long:  "Start the HTTP JSON API server exposing the pet assistant to websites and mobile apps, authenticated with API keys",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := initLogger(arg); err != nil {
+				return err
+			}
+
+			slog.Info("Starting Help My Pet API", slog.String("version", arg.version))
+
+			cfg, err := loadConfig(arg)
+			if err != nil {
+				return err
+			}
+
+			llm, err := newLLM(&cfg.AI)
+			if err != nil {
+				return fmt.Errorf("failed to initialize LLM provider: %w", err)
+			}
+
+			redisClient, closeRedis := openRedis(cfg)
+			defer closeRedis()
+
+			aiService, store, err := newAIService(cfg, llm, redisClient)
+			if err != nil {
+				return err
+			}
+
+			defer store.close()
+
+			go sweepExpired(cmd.Context(), store)
+
+			server, err := api.New(cfg.API, aiService)
+			if err != nil {
+				return fmt.Errorf("failed to create api server: %w", err)
+			}
+
+			return server.Run(cmd.Context())
+		},
+	}
+}
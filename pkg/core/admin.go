@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+)
+
+// ErrQuotasDisabled is returned when the rate limiter doesn't support administration of user quotas.
+var ErrQuotasDisabled = errors.New("quota management is not supported by the rate limiter")
+
+// QuotaManager is implemented by rate limiters supporting administration of user quotas at runtime.
+type QuotaManager interface {
+	// DailyUsage returns the number of distinct users who asked questions today and the number of their questions.
+	DailyUsage(ctx context.Context) (users, questions int, err error)
+	// ResetQuota removes the question history of the user, so the limits of the user start over.
+	ResetQuota(ctx context.Context, userID string) error
+	// SetWhitelisted adds the user to the whitelist exempt from rate limits, or removes the user from it.
+	SetWhitelisted(ctx context.Context, userID string, whitelisted bool) error
+}
+
+// Stats holds usage statistics of the service reported to admins.
+// Spend is nil when the service has no budget tracker.
+type Stats struct {
+	Spend          *budget.Spend
+	ActiveUsers    int
+	QuestionsAsked int
+}
+
+// UserInfo holds the pet profiles and the conversation state of a user reported to admins.
+// Profiles is nil when the user has no pets; State is empty when the user has no active conversation.
+type UserInfo struct {
+	Profiles *pet.Profiles
+	State    conversation.ConversationState
+	Turns    int
+}
+
+// GetStats returns today's usage statistics of the service and the global spend, if budget tracking is enabled.
+// Returns ErrQuotasDisabled if the rate limiter doesn't report usage, or an error if fetching the statistics fails.
+func (s *AIService) GetStats(ctx context.Context) (*Stats, error) {
+	quotas, err := s.quotaManager()
+	if err != nil {
+		return nil, err
+	}
+
+	users, questions, err := quotas.DailyUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily usage: %w", err)
+	}
+
+	stats := &Stats{
+		ActiveUsers:    users,
+		QuestionsAsked: questions,
+	}
+
+	if s.budget != nil {
+		// Spend of all users is reported, the user part of the report is not used
+		if stats.Spend, err = s.budget.GetSpend(ctx, ""); err != nil {
+			return nil, fmt.Errorf("failed to get spend: %w", err)
+		}
+	}
+
+	return stats, nil
+}
+
+// GetUserInfo returns the pet profiles of the user and the state of the user's private chat conversation.
+// Returns an error if fetching the profiles or the conversation fails.
+func (s *AIService) GetUserInfo(ctx context.Context, userID string) (*UserInfo, error) {
+	info := &UserInfo{}
+
+	profiles, err := s.profileRepo.GetProfiles(ctx, userID)
+	switch {
+	case errors.Is(err, ErrProfileNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get pet profiles: %w", err)
+	default:
+		info.Profiles = profiles
+	}
+
+	// Conversations are kept per chat, the private chat with the bot has the ID of the user
+	conv, err := s.repo.FindByID(ctx, userID)
+	switch {
+	case errors.Is(err, ErrConversationNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	default:
+		info.State = conv.GetState()
+		info.Turns = len(conv.Turns(0))
+	}
+
+	return info, nil
+}
+
+// ResetQuota starts the rate limits of the user over and clears the user's spend, if budget tracking is enabled.
+// Global request counters and global spend are kept.
+// Returns ErrQuotasDisabled if the rate limiter doesn't support it, or an error if resetting fails.
+func (s *AIService) ResetQuota(ctx context.Context, userID string) error {
+	quotas, err := s.quotaManager()
+	if err != nil {
+		return err
+	}
+
+	if err := quotas.ResetQuota(ctx, userID); err != nil {
+		return fmt.Errorf("failed to reset rate limits: %w", err)
+	}
+
+	if s.budget != nil {
+		if err := s.budget.ResetUserSpend(ctx, userID); err != nil {
+			return fmt.Errorf("failed to reset spend: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetWhitelisted adds the user to the whitelist exempt from rate limits, or removes the user from it.
+// Returns ErrQuotasDisabled if the rate limiter doesn't support it, or an error if updating the whitelist fails.
+func (s *AIService) SetWhitelisted(ctx context.Context, userID string, whitelisted bool) error {
+	quotas, err := s.quotaManager()
+	if err != nil {
+		return err
+	}
+
+	if err := quotas.SetWhitelisted(ctx, userID, whitelisted); err != nil {
+		return fmt.Errorf("failed to update whitelist: %w", err)
+	}
+
+	return nil
+}
+
+// quotaManager returns the rate limiter if it supports administration of user quotas.
+// Returns ErrQuotasDisabled otherwise.
+func (s *AIService) quotaManager() (QuotaManager, error) {
+	quotas, ok := s.rateLimiter.(QuotaManager)
+	if !ok {
+		return nil, ErrQuotasDisabled
+	}
+
+	return quotas, nil
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// quotaRateLimiter combines RateLimiter and QuotaManager mocks to imitate a rate limiter supporting quota administration.
+type quotaRateLimiter struct {
+	*MockRateLimiter
+	*MockQuotaManager
+}
+
+func newQuotaRateLimiter(t *testing.T) (quotaRateLimiter, *MockQuotaManager) {
+	quotas := NewMockQuotaManager(t)
+	return quotaRateLimiter{MockRateLimiter: NewMockRateLimiter(t), MockQuotaManager: quotas}, quotas
+}
+
+func TestAIService_GetStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not supported by rate limiter", func(t *testing.T) {
+		svc := NewAIService(NewMockLLM(t), nil, nil, NewMockRateLimiter(t))
+
+		_, err := svc.GetStats(ctx)
+		assert.ErrorIs(t, err, ErrQuotasDisabled)
+	})
+
+	t.Run("without budget", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().DailyUsage(ctx).Return(3, 7, nil)
+
+		stats, err := NewAIService(NewMockLLM(t), nil, nil, limiter).GetStats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &Stats{ActiveUsers: 3, QuestionsAsked: 7}, stats)
+	})
+
+	t.Run("with budget", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().DailyUsage(ctx).Return(3, 7, nil)
+
+		tracker := NewMockBudgetTracker(t)
+		tracker.EXPECT().GetSpend(ctx, "").Return(&budget.Spend{GlobalDaily: 1.5}, nil)
+
+		stats, err := NewAIService(NewMockLLM(t), nil, nil, limiter).WithBudgetTracker(tracker).GetStats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &Stats{ActiveUsers: 3, QuestionsAsked: 7, Spend: &budget.Spend{GlobalDaily: 1.5}}, stats)
+	})
+
+	t.Run("usage lookup fails", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().DailyUsage(ctx).Return(0, 0, assert.AnError)
+
+		_, err := NewAIService(NewMockLLM(t), nil, nil, limiter).GetStats(ctx)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestAIService_GetUserInfo(t *testing.T) {
+	ctx := context.Background()
+	profiles := &pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}}
+
+	tests := []struct {
+		setupMocks func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository)
+		want       *UserInfo
+		name       string
+		wantErr    bool
+	}{
+		{
+			name: "user with pets and conversation",
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				conv := conversation.NewConversation("42")
+				conv.AddMessage("user", "Hello")
+				conv.AddMessage("assistant", "Hi")
+
+				profileRepo.EXPECT().GetProfiles(ctx, "42").Return(profiles, nil)
+				repo.EXPECT().FindByID(ctx, "42").Return(conv, nil)
+			},
+			want: &UserInfo{Profiles: profiles, State: conversation.StateNormal, Turns: 2},
+		},
+		{
+			name: "unknown user",
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetProfiles(ctx, "42").Return(nil, ErrProfileNotFound)
+				repo.EXPECT().FindByID(ctx, "42").Return(nil, ErrConversationNotFound)
+			},
+			want: &UserInfo{},
+		},
+		{
+			name: "profile lookup fails",
+			setupMocks: func(_ *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetProfiles(ctx, "42").Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "conversation lookup fails",
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetProfiles(ctx, "42").Return(profiles, nil)
+				repo.EXPECT().FindByID(ctx, "42").Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockConversationRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+			tt.setupMocks(repo, profileRepo)
+
+			info, err := NewAIService(NewMockLLM(t), repo, profileRepo, nil).GetUserInfo(ctx, "42")
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, assert.AnError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, info)
+		})
+	}
+}
+
+func TestAIService_ResetQuota(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not supported by rate limiter", func(t *testing.T) {
+		err := NewAIService(NewMockLLM(t), nil, nil, nil).ResetQuota(ctx, "42")
+		assert.ErrorIs(t, err, ErrQuotasDisabled)
+	})
+
+	t.Run("resets rate limits and spend", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().ResetQuota(ctx, "42").Return(nil)
+
+		tracker := NewMockBudgetTracker(t)
+		tracker.EXPECT().ResetUserSpend(ctx, "42").Return(nil)
+
+		assert.NoError(t, NewAIService(NewMockLLM(t), nil, nil, limiter).WithBudgetTracker(tracker).ResetQuota(ctx, "42"))
+	})
+
+	t.Run("reset fails", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().ResetQuota(ctx, "42").Return(assert.AnError)
+
+		assert.ErrorIs(t, NewAIService(NewMockLLM(t), nil, nil, limiter).ResetQuota(ctx, "42"), assert.AnError)
+	})
+
+	t.Run("spend reset fails", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().ResetQuota(ctx, "42").Return(nil)
+
+		tracker := NewMockBudgetTracker(t)
+		tracker.EXPECT().ResetUserSpend(ctx, "42").Return(assert.AnError)
+
+		assert.ErrorIs(t, NewAIService(NewMockLLM(t), nil, nil, limiter).WithBudgetTracker(tracker).ResetQuota(ctx, "42"), assert.AnError)
+	})
+}
+
+func TestAIService_SetWhitelisted(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not supported by rate limiter", func(t *testing.T) {
+		err := NewAIService(NewMockLLM(t), nil, nil, nil).SetWhitelisted(ctx, "42", true)
+		assert.ErrorIs(t, err, ErrQuotasDisabled)
+	})
+
+	t.Run("updates whitelist", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().SetWhitelisted(ctx, "42", false).Return(nil)
+
+		assert.NoError(t, NewAIService(NewMockLLM(t), nil, nil, limiter).SetWhitelisted(ctx, "42", false))
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		limiter, quotas := newQuotaRateLimiter(t)
+		quotas.EXPECT().SetWhitelisted(ctx, "42", true).Return(assert.AnError)
+
+		assert.ErrorIs(t, NewAIService(NewMockLLM(t), nil, nil, limiter).SetWhitelisted(ctx, "42", true), assert.AnError)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
@@ -14,10 +15,16 @@ var (
 	// ErrConversationNotFound is returned when a conversation is not found.
 	ErrConversationNotFound = fmt.Errorf("conversation not found")
 
+	// ErrConversationConflict is returned when a conversation is saved after another request changed it.
+	ErrConversationConflict = errors.New("conversation was changed by another request")
+
 	// ErrRateLimit is returned when the API rate limit is exceeded
 	ErrRateLimit = errors.New("rate limit exceeded")
 
-	// ErrGlobalLimit is returned when the global daily request limit is exceeded
+	// ErrUserBudget is returned when the daily or monthly spend ceiling of the user is reached
+	ErrUserBudget = errors.New("spend limit of the user exceeded")
+
+	// ErrGlobalLimit is returned when the global daily request limit or a global spend ceiling is exceeded
 	ErrGlobalLimit = errors.New("global request limit exceeded for today, please try again tomorrow")
 
 	ErrProfileNotFound = fmt.Errorf("pet profile not found")
@@ -27,18 +34,27 @@ type Conversation interface {
 	GetID() string
 	GetState() conversation.ConversationState
 	AddMessage(role, content string)
-	History(skip int) string
+	Turns(skip int) []message.Turn
+	PendingSummary() (string, []conversation.Message)
+	SetSummary(summary string, folded int)
 	StartFollowUpQuestions(initialPrompt string, questions []message.Question) error
 	StartProfileQuestions(ctx context.Context) error
+	StartNewPetQuestions(ctx context.Context) error
+	StartVaccinationQuestions(ctx context.Context, petName string) error
+	VaccinationPetName() string
 	GetCurrentQuestion() (*message.Question, error)
 	AddQuestionAnswer(answer string) (bool, error)
 	GetQuestionnaireResult() ([]conversation.QuestionAnswer, error)
 	CancelQuestionnaire()
+	GetVersion() int64
+	SetVersion(version int64)
 }
 
 // ConversationRepository defines the interface for conversation storage operations.
 type ConversationRepository interface {
-	// Save stores a conversation in the repository.
+	// Save stores a conversation in the repository and increments its version.
+	// It returns ErrConversationConflict if the stored version differs from the version of the conversation,
+	// a conversation that is not stored has version 0.
 	Save(ctx context.Context, conversation Conversation) error
 
 	// FindByID retrieves a conversation by its id.
@@ -53,30 +69,49 @@ type ConversationRepository interface {
 
 // RateLimiter defines the interface for rate limiting functionality
 type RateLimiter interface {
-	// IsNewQuestionAllowed checks if a user is allowed to ask a new question
-	IsNewQuestionAllowed(ctx context.Context, userID string) (bool, error)
-	// RecordNewQuestion records that a user has asked a new question
-	RecordNewQuestion(ctx context.Context, userID string) error
+	// AllowNewQuestion checks if a user is allowed to ask a new question and records the question if so,
+	// the check and the record are atomic, so concurrent questions can't exceed the limits
+	AllowNewQuestion(ctx context.Context, userID string) (bool, error)
 }
 
 // PetProfileRepository defines the interface for pet profile storage operations
 type PetProfileRepository interface {
+	// SaveProfile replaces the user's active pet profile, or adds it if the user has no pets yet.
 	SaveProfile(ctx context.Context, userID string, profile *pet.Profile) error
+	// AddProfile adds a new pet profile for the user and makes it the active one.
+	AddProfile(ctx context.Context, userID string, profile *pet.Profile) error
+	// GetProfiles returns all pet profiles of the user, or ErrProfileNotFound if there are none.
+	GetProfiles(ctx context.Context, userID string) (*pet.Profiles, error)
+	// GetCurrentProfile returns the user's active pet profile, or ErrProfileNotFound if there are none.
 	GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error)
+	// SetActiveProfile makes the pet with the given name active, or returns ErrProfileNotFound.
+	SetActiveProfile(ctx context.Context, userID, name string) error
+	// UpdateProfile applies fn to the profile of the pet with the given name, or returns ErrProfileNotFound.
+	UpdateProfile(ctx context.Context, userID, name string, fn func(profile *pet.Profile)) error
+	// RemoveProfile removes the pet with the given name, or returns ErrProfileNotFound.
+	RemoveProfile(ctx context.Context, userID, name string) error
+	// RemoveUserProfiles removes all pet profiles of the user.
 	RemoveUserProfiles(ctx context.Context, userID string) error
 }
 
-// LLM interface represents the language model capabilities
+// LLM interface represents the language model capabilities.
+// The last of the turns is the current request; the preceding ones are the conversation history.
 type LLM interface {
-	Analyze(ctx context.Context, prompt string, imgs []*message.Image) (*message.LLMResult, error)
-	Report(ctx context.Context, request string) (*message.LLMResult, error)
+	Analyze(ctx context.Context, turns []message.Turn) (*message.LLMResult, error)
+	Report(ctx context.Context, turns []message.Turn) (*message.LLMResult, error)
 }
 
 type AIService struct {
-	llm         LLM
-	repo        ConversationRepository
-	profileRepo PetProfileRepository
-	rateLimiter RateLimiter
+	llm           LLM
+	repo          ConversationRepository
+	profileRepo   PetProfileRepository
+	rateLimiter   RateLimiter
+	reminderRepo  ReminderRepository
+	budget        BudgetTracker
+	broadcastRepo BroadcastRepository
+	feedbackRepo  FeedbackRepository
+	// summaries tracks summarizations running in the background
+	summaries sync.WaitGroup
 }
 
 func NewAIService(llm LLM, repo ConversationRepository, profileRepo PetProfileRepository, rateLimiter RateLimiter) *AIService {
@@ -42,9 +42,8 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Message: "Cats need a balanced diet...\n\nHow old is your cat?",
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
@@ -52,9 +51,9 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					Save(context.Background(), conv).
 					Return(nil)
-				expectedPrompt := "\nCurrent question: What food is good for cats?"
+				expectedTurns := []message.Turn{message.NewUserTurn("Current question: What food is good for cats?", nil)}
 				mockLLM.EXPECT().
-					Analyze(context.Background(), expectedPrompt, []*message.Image(nil)).
+					Analyze(context.Background(), expectedTurns).
 					Return(&message.LLMResult{
 						Text: "Cats need a balanced diet...",
 						Questions: []message.Question{
@@ -88,9 +87,8 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Answers: []string{},
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
@@ -98,9 +96,9 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					Save(context.Background(), conv).
 					Return(nil)
-				expectedPrompt := "\nCurrent question: What food is good for cats?"
+				expectedTurns := []message.Turn{message.NewUserTurn("Current question: What food is good for cats?", nil)}
 				mockLLM.EXPECT().
-					Analyze(context.Background(), expectedPrompt, []*message.Image(nil)).
+					Analyze(context.Background(), expectedTurns).
 					Return(&message.LLMResult{
 						Text:      "Cats need a balanced diet...",
 						Questions: []message.Question{},
@@ -128,9 +126,8 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Answers: []string{},
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
@@ -138,9 +135,9 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					Save(context.Background(), conv).
 					Return(nil)
-				expectedPrompt := "\nCurrent question: "
+				expectedTurns := []message.Turn{message.NewUserTurn("Current question: ", nil)}
 				mockLLM.EXPECT().
-					Analyze(context.Background(), expectedPrompt, []*message.Image(nil)).
+					Analyze(context.Background(), expectedTurns).
 					Return(&message.LLMResult{
 						Text:      "I understand you have a pet-related question...",
 						Questions: []message.Question{},
@@ -160,9 +157,8 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Text:   "What food is good for cats?",
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
@@ -170,9 +166,9 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					Save(context.Background(), conv).
 					Return(nil)
-				expectedPrompt := "\nCurrent question: What food is good for cats?"
+				expectedTurns := []message.Turn{message.NewUserTurn("Current question: What food is good for cats?", nil)}
 				mockLLM.EXPECT().
-					Analyze(context.Background(), expectedPrompt, []*message.Image(nil)).
+					Analyze(context.Background(), expectedTurns).
 					Return(nil, fmt.Errorf("llm error"))
 			},
 			wantErr:       true,
@@ -204,7 +200,7 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(false, nil)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(false, nil)
 			},
 			wantErr:       true,
 			errorContains: "rate limit exceeded for user",
@@ -220,7 +216,7 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(false, fmt.Errorf("rate limit check failed"))
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(false, fmt.Errorf("rate limit check failed"))
 			},
 			wantErr:       true,
 			errorContains: "failed to check rate limit",
@@ -233,8 +229,7 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Text:   "What food is good for cats?",
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
 					Return(conv, nil)
@@ -262,9 +257,8 @@ func TestAIService_ProcessMessage(t *testing.T) {
 				Answers: []string{},
 			},
 			setupMocks: func(t *testing.T, mockLLM *MockLLM, mockRepo *MockConversationRepository, mockProfileRepo *MockPetProfileRepository, mockRateLimiter *MockRateLimiter, conv *conversation.Conversation) {
-				mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-				mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(nil)
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockRateLimiter.On("AllowNewQuestion", context.Background(), "user123").Return(true, nil)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 				// Add previous conversation
 				conv.AddMessage("user", "What food is good for cats?")
 				conv.AddMessage("assistant", "Cats need a balanced diet...")
@@ -278,9 +272,13 @@ func TestAIService_ProcessMessage(t *testing.T) {
 					Save(context.Background(), conv).
 					Return(nil)
 
-				expectedPrompt := "Previous conversation:\nuser: What food is good for cats?\nassistant: Cats need a balanced diet...\n\nCurrent question: What about dogs?"
+				expectedTurns := []message.Turn{
+					message.NewUserTurn("What food is good for cats?", nil),
+					message.NewAssistantTurn("Cats need a balanced diet..."),
+					message.NewUserTurn("Current question: What about dogs?", nil),
+				}
 				mockLLM.EXPECT().
-					Analyze(context.Background(), expectedPrompt, []*message.Image(nil)).
+					Analyze(context.Background(), expectedTurns).
 					Return(&message.LLMResult{
 						Text:      "Dogs need different food...",
 						Questions: []message.Question{},
@@ -397,7 +395,7 @@ func TestAIService_ProcessMessage_Questionnaire(t *testing.T) {
 				_, err = conv.AddQuestionAnswer("2 years old")
 				require.NoError(t, err)
 
-				mockProfileRepo.EXPECT().GetCurrentProfile(context.Background(), "user123").Return(nil, ErrProfileNotFound)
+				mockProfileRepo.EXPECT().GetProfiles(context.Background(), "user123").Return(nil, ErrProfileNotFound)
 
 				mockRepo.EXPECT().
 					FindOrCreate(context.Background(), "test-chat").
@@ -408,9 +406,11 @@ func TestAIService_ProcessMessage_Questionnaire(t *testing.T) {
 					Save(context.Background(), conv).
 					Return(nil)
 
-				expectedPrompt := "\nFollow-up information:\nQuestion: How old is your cat?\nAnswer: 2 years old\nQuestion: Is your cat indoor or outdoor?\nAnswer: Indoor\n"
+				expectedTurns := []message.Turn{
+					message.NewUserTurn("Follow-up information:\nQuestion: How old is your cat?\nAnswer: 2 years old\nQuestion: Is your cat indoor or outdoor?\nAnswer: Indoor\n", nil),
+				}
 				mockLLM.EXPECT().
-					Report(context.Background(), expectedPrompt).
+					Report(context.Background(), expectedTurns).
 					Return(&message.LLMResult{
 						Text: "Based on your answers, here's my advice...",
 					}, nil)
@@ -477,33 +477,6 @@ func TestAIService_ProcessMessage_Questionnaire(t *testing.T) {
 	}
 }
 
-func TestAIService_ProcessMessage_RateLimiterRecordError(t *testing.T) {
-	mockLLM := NewMockLLM(t)
-	mockRepo := NewMockConversationRepository(t)
-	mockProfileRepo := NewMockPetProfileRepository(t)
-	mockRateLimiter := NewMockRateLimiter(t)
-	conv := conversation.NewConversation("test-chat")
-
-	mockRepo.EXPECT().
-		FindOrCreate(context.Background(), "test-chat").
-		Return(conv, nil)
-
-	mockRateLimiter.On("IsNewQuestionAllowed", context.Background(), "user123").Return(true, nil)
-	mockRateLimiter.On("RecordNewQuestion", context.Background(), "user123").Return(fmt.Errorf("record error"))
-
-	svc := NewAIService(mockLLM, mockRepo, mockProfileRepo, mockRateLimiter)
-
-	request := &message.UserMessage{
-		UserID: "user123",
-		ChatID: "test-chat",
-		Text:   "test question",
-	}
-
-	_, err := svc.ProcessMessage(context.Background(), request)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to record rate limit")
-}
-
 func TestAIService_ProcessMessage_ContextCancellation(t *testing.T) {
 	mockLLM := NewMockLLM(t)
 	mockRepo := NewMockConversationRepository(t)
@@ -514,9 +487,8 @@ func TestAIService_ProcessMessage_ContextCancellation(t *testing.T) {
 	cancel()
 
 	mockRateLimiter := NewMockRateLimiter(t)
-	mockRateLimiter.On("IsNewQuestionAllowed", ctx, "user123").Return(true, nil)
-	mockRateLimiter.On("RecordNewQuestion", ctx, "user123").Return(nil)
-	mockProfileRepo.EXPECT().GetCurrentProfile(ctx, "user123").Return(nil, ErrProfileNotFound)
+	mockRateLimiter.On("AllowNewQuestion", ctx, "user123").Return(true, nil)
+	mockProfileRepo.EXPECT().GetProfiles(ctx, "user123").Return(nil, ErrProfileNotFound)
 
 	conv := conversation.NewConversation("test-chat")
 	mockRepo.EXPECT().
@@ -528,7 +500,7 @@ func TestAIService_ProcessMessage_ContextCancellation(t *testing.T) {
 		Return(nil)
 
 	mockLLM.EXPECT().
-		Analyze(ctx, "\nCurrent question: test question", []*message.Image(nil)).
+		Analyze(ctx, []message.Turn{message.NewUserTurn("Current question: test question", nil)}).
 		Return(nil, context.Canceled)
 
 	svc := NewAIService(mockLLM, mockRepo, mockProfileRepo, mockRateLimiter)
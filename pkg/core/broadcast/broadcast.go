@@ -0,0 +1,152 @@
+// Package broadcast defines announcements sent to all known chats of the bot, with optional per-language variants.
+package broadcast
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrEmptyText is returned when the broadcast message or one of its variants has no text.
+	ErrEmptyText = errors.New("broadcast text is empty")
+	// ErrTextTooLong is returned when the broadcast message or one of its variants doesn't fit into a Telegram message.
+	ErrTextTooLong = errors.New("broadcast text is too long")
+)
+
+// maxTextLength is the maximum length of a Telegram message
+const maxTextLength = 4096
+
+// variantMarker matches a line starting a language variant of the message, e.g. "[es]" or "[pt-br]"
+var variantMarker = regexp.MustCompile(`^\[([a-zA-Z]{2,3}(?:[-_][a-zA-Z0-9]{2,8})?)\]$`)
+
+// Chat is a recipient of broadcasts, Language is the language code of the user who wrote to the bot last.
+type Chat struct {
+	ID       string `json:"id"`
+	Language string `json:"language,omitempty"`
+}
+
+// Broadcast is an announcement delivered to all chats known at the time it was created.
+// Text is sent to chats without a matching language variant; Variants maps lowercase language codes to their texts.
+// Total is the number of queued recipients, Sent and Failed track delivery progress.
+type Broadcast struct {
+	CreatedAt   time.Time         `json:"created_at"`
+	CompletedAt time.Time         `json:"completed_at,omitempty"`
+	Variants    map[string]string `json:"variants,omitempty"`
+	ID          string            `json:"id"`
+	Text        string            `json:"text"`
+	Total       int               `json:"total"`
+	Sent        int               `json:"sent"`
+	Failed      int               `json:"failed"`
+}
+
+// New creates a broadcast from the message definition.
+// The definition starts with the default text, a line with a language code in brackets, e.g. "[es]",
+// starts the variant of the message sent to chats in that language.
+// Returns ErrEmptyText or ErrTextTooLong if the default text or one of the variants is invalid.
+func New(definition string, now time.Time) (*Broadcast, error) {
+	b := &Broadcast{
+		ID:        uuid.New().String(),
+		CreatedAt: now,
+	}
+
+	var (
+		language string
+		lines    []string
+	)
+
+	flush := func() error {
+		text := strings.TrimSpace(strings.Join(lines, "\n"))
+
+		switch {
+		case text == "":
+			return ErrEmptyText
+		case utf8.RuneCountInString(text) > maxTextLength:
+			return ErrTextTooLong
+		case language == "":
+			b.Text = text
+		default:
+			if b.Variants == nil {
+				b.Variants = make(map[string]string)
+			}
+
+			b.Variants[language] = text
+		}
+
+		return nil
+	}
+
+	for _, line := range strings.Split(definition, "\n") {
+		match := variantMarker.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			lines = append(lines, line)
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+
+		language, lines = normalizeLanguage(match[1]), nil
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Variant returns the language of the variant sent to chats in the language,
+// falling back from a regional code like "pt-br" to its base language "pt".
+// Returns an empty string if the default text is sent.
+func (b *Broadcast) Variant(language string) string {
+	language = normalizeLanguage(language)
+
+	if _, ok := b.Variants[language]; ok {
+		return language
+	}
+
+	if base, _, ok := strings.Cut(language, "-"); ok {
+		if _, ok := b.Variants[base]; ok {
+			return base
+		}
+	}
+
+	return ""
+}
+
+// TextFor returns the text sent to chats in the language.
+func (b *Broadcast) TextFor(language string) string {
+	if variant := b.Variant(language); variant != "" {
+		return b.Variants[variant]
+	}
+
+	return b.Text
+}
+
+// Recipients returns the number of chats receiving each variant of the broadcast, given the number of chats per language.
+// The default text is reported under an empty language.
+func (b *Broadcast) Recipients(chats map[string]int) map[string]int {
+	recipients := make(map[string]int, len(b.Variants)+1)
+
+	for language, count := range chats {
+		recipients[b.Variant(language)] += count
+	}
+
+	return recipients
+}
+
+// Completed reports whether delivery of the broadcast is finished.
+func (b *Broadcast) Completed() bool {
+	return !b.CompletedAt.IsZero()
+}
+
+// normalizeLanguage converts the language code to the lowercase form used as variant key, e.g. "pt_BR" to "pt-br".
+func normalizeLanguage(language string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(language)), "_", "-")
+}
@@ -0,0 +1,97 @@
+package broadcast
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		wantVariants map[string]string
+		wantErr      error
+		name         string
+		definition   string
+		wantText     string
+	}{
+		{
+			name:       "default text only",
+			definition: "  We are back online!\n",
+			wantText:   "We are back online!",
+		},
+		{
+			name:       "language variants",
+			definition: "We are back online!\n\nThanks for waiting.\n[es]\n¡Volvemos a estar en línea!\n[pt_BR]\nEstamos de volta!",
+			wantText:   "We are back online!\n\nThanks for waiting.",
+			wantVariants: map[string]string{
+				"es":    "¡Volvemos a estar en línea!",
+				"pt-br": "Estamos de volta!",
+			},
+		},
+		{
+			name:       "brackets inside a line are text",
+			definition: "Use [es] to switch the language",
+			wantText:   "Use [es] to switch the language",
+		},
+		{
+			name:       "empty default text",
+			definition: "[es]\n¡Hola!",
+			wantErr:    ErrEmptyText,
+		},
+		{
+			name:       "empty variant",
+			definition: "Hello!\n[es]\n",
+			wantErr:    ErrEmptyText,
+		},
+		{
+			name:       "too long",
+			definition: strings.Repeat("a", maxTextLength+1),
+			wantErr:    ErrTextTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.definition, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, b.ID)
+			assert.Equal(t, now, b.CreatedAt)
+			assert.Equal(t, tt.wantText, b.Text)
+			assert.Equal(t, tt.wantVariants, b.Variants)
+		})
+	}
+}
+
+func TestBroadcast_TextFor(t *testing.T) {
+	b := &Broadcast{
+		Text:     "Hello!",
+		Variants: map[string]string{"es": "¡Hola!", "pt-br": "Olá!"},
+	}
+
+	assert.Equal(t, "¡Hola!", b.TextFor("es"))
+	assert.Equal(t, "¡Hola!", b.TextFor("es-MX"))
+	assert.Equal(t, "Olá!", b.TextFor("pt-BR"))
+	assert.Equal(t, "Hello!", b.TextFor("pt"))
+	assert.Equal(t, "Hello!", b.TextFor(""))
+}
+
+func TestBroadcast_Recipients(t *testing.T) {
+	b := &Broadcast{
+		Text:     "Hello!",
+		Variants: map[string]string{"es": "¡Hola!", "ru": "Привет!"},
+	}
+
+	got := b.Recipients(map[string]int{"en": 10, "": 2, "es": 3, "es-ar": 1})
+
+	assert.Equal(t, map[string]int{"": 12, "es": 4}, got)
+}
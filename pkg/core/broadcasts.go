@@ -0,0 +1,317 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+)
+
+const (
+	// broadcastPollInterval defines how often the worker checks for pending broadcasts
+	broadcastPollInterval = 10 * time.Second
+	// broadcastBatchSize limits the number of recipients taken from the queue at once,
+	// it bounds the number of duplicated messages if the process crashes in the middle of a batch
+	broadcastBatchSize = 25
+	// broadcastRate is the number of broadcast messages sent per second, it stays below Telegram's limit
+	// of about 30 messages per second to leave room for replies to users
+	broadcastRate = 20
+	// broadcastMaxAttempts limits the number of attempts to deliver a message to a chat hitting flood limits
+	broadcastMaxAttempts = 3
+	// broadcastLeaseTTL is the time a worker owns a broadcast without renewing the lease, it is renewed before
+	// every batch and must exceed the time of sending a batch including the waits for flood limits
+	broadcastLeaseTTL = 2 * time.Minute
+)
+
+var (
+	// ErrBroadcastsDisabled is returned when the service is created without a broadcast repository.
+	ErrBroadcastsDisabled = errors.New("broadcasts are not configured")
+	// ErrBroadcastNotFound is returned when there is no pending broadcast.
+	ErrBroadcastNotFound = errors.New("broadcast not found")
+	// ErrBroadcastLeased is returned when the broadcast is delivered by another worker.
+	ErrBroadcastLeased = errors.New("broadcast is leased by another worker")
+	// ErrChatUnavailable is returned by BroadcastSender when the bot can't write to the chat anymore,
+	// e.g. the user blocked the bot or the chat was deleted.
+	ErrChatUnavailable = errors.New("chat is unavailable")
+)
+
+// FloodError is returned by BroadcastSender when the chat or the bot hit the flood limits of Telegram.
+// RetryAfter is the time to wait before the message can be sent again.
+type FloodError struct {
+	RetryAfter time.Duration
+}
+
+func (e *FloodError) Error() string {
+	return fmt.Sprintf("flood limit reached, retry after %s", e.RetryAfter)
+}
+
+// BroadcastRepository defines the interface for storage of broadcast recipients and delivery queues
+type BroadcastRepository interface {
+	// SaveChat adds the chat to the recipients of future broadcasts or updates its language.
+	SaveChat(ctx context.Context, chat broadcast.Chat) error
+	// RemoveChat removes the chat from the recipients of future broadcasts.
+	RemoveChat(ctx context.Context, chatID string) error
+	// CountChats returns the number of known chats per language.
+	CountChats(ctx context.Context) (map[string]int, error)
+	// CreateBroadcast stores the broadcast and queues all known chats as its recipients, setting its Total.
+	CreateBroadcast(ctx context.Context, b *broadcast.Broadcast) error
+	// GetPendingBroadcast returns the oldest broadcast which is not completed, or ErrBroadcastNotFound.
+	GetPendingBroadcast(ctx context.Context) (*broadcast.Broadcast, error)
+	// LeaseBroadcast acquires or renews the lease of the broadcast for owner for the duration of ttl
+	// and returns the current state of the broadcast. Only the owner of the lease delivers the broadcast.
+	// Returns ErrBroadcastLeased if another owner holds the lease, or ErrBroadcastNotFound.
+	LeaseBroadcast(ctx context.Context, id, owner string, ttl time.Duration) (*broadcast.Broadcast, error)
+	// GetRecipients returns up to limit recipients from the head of the queue of the broadcast.
+	GetRecipients(ctx context.Context, id string, limit int) ([]broadcast.Chat, error)
+	// UpdateBroadcast removes the first processed recipients from the queue of the broadcast and stores its progress
+	// if owner still holds the lease, otherwise it returns ErrBroadcastLeased.
+	// Completed broadcasts are no longer pending and their lease is released.
+	UpdateBroadcast(ctx context.Context, b *broadcast.Broadcast, owner string, processed int) error
+}
+
+// BroadcastSender delivers broadcast messages to chats
+type BroadcastSender interface {
+	SendBroadcast(ctx context.Context, chat broadcast.Chat, text string) error
+}
+
+// WithBroadcastRepository enables broadcasts for the service using the provided repository.
+// Returns the service to allow chaining with the constructor.
+func (s *AIService) WithBroadcastRepository(repo BroadcastRepository) *AIService {
+	s.broadcastRepo = repo
+	return s
+}
+
+// TrackChat remembers the chat as a recipient of broadcasts, language selects the variant of broadcasts sent to it.
+// It does nothing if broadcasts are not configured.
+// Returns an error if storing the chat fails.
+func (s *AIService) TrackChat(ctx context.Context, chatID, language string) error {
+	if s.broadcastRepo == nil {
+		return nil
+	}
+
+	if err := s.broadcastRepo.SaveChat(ctx, broadcast.Chat{ID: chatID, Language: language}); err != nil {
+		return fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveChat stops sending broadcasts to the chat, e.g. after the user blocked the bot.
+// It does nothing if broadcasts are not configured.
+// Returns an error if removing the chat fails.
+func (s *AIService) RemoveChat(ctx context.Context, chatID string) error {
+	if s.broadcastRepo == nil {
+		return nil
+	}
+
+	if err := s.broadcastRepo.RemoveChat(ctx, chatID); err != nil {
+		return fmt.Errorf("failed to remove chat: %w", err)
+	}
+
+	return nil
+}
+
+// PreviewBroadcast returns the number of chats receiving each variant of the broadcast without sending it,
+// the default text is reported under an empty language.
+// Returns ErrBroadcastsDisabled if broadcasts are not configured, or an error if counting the chats fails.
+func (s *AIService) PreviewBroadcast(ctx context.Context, b *broadcast.Broadcast) (map[string]int, error) {
+	if s.broadcastRepo == nil {
+		return nil, ErrBroadcastsDisabled
+	}
+
+	chats, err := s.broadcastRepo.CountChats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count chats: %w", err)
+	}
+
+	return b.Recipients(chats), nil
+}
+
+// StartBroadcast queues the broadcast for delivery to all known chats, it is sent by RunBroadcasts.
+// Returns ErrBroadcastsDisabled if broadcasts are not configured, or an error if queueing fails.
+func (s *AIService) StartBroadcast(ctx context.Context, b *broadcast.Broadcast) error {
+	if s.broadcastRepo == nil {
+		return ErrBroadcastsDisabled
+	}
+
+	if err := s.broadcastRepo.CreateBroadcast(ctx, b); err != nil {
+		return fmt.Errorf("failed to create broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// RunBroadcasts periodically delivers pending broadcasts through the sender until ctx is cancelled.
+// Messages are paced to stay within Telegram's global flood limit, and delivery progress is stored
+// after every batch, so an interrupted broadcast continues after a restart.
+// Running instances share the work through a lease: a broadcast is delivered by one instance at a time,
+// and another instance takes it over once the lease of a stopped one expires.
+// Returns nil when ctx is cancelled or ErrBroadcastsDisabled if broadcasts are not configured.
+func (s *AIService) RunBroadcasts(ctx context.Context, sender BroadcastSender) error {
+	if s.broadcastRepo == nil {
+		return ErrBroadcastsDisabled
+	}
+
+	owner := uuid.New().String()
+
+	pace := time.NewTicker(time.Second / broadcastRate)
+	defer pace.Stop()
+
+	ticker := time.NewTicker(broadcastPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.deliverBroadcasts(ctx, sender, owner, pace.C)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverBroadcasts sends pending broadcasts one after another until there are none left or ctx is cancelled.
+// It stops when the oldest pending broadcast is delivered by another worker, the worker checks it again on the next poll.
+// Every message waits for a tick of pace. Failures are logged and don't stop delivery to the other chats.
+func (s *AIService) deliverBroadcasts(ctx context.Context, sender BroadcastSender, owner string, pace <-chan time.Time) {
+	for ctx.Err() == nil {
+		pending, err := s.broadcastRepo.GetPendingBroadcast(ctx)
+		if errors.Is(err, ErrBroadcastNotFound) {
+			return
+		} else if err != nil {
+			slog.ErrorContext(ctx, "Failed to get pending broadcast", slog.Any("error", err))
+			return
+		}
+
+		err = s.deliverBroadcast(ctx, sender, pending.ID, owner, pace)
+
+		switch {
+		case errors.Is(err, ErrBroadcastLeased):
+			slog.DebugContext(ctx, "Broadcast is delivered by another worker", slog.String("broadcast_id", pending.ID))
+			return
+		case err != nil:
+			slog.ErrorContext(ctx, "Failed to deliver broadcast", slog.String("broadcast_id", pending.ID), slog.Any("error", err))
+			return
+		}
+	}
+}
+
+// deliverBroadcast leases the broadcast for owner and sends it to the queued recipients batch by batch,
+// storing the progress after each batch and renewing the lease before the next one.
+// Returns ErrBroadcastLeased if another worker delivers the broadcast, or an error if the queue can't be read
+// or the progress can't be stored.
+func (s *AIService) deliverBroadcast(ctx context.Context, sender BroadcastSender, id, owner string, pace <-chan time.Time) error {
+	b, err := s.broadcastRepo.LeaseBroadcast(ctx, id, owner, broadcastLeaseTTL)
+	if err != nil {
+		return fmt.Errorf("failed to lease broadcast: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Delivering broadcast", slog.String("broadcast_id", b.ID), slog.Int("total", b.Total), slog.Int("sent", b.Sent))
+
+	for {
+		chats, err := s.broadcastRepo.GetRecipients(ctx, b.ID, broadcastBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get recipients: %w", err)
+		}
+
+		if len(chats) == 0 {
+			b.CompletedAt = time.Now()
+
+			if err := s.broadcastRepo.UpdateBroadcast(ctx, b, owner, 0); err != nil {
+				return fmt.Errorf("failed to complete broadcast: %w", err)
+			}
+
+			slog.InfoContext(ctx, "Broadcast completed", slog.String("broadcast_id", b.ID), slog.Int("sent", b.Sent), slog.Int("failed", b.Failed))
+
+			return nil
+		}
+
+		processed := 0
+
+		for _, chat := range chats {
+			err := s.sendBroadcast(ctx, sender, b, chat, pace)
+			if err != nil && ctx.Err() != nil {
+				// The delivery was interrupted by shutdown, the chat stays in the queue
+				break
+			}
+
+			processed++
+
+			if err == nil {
+				b.Sent++
+			} else {
+				b.Failed++
+				s.handleBroadcastFailure(ctx, b, chat, err)
+			}
+
+			if ctx.Err() != nil {
+				break
+			}
+		}
+
+		// Progress is stored even on shutdown, so the delivered part of the batch isn't sent again after a restart
+		if err := s.broadcastRepo.UpdateBroadcast(context.WithoutCancel(ctx), b, owner, processed); err != nil {
+			return fmt.Errorf("failed to update broadcast: %w", err)
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if b, err = s.broadcastRepo.LeaseBroadcast(ctx, id, owner, broadcastLeaseTTL); err != nil {
+			return fmt.Errorf("failed to renew broadcast lease: %w", err)
+		}
+	}
+}
+
+// handleBroadcastFailure logs the failed delivery and removes the chat from recipients if the bot can't write to it anymore.
+func (s *AIService) handleBroadcastFailure(ctx context.Context, b *broadcast.Broadcast, chat broadcast.Chat, err error) {
+	slog.WarnContext(ctx, "Failed to send broadcast", slog.String("broadcast_id", b.ID), slog.String("chat_id", chat.ID), slog.Any("error", err))
+
+	if !errors.Is(err, ErrChatUnavailable) {
+		return
+	}
+
+	if err := s.broadcastRepo.RemoveChat(ctx, chat.ID); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove unavailable chat", slog.String("chat_id", chat.ID), slog.Any("error", err))
+	}
+}
+
+// sendBroadcast sends the variant of the broadcast matching the language of the chat.
+// The message waits for a tick of pace before every attempt and is retried after the delay requested by Telegram
+// when it hits flood limits.
+// Returns the error of the last attempt or the context error if ctx is cancelled.
+func (s *AIService) sendBroadcast(ctx context.Context, sender BroadcastSender, b *broadcast.Broadcast, chat broadcast.Chat, pace <-chan time.Time) error {
+	text := b.TextFor(chat.Language)
+
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-pace:
+		}
+
+		err := sender.SendBroadcast(ctx, chat, text)
+
+		var flood *FloodError
+		if !errors.As(err, &flood) || attempt >= broadcastMaxAttempts {
+			return err
+		}
+
+		slog.WarnContext(ctx, "Broadcast hit flood limit", slog.String("chat_id", chat.ID), slog.Duration("retry_after", flood.RetryAfter))
+
+		timer := time.NewTimer(flood.RetryAfter)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
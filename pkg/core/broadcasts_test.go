@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// noPace returns a pace channel which never blocks
+func noPace() <-chan time.Time {
+	pace := make(chan time.Time)
+	close(pace)
+
+	return pace
+}
+
+func TestAIService_TrackChat(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, (&AIService{}).TrackChat(ctx, "123", "en"))
+	assert.NoError(t, (&AIService{}).RemoveChat(ctx, "123"))
+
+	repo := NewMockBroadcastRepository(t)
+	svc := (&AIService{}).WithBroadcastRepository(repo)
+
+	repo.EXPECT().SaveChat(ctx, broadcast.Chat{ID: "123", Language: "en"}).Return(nil)
+	repo.EXPECT().RemoveChat(ctx, "456").Return(assert.AnError)
+
+	assert.NoError(t, svc.TrackChat(ctx, "123", "en"))
+	assert.ErrorIs(t, svc.RemoveChat(ctx, "456"), assert.AnError)
+}
+
+func TestAIService_PreviewBroadcast(t *testing.T) {
+	ctx := context.Background()
+	b := &broadcast.Broadcast{Text: "Hello!", Variants: map[string]string{"es": "¡Hola!"}}
+
+	_, err := (&AIService{}).PreviewBroadcast(ctx, b)
+	assert.ErrorIs(t, err, ErrBroadcastsDisabled)
+
+	repo := NewMockBroadcastRepository(t)
+	repo.EXPECT().CountChats(ctx).Return(map[string]int{"en": 2, "es-mx": 1}, nil)
+
+	got, err := (&AIService{}).WithBroadcastRepository(repo).PreviewBroadcast(ctx, b)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"": 2, "es": 1}, got)
+}
+
+func TestAIService_StartBroadcast(t *testing.T) {
+	ctx := context.Background()
+	b := &broadcast.Broadcast{ID: "b1", Text: "Hello!"}
+
+	assert.ErrorIs(t, (&AIService{}).StartBroadcast(ctx, b), ErrBroadcastsDisabled)
+
+	repo := NewMockBroadcastRepository(t)
+	repo.EXPECT().CreateBroadcast(ctx, b).Return(assert.AnError)
+
+	assert.ErrorIs(t, (&AIService{}).WithBroadcastRepository(repo).StartBroadcast(ctx, b), assert.AnError)
+}
+
+func TestAIService_deliverBroadcasts(t *testing.T) {
+	ctx := context.Background()
+	b := &broadcast.Broadcast{ID: "b1", Text: "Hello!", Variants: map[string]string{"es": "¡Hola!"}, Total: 3}
+
+	repo := NewMockBroadcastRepository(t)
+	sender := NewMockBroadcastSender(t)
+	svc := (&AIService{}).WithBroadcastRepository(repo)
+
+	repo.EXPECT().GetPendingBroadcast(mock.Anything).Return(&broadcast.Broadcast{ID: "b1"}, nil).Once()
+	repo.EXPECT().LeaseBroadcast(mock.Anything, "b1", "w1", broadcastLeaseTTL).Return(b, nil).Twice()
+	repo.EXPECT().GetRecipients(mock.Anything, "b1", broadcastBatchSize).Return([]broadcast.Chat{
+		{ID: "1", Language: "en"},
+		{ID: "2", Language: "es"},
+		{ID: "3", Language: "es-AR"},
+	}, nil).Once()
+
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "1", Language: "en"}, "Hello!").Return(nil).Once()
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "2", Language: "es"}, "¡Hola!").Return(ErrChatUnavailable).Once()
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "3", Language: "es-AR"}, "¡Hola!").Return(&FloodError{RetryAfter: time.Millisecond}).Once()
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "3", Language: "es-AR"}, "¡Hola!").Return(nil).Once()
+
+	repo.EXPECT().RemoveChat(mock.Anything, "2").Return(nil).Once()
+	repo.EXPECT().UpdateBroadcast(mock.Anything, b, "w1", 3).Return(nil).Once()
+
+	repo.EXPECT().GetRecipients(mock.Anything, "b1", broadcastBatchSize).Return(nil, nil).Once()
+	repo.EXPECT().UpdateBroadcast(mock.Anything, b, "w1", 0).Return(nil).Once()
+
+	repo.EXPECT().GetPendingBroadcast(mock.Anything).Return(nil, ErrBroadcastNotFound).Once()
+
+	svc.deliverBroadcasts(ctx, sender, "w1", noPace())
+
+	assert.Equal(t, 2, b.Sent)
+	assert.Equal(t, 1, b.Failed)
+	assert.True(t, b.Completed())
+}
+
+func TestAIService_deliverBroadcasts_Leased(t *testing.T) {
+	repo := NewMockBroadcastRepository(t)
+	sender := NewMockBroadcastSender(t)
+	svc := (&AIService{}).WithBroadcastRepository(repo)
+
+	// The broadcast is delivered by another worker, so this one sends nothing until the next poll
+	repo.EXPECT().GetPendingBroadcast(mock.Anything).Return(&broadcast.Broadcast{ID: "b1"}, nil).Once()
+	repo.EXPECT().LeaseBroadcast(mock.Anything, "b1", "w1", broadcastLeaseTTL).Return(nil, ErrBroadcastLeased).Once()
+
+	svc.deliverBroadcasts(context.Background(), sender, "w1", noPace())
+}
+
+func TestAIService_deliverBroadcast_LeaseLost(t *testing.T) {
+	b := &broadcast.Broadcast{ID: "b1", Text: "Hello!", Total: 2}
+
+	repo := NewMockBroadcastRepository(t)
+	sender := NewMockBroadcastSender(t)
+	svc := (&AIService{}).WithBroadcastRepository(repo)
+
+	repo.EXPECT().LeaseBroadcast(mock.Anything, "b1", "w1", broadcastLeaseTTL).Return(b, nil).Once()
+	repo.EXPECT().GetRecipients(mock.Anything, "b1", broadcastBatchSize).Return([]broadcast.Chat{{ID: "1"}}, nil).Once()
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "1"}, "Hello!").Return(nil).Once()
+	repo.EXPECT().UpdateBroadcast(mock.Anything, b, "w1", 1).Return(ErrBroadcastLeased).Once()
+
+	assert.ErrorIs(t, svc.deliverBroadcast(context.Background(), sender, "b1", "w1", noPace()), ErrBroadcastLeased)
+}
+
+func TestAIService_deliverBroadcast_Shutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := &broadcast.Broadcast{ID: "b1", Text: "Hello!", Total: 2}
+
+	repo := NewMockBroadcastRepository(t)
+	sender := NewMockBroadcastSender(t)
+	svc := (&AIService{}).WithBroadcastRepository(repo)
+
+	repo.EXPECT().LeaseBroadcast(mock.Anything, "b1", "w1", broadcastLeaseTTL).Return(b, nil).Once()
+	repo.EXPECT().GetRecipients(mock.Anything, "b1", broadcastBatchSize).Return([]broadcast.Chat{{ID: "1"}, {ID: "2"}}, nil).Once()
+
+	sender.EXPECT().SendBroadcast(mock.Anything, broadcast.Chat{ID: "1"}, "Hello!").
+		RunAndReturn(func(context.Context, broadcast.Chat, string) error {
+			cancel()
+			return nil
+		}).Once()
+
+	// The progress of the interrupted batch is stored, so only the remaining recipient is sent after a restart
+	repo.EXPECT().UpdateBroadcast(mock.Anything, b, "w1", 1).Return(nil).Once()
+
+	require.NoError(t, svc.deliverBroadcast(ctx, sender, "b1", "w1", noPace()))
+	assert.Equal(t, 1, b.Sent)
+	assert.False(t, b.Completed())
+}
+
+func TestAIService_RunBroadcasts_Disabled(t *testing.T) {
+	svc := &AIService{}
+
+	assert.ErrorIs(t, svc.RunBroadcasts(context.Background(), NewMockBroadcastSender(t)), ErrBroadcastsDisabled)
+}
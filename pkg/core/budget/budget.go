@@ -0,0 +1,193 @@
+// Package budget prices LLM token usage and defines spend ceilings enforced for questions.
+package budget
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// tokensPerPriceUnit is the number of tokens prices are defined for
+	tokensPerPriceUnit = 1_000_000
+	// cacheWriteMultiplier is the default price of tokens written to the prompt cache relative to input tokens
+	cacheWriteMultiplier = 1.25
+	// cacheReadMultiplier is the default price of tokens read from the prompt cache relative to input tokens
+	cacheReadMultiplier = 0.1
+)
+
+// Config holds spend ceilings in USD and the price table used to convert token usage into spend.
+// Storage selects where spend is kept: "memory" (default) or "redis" to share it between instances.
+// A ceiling of zero disables the check.
+type Config struct {
+	Storage            string  `mapstructure:"storage"`
+	Prices             []Price `mapstructure:"prices"`
+	GlobalDailyLimit   float64 `mapstructure:"global_daily_limit"`
+	GlobalMonthlyLimit float64 `mapstructure:"global_monthly_limit"`
+	UserDailyLimit     float64 `mapstructure:"user_daily_limit"`
+	UserMonthlyLimit   float64 `mapstructure:"user_monthly_limit"`
+}
+
+// Price defines the cost of a model in USD per million tokens.
+// CacheWrite and CacheRead default to 1.25 and 0.1 of the Input price when they are not set.
+type Price struct {
+	Model      string  `mapstructure:"model"`
+	Input      float64 `mapstructure:"input"`
+	Output     float64 `mapstructure:"output"`
+	CacheWrite float64 `mapstructure:"cache_write"`
+	CacheRead  float64 `mapstructure:"cache_read"`
+}
+
+// Usage holds the number of tokens consumed by a single LLM call.
+type Usage struct {
+	Model            string
+	InputTokens      int64
+	OutputTokens     int64
+	CacheWriteTokens int64
+	CacheReadTokens  int64
+}
+
+// Spend holds the current spend in USD of a user and of all users, together with the configured ceilings.
+type Spend struct {
+	UserDaily          float64
+	UserMonthly        float64
+	GlobalDaily        float64
+	GlobalMonthly      float64
+	UserDailyLimit     float64
+	UserMonthlyLimit   float64
+	GlobalDailyLimit   float64
+	GlobalMonthlyLimit float64
+}
+
+// Cost converts the token usage into USD using the price table.
+// Usage of models missing in the table is logged and counted as free.
+func (c *Config) Cost(usage ...Usage) float64 {
+	var total float64
+
+	for _, u := range usage {
+		price, ok := c.price(u.Model)
+		if !ok {
+			slog.Warn("No price configured for model, its usage is not counted towards the budget", slog.String("model", u.Model))
+			continue
+		}
+
+		cacheWrite := price.CacheWrite
+		if cacheWrite == 0 {
+			cacheWrite = price.Input * cacheWriteMultiplier
+		}
+
+		cacheRead := price.CacheRead
+		if cacheRead == 0 {
+			cacheRead = price.Input * cacheReadMultiplier
+		}
+
+		total += (float64(u.InputTokens)*price.Input +
+			float64(u.OutputTokens)*price.Output +
+			float64(u.CacheWriteTokens)*cacheWrite +
+			float64(u.CacheReadTokens)*cacheRead) / tokensPerPriceUnit
+	}
+
+	return total
+}
+
+// price returns the price of the model, or false if the model is not in the price table.
+func (c *Config) price(model string) (Price, bool) {
+	for _, p := range c.Prices {
+		if p.Model == model {
+			return p, true
+		}
+	}
+
+	return Price{}, false
+}
+
+// NewSpend creates a Spend report with the ceilings of the configuration.
+func (c *Config) NewSpend() *Spend {
+	return &Spend{
+		UserDailyLimit:     c.UserDailyLimit,
+		UserMonthlyLimit:   c.UserMonthlyLimit,
+		GlobalDailyLimit:   c.GlobalDailyLimit,
+		GlobalMonthlyLimit: c.GlobalMonthlyLimit,
+	}
+}
+
+// GlobalExceeded reports whether the global spend reached one of the global ceilings.
+func (s *Spend) GlobalExceeded() bool {
+	return exceeded(s.GlobalDaily, s.GlobalDailyLimit) || exceeded(s.GlobalMonthly, s.GlobalMonthlyLimit)
+}
+
+// UserExceeded reports whether the spend of the user reached one of the user ceilings.
+func (s *Spend) UserExceeded() bool {
+	return exceeded(s.UserDaily, s.UserDailyLimit) || exceeded(s.UserMonthly, s.UserMonthlyLimit)
+}
+
+// exceeded reports whether the spend reached the limit, zero limits are disabled.
+func exceeded(spend, limit float64) bool {
+	return limit > 0 && spend >= limit
+}
+
+// Day returns the identifier of the UTC day the time belongs to, daily spend is accounted per such day.
+func Day(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// Month returns the identifier of the UTC month the time belongs to, monthly spend is accounted per such month.
+func Month(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// recorderKey is the context key holding the usage recorder of the request.
+type recorderKey struct{}
+
+// Recorder collects token usage of all LLM calls made while handling a request.
+type Recorder struct {
+	usage []Usage
+	mu    sync.Mutex
+}
+
+// WithRecorder returns a context collecting token usage reported with Record into the returned recorder.
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	rec := &Recorder{}
+	return context.WithValue(ctx, recorderKey{}, rec), rec
+}
+
+// Record adds the token usage of an LLM call to the recorder of the context.
+// It does nothing if the context has no recorder.
+func Record(ctx context.Context, usage Usage) {
+	rec, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	rec.usage = append(rec.usage, usage)
+}
+
+// Usage returns the token usage recorded so far.
+func (r *Recorder) Usage() []Usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Usage(nil), r.usage...)
+}
+
+// LastModel returns the model of the last LLM call recorded in the context.
+// Returns an empty string if the context has no recorder or nothing was recorded.
+func LastModel(ctx context.Context) string {
+	rec, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return ""
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if len(rec.usage) == 0 {
+		return ""
+	}
+
+	return rec.usage[len(rec.usage)-1].Model
+}
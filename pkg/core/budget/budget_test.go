@@ -0,0 +1,115 @@
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Cost(t *testing.T) {
+	cfg := &Config{
+		Prices: []Price{
+			{Model: "sonnet", Input: 3, Output: 15},
+			{Model: "haiku", Input: 1, Output: 5, CacheWrite: 2, CacheRead: 0.5},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		usage []Usage
+		want  float64
+	}{
+		{
+			name: "no usage",
+		},
+		{
+			name:  "input and output tokens",
+			usage: []Usage{{Model: "sonnet", InputTokens: 1_000_000, OutputTokens: 100_000}},
+			want:  4.5,
+		},
+		{
+			name:  "default cache prices",
+			usage: []Usage{{Model: "sonnet", CacheWriteTokens: 1_000_000, CacheReadTokens: 1_000_000}},
+			want:  3*1.25 + 3*0.1,
+		},
+		{
+			name:  "configured cache prices",
+			usage: []Usage{{Model: "haiku", CacheWriteTokens: 1_000_000, CacheReadTokens: 1_000_000}},
+			want:  2.5,
+		},
+		{
+			name: "several calls",
+			usage: []Usage{
+				{Model: "haiku", InputTokens: 2_000_000},
+				{Model: "sonnet", OutputTokens: 1_000_000},
+			},
+			want: 17,
+		},
+		{
+			name:  "unknown model is free",
+			usage: []Usage{{Model: "unknown", InputTokens: 1_000_000}, {Model: "haiku", OutputTokens: 1_000_000}},
+			want:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, cfg.Cost(tt.usage...), 1e-9)
+		})
+	}
+}
+
+func TestSpend_Exceeded(t *testing.T) {
+	cfg := &Config{GlobalDailyLimit: 10, UserMonthlyLimit: 2}
+
+	spend := cfg.NewSpend()
+	assert.False(t, spend.GlobalExceeded())
+	assert.False(t, spend.UserExceeded())
+
+	// Disabled ceilings are never reached
+	spend.GlobalMonthly = 1000
+	spend.UserDaily = 1000
+	assert.False(t, spend.GlobalExceeded())
+	assert.False(t, spend.UserExceeded())
+
+	spend.GlobalDaily = 10
+	spend.UserMonthly = 2.5
+	assert.True(t, spend.GlobalExceeded())
+	assert.True(t, spend.UserExceeded())
+}
+
+func TestPeriods(t *testing.T) {
+	ts := time.Date(2025, 3, 31, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	assert.Equal(t, "2025-04-01", Day(ts))
+	assert.Equal(t, "2025-04", Month(ts))
+}
+
+func TestRecorder(t *testing.T) {
+	// Usage is ignored without a recorder
+	Record(context.Background(), Usage{Model: "sonnet", InputTokens: 1})
+
+	ctx, rec := WithRecorder(context.Background())
+
+	Record(ctx, Usage{Model: "haiku", InputTokens: 10})
+	Record(ctx, Usage{Model: "sonnet", OutputTokens: 20})
+
+	assert.Equal(t, []Usage{
+		{Model: "haiku", InputTokens: 10},
+		{Model: "sonnet", OutputTokens: 20},
+	}, rec.Usage())
+}
+
+func TestLastModel(t *testing.T) {
+	assert.Empty(t, LastModel(context.Background()))
+
+	ctx, _ := WithRecorder(context.Background())
+	assert.Empty(t, LastModel(ctx))
+
+	Record(ctx, Usage{Model: "haiku", InputTokens: 10})
+	Record(ctx, Usage{Model: "sonnet", OutputTokens: 20})
+
+	assert.Equal(t, "sonnet", LastModel(ctx))
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// maxSaveAttempts limits the attempts to save a conversation or a reminder changed concurrently by other requests
+const maxSaveAttempts = 3
+
+// mergeFunc re-applies the changes of the current request to the latest version of the conversation.
+// It returns ErrConversationConflict if the changes no longer apply to it.
+type mergeFunc func(latest Conversation) error
+
+// saveConversation saves the conversation changed by the current request. If another request saved the conversation
+// since it was loaded, e.g. /cancel while the LLM was answering, the latest version is loaded and merge re-applies
+// the changes of the current request to it, so the changes of neither request are lost.
+// A nil merge reports the conflict, it is used for questionnaire answers, which are valid only for the question
+// they answer.
+// Returns the saved conversation, which is the latest version if the changes were merged,
+// ErrConversationConflict if they can't be merged in maxSaveAttempts, or an error if the repository fails.
+func (s *AIService) saveConversation(ctx context.Context, conv Conversation, merge mergeFunc) (Conversation, error) {
+	for attempt := 1; ; attempt++ {
+		err := s.repo.Save(ctx, conv)
+
+		switch {
+		case err == nil:
+			return conv, nil
+		case !errors.Is(err, ErrConversationConflict), merge == nil, attempt == maxSaveAttempts:
+			return nil, err
+		}
+
+		slog.DebugContext(ctx, "Conversation changed concurrently, merging changes",
+			slog.String("chat_id", conv.GetID()),
+			slog.Int("attempt", attempt),
+		)
+
+		latest, err := s.repo.FindOrCreate(ctx, conv.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get conversation: %w", err)
+		}
+
+		if err := merge(latest); err != nil {
+			return nil, err
+		}
+
+		conv = latest
+	}
+}
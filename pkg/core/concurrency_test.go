@@ -0,0 +1,334 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// versionedRepository is a ConversationRepository saving conversations with compare-and-set on their version,
+// as the storage drivers do. Conversations are stored serialized, so concurrent requests never share them.
+type versionedRepository struct {
+	conversations map[string][]byte
+	mu            sync.Mutex
+}
+
+func newVersionedRepository() *versionedRepository {
+	return &versionedRepository{conversations: make(map[string][]byte)}
+}
+
+func (r *versionedRepository) Save(_ context.Context, conv Conversation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var storedVersion int64
+
+	if data, ok := r.conversations[conv.GetID()]; ok {
+		stored, err := conversation.Unmarshal(data)
+		if err != nil {
+			return err
+		}
+
+		storedVersion = stored.GetVersion()
+	}
+
+	if storedVersion != conv.GetVersion() {
+		return ErrConversationConflict
+	}
+
+	conv.SetVersion(storedVersion + 1)
+
+	data, err := json.Marshal(conv)
+	if err != nil {
+		return err
+	}
+
+	r.conversations[conv.GetID()] = data
+
+	return nil
+}
+
+func (r *versionedRepository) FindByID(_ context.Context, id string) (Conversation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, ok := r.conversations[id]
+	if !ok {
+		return nil, ErrConversationNotFound
+	}
+
+	return conversation.Unmarshal(data)
+}
+
+func (r *versionedRepository) FindOrCreate(ctx context.Context, id string) (Conversation, error) {
+	conv, err := r.FindByID(ctx, id)
+	if err == ErrConversationNotFound {
+		return conversation.NewConversation(id), nil
+	}
+
+	return conv, err
+}
+
+func (r *versionedRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.conversations, id)
+
+	return nil
+}
+
+// messagesOf returns the contents of the conversation messages with the given role,
+// including messages evicted from the history and waiting to be summarized.
+func messagesOf(conv Conversation, role string) []string {
+	var contents []string
+
+	c := conv.(*conversation.Conversation)
+
+	for _, msg := range slices.Concat(c.Unsummarized, c.Messages) {
+		if msg.Role == role {
+			contents = append(contents, msg.Content)
+		}
+	}
+
+	return contents
+}
+
+func TestAIService_saveConversation(t *testing.T) {
+	conflict := fmt.Errorf("failed to save conversation: %w", ErrConversationConflict)
+
+	addReply := func(latest Conversation) error {
+		latest.AddMessage("assistant", "reply")
+		return nil
+	}
+
+	tests := []struct {
+		setupMocks   func(repo *MockConversationRepository, conv, latest *conversation.Conversation)
+		merge        mergeFunc
+		name         string
+		wantErr      error
+		wantMessages int
+		wantLatest   bool
+	}{
+		{
+			name: "saved",
+			setupMocks: func(repo *MockConversationRepository, conv, _ *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(nil).Once()
+			},
+			merge:        addReply,
+			wantMessages: 1,
+		},
+		{
+			name: "conflict without merge",
+			setupMocks: func(repo *MockConversationRepository, conv, _ *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(conflict).Once()
+			},
+			wantErr: ErrConversationConflict,
+		},
+		{
+			name: "conflict merged into latest version",
+			setupMocks: func(repo *MockConversationRepository, conv, latest *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(conflict).Once()
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil).Once()
+				repo.EXPECT().Save(mock.Anything, latest).Return(nil).Once()
+			},
+			merge:        addReply,
+			wantMessages: 2,
+			wantLatest:   true,
+		},
+		{
+			name: "conflicts exceed save attempts",
+			setupMocks: func(repo *MockConversationRepository, conv, latest *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(conflict).Once()
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil).Times(maxSaveAttempts - 1)
+				repo.EXPECT().Save(mock.Anything, latest).Return(conflict).Times(maxSaveAttempts - 1)
+			},
+			merge:   addReply,
+			wantErr: ErrConversationConflict,
+		},
+		{
+			name: "latest version can't be loaded",
+			setupMocks: func(repo *MockConversationRepository, conv, _ *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(conflict).Once()
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(nil, assert.AnError).Once()
+			},
+			merge:   addReply,
+			wantErr: assert.AnError,
+		},
+		{
+			name: "changes can't be merged",
+			setupMocks: func(repo *MockConversationRepository, conv, latest *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(conflict).Once()
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil).Once()
+			},
+			merge: func(Conversation) error {
+				return ErrConversationConflict
+			},
+			wantErr: ErrConversationConflict,
+		},
+		{
+			name: "repository fails",
+			setupMocks: func(repo *MockConversationRepository, conv, _ *conversation.Conversation) {
+				repo.EXPECT().Save(mock.Anything, conv).Return(assert.AnError).Once()
+			},
+			merge:   addReply,
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := conversation.NewConversation("chat1")
+			conv.AddMessage("assistant", "reply")
+
+			latest := conversation.NewConversation("chat1")
+			latest.AddMessage("user", "question")
+
+			repo := NewMockConversationRepository(t)
+			tt.setupMocks(repo, conv, latest)
+
+			svc := &AIService{repo: repo}
+
+			saved, err := svc.saveConversation(context.Background(), conv, tt.merge)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, saved)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.wantLatest {
+				assert.Same(t, latest, saved)
+			} else {
+				assert.Same(t, conv, saved)
+			}
+
+			assert.Len(t, saved.(*conversation.Conversation).Messages, tt.wantMessages)
+		})
+	}
+}
+
+func TestAIService_ConcurrentRequests(t *testing.T) {
+	const chatID = "chat1"
+
+	// blockAnalyze makes the LLM wait until release is closed, the returned channel receives a value for each call
+	blockAnalyze := func(llm *MockLLM, release <-chan struct{}, questions []message.Question) <-chan struct{} {
+		started := make(chan struct{}, 10)
+
+		llm.EXPECT().Analyze(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, turns []message.Turn) (*message.LLMResult, error) {
+			started <- struct{}{}
+			<-release
+
+			question := strings.TrimPrefix(turns[len(turns)-1].Content, "Current question: ")
+
+			return &message.LLMResult{Text: "Answer to " + question, Questions: questions}, nil
+		})
+
+		return started
+	}
+
+	t.Run("messages of all concurrent questions are kept", func(t *testing.T) {
+		const requests = 3
+
+		llm := NewMockLLM(t)
+		profileRepo := NewMockPetProfileRepository(t)
+		profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+
+		release := make(chan struct{})
+		started := blockAnalyze(llm, release, nil)
+
+		repo := newVersionedRepository()
+		svc := NewAIService(llm, repo, profileRepo, nil)
+
+		var wg sync.WaitGroup
+
+		errs := make(chan error, requests)
+
+		for i := range requests {
+			wg.Go(func() {
+				_, err := svc.ProcessMessage(context.Background(), &message.UserMessage{
+					UserID: "user1",
+					ChatID: chatID,
+					Text:   fmt.Sprintf("Question %d", i),
+				})
+				errs <- err
+			})
+		}
+
+		// All questions are saved before the LLM answers any of them
+		for range requests {
+			<-started
+		}
+
+		close(release)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+
+		conv, err := repo.FindByID(context.Background(), chatID)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"Question 0", "Question 1", "Question 2"}, messagesOf(conv, "user"))
+		assert.ElementsMatch(t, []string{"Answer to Question 0", "Answer to Question 1", "Answer to Question 2"}, messagesOf(conv, "assistant"))
+		assert.Equal(t, int64(2*requests), conv.GetVersion())
+	})
+
+	t.Run("questionnaire started while answering is kept", func(t *testing.T) {
+		llm := NewMockLLM(t)
+		profileRepo := NewMockPetProfileRepository(t)
+		profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+
+		release := make(chan struct{})
+		started := blockAnalyze(llm, release, []message.Question{{Text: "How old is your cat?"}})
+
+		repo := newVersionedRepository()
+		svc := NewAIService(llm, repo, profileRepo, nil)
+
+		var (
+			resp *message.Response
+			err  error
+			wg   sync.WaitGroup
+		)
+
+		wg.Go(func() {
+			resp, err = svc.ProcessMessage(context.Background(), &message.UserMessage{
+				UserID: "user1",
+				ChatID: chatID,
+				Text:   "Is my cat healthy?",
+			})
+		})
+
+		<-started
+
+		_, editErr := svc.ProcessEditProfile(context.Background(), &message.UserMessage{UserID: "user1", ChatID: chatID})
+		require.NoError(t, editErr)
+
+		close(release)
+		wg.Wait()
+
+		require.NoError(t, err)
+		assert.Equal(t, "Answer to Is my cat healthy?", resp.Message)
+
+		conv, err := repo.FindByID(context.Background(), chatID)
+		require.NoError(t, err)
+
+		assert.Equal(t, conversation.StatePetProfileQuestioning, conv.GetState())
+		assert.Equal(t, []string{"Answer to Is my cat healthy?"}, messagesOf(conv, "assistant"))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
@@ -16,11 +17,24 @@ type ConversationState string
 const (
 	MaxMessageHistory = 5   // Maximum number of messages to keep in history
 	MaxAnswerLength   = 200 // Maximum length of an answer
+	MaxUnsummarized   = 20  // Maximum number of evicted messages waiting to be summarized
+
+	StateNormal                 ConversationState = "normal"
+	StateFollowUpQuestioning    ConversationState = "questioning" // Used for LLM questionnaire (backward compatibility)
+	StatePetProfileQuestioning  ConversationState = "pet_profile_questioning"
+	StateNewPetQuestioning      ConversationState = "new_pet_questioning"
+	StateVaccinationQuestioning ConversationState = "vaccination_questioning"
+	StateCompleted              ConversationState = "completed"
+)
+
+// TTL defines how long conversations are stored after their last update (1 week)
+const TTL = 7 * 24 * time.Hour
 
-	StateNormal                ConversationState = "normal"
-	StateFollowUpQuestioning   ConversationState = "questioning" // Used for LLM questionnaire (backward compatibility)
-	StatePetProfileQuestioning ConversationState = "pet_profile_questioning"
-	StateCompleted             ConversationState = "completed"
+const (
+	// SummaryTurnPrefix starts the turn with the summary of the earlier conversation
+	SummaryTurnPrefix = "Summary of earlier conversation:\n"
+	// MediaTurnPrefix starts the turns describing media attached to the user's messages
+	MediaTurnPrefix = "Media content:\n"
 )
 
 var (
@@ -49,11 +63,17 @@ type QuestionAnswer struct {
 }
 
 // Conversation represents a chat conversation with its context and messages.
+// Summary holds the summary of messages evicted from the history, Unsummarized holds evicted messages
+// that are not folded into the summary yet. Version counts saves of the conversation, repositories use it
+// to reject saves of a conversation changed by another request since it was loaded.
 type Conversation struct {
 	ID            string
 	State         ConversationState
+	Summary       string
 	Messages      []Message
+	Unsummarized  []Message
 	Questionnaire QuestionnaireState `json:"questionnaire"`
+	Version       int64
 }
 
 // Message represents a single message in a conversation.
@@ -84,6 +104,16 @@ func (c *Conversation) GetID() string {
 	return c.ID
 }
 
+// GetVersion returns the version of the conversation, it is 0 for a conversation that was never saved.
+func (c *Conversation) GetVersion() int64 {
+	return c.Version
+}
+
+// SetVersion sets the version of the conversation, it is called by repositories when the conversation is saved.
+func (c *Conversation) SetVersion(version int64) {
+	c.Version = version
+}
+
 // AddMessage adds a new message to the conversation.
 func (c *Conversation) AddMessage(role, content string) {
 	c.Messages = append(c.Messages, Message{
@@ -92,27 +122,65 @@ func (c *Conversation) AddMessage(role, content string) {
 		Timestamp: time.Now(),
 	})
 
-	// Keep only the last N messages
+	// Keep only the last N messages, evicted ones wait to be folded into the summary
 	if len(c.Messages) > MaxMessageHistory {
-		c.Messages = c.Messages[len(c.Messages)-MaxMessageHistory:]
+		evicted := len(c.Messages) - MaxMessageHistory
+
+		c.Unsummarized = append(c.Unsummarized, c.Messages[:evicted]...)
+		c.Messages = slices.Clone(c.Messages[evicted:])
+
+		if len(c.Unsummarized) > MaxUnsummarized {
+			c.Unsummarized = c.Unsummarized[len(c.Unsummarized)-MaxUnsummarized:]
+		}
 	}
 }
 
-// History retrieves the conversation history up until the specified number of recent messages to skip.
-// It includes all messages older than the skipped count in the returned history.
+// PendingSummary returns the current summary together with messages evicted from the history since it was made.
+// Returns an empty slice of messages if there is nothing to summarize.
+func (c *Conversation) PendingSummary() (string, []Message) {
+	return c.Summary, c.Unsummarized
+}
+
+// SetSummary replaces the conversation summary with the one that folds in the first folded evicted messages.
+func (c *Conversation) SetSummary(summary string, folded int) {
+	c.Summary = summary
+	c.Unsummarized = c.Unsummarized[min(folded, len(c.Unsummarized)):]
+
+	if len(c.Unsummarized) == 0 {
+		c.Unsummarized = nil
+	}
+}
+
+// Turns converts the conversation history into turns for the LLM, excluding the specified number of most recent messages.
+// The summary of earlier messages, if any, is sent as the first user turn. Media descriptions are sent as user turns
+// following the message they describe, so the LLM sees them as part of the user's message.
 // skip specifies the number of most recent messages to exclude from the history.
-// Returns a string representation of the filtered conversation history.
-func (c *Conversation) History(skip int) string {
+// Returns the turns in chronological order, or an empty slice if there is nothing to send.
+func (c *Conversation) Turns(skip int) []message.Turn {
+	turns := make([]message.Turn, 0, len(c.Messages)+1)
+
+	if c.Summary != "" {
+		turns = append(turns, message.NewUserTurn(SummaryTurnPrefix+c.Summary, nil))
+	}
+
 	if len(c.Messages) <= skip {
-		return ""
+		return turns
 	}
 
-	history := "Previous conversation:\n"
 	for _, msg := range c.Messages[:len(c.Messages)-skip] {
-		history += fmt.Sprintf("%s: %s\n", msg.Role, msg.Content)
+		switch msg.Role {
+		case string(message.RoleUser):
+			turns = append(turns, message.NewUserTurn(msg.Content, nil))
+		case string(message.RoleAssistant):
+			turns = append(turns, message.NewAssistantTurn(msg.Content))
+		case "media_description":
+			turns = append(turns, message.NewUserTurn(MediaTurnPrefix+msg.Content, nil))
+		default:
+			turns = append(turns, message.NewUserTurn(fmt.Sprintf("%s: %s", msg.Role, msg.Content), nil))
+		}
 	}
 
-	return history
+	return turns
 }
 
 // StartFollowUpQuestions initializes the follow-up questioning state (backward compatible name)
@@ -151,10 +219,45 @@ func (c *Conversation) StartProfileQuestions(ctx context.Context) error {
 	return nil
 }
 
+// StartNewPetQuestions initializes the pet profile questionnaire for adding another pet
+func (c *Conversation) StartNewPetQuestions(ctx context.Context) error {
+	if c.State != StateNormal {
+		return fmt.Errorf("conversation is not in normal state %s", c.State)
+	}
+
+	c.State = StateNewPetQuestioning
+	c.Questionnaire = NewPetProfileQuestionnaireState(ctx)
+
+	return nil
+}
+
+// StartVaccinationQuestions initializes the questionnaire for adding a vaccination or preventive treatment record
+// to the pet with the given name
+func (c *Conversation) StartVaccinationQuestions(ctx context.Context, petName string) error {
+	if c.State != StateNormal {
+		return fmt.Errorf("conversation is not in normal state %s", c.State)
+	}
+
+	c.State = StateVaccinationQuestioning
+	c.Questionnaire = NewVaccinationQuestionnaireState(ctx, petName)
+
+	return nil
+}
+
+// VaccinationPetName returns the name of the pet the active vaccination questionnaire adds the record to,
+// or an empty string if there is no such questionnaire. The questionnaire is cleared by GetQuestionnaireResult.
+func (c *Conversation) VaccinationPetName() string {
+	if q, ok := c.Questionnaire.(*VaccinationStateImpl); ok {
+		return q.PetName
+	}
+
+	return ""
+}
+
 // GetCurrentQuestion returns the current question in the active questionnaire
 func (c *Conversation) GetCurrentQuestion() (*message.Question, error) {
 	switch c.State {
-	case StateFollowUpQuestioning, StatePetProfileQuestioning: // LLM questionnaire
+	case StateFollowUpQuestioning, StatePetProfileQuestioning, StateNewPetQuestioning, StateVaccinationQuestioning: // LLM questionnaire
 		if c.Questionnaire == nil {
 			return nil, fmt.Errorf("questionnaire not initialized")
 		}
@@ -168,7 +271,7 @@ func (c *Conversation) GetCurrentQuestion() (*message.Question, error) {
 // AddQuestionAnswer adds an answer to the current question and moves to the next one
 func (c *Conversation) AddQuestionAnswer(answer string) (bool, error) {
 	switch c.State {
-	case StateFollowUpQuestioning, StatePetProfileQuestioning:
+	case StateFollowUpQuestioning, StatePetProfileQuestioning, StateNewPetQuestioning, StateVaccinationQuestioning:
 		if c.Questionnaire == nil {
 			return false, fmt.Errorf("pet profile questionnaire not initialized")
 		}
@@ -219,8 +322,11 @@ func Unmarshal(data []byte) (*Conversation, error) {
 	var tmpConv struct {
 		ID            string
 		State         ConversationState
+		Summary       string
 		Messages      []Message
+		Unsummarized  []Message
 		Questionnaire json.RawMessage `json:"questionnaire"`
+		Version       int64
 	}
 
 	if err := json.Unmarshal(data, &tmpConv); err != nil {
@@ -233,11 +339,14 @@ func Unmarshal(data []byte) (*Conversation, error) {
 		// it means we failed to process questionnaire result, and we just need to reset the state to normal
 
 		return &Conversation{
-			ID:       tmpConv.ID,
-			State:    StateNormal,
-			Messages: tmpConv.Messages,
+			ID:           tmpConv.ID,
+			State:        StateNormal,
+			Summary:      tmpConv.Summary,
+			Messages:     tmpConv.Messages,
+			Unsummarized: tmpConv.Unsummarized,
+			Version:      tmpConv.Version,
 		}, nil
-	case StatePetProfileQuestioning:
+	case StatePetProfileQuestioning, StateNewPetQuestioning:
 		var q PetProfileStateImpl
 		if err := json.Unmarshal(tmpConv.Questionnaire, &q); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal pet profile questionnaire: %w", err)
@@ -245,9 +354,27 @@ func Unmarshal(data []byte) (*Conversation, error) {
 
 		return &Conversation{
 			ID:            tmpConv.ID,
-			State:         StatePetProfileQuestioning,
+			State:         tmpConv.State,
+			Summary:       tmpConv.Summary,
+			Messages:      tmpConv.Messages,
+			Unsummarized:  tmpConv.Unsummarized,
+			Questionnaire: &q,
+			Version:       tmpConv.Version,
+		}, nil
+	case StateVaccinationQuestioning:
+		var q VaccinationStateImpl
+		if err := json.Unmarshal(tmpConv.Questionnaire, &q); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal vaccination questionnaire: %w", err)
+		}
+
+		return &Conversation{
+			ID:            tmpConv.ID,
+			State:         StateVaccinationQuestioning,
+			Summary:       tmpConv.Summary,
 			Messages:      tmpConv.Messages,
+			Unsummarized:  tmpConv.Unsummarized,
 			Questionnaire: &q,
+			Version:       tmpConv.Version,
 		}, nil
 	case StateFollowUpQuestioning:
 		var q FollowUpQuestionnaireState
@@ -258,8 +385,11 @@ func Unmarshal(data []byte) (*Conversation, error) {
 		return &Conversation{
 			ID:            tmpConv.ID,
 			State:         StateFollowUpQuestioning,
+			Summary:       tmpConv.Summary,
 			Messages:      tmpConv.Messages,
+			Unsummarized:  tmpConv.Unsummarized,
 			Questionnaire: &q,
+			Version:       tmpConv.Version,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unknown conversation state: %s", tmpConv.State)
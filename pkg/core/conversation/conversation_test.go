@@ -1,6 +1,7 @@
 package conversation
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -86,8 +87,8 @@ func TestConversation_GetContext(t *testing.T) {
 			role    string
 			content string
 		}
+		wantOutput []message.Turn
 		skip       int
-		wantOutput string
 	}{
 		{
 			name: "retrieve full history",
@@ -99,8 +100,12 @@ func TestConversation_GetContext(t *testing.T) {
 				{"assistant", "Hi there!"},
 				{"user", "How are you?"},
 			},
-			skip:       0,
-			wantOutput: "Previous conversation:\nuser: Hello\nassistant: Hi there!\nuser: How are you?\n",
+			skip: 0,
+			wantOutput: []message.Turn{
+				message.NewUserTurn("Hello", nil),
+				message.NewAssistantTurn("Hi there!"),
+				message.NewUserTurn("How are you?", nil),
+			},
 		},
 		{
 			name: "skip one message",
@@ -112,8 +117,11 @@ func TestConversation_GetContext(t *testing.T) {
 				{"assistant", "Hi there!"},
 				{"user", "How are you?"},
 			},
-			skip:       1,
-			wantOutput: "Previous conversation:\nuser: Hello\nassistant: Hi there!\n",
+			skip: 1,
+			wantOutput: []message.Turn{
+				message.NewUserTurn("Hello", nil),
+				message.NewAssistantTurn("Hi there!"),
+			},
 		},
 		{
 			name: "skip all messages",
@@ -125,13 +133,30 @@ func TestConversation_GetContext(t *testing.T) {
 				{"assistant", "Hi there!"},
 			},
 			skip:       2,
-			wantOutput: "",
+			wantOutput: []message.Turn{},
+		},
+		{
+			name: "media description is sent as user turn",
+			messages: []struct {
+				role    string
+				content string
+			}{
+				{"user", "What is on his paw?"},
+				{"media_description", "A small red bump"},
+				{"assistant", "It looks like an insect bite."},
+			},
+			skip: 0,
+			wantOutput: []message.Turn{
+				message.NewUserTurn("What is on his paw?", nil),
+				message.NewUserTurn("Media content:\nA small red bump", nil),
+				message.NewAssistantTurn("It looks like an insect bite."),
+			},
 		},
 		{
 			name:       "no messages",
 			messages:   []struct{ role, content string }{},
 			skip:       0,
-			wantOutput: "",
+			wantOutput: []message.Turn{},
 		},
 		{
 			name: "skip more messages than exist",
@@ -142,7 +167,7 @@ func TestConversation_GetContext(t *testing.T) {
 				{"user", "Hello"},
 			},
 			skip:       5,
-			wantOutput: "",
+			wantOutput: []message.Turn{},
 		},
 	}
 
@@ -154,7 +179,7 @@ func TestConversation_GetContext(t *testing.T) {
 				conv.AddMessage(msg.role, msg.content)
 			}
 
-			output := conv.History(tt.skip)
+			output := conv.Turns(tt.skip)
 			assert.Equal(t, tt.wantOutput, output)
 		})
 	}
@@ -388,6 +413,18 @@ func TestConversationUnmarshal_NormalState(t *testing.T) {
 	assert.Nil(t, conv.Questionnaire)
 }
 
+func TestConversationUnmarshal_Version(t *testing.T) {
+	conv := NewConversation("test-GetID")
+	conv.SetVersion(7)
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), got.GetVersion())
+}
+
 func TestConversationUnmarshal_CompletedState(t *testing.T) {
 	data, err := json.Marshal(struct {
 		ID            string
@@ -440,6 +477,34 @@ func TestConversationUnmarshal_ProfileState(t *testing.T) {
 	assert.NotNil(t, conv.Questionnaire)
 }
 
+func TestConversation_StartNewPetQuestions(t *testing.T) {
+	conv := NewConversation("test-id")
+
+	require.NoError(t, conv.StartNewPetQuestions(context.Background()))
+	assert.Equal(t, StateNewPetQuestioning, conv.GetState())
+
+	question, err := conv.GetCurrentQuestion()
+	require.NoError(t, err)
+	assert.Equal(t, "What is your pet's name?", question.Text)
+
+	isComplete, err := conv.AddQuestionAnswer("Bella")
+	require.NoError(t, err)
+	assert.False(t, isComplete)
+
+	assert.Error(t, conv.StartNewPetQuestions(context.Background()))
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	restored, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, StateNewPetQuestioning, restored.GetState())
+
+	question, err = restored.GetCurrentQuestion()
+	require.NoError(t, err)
+	assert.Equal(t, "What type of pet do you have?", question.Text)
+}
+
 func TestConversationUnmarshal_FollowUpState(t *testing.T) {
 	mockQuestionnaire, err := json.Marshal(struct {
 		InitialPrompt string
@@ -0,0 +1,90 @@
+package conversation
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversation_EvictedMessagesSummary(t *testing.T) {
+	conv := NewConversation("test-id")
+
+	for i := 0; i < MaxMessageHistory+2; i++ {
+		conv.AddMessage("user", fmt.Sprintf("Text %d", i))
+	}
+
+	summary, pending := conv.PendingSummary()
+	assert.Empty(t, summary)
+	require.Len(t, pending, 2)
+	assert.Equal(t, "Text 0", pending[0].Content)
+	assert.Equal(t, "Text 1", pending[1].Content)
+
+	conv.AddMessage("user", "Text 7")
+	conv.SetSummary("Max is limping since Monday.", 2)
+
+	summary, pending = conv.PendingSummary()
+	assert.Equal(t, "Max is limping since Monday.", summary)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "Text 2", pending[0].Content)
+
+	conv.SetSummary("Max is limping since Monday, vet visit planned.", 10)
+
+	_, pending = conv.PendingSummary()
+	assert.Empty(t, pending)
+}
+
+func TestConversation_UnsummarizedLimit(t *testing.T) {
+	conv := NewConversation("test-id")
+
+	for i := 0; i < MaxMessageHistory+MaxUnsummarized+3; i++ {
+		conv.AddMessage("user", fmt.Sprintf("Text %d", i))
+	}
+
+	_, pending := conv.PendingSummary()
+	require.Len(t, pending, MaxUnsummarized)
+	assert.Equal(t, "Text 3", pending[0].Content)
+}
+
+func TestConversation_HistoryWithSummary(t *testing.T) {
+	conv := NewConversation("test-id")
+	conv.Summary = "Max is limping since Monday."
+
+	summaryTurn := message.NewUserTurn("Summary of earlier conversation:\nMax is limping since Monday.", nil)
+
+	assert.Equal(t, []message.Turn{summaryTurn}, conv.Turns(0))
+
+	conv.AddMessage("user", "Is it getting worse?")
+	conv.AddMessage("assistant", "Please describe the leg.")
+
+	expected := []message.Turn{
+		summaryTurn,
+		message.NewUserTurn("Is it getting worse?", nil),
+	}
+
+	assert.Equal(t, expected, conv.Turns(1))
+}
+
+func TestConversationUnmarshal_KeepsSummary(t *testing.T) {
+	conv := NewConversation("test-id")
+
+	for i := 0; i < MaxMessageHistory+1; i++ {
+		conv.AddMessage("user", fmt.Sprintf("Text %d", i))
+	}
+
+	conv.SetSummary("Max is limping since Monday.", 0)
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	restored, err := Unmarshal(data)
+	require.NoError(t, err)
+
+	summary, pending := restored.PendingSummary()
+	assert.Equal(t, "Max is limping since Monday.", summary)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "Text 0", pending[0].Content)
+}
@@ -0,0 +1,133 @@
+package conversation
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// optionalVaccinationFields lists fields of the vaccination questionnaire the user can skip
+var optionalVaccinationFields = map[string]bool{
+	"vaccine_next_due": true,
+	"vaccine_clinic":   true,
+}
+
+// VaccinationStateImpl implements QuestionnaireState for adding a vaccination or preventive treatment record.
+// SkipAnswer is the localized answer the user sends to leave an optional field empty,
+// PetName is the name of the pet the record is added to.
+type VaccinationStateImpl struct {
+	SkipAnswer   string           `json:"skip_answer"`
+	PetName      string           `json:"pet_name"`
+	QAPairs      []QuestionAnswer `json:"qa_pairs"`
+	CurrentIndex int              `json:"current_index"`
+}
+
+// NewVaccinationQuestionnaireState initializes a questionnaire asking for the vaccine or treatment name, the date it was given,
+// the date it is due next and the clinic, the last two being optional, for the record of the pet with the given name.
+// Returns a pointer to a VaccinationStateImpl instance with the questions and initial index set to 0.
+func NewVaccinationQuestionnaireState(ctx context.Context, petName string) *VaccinationStateImpl {
+	skip := i18n.GetLocale(ctx).Sprintf("skip")
+
+	questions := []QuestionAnswer{
+		{
+			Question: message.Question{
+				Text: i18n.GetLocale(ctx).Sprintf("Which vaccine or preventive treatment was given (e.g., rabies, deworming, flea treatment)?"),
+			},
+			Field: "vaccine_name",
+		},
+		{
+			Question: message.Question{
+				Text: i18n.GetLocale(ctx).Sprintf("When was it given? Please enter the date in the format YYYY-MM-DD (e.g., 2024-05-31)."),
+			},
+			Field: "vaccine_date",
+		},
+		{
+			Question: message.Question{
+				Text:    i18n.GetLocale(ctx).Sprintf("When is the next dose due? Please enter the date in the format YYYY-MM-DD, or skip if you don't know."),
+				Answers: []string{skip},
+			},
+			Field: "vaccine_next_due",
+		},
+		{
+			Question: message.Question{
+				Text:    i18n.GetLocale(ctx).Sprintf("Which clinic gave it?"),
+				Answers: []string{skip},
+			},
+			Field: "vaccine_clinic",
+		},
+	}
+
+	return &VaccinationStateImpl{
+		SkipAnswer:   skip,
+		PetName:      petName,
+		QAPairs:      questions,
+		CurrentIndex: 0,
+	}
+}
+
+// GetCurrentQuestion retrieves the current question from the questionnaire.
+// Returns ErrNoMoreQuestions if all questions are answered.
+func (s *VaccinationStateImpl) GetCurrentQuestion() (*message.Question, error) {
+	if s.CurrentIndex >= len(s.QAPairs) {
+		return nil, ErrNoMoreQuestions
+	}
+
+	return &s.QAPairs[s.CurrentIndex].Question, nil
+}
+
+// ProcessAnswer validates and stores the answer for the current question and advances to the next question.
+// Skipping an optional question stores an empty answer.
+// Returns true if all questions have been answered, or an error if the answer is invalid or there are no more questions.
+func (s *VaccinationStateImpl) ProcessAnswer(answer string) (bool, error) {
+	if s.CurrentIndex >= len(s.QAPairs) {
+		return false, ErrNoMoreQuestions
+	}
+
+	qa := &s.QAPairs[s.CurrentIndex]
+	answer = strings.TrimSpace(answer)
+
+	if optionalVaccinationFields[qa.Field] && strings.EqualFold(answer, s.SkipAnswer) {
+		answer = ""
+	}
+
+	if err := validateVaccination(qa.Field, answer); err != nil {
+		return false, err
+	}
+
+	qa.Answer = answer
+	s.CurrentIndex++
+
+	return s.CurrentIndex >= len(s.QAPairs), nil
+}
+
+// GetResults retrieves all question and answer pairs from the questionnaire.
+// Returns ErrQuestionnaireIncomplete if not all questions have been answered.
+func (s *VaccinationStateImpl) GetResults() ([]QuestionAnswer, error) {
+	if s.CurrentIndex < len(s.QAPairs) {
+		return nil, ErrQuestionnaireIncomplete
+	}
+
+	return s.QAPairs, nil
+}
+
+// validateVaccination checks the answer for the specified field of the vaccination questionnaire.
+// The date a vaccine was given can't be in the future, while the next due date can; skipped optional fields are valid.
+func validateVaccination(field, answer string) error {
+	switch {
+	case field == "vaccine_date":
+		return validateDOB(answer)
+	case answer == "" && optionalVaccinationFields[field]:
+		return nil
+	case field == "vaccine_next_due":
+		if _, err := time.Parse("2006-01-02", answer); err != nil {
+			return message.ErrInvalidDates
+		}
+
+		return nil
+	default:
+		return validateLength(answer, defaultMaxLength)
+	}
+}
@@ -0,0 +1,127 @@
+package conversation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaccinationQuestionnaire_ProcessAnswer(t *testing.T) {
+	tests := []struct {
+		expectedErr error
+		name        string
+		answers     []string
+		expected    []string
+	}{
+		{
+			name:     "all fields",
+			answers:  []string{"Rabies", "2024-05-01", "2027-05-01", "City Vet"},
+			expected: []string{"Rabies", "2024-05-01", "2027-05-01", "City Vet"},
+		},
+		{
+			name:     "optional fields skipped",
+			answers:  []string{"Deworming", "2024-05-01", "Skip", "skip"},
+			expected: []string{"Deworming", "2024-05-01", "", ""},
+		},
+		{
+			name:        "date in the future",
+			answers:     []string{"Rabies", "2999-01-01"},
+			expectedErr: message.ErrFutureDate,
+		},
+		{
+			name:        "invalid next due date",
+			answers:     []string{"Rabies", "2024-05-01", "next year"},
+			expectedErr: message.ErrInvalidDates,
+		},
+		{
+			name:        "date can't be skipped",
+			answers:     []string{"Rabies", "skip"},
+			expectedErr: message.ErrInvalidDates,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := NewVaccinationQuestionnaireState(context.Background(), "Max")
+
+			var (
+				complete bool
+				err      error
+			)
+
+			for _, answer := range tt.answers {
+				complete, err = state.ProcessAnswer(answer)
+				if err != nil {
+					break
+				}
+			}
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, complete)
+
+			results, err := state.GetResults()
+			require.NoError(t, err)
+
+			answers := make([]string, len(results))
+			for i, qa := range results {
+				answers[i] = qa.Answer
+			}
+
+			assert.Equal(t, tt.expected, answers)
+		})
+	}
+}
+
+func TestVaccinationQuestionnaire_Incomplete(t *testing.T) {
+	state := NewVaccinationQuestionnaireState(context.Background(), "Max")
+
+	_, err := state.GetResults()
+	assert.ErrorIs(t, err, ErrQuestionnaireIncomplete)
+
+	question, err := state.GetCurrentQuestion()
+	require.NoError(t, err)
+	assert.Empty(t, question.Answers)
+}
+
+func TestConversation_StartVaccinationQuestions(t *testing.T) {
+	conv := NewConversation("test-id")
+
+	assert.Empty(t, conv.VaccinationPetName())
+
+	require.NoError(t, conv.StartVaccinationQuestions(context.Background(), "Max"))
+	assert.Equal(t, StateVaccinationQuestioning, conv.GetState())
+
+	isComplete, err := conv.AddQuestionAnswer("Rabies")
+	require.NoError(t, err)
+	assert.False(t, isComplete)
+
+	assert.Error(t, conv.StartVaccinationQuestions(context.Background(), "Bella"))
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	restored, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, StateVaccinationQuestioning, restored.GetState())
+	assert.Equal(t, "Max", restored.VaccinationPetName())
+
+	question, err := restored.GetCurrentQuestion()
+	require.NoError(t, err)
+	assert.Contains(t, question.Text, "When was it given?")
+
+	_, err = restored.AddQuestionAnswer("2024-05-01")
+	require.NoError(t, err)
+
+	question, err = restored.GetCurrentQuestion()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"skip"}, question.Answers)
+}
@@ -352,52 +352,175 @@ func (_c *MockConversation_GetState_Call) RunAndReturn(run func() conversation.C
 	return _c
 }
 
-// History provides a mock function with given fields: skip
-func (_m *MockConversation) History(skip int) string {
-	ret := _m.Called(skip)
+// GetVersion provides a mock function with no fields
+func (_m *MockConversation) GetVersion() int64 {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for History")
+		panic("no return value specified for GetVersion")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(int) string); ok {
-		r0 = rf(skip)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// MockConversation_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
-type MockConversation_History_Call struct {
+// MockConversation_GetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersion'
+type MockConversation_GetVersion_Call struct {
 	*mock.Call
 }
 
-// History is a helper method to define mock.On call
-//   - skip int
-func (_e *MockConversation_Expecter) History(skip interface{}) *MockConversation_History_Call {
-	return &MockConversation_History_Call{Call: _e.mock.On("History", skip)}
+// GetVersion is a helper method to define mock.On call
+func (_e *MockConversation_Expecter) GetVersion() *MockConversation_GetVersion_Call {
+	return &MockConversation_GetVersion_Call{Call: _e.mock.On("GetVersion")}
 }
 
-func (_c *MockConversation_History_Call) Run(run func(skip int)) *MockConversation_History_Call {
+func (_c *MockConversation_GetVersion_Call) Run(run func()) *MockConversation_GetVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockConversation_History_Call) Return(_a0 string) *MockConversation_History_Call {
+func (_c *MockConversation_GetVersion_Call) Return(_a0 int64) *MockConversation_GetVersion_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockConversation_History_Call) RunAndReturn(run func(int) string) *MockConversation_History_Call {
+func (_c *MockConversation_GetVersion_Call) RunAndReturn(run func() int64) *MockConversation_GetVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingSummary provides a mock function with no fields
+func (_m *MockConversation) PendingSummary() (string, []conversation.Message) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingSummary")
+	}
+
+	var r0 string
+	var r1 []conversation.Message
+	if rf, ok := ret.Get(0).(func() (string, []conversation.Message)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() []conversation.Message); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]conversation.Message)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockConversation_PendingSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingSummary'
+type MockConversation_PendingSummary_Call struct {
+	*mock.Call
+}
+
+// PendingSummary is a helper method to define mock.On call
+func (_e *MockConversation_Expecter) PendingSummary() *MockConversation_PendingSummary_Call {
+	return &MockConversation_PendingSummary_Call{Call: _e.mock.On("PendingSummary")}
+}
+
+func (_c *MockConversation_PendingSummary_Call) Run(run func()) *MockConversation_PendingSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConversation_PendingSummary_Call) Return(_a0 string, _a1 []conversation.Message) *MockConversation_PendingSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConversation_PendingSummary_Call) RunAndReturn(run func() (string, []conversation.Message)) *MockConversation_PendingSummary_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
+// SetSummary provides a mock function with given fields: summary, folded
+func (_m *MockConversation) SetSummary(summary string, folded int) {
+	_m.Called(summary, folded)
+}
+
+// MockConversation_SetSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSummary'
+type MockConversation_SetSummary_Call struct {
+	*mock.Call
+}
+
+// SetSummary is a helper method to define mock.On call
+//   - summary string
+//   - folded int
+func (_e *MockConversation_Expecter) SetSummary(summary interface{}, folded interface{}) *MockConversation_SetSummary_Call {
+	return &MockConversation_SetSummary_Call{Call: _e.mock.On("SetSummary", summary, folded)}
+}
+
+func (_c *MockConversation_SetSummary_Call) Run(run func(summary string, folded int)) *MockConversation_SetSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockConversation_SetSummary_Call) Return() *MockConversation_SetSummary_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockConversation_SetSummary_Call) RunAndReturn(run func(string, int)) *MockConversation_SetSummary_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetVersion provides a mock function with given fields: version
+func (_m *MockConversation) SetVersion(version int64) {
+	_m.Called(version)
+}
+
+// MockConversation_SetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVersion'
+type MockConversation_SetVersion_Call struct {
+	*mock.Call
+}
+
+// SetVersion is a helper method to define mock.On call
+//   - version int64
+func (_e *MockConversation_Expecter) SetVersion(version interface{}) *MockConversation_SetVersion_Call {
+	return &MockConversation_SetVersion_Call{Call: _e.mock.On("SetVersion", version)}
+}
+
+func (_c *MockConversation_SetVersion_Call) Run(run func(version int64)) *MockConversation_SetVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockConversation_SetVersion_Call) Return() *MockConversation_SetVersion_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockConversation_SetVersion_Call) RunAndReturn(run func(int64)) *MockConversation_SetVersion_Call {
+	_c.Run(run)
+	return _c
+}
+
 // StartFollowUpQuestions provides a mock function with given fields: initialPrompt, questions
 func (_m *MockConversation) StartFollowUpQuestions(initialPrompt string, questions []message.Question) error {
 	ret := _m.Called(initialPrompt, questions)
@@ -445,6 +568,52 @@ func (_c *MockConversation_StartFollowUpQuestions_Call) RunAndReturn(run func(st
 	return _c
 }
 
+// StartNewPetQuestions provides a mock function with given fields: ctx
+func (_m *MockConversation) StartNewPetQuestions(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartNewPetQuestions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConversation_StartNewPetQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartNewPetQuestions'
+type MockConversation_StartNewPetQuestions_Call struct {
+	*mock.Call
+}
+
+// StartNewPetQuestions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConversation_Expecter) StartNewPetQuestions(ctx interface{}) *MockConversation_StartNewPetQuestions_Call {
+	return &MockConversation_StartNewPetQuestions_Call{Call: _e.mock.On("StartNewPetQuestions", ctx)}
+}
+
+func (_c *MockConversation_StartNewPetQuestions_Call) Run(run func(ctx context.Context)) *MockConversation_StartNewPetQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConversation_StartNewPetQuestions_Call) Return(_a0 error) *MockConversation_StartNewPetQuestions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConversation_StartNewPetQuestions_Call) RunAndReturn(run func(context.Context) error) *MockConversation_StartNewPetQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartProfileQuestions provides a mock function with given fields: ctx
 func (_m *MockConversation) StartProfileQuestions(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -491,6 +660,146 @@ func (_c *MockConversation_StartProfileQuestions_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// StartVaccinationQuestions provides a mock function with given fields: ctx, petName
+func (_m *MockConversation) StartVaccinationQuestions(ctx context.Context, petName string) error {
+	ret := _m.Called(ctx, petName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartVaccinationQuestions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, petName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConversation_StartVaccinationQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartVaccinationQuestions'
+type MockConversation_StartVaccinationQuestions_Call struct {
+	*mock.Call
+}
+
+// StartVaccinationQuestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - petName string
+func (_e *MockConversation_Expecter) StartVaccinationQuestions(ctx interface{}, petName interface{}) *MockConversation_StartVaccinationQuestions_Call {
+	return &MockConversation_StartVaccinationQuestions_Call{Call: _e.mock.On("StartVaccinationQuestions", ctx, petName)}
+}
+
+func (_c *MockConversation_StartVaccinationQuestions_Call) Run(run func(ctx context.Context, petName string)) *MockConversation_StartVaccinationQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConversation_StartVaccinationQuestions_Call) Return(_a0 error) *MockConversation_StartVaccinationQuestions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConversation_StartVaccinationQuestions_Call) RunAndReturn(run func(context.Context, string) error) *MockConversation_StartVaccinationQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Turns provides a mock function with given fields: skip
+func (_m *MockConversation) Turns(skip int) []message.Turn {
+	ret := _m.Called(skip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Turns")
+	}
+
+	var r0 []message.Turn
+	if rf, ok := ret.Get(0).(func(int) []message.Turn); ok {
+		r0 = rf(skip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]message.Turn)
+		}
+	}
+
+	return r0
+}
+
+// MockConversation_Turns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Turns'
+type MockConversation_Turns_Call struct {
+	*mock.Call
+}
+
+// Turns is a helper method to define mock.On call
+//   - skip int
+func (_e *MockConversation_Expecter) Turns(skip interface{}) *MockConversation_Turns_Call {
+	return &MockConversation_Turns_Call{Call: _e.mock.On("Turns", skip)}
+}
+
+func (_c *MockConversation_Turns_Call) Run(run func(skip int)) *MockConversation_Turns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockConversation_Turns_Call) Return(_a0 []message.Turn) *MockConversation_Turns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConversation_Turns_Call) RunAndReturn(run func(int) []message.Turn) *MockConversation_Turns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VaccinationPetName provides a mock function with no fields
+func (_m *MockConversation) VaccinationPetName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VaccinationPetName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockConversation_VaccinationPetName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VaccinationPetName'
+type MockConversation_VaccinationPetName_Call struct {
+	*mock.Call
+}
+
+// VaccinationPetName is a helper method to define mock.On call
+func (_e *MockConversation_Expecter) VaccinationPetName() *MockConversation_VaccinationPetName_Call {
+	return &MockConversation_VaccinationPetName_Call{Call: _e.mock.On("VaccinationPetName")}
+}
+
+func (_c *MockConversation_VaccinationPetName_Call) Run(run func()) *MockConversation_VaccinationPetName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConversation_VaccinationPetName_Call) Return(_a0 string) *MockConversation_VaccinationPetName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConversation_VaccinationPetName_Call) RunAndReturn(run func() string) *MockConversation_VaccinationPetName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockConversation creates a new instance of MockConversation. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockConversation(t interface {
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+var (
+	// ErrFeedbackDisabled is returned when the service is created without a feedback repository.
+	ErrFeedbackDisabled = errors.New("feedback is not configured")
+	// ErrAnswerNotFound is returned when the rated answer doesn't exist, has expired or belongs to another user.
+	ErrAnswerNotFound = errors.New("answer not found")
+)
+
+// FeedbackRepository defines the interface for storage of answers and their ratings
+type FeedbackRepository interface {
+	// SaveAnswer stores the unrated answer, so it can be rated later. Unrated answers may expire.
+	SaveAnswer(ctx context.Context, f *feedback.Feedback) error
+	// GetAnswer returns the answer with the ID, or ErrAnswerNotFound.
+	GetAnswer(ctx context.Context, id string) (*feedback.Feedback, error)
+	// SaveFeedback stores the rated answer, rated answers are kept until they are exported and removed manually.
+	SaveFeedback(ctx context.Context, f *feedback.Feedback) error
+	// SetPendingReason remembers the answer the user is asked to give the reason of the rating for.
+	SetPendingReason(ctx context.Context, userID, answerID string) error
+	// PopPendingReason returns and forgets the answer waiting for the reason of the user, or ErrAnswerNotFound.
+	PopPendingReason(ctx context.Context, userID string) (string, error)
+	// ListFeedback returns all rated answers ordered by the time they were rated.
+	ListFeedback(ctx context.Context) ([]*feedback.Feedback, error)
+}
+
+// PromptVersioner is implemented by LLMs which can identify the version of their prompts,
+// the version is stored with answers to compare ratings of prompt changes.
+type PromptVersioner interface {
+	PromptVersion() string
+}
+
+// WithFeedbackRepository enables rating of final answers using the provided repository.
+// Returns the service to allow chaining with the constructor.
+func (s *AIService) WithFeedbackRepository(repo FeedbackRepository) *AIService {
+	s.feedbackRepo = repo
+	return s
+}
+
+// RateAnswer stores the user's rating of the answer. After a negative rating the next reason given by the user
+// with AddFeedbackReason is attached to the answer.
+// Returns ErrFeedbackDisabled if feedback is not configured, ErrAnswerNotFound if the answer doesn't exist
+// or wasn't given to the user, or an error if storing the rating fails.
+func (s *AIService) RateAnswer(ctx context.Context, userID, answerID string, rating feedback.Rating) error {
+	if s.feedbackRepo == nil {
+		return ErrFeedbackDisabled
+	}
+
+	f, err := s.getAnswer(ctx, userID, answerID)
+	if err != nil {
+		return err
+	}
+
+	f.Rate(rating, time.Now())
+
+	if err := s.feedbackRepo.SaveFeedback(ctx, f); err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	if rating != feedback.RatingDown {
+		return nil
+	}
+
+	if err := s.feedbackRepo.SetPendingReason(ctx, userID, answerID); err != nil {
+		return fmt.Errorf("failed to set pending feedback reason: %w", err)
+	}
+
+	return nil
+}
+
+// AddFeedbackReason attaches the reason to the answer the user has rated negatively last.
+// Returns true if the reason was attached, false if feedback is not configured or no reason is expected from the user,
+// or an error if storing the reason fails.
+func (s *AIService) AddFeedbackReason(ctx context.Context, userID, reason string) (bool, error) {
+	if s.feedbackRepo == nil {
+		return false, nil
+	}
+
+	answerID, err := s.feedbackRepo.PopPendingReason(ctx, userID)
+	if errors.Is(err, ErrAnswerNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get pending feedback reason: %w", err)
+	}
+
+	f, err := s.getAnswer(ctx, userID, answerID)
+	if errors.Is(err, ErrAnswerNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	f.SetReason(reason)
+
+	if err := s.feedbackRepo.SaveFeedback(ctx, f); err != nil {
+		return false, fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return true, nil
+}
+
+// getAnswer returns the answer with the ID given to the user.
+// Returns ErrAnswerNotFound if the answer doesn't exist or belongs to another user, or an error if retrieval fails.
+func (s *AIService) getAnswer(ctx context.Context, userID, answerID string) (*feedback.Feedback, error) {
+	f, err := s.feedbackRepo.GetAnswer(ctx, answerID)
+	if errors.Is(err, ErrAnswerNotFound) {
+		return nil, ErrAnswerNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get answer: %w", err)
+	}
+
+	if f.UserID != userID {
+		return nil, ErrAnswerNotFound
+	}
+
+	return f, nil
+}
+
+// saveAnswer stores the final answer with the question, the model and the prompt version it was generated with,
+// and sets its ID on the response, so the user can rate it.
+// It does nothing if feedback is not configured. Failures are logged and leave the answer without rating buttons.
+func (s *AIService) saveAnswer(ctx context.Context, request *message.UserMessage, question string, resp *message.Response) {
+	if s.feedbackRepo == nil {
+		return
+	}
+
+	f := feedback.New(request.UserID, request.ChatID, question, resp.Message, time.Now())
+	f.Model = budget.LastModel(ctx)
+
+	if versioner, ok := s.llm.(PromptVersioner); ok {
+		f.PromptVersion = versioner.PromptVersion()
+	}
+
+	if err := s.feedbackRepo.SaveAnswer(ctx, f); err != nil {
+		slog.ErrorContext(ctx, "Failed to save answer for feedback", slog.Any("error", err))
+		return
+	}
+
+	resp.AnswerID = f.ID
+}
+
+// followUpQuestion returns the question a follow-up report is generated for: the last question of the user
+// in the history followed by the final request with the collected follow-up information.
+func followUpQuestion(turns []message.Turn) string {
+	if len(turns) == 0 {
+		return ""
+	}
+
+	request := turns[len(turns)-1].Content
+
+	if question := lastQuestion(turns[:len(turns)-1]); question != "" {
+		return question + "\n\n" + request
+	}
+
+	return request
+}
+
+// lastQuestion returns the last question of the user in the history, skipping media descriptions and summaries.
+// Returns an empty string if the history has no questions.
+func lastQuestion(turns []message.Turn) string {
+	for i := len(turns) - 1; i >= 0; i-- {
+		turn := turns[i]
+
+		if turn.Role != message.RoleUser ||
+			strings.HasPrefix(turn.Content, conversation.MediaTurnPrefix) ||
+			strings.HasPrefix(turn.Content, conversation.SummaryTurnPrefix) {
+			continue
+		}
+
+		return turn.Content
+	}
+
+	return ""
+}
@@ -0,0 +1,93 @@
+package feedback
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxReasonLength is the maximum number of characters kept from the reason of a rating
+	MaxReasonLength = 1000
+	// AnswerTTL limits how long an answer can be rated after it was given
+	AnswerTTL = 30 * 24 * time.Hour
+	// ReasonTTL limits how long the reason of a negative rating is awaited
+	ReasonTTL = time.Hour
+)
+
+// ErrInvalidRating is returned when the rating is neither up nor down.
+var ErrInvalidRating = errors.New("invalid rating")
+
+// Rating is the assessment of an answer by the user who received it
+type Rating string
+
+const (
+	RatingUp   Rating = "up"
+	RatingDown Rating = "down"
+)
+
+// ParseRating converts the value into a Rating.
+// Returns ErrInvalidRating if the value is not a known rating.
+func ParseRating(value string) (Rating, error) {
+	switch rating := Rating(value); rating {
+	case RatingUp, RatingDown:
+		return rating, nil
+	default:
+		return "", ErrInvalidRating
+	}
+}
+
+// Feedback is a final answer given to a user together with the context it was generated in
+// and the user's rating of it, if the answer was rated.
+type Feedback struct {
+	CreatedAt     time.Time `json:"created_at"`
+	RatedAt       time.Time `json:"rated_at"`
+	ID            string    `json:"id"`
+	UserID        string    `json:"user_id"`
+	ChatID        string    `json:"chat_id"`
+	Question      string    `json:"question"`
+	Answer        string    `json:"answer"`
+	Model         string    `json:"model,omitempty"`
+	PromptVersion string    `json:"prompt_version,omitempty"`
+	Rating        Rating    `json:"rating,omitempty"`
+	Reason        string    `json:"reason,omitempty"`
+}
+
+// New creates an unrated answer record with a unique ID.
+// question is the user's request the answer was generated for, including follow-up answers if any.
+// Returns the created record.
+func New(userID, chatID, question, answer string, now time.Time) *Feedback {
+	return &Feedback{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		ChatID:    chatID,
+		Question:  question,
+		Answer:    answer,
+		CreatedAt: now,
+	}
+}
+
+// Rate sets the rating of the answer, rating it again replaces the previous rating.
+func (f *Feedback) Rate(rating Rating, now time.Time) {
+	f.Rating = rating
+	f.RatedAt = now
+}
+
+// Rated reports whether the user has rated the answer.
+func (f *Feedback) Rated() bool {
+	return f.Rating != ""
+}
+
+// SetReason sets the free-text reason of the rating, the reason is trimmed and truncated to MaxReasonLength characters.
+func (f *Feedback) SetReason(reason string) {
+	reason = strings.TrimSpace(reason)
+
+	if utf8.RuneCountInString(reason) > MaxReasonLength {
+		reason = string([]rune(reason)[:MaxReasonLength])
+	}
+
+	f.Reason = reason
+}
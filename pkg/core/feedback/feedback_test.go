@@ -0,0 +1,61 @@
+package feedback
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRating(t *testing.T) {
+	tests := []struct {
+		wantErr error
+		name    string
+		value   string
+		want    Rating
+	}{
+		{name: "up", value: "up", want: RatingUp},
+		{name: "down", value: "down", want: RatingDown},
+		{name: "unknown", value: "meh", wantErr: ErrInvalidRating},
+		{name: "empty", value: "", wantErr: ErrInvalidRating},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRating(tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFeedback(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	f := New("user1", "chat1", "Is chocolate toxic for dogs?", "Yes, it is.", now)
+
+	assert.NotEmpty(t, f.ID)
+	assert.NotEqual(t, f.ID, New("user1", "chat1", "", "", now).ID)
+	assert.Equal(t, now, f.CreatedAt)
+	assert.False(t, f.Rated())
+
+	f.Rate(RatingDown, now.Add(time.Minute))
+
+	assert.True(t, f.Rated())
+	assert.Equal(t, RatingDown, f.Rating)
+	assert.Equal(t, now.Add(time.Minute), f.RatedAt)
+
+	f.SetReason("  too vague \n")
+	assert.Equal(t, "too vague", f.Reason)
+
+	f.SetReason(strings.Repeat("é", MaxReasonLength+10))
+	assert.Equal(t, strings.Repeat("é", MaxReasonLength), f.Reason)
+}
@@ -0,0 +1,269 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// versionedLLM is an LLM reporting the version of its prompts
+type versionedLLM struct {
+	*MockLLM
+}
+
+func (versionedLLM) PromptVersion() string {
+	return "v1"
+}
+
+func TestAIService_ProcessMessage_Feedback(t *testing.T) {
+	request := &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: "Is chocolate dangerous for dogs?"}
+
+	tests := []struct {
+		saveErr      error
+		name         string
+		wantAnswerID bool
+	}{
+		{name: "answer is stored for rating", wantAnswerID: true},
+		{name: "storing failure leaves the answer unrated", saveErr: assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := NewMockLLM(t)
+			repo := NewMockConversationRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+			feedbackRepo := NewMockFeedbackRepository(t)
+
+			repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(conversation.NewConversation("chat1"), nil)
+			repo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+			profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+
+			llm.EXPECT().Analyze(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, _ []message.Turn) (*message.LLMResult, error) {
+					budget.Record(ctx, budget.Usage{Model: "main", InputTokens: 200})
+					return &message.LLMResult{Text: "Yes, it is.", Urgency: message.UrgencyInformational}, nil
+				})
+
+			var saved *feedback.Feedback
+
+			feedbackRepo.EXPECT().SaveAnswer(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, f *feedback.Feedback) error {
+					saved = f
+					return tt.saveErr
+				})
+
+			svc := NewAIService(versionedLLM{llm}, repo, profileRepo, nil).WithFeedbackRepository(feedbackRepo)
+
+			resp, err := svc.ProcessMessage(context.Background(), request)
+			require.NoError(t, err)
+
+			require.NotNil(t, saved)
+			assert.Equal(t, "user1", saved.UserID)
+			assert.Equal(t, "chat1", saved.ChatID)
+			assert.Equal(t, request.Text, saved.Question)
+			assert.Equal(t, "Yes, it is.", saved.Answer)
+			assert.Equal(t, "main", saved.Model)
+			assert.Equal(t, "v1", saved.PromptVersion)
+			assert.False(t, saved.Rated())
+
+			if tt.wantAnswerID {
+				assert.Equal(t, saved.ID, resp.AnswerID)
+			} else {
+				assert.Empty(t, resp.AnswerID)
+			}
+		})
+	}
+}
+
+func TestFollowUpQuestion(t *testing.T) {
+	tests := []struct {
+		name  string
+		want  string
+		turns []message.Turn
+	}{
+		{
+			name: "question with follow-up information",
+			turns: []message.Turn{
+				message.NewUserTurn(conversation.SummaryTurnPrefix+"Earlier talk", nil),
+				message.NewUserTurn("My cat is sneezing", nil),
+				message.NewUserTurn(conversation.MediaTurnPrefix+"A cat", nil),
+				message.NewAssistantTurn("How old is your cat?"),
+				message.NewUserTurn("Follow-up information:\nQuestion: How old is your cat?\nAnswer: 2\n", nil),
+			},
+			want: "My cat is sneezing\n\nFollow-up information:\nQuestion: How old is your cat?\nAnswer: 2\n",
+		},
+		{
+			name: "question is not in the history",
+			turns: []message.Turn{
+				message.NewAssistantTurn("How old is your cat?"),
+				message.NewUserTurn("Follow-up information:", nil),
+			},
+			want: "Follow-up information:",
+		},
+		{
+			name: "no turns",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, followUpQuestion(tt.turns))
+		})
+	}
+}
+
+func TestAIService_RateAnswer(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		setupMocks func(repo *MockFeedbackRepository)
+		wantErr    error
+		name       string
+		rating     feedback.Rating
+	}{
+		{
+			name:   "positive rating",
+			rating: feedback.RatingUp,
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user1"}, nil)
+				repo.EXPECT().SaveFeedback(ctx, mock.MatchedBy(func(f *feedback.Feedback) bool {
+					return f.Rating == feedback.RatingUp && !f.RatedAt.IsZero()
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "negative rating waits for the reason",
+			rating: feedback.RatingDown,
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user1"}, nil)
+				repo.EXPECT().SaveFeedback(ctx, mock.MatchedBy(func(f *feedback.Feedback) bool {
+					return f.Rating == feedback.RatingDown
+				})).Return(nil)
+				repo.EXPECT().SetPendingReason(ctx, "user1", "a1").Return(nil)
+			},
+		},
+		{
+			name:   "answer expired",
+			rating: feedback.RatingUp,
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(nil, ErrAnswerNotFound)
+			},
+			wantErr: ErrAnswerNotFound,
+		},
+		{
+			name:   "answer of another user",
+			rating: feedback.RatingUp,
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user2"}, nil)
+			},
+			wantErr: ErrAnswerNotFound,
+		},
+		{
+			name:   "saving fails",
+			rating: feedback.RatingUp,
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user1"}, nil)
+				repo.EXPECT().SaveFeedback(ctx, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockFeedbackRepository(t)
+			tt.setupMocks(repo)
+
+			err := (&AIService{}).WithFeedbackRepository(repo).RateAnswer(ctx, "user1", "a1", tt.rating)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.ErrorIs(t, (&AIService{}).RateAnswer(ctx, "user1", "a1", feedback.RatingUp), ErrFeedbackDisabled)
+}
+
+func TestAIService_AddFeedbackReason(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		setupMocks func(repo *MockFeedbackRepository)
+		wantErr    error
+		name       string
+		want       bool
+	}{
+		{
+			name: "reason is attached",
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().PopPendingReason(ctx, "user1").Return("a1", nil)
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user1", Rating: feedback.RatingDown}, nil)
+				repo.EXPECT().SaveFeedback(ctx, mock.MatchedBy(func(f *feedback.Feedback) bool {
+					return f.Reason == "Too vague"
+				})).Return(nil)
+			},
+			want: true,
+		},
+		{
+			name: "no reason expected",
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().PopPendingReason(ctx, "user1").Return("", ErrAnswerNotFound)
+			},
+		},
+		{
+			name: "answer expired",
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().PopPendingReason(ctx, "user1").Return("a1", nil)
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(nil, ErrAnswerNotFound)
+			},
+		},
+		{
+			name: "pending reason lookup fails",
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().PopPendingReason(ctx, "user1").Return("", assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "saving fails",
+			setupMocks: func(repo *MockFeedbackRepository) {
+				repo.EXPECT().PopPendingReason(ctx, "user1").Return("a1", nil)
+				repo.EXPECT().GetAnswer(ctx, "a1").Return(&feedback.Feedback{ID: "a1", UserID: "user1"}, nil)
+				repo.EXPECT().SaveFeedback(ctx, mock.Anything).Return(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockFeedbackRepository(t)
+			tt.setupMocks(repo)
+
+			got, err := (&AIService{}).WithFeedbackRepository(repo).AddFeedbackReason(ctx, "user1", " Too vague ")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	added, err := (&AIService{}).AddFeedbackReason(ctx, "user1", "Too vague")
+	require.NoError(t, err)
+	assert.False(t, added)
+}
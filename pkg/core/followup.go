@@ -25,7 +25,8 @@ func (s *AIService) ProcessFollowUpAnswer(ctx context.Context, conv Conversation
 	}
 
 	// Save conv after adding answer
-	if err := s.repo.Save(ctx, conv); err != nil {
+	conv, err = s.saveConversation(ctx, conv, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
@@ -40,7 +41,7 @@ func (s *AIService) ProcessFollowUpAnswer(ctx context.Context, conv Conversation
 	}
 
 	// Save conv state
-	if err := s.repo.Save(ctx, conv); err != nil {
+	if _, err := s.saveConversation(ctx, conv, nil); err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
@@ -52,55 +53,69 @@ func (s *AIService) ProcessFollowUpAnswer(ctx context.Context, conv Conversation
 // Saves the updated conversation and returns the generated response.
 // Returns an error if prompt preparation, AI response retrieval, or conversation saving fails.
 func (s *AIService) handleCompletedFollowUp(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
-	// Build prompt with conv history and question-answer pairs
-	prompt, err := s.prepareFollowUpPrompt(ctx, conv, request)
+	// Build turns with conv history and question-answer pairs
+	turns, err := s.prepareFollowUpPrompt(ctx, conv, request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare prompt: %w", err)
 	}
 
 	// Get final response from LLM
-	response, err := s.llm.Report(ctx, prompt)
+	response, err := s.report(ctx, turns)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get AI response: %w", err)
 	}
 
-	// Add AI's response to conv history
+	// Add AI's response to conv history, it is added to the latest conversation if it changed while the LLM was
+	// answering, so a questionnaire started in the meantime is kept
 	conv.AddMessage("assistant", response.Text)
 
-	// Save conv state
-	if err := s.repo.Save(ctx, conv); err != nil {
+	conv, err = s.saveConversation(ctx, conv, func(latest Conversation) error {
+		latest.AddMessage("assistant", response.Text)
+		return nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
-	return message.NewResponse(response.Text, []string{}), nil
+	// Fold messages evicted from the history into the summary in the background once the answer is saved
+	s.summarizeHistory(ctx, conv)
+
+	resp := message.NewResponse(response.Text, []string{})
+	resp.Urgency = response.Urgency
+
+	s.saveAnswer(ctx, request, followUpQuestion(turns), resp)
+
+	return resp, nil
 }
 
-// prepareFollowUpPrompt constructs a detailed prompt for the AI model based on conversation history, pet profiles, and Q&A data.
-// It retrieves any completed Q&A pairs and the relevant pet profile, if available, to enhance the context of the prompt.
-// Returns the prepared prompt string and an error if fetching Q&A pairs, pet profiles, or other context data fails.
-func (s *AIService) prepareFollowUpPrompt(ctx context.Context, conv Conversation, request *message.UserMessage) (string, error) {
+// prepareFollowUpPrompt constructs the turns for the AI model based on conversation history, pet profiles, and Q&A data.
+// The conversation history, ending with the assistant's follow-up questions, is followed by a user turn with
+// the relevant pet profile, if available, and the collected Q&A pairs.
+// Returns the prepared turns and an error if fetching Q&A pairs, pet profiles, or other context data fails.
+func (s *AIService) prepareFollowUpPrompt(ctx context.Context, conv Conversation, request *message.UserMessage) ([]message.Turn, error) {
 	// Get all collected question-answer pairs
 	qaPairs, err := conv.GetQuestionnaireResult()
 	if err != nil {
-		return "", fmt.Errorf("failed to get questionnaire result: %w", err)
+		return nil, fmt.Errorf("failed to get questionnaire result: %w", err)
 	}
 
 	var prompt string
 
-	// Fetch pet profile from repository
-	petProfile, err := s.profileRepo.GetCurrentProfile(ctx, request.UserID)
+	// Fetch profile of the pet the question followed up on is about
+	petProfile, err := s.petProfileFor(ctx, request.UserID, lastQuestion(conv.Turns(0)))
 	if errors.Is(err, ErrProfileNotFound) {
 		// If no profile found, do not include pet profiles in prompt
 	} else if err != nil {
-		return "", fmt.Errorf("failed to fetch pet profiles: %w", err)
+		return nil, fmt.Errorf("failed to fetch pet profiles: %w", err)
 	} else {
 		// Include pet profiles in prompt
-		prompt += fmt.Sprintf("%s\n\n", petProfile.String())
+		prompt += profilePrompt(petProfile)
 	}
 
-	prompt += fmt.Sprintf("%s\nFollow-up information:\n", conv.History(1))
+	prompt += "Follow-up information:\n"
 	for _, qa := range qaPairs {
 		prompt += fmt.Sprintf("Question: %s\nAnswer: %s\n", qa.Question.Text, qa.Answer)
 	}
-	return prompt, nil
+
+	return append(conv.Turns(0), message.NewUserTurn(prompt, nil)), nil
 }
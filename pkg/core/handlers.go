@@ -6,13 +6,24 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
 	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
 )
 
 func (s *AIService) ProcessMessage(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
 	slog.DebugContext(ctx, "getting pet advice", "input", request.Text)
 
+	// Collect token usage of all LLM calls of the request, including follow-up reports and media analysis,
+	// it is priced for spend ceilings and identifies the model of answers stored for feedback
+	if s.budget != nil || s.feedbackRepo != nil {
+		var usage *budget.Recorder
+
+		ctx, usage = budget.WithRecorder(ctx)
+		defer s.recordUsage(ctx, request.UserID, usage)
+	}
+
 	conv, err := s.repo.FindOrCreate(ctx, request.ChatID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get conversation: %w", err)
@@ -21,8 +32,10 @@ func (s *AIService) ProcessMessage(ctx context.Context, request *message.UserMes
 	switch conv.GetState() {
 	case conversation.StateNormal:
 		return s.handleNewQuestion(ctx, conv, request)
-	case conversation.StatePetProfileQuestioning:
+	case conversation.StatePetProfileQuestioning, conversation.StateNewPetQuestioning:
 		return s.ProcessProfileAnswer(ctx, conv, request)
+	case conversation.StateVaccinationQuestioning:
+		return s.ProcessVaccinationAnswer(ctx, conv, request)
 	case conversation.StateFollowUpQuestioning:
 		return s.ProcessFollowUpAnswer(ctx, conv, request)
 	default:
@@ -32,6 +45,7 @@ func (s *AIService) ProcessMessage(ctx context.Context, request *message.UserMes
 
 // CancelQuestionnaire cancels the active questionnaire for the specified chat ID.
 // It retrieves or initializes the conversation, updates its state, and persists the changes to the repository.
+// The cancellation is applied again if another request changed the conversation in the meantime.
 // Returns error if retrieving or saving the conversation fails.
 func (s *AIService) CancelQuestionnaire(ctx context.Context, chatID string) error {
 	conv, err := s.repo.FindOrCreate(ctx, chatID)
@@ -41,7 +55,11 @@ func (s *AIService) CancelQuestionnaire(ctx context.Context, chatID string) erro
 
 	conv.CancelQuestionnaire()
 
-	if err := s.repo.Save(ctx, conv); err != nil {
+	_, err = s.saveConversation(ctx, conv, func(latest Conversation) error {
+		latest.CancelQuestionnaire()
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed to save conversation: %w", err)
 	}
 
@@ -50,88 +68,127 @@ func (s *AIService) CancelQuestionnaire(ctx context.Context, chatID string) erro
 
 // handleNewQuestion processes a new question from the user
 func (s *AIService) handleNewQuestion(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
+	// Check spend ceilings, follow-up reports are not checked so a started questionnaire can always be completed
+	if err := s.checkBudget(ctx, request.UserID); err != nil {
+		return nil, fmt.Errorf("failed to check budget: %w", err)
+	}
+
 	// Check rate limit for new questions
 	if s.rateLimiter != nil {
-		allowed, err := s.rateLimiter.IsNewQuestionAllowed(ctx, request.UserID)
+		allowed, err := s.rateLimiter.AllowNewQuestion(ctx, request.UserID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to check rate limit: %w", err)
 		}
 		if !allowed {
 			return nil, fmt.Errorf("rate limit exceeded for user %s", request.UserID)
 		}
-
-		if err := s.rateLimiter.RecordNewQuestion(ctx, request.UserID); err != nil {
-			return nil, fmt.Errorf("failed to record rate limit: %w", err)
-		}
 	}
 
 	// Add user's question to conv
 	conv.AddMessage("user", request.Text)
 
-	// Save conv immediately after adding user's message
-	if err := s.repo.Save(ctx, conv); err != nil {
+	// Save conv immediately after adding user's message, messages of concurrent requests are kept
+	conv, err := s.saveConversation(ctx, conv, func(latest Conversation) error {
+		latest.AddMessage("user", request.Text)
+		return nil
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
-	// Build prompt with pet profiles and conv context
-	var prompt string
-
-	// Fetch pet profile from repository
-	petProfile, err := s.profileRepo.GetCurrentProfile(ctx, request.UserID)
+	// Fetch profile of the pet the question is about
+	petProfile, err := s.petProfileFor(ctx, request.UserID, request.Text)
 	if errors.Is(err, ErrProfileNotFound) {
 		// If no profile found, do not include pet profiles in prompt
+		petProfile = nil
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to fetch pet profiles: %w", err)
-	} else {
-		// Include pet profiles in prompt
-		prompt += fmt.Sprintf("%s\n\n", petProfile.String())
 	}
 
-	prompt += fmt.Sprintf("%s\nCurrent question: %s", conv.History(1), request.Text)
+	// Build the current request with the pet profile, the conv history is sent as previous turns
+	turns := append(conv.Turns(1), NewQuestionTurn(petProfile, request.Text, request.Images))
 
-	response, err := s.llm.Analyze(ctx, prompt, request.Images)
+	response, err := s.analyze(ctx, turns)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get AI response: %w", err)
 	}
 
-	if response.Media != "" {
-		conv.AddMessage("media_description", response.Media)
-	}
+	// The answer is added to the latest conversation if it changed while the LLM was answering,
+	// follow-up questions are dropped if a questionnaire was started in the meantime, e.g. with /editprofile
+	var questioning bool
 
-	// Add AI's response to conv
-	conv.AddMessage("assistant", response.Text)
+	addAnswer := func(c Conversation) error {
+		if response.Media != "" {
+			c.AddMessage("media_description", response.Media)
+		}
+
+		// Add AI's response to conv
+		c.AddMessage("assistant", response.Text)
+
+		questioning = len(response.Questions) > 0 && c.GetState() == conversation.StateNormal
+		if !questioning {
+			return nil
+		}
 
-	// Handle follow-up questions if any
-	if len(response.Questions) > 0 {
 		// Initialize questionnaire
-		if err := conv.StartFollowUpQuestions(response.Text, response.Questions); err != nil {
-			return nil, fmt.Errorf("failed to start follow-up questions: %w", err)
+		if err := c.StartFollowUpQuestions(response.Text, response.Questions); err != nil {
+			return fmt.Errorf("failed to start follow-up questions: %w", err)
 		}
 
+		return nil
+	}
+
+	if err := addAnswer(conv); err != nil {
+		return nil, err
+	}
+
+	// Save conv state
+	if conv, err = s.saveConversation(ctx, conv, addAnswer); err != nil {
+		return nil, fmt.Errorf("failed to save conversation: %w", err)
+	}
+
+	// Fold messages evicted from the history into the summary in the background once the answer is saved
+	s.summarizeHistory(ctx, conv)
+
+	// Handle follow-up questions if any
+	if questioning {
 		// Get the first question
 		currentQuestion, err := conv.GetCurrentQuestion()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get first question: %w", err)
 		}
 
-		// Save conv state
-		if err := s.repo.Save(ctx, conv); err != nil {
-			return nil, fmt.Errorf("failed to save conversation: %w", err)
-		}
-
 		// Return response with the first question
-		return message.NewResponse(
+		resp := message.NewResponse(
 			response.Text+"\n\n"+currentQuestion.Text,
 			currentQuestion.Answers,
-		), nil
+		)
+		resp.Urgency = response.Urgency
+
+		return resp, nil
 	}
 
-	// Save conv state
-	if err := s.repo.Save(ctx, conv); err != nil {
-		return nil, fmt.Errorf("failed to save conversation: %w", err)
+	resp := message.NewResponse(response.Text, []string{})
+	resp.Urgency = response.Urgency
+
+	s.saveAnswer(ctx, request, request.Text, resp)
+
+	return resp, nil
+}
+
+// NewQuestionTurn builds the user turn asking a new question together with the profile of the pet it is about.
+// profile is nil if the user has no pets. The same turn is used to evaluate prompts offline.
+// Returns the turn following the conversation history in the LLM request.
+func NewQuestionTurn(profile *pet.Profile, question string, images []*message.Image) message.Turn {
+	var prompt string
+
+	if profile != nil {
+		prompt += profilePrompt(profile)
 	}
 
-	return message.NewResponse(response.Text, []string{}), nil
+	prompt += "Current question: " + question
+
+	return message.NewUserTurn(prompt, images)
 }
 
 // ResetUserConversation removes all user profiles and deletes the specified conversation.
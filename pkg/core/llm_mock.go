@@ -24,9 +24,9 @@ func (_m *MockLLM) EXPECT() *MockLLM_Expecter {
 	return &MockLLM_Expecter{mock: &_m.Mock}
 }
 
-// Analyze provides a mock function with given fields: ctx, prompt, imgs
-func (_m *MockLLM) Analyze(ctx context.Context, prompt string, imgs []*message.Image) (*message.LLMResult, error) {
-	ret := _m.Called(ctx, prompt, imgs)
+// Analyze provides a mock function with given fields: ctx, turns
+func (_m *MockLLM) Analyze(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	ret := _m.Called(ctx, turns)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Analyze")
@@ -34,19 +34,19 @@ func (_m *MockLLM) Analyze(ctx context.Context, prompt string, imgs []*message.I
 
 	var r0 *message.LLMResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*message.Image) (*message.LLMResult, error)); ok {
-		return rf(ctx, prompt, imgs)
+	if rf, ok := ret.Get(0).(func(context.Context, []message.Turn) (*message.LLMResult, error)); ok {
+		return rf(ctx, turns)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*message.Image) *message.LLMResult); ok {
-		r0 = rf(ctx, prompt, imgs)
+	if rf, ok := ret.Get(0).(func(context.Context, []message.Turn) *message.LLMResult); ok {
+		r0 = rf(ctx, turns)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*message.LLMResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*message.Image) error); ok {
-		r1 = rf(ctx, prompt, imgs)
+	if rf, ok := ret.Get(1).(func(context.Context, []message.Turn) error); ok {
+		r1 = rf(ctx, turns)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,15 +61,14 @@ type MockLLM_Analyze_Call struct {
 
 // Analyze is a helper method to define mock.On call
 //   - ctx context.Context
-//   - prompt string
-//   - imgs []*message.Image
-func (_e *MockLLM_Expecter) Analyze(ctx interface{}, prompt interface{}, imgs interface{}) *MockLLM_Analyze_Call {
-	return &MockLLM_Analyze_Call{Call: _e.mock.On("Analyze", ctx, prompt, imgs)}
+//   - turns []message.Turn
+func (_e *MockLLM_Expecter) Analyze(ctx interface{}, turns interface{}) *MockLLM_Analyze_Call {
+	return &MockLLM_Analyze_Call{Call: _e.mock.On("Analyze", ctx, turns)}
 }
 
-func (_c *MockLLM_Analyze_Call) Run(run func(ctx context.Context, prompt string, imgs []*message.Image)) *MockLLM_Analyze_Call {
+func (_c *MockLLM_Analyze_Call) Run(run func(ctx context.Context, turns []message.Turn)) *MockLLM_Analyze_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]*message.Image))
+		run(args[0].(context.Context), args[1].([]message.Turn))
 	})
 	return _c
 }
@@ -79,14 +78,14 @@ func (_c *MockLLM_Analyze_Call) Return(_a0 *message.LLMResult, _a1 error) *MockL
 	return _c
 }
 
-func (_c *MockLLM_Analyze_Call) RunAndReturn(run func(context.Context, string, []*message.Image) (*message.LLMResult, error)) *MockLLM_Analyze_Call {
+func (_c *MockLLM_Analyze_Call) RunAndReturn(run func(context.Context, []message.Turn) (*message.LLMResult, error)) *MockLLM_Analyze_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Report provides a mock function with given fields: ctx, request
-func (_m *MockLLM) Report(ctx context.Context, request string) (*message.LLMResult, error) {
-	ret := _m.Called(ctx, request)
+// Report provides a mock function with given fields: ctx, turns
+func (_m *MockLLM) Report(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	ret := _m.Called(ctx, turns)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Report")
@@ -94,19 +93,19 @@ func (_m *MockLLM) Report(ctx context.Context, request string) (*message.LLMResu
 
 	var r0 *message.LLMResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*message.LLMResult, error)); ok {
-		return rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, []message.Turn) (*message.LLMResult, error)); ok {
+		return rf(ctx, turns)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *message.LLMResult); ok {
-		r0 = rf(ctx, request)
+	if rf, ok := ret.Get(0).(func(context.Context, []message.Turn) *message.LLMResult); ok {
+		r0 = rf(ctx, turns)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*message.LLMResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, request)
+	if rf, ok := ret.Get(1).(func(context.Context, []message.Turn) error); ok {
+		r1 = rf(ctx, turns)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,14 +120,14 @@ type MockLLM_Report_Call struct {
 
 // Report is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request string
-func (_e *MockLLM_Expecter) Report(ctx interface{}, request interface{}) *MockLLM_Report_Call {
-	return &MockLLM_Report_Call{Call: _e.mock.On("Report", ctx, request)}
+//   - turns []message.Turn
+func (_e *MockLLM_Expecter) Report(ctx interface{}, turns interface{}) *MockLLM_Report_Call {
+	return &MockLLM_Report_Call{Call: _e.mock.On("Report", ctx, turns)}
 }
 
-func (_c *MockLLM_Report_Call) Run(run func(ctx context.Context, request string)) *MockLLM_Report_Call {
+func (_c *MockLLM_Report_Call) Run(run func(ctx context.Context, turns []message.Turn)) *MockLLM_Report_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].([]message.Turn))
 	})
 	return _c
 }
@@ -138,7 +137,7 @@ func (_c *MockLLM_Report_Call) Return(_a0 *message.LLMResult, _a1 error) *MockLL
 	return _c
 }
 
-func (_c *MockLLM_Report_Call) RunAndReturn(run func(context.Context, string) (*message.LLMResult, error)) *MockLLM_Report_Call {
+func (_c *MockLLM_Report_Call) RunAndReturn(run func(context.Context, []message.Turn) (*message.LLMResult, error)) *MockLLM_Report_Call {
 	_c.Call.Return(run)
 	return _c
 }
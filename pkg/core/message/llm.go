@@ -1,16 +1,18 @@
 package message
 
-// LLMResult represents a structured response from the LLM
+// LLMResult represents a structured response from the LLM.
+// The jsonschema tags describe the fields for providers requesting the response as structured tool input.
 type LLMResult struct {
-	Text      string     `json:"text"`
-	Questions []Question `json:"questions"`
-	Media     string     `json:"media,omitempty"`
-	Reasoning string     `json:"reasoning,omitempty"`
+	Text      string     `json:"text" jsonschema_description:"Plain text response to the user's request, empty when questions are asked instead"`
+	Questions []Question `json:"questions" jsonschema_description:"Follow-up questions when more information is needed, empty when the text response is provided"`
+	Media     string     `json:"media,omitempty" jsonschema:"-"`
+	Reasoning string     `json:"reasoning,omitempty" jsonschema_description:"Step by step reasoning behind the response"`
+	Urgency   Urgency    `json:"urgency,omitempty" jsonschema:"required,enum=emergency,enum=see_vet_soon,enum=monitor,enum=informational" jsonschema_description:"Triage level of the situation"`
 }
 
 // Question represents a follow-up question with optional predefined answers
 type Question struct {
-	Text    string   `json:"text"`
-	Reason  string   `json:"reason,omitempty"`
-	Answers []string `json:"answers,omitempty"`
+	Text    string   `json:"text" jsonschema:"required" jsonschema_description:"Precise question addressing one piece of missing information"`
+	Reason  string   `json:"reason,omitempty" jsonschema_description:"Why the answer is important for the analysis"`
+	Answers []string `json:"answers,omitempty" jsonschema_description:"Expected answers the user can choose from"`
 }
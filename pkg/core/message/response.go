@@ -2,8 +2,10 @@ package message
 
 // Response represents the structured response from the AI service
 type Response struct {
-	Message string   `json:"message"` // Main response message
-	Answers []string `json:"answers"` // Possible answers for the follow-up question
+	Message  string   `json:"message"`             // Main response message
+	Answers  []string `json:"answers"`             // Possible answers for the follow-up question
+	Urgency  Urgency  `json:"urgency,omitempty"`   // Triage level of the concern, empty if not assessed
+	AnswerID string   `json:"answer_id,omitempty"` // ID of the final answer the user can rate, empty if it can't be rated
 }
 
 // NewResponse creates a new Response
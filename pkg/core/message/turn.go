@@ -0,0 +1,36 @@
+package message
+
+// Role defines the author of a conversation turn sent to the LLM.
+type Role string
+
+const (
+	// RoleUser marks turns written by the pet owner, including context provided on their behalf.
+	RoleUser Role = "user"
+	// RoleAssistant marks turns with previous answers of the assistant.
+	RoleAssistant Role = "assistant"
+)
+
+// Turn represents a single message of the conversation sent to the LLM.
+// Images are only supported for user turns.
+type Turn struct {
+	Role    Role
+	Content string
+	Images  []*Image
+}
+
+// NewUserTurn creates a user turn with the given content and optional images.
+func NewUserTurn(content string, imgs []*Image) Turn {
+	return Turn{
+		Role:    RoleUser,
+		Content: content,
+		Images:  imgs,
+	}
+}
+
+// NewAssistantTurn creates an assistant turn with the given content.
+func NewAssistantTurn(content string) Turn {
+	return Turn{
+		Role:    RoleAssistant,
+		Content: content,
+	}
+}
@@ -0,0 +1,48 @@
+package message
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Urgency represents the triage level of a pet health concern assessed by the LLM.
+type Urgency string
+
+const (
+	// UrgencyEmergency means the pet needs immediate veterinary care.
+	UrgencyEmergency Urgency = "emergency"
+	// UrgencySeeVetSoon means the pet should be examined by a veterinarian within a day or two.
+	UrgencySeeVetSoon Urgency = "see_vet_soon"
+	// UrgencyMonitor means the condition can be watched at home, with a vet visit if it worsens.
+	UrgencyMonitor Urgency = "monitor"
+	// UrgencyInformational means the request is not about a health concern, such as nutrition or training questions.
+	UrgencyInformational Urgency = "informational"
+)
+
+// ParseUrgency converts the provided value into a known urgency level.
+// It ignores case and accepts spaces or hyphens instead of underscores, so "See vet soon" is recognized.
+// Returns an empty Urgency if the value is not a known level.
+func ParseUrgency(value string) Urgency {
+	normalized := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(value)))
+
+	switch u := Urgency(normalized); u {
+	case UrgencyEmergency, UrgencySeeVetSoon, UrgencyMonitor, UrgencyInformational:
+		return u
+	default:
+		return ""
+	}
+}
+
+// UnmarshalJSON decodes the urgency level from a JSON string, normalizing it with ParseUrgency.
+// Unknown levels are decoded as an empty Urgency instead of failing the whole response.
+func (u *Urgency) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		*u = ""
+		return nil //nolint:nilerr // urgency is optional, invalid values must not break response parsing
+	}
+
+	*u = ParseUrgency(value)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUrgency(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Urgency
+	}{
+		{value: "emergency", want: UrgencyEmergency},
+		{value: "EMERGENCY", want: UrgencyEmergency},
+		{value: "see_vet_soon", want: UrgencySeeVetSoon},
+		{value: "See vet soon", want: UrgencySeeVetSoon},
+		{value: "see-vet-soon", want: UrgencySeeVetSoon},
+		{value: " monitor ", want: UrgencyMonitor},
+		{value: "informational", want: UrgencyInformational},
+		{value: "critical", want: ""},
+		{value: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseUrgency(tt.value))
+		})
+	}
+}
+
+func TestUrgency_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Urgency
+	}{
+		{name: "known level", data: `{"text": "Go to the vet", "urgency": "Emergency"}`, want: UrgencyEmergency},
+		{name: "unknown level", data: `{"text": "Hi", "urgency": "whatever"}`, want: ""},
+		{name: "invalid type", data: `{"text": "Hi", "urgency": 3}`, want: ""},
+		{name: "missing", data: `{"text": "Hi"}`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result LLMResult
+
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &result))
+			assert.Equal(t, tt.want, result.Urgency)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package pet
 import (
 	"cmp"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 )
 
 const profileTemplate = `
@@ -23,21 +25,173 @@ Food Preferences: %s
 
 // Profile represents a pet's profile information
 type Profile struct {
-	Name            string `json:"name"`
-	Species         string `json:"species"`
-	Breed           string `json:"breed"`
-	DateOfBirth     string `json:"date_of_birth"`
-	Gender          string `json:"gender"`
-	Weight          string `json:"weight"`
-	Neutered        string `json:"neutered,omitempty"`
-	Activity        string `json:"activity,omitempty"`
-	ChronicDiseases string `json:"chronic_diseases,omitempty"`
-	FoodPreferences string `json:"food_preferences,omitempty"`
+	Name            string        `json:"name"`
+	Species         string        `json:"species"`
+	Breed           string        `json:"breed"`
+	DateOfBirth     string        `json:"date_of_birth"`
+	Gender          string        `json:"gender"`
+	Weight          string        `json:"weight"`
+	Neutered        string        `json:"neutered,omitempty"`
+	Activity        string        `json:"activity,omitempty"`
+	ChronicDiseases string        `json:"chronic_diseases,omitempty"`
+	FoodPreferences string        `json:"food_preferences,omitempty"`
+	Vaccinations    []Vaccination `json:"vaccinations,omitempty"`
+	WeightHistory   []WeightEntry `json:"weight_history,omitempty"`
 }
 
 // Profiles represents a collection of pet profiles for a user
 type Profiles struct {
 	Profiles []Profile `json:"profiles"`
+	Active   int       `json:"active"`
+}
+
+// Current returns the active pet profile of the collection.
+// It falls back to the first profile when the stored active index is out of range.
+// Returns nil if the collection is empty.
+func (p *Profiles) Current() *Profile {
+	if len(p.Profiles) == 0 {
+		return nil
+	}
+
+	if p.Active < 0 || p.Active >= len(p.Profiles) {
+		p.Active = 0
+	}
+
+	return &p.Profiles[p.Active]
+}
+
+// Find looks up a pet profile by its name, ignoring case and surrounding whitespace.
+// Returns the index of the matching profile or -1 if there is no pet with such name.
+func (p *Profiles) Find(name string) int {
+	name = strings.TrimSpace(name)
+
+	for i, profile := range p.Profiles {
+		if strings.EqualFold(strings.TrimSpace(profile.Name), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Add appends the provided profile to the collection and makes it the active one.
+// If a pet with the same name already exists, its profile is replaced instead of creating a duplicate,
+// keeping the pet's vaccination records and weight history as ReplaceCurrent does.
+func (p *Profiles) Add(profile Profile) {
+	if i := p.Find(profile.Name); i != -1 {
+		p.Profiles[i] = profile.withHistoryOf(p.Profiles[i])
+		p.Active = i
+
+		return
+	}
+
+	p.Profiles = append(p.Profiles, profile)
+	p.Active = len(p.Profiles) - 1
+}
+
+// ReplaceCurrent overwrites the active pet profile with the provided one.
+// Vaccination records and weight history of the active pet are kept if the provided profile has none,
+// so editing the profile doesn't erase the pet's medical history.
+// If the collection is empty, the profile is added as the first and active pet.
+func (p *Profiles) ReplaceCurrent(profile Profile) {
+	current := p.Current()
+	if current == nil {
+		p.Add(profile)
+		return
+	}
+
+	p.Profiles[p.Active] = profile.withHistoryOf(*current)
+}
+
+// withHistoryOf returns the profile with vaccination records and weight history of the previous profile of the pet
+// if the profile has none.
+func (p Profile) withHistoryOf(previous Profile) Profile {
+	if len(p.Vaccinations) == 0 {
+		p.Vaccinations = previous.Vaccinations
+	}
+
+	if len(p.WeightHistory) == 0 {
+		p.WeightHistory = previous.WeightHistory
+	}
+
+	return p
+}
+
+// Select makes the pet with the given name the active one.
+// Returns false if there is no pet with such name in the collection.
+func (p *Profiles) Select(name string) bool {
+	i := p.Find(name)
+	if i == -1 {
+		return false
+	}
+
+	p.Active = i
+
+	return true
+}
+
+// Update applies fn to the profile of the pet with the given name, the active pet is not changed.
+// Returns false if there is no pet with such name in the collection.
+func (p *Profiles) Update(name string, fn func(profile *Profile)) bool {
+	i := p.Find(name)
+	if i == -1 {
+		return false
+	}
+
+	fn(&p.Profiles[i])
+
+	return true
+}
+
+// Remove deletes the pet with the given name from the collection.
+// The active pet is preserved when possible, otherwise the first pet becomes active.
+// Returns false if there is no pet with such name in the collection.
+func (p *Profiles) Remove(name string) bool {
+	i := p.Find(name)
+	if i == -1 {
+		return false
+	}
+
+	p.Profiles = append(p.Profiles[:i], p.Profiles[i+1:]...)
+
+	switch {
+	case p.Active > i:
+		p.Active--
+	case p.Active == i:
+		p.Active = 0
+	}
+
+	return true
+}
+
+// Mentioned returns the index of the single pet whose name is mentioned in the provided text.
+// Names are matched as whole words ignoring case, so "Max" does not match "maximum".
+// Returns -1 if no pet or more than one pet is mentioned.
+func (p *Profiles) Mentioned(text string) int {
+	normalizedText := " " + normalizeWords(text) + " "
+	found := -1
+
+	for i, profile := range p.Profiles {
+		name := normalizeWords(profile.Name)
+		if name == "" || !strings.Contains(normalizedText, " "+name+" ") {
+			continue
+		}
+
+		if found != -1 {
+			return -1
+		}
+
+		found = i
+	}
+
+	return found
+}
+
+// normalizeWords lowercases the text and joins its words with single spaces, dropping punctuation.
+func normalizeWords(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
 }
 
 // String generates a formatted string representation of the Profile.
@@ -194,3 +194,164 @@ Food Preferences: Not provided
 		})
 	}
 }
+
+func TestProfiles_Current(t *testing.T) {
+	assert.Nil(t, (&Profiles{}).Current())
+
+	profiles := &Profiles{Profiles: []Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1}
+	assert.Equal(t, "Bella", profiles.Current().Name)
+
+	profiles.Active = 5
+	assert.Equal(t, "Max", profiles.Current().Name)
+	assert.Equal(t, 0, profiles.Active)
+}
+
+func TestProfiles_Add(t *testing.T) {
+	profiles := &Profiles{}
+
+	profiles.Add(Profile{Name: "Max", Species: "dog"})
+	profiles.Add(Profile{Name: "Bella", Species: "cat"})
+
+	assert.Len(t, profiles.Profiles, 2)
+	assert.Equal(t, 1, profiles.Active)
+
+	records := []Vaccination{{Name: "Rabies", Date: "2024-01-01"}}
+	weights := []WeightEntry{{Value: 29, Unit: "kg"}}
+	profiles.Profiles[0].Vaccinations = records
+	profiles.Profiles[0].WeightHistory = weights
+
+	profiles.Add(Profile{Name: " max ", Species: "dog", Weight: "30 kg"})
+
+	assert.Len(t, profiles.Profiles, 2)
+	assert.Equal(t, 0, profiles.Active)
+	assert.Equal(t, "30 kg", profiles.Current().Weight)
+	assert.Equal(t, records, profiles.Current().Vaccinations, "history of the pet with the same name is kept")
+	assert.Equal(t, weights, profiles.Current().WeightHistory)
+}
+
+func TestProfiles_ReplaceCurrent(t *testing.T) {
+	profiles := &Profiles{}
+
+	profiles.ReplaceCurrent(Profile{Name: "Max"})
+	assert.Equal(t, []Profile{{Name: "Max"}}, profiles.Profiles)
+
+	profiles.Add(Profile{Name: "Bella"})
+	profiles.ReplaceCurrent(Profile{Name: "Luna"})
+	assert.Equal(t, []Profile{{Name: "Max"}, {Name: "Luna"}}, profiles.Profiles)
+	assert.Equal(t, 1, profiles.Active)
+
+	records := []Vaccination{{Name: "Rabies", Date: "2024-01-01"}}
+	profiles.Profiles[1].Vaccinations = records
+	profiles.ReplaceCurrent(Profile{Name: "Luna", Weight: "4 kg"})
+	assert.Equal(t, records, profiles.Current().Vaccinations)
+}
+
+func TestProfiles_Select(t *testing.T) {
+	profiles := &Profiles{Profiles: []Profile{{Name: "Max"}, {Name: "Bella"}}}
+
+	assert.True(t, profiles.Select("BELLA"))
+	assert.Equal(t, 1, profiles.Active)
+
+	assert.False(t, profiles.Select("Rex"))
+	assert.Equal(t, 1, profiles.Active)
+}
+
+func TestProfiles_Update(t *testing.T) {
+	profiles := &Profiles{Profiles: []Profile{{Name: "Max"}, {Name: "Bella"}}}
+
+	assert.True(t, profiles.Update("bella", func(profile *Profile) {
+		profile.AddVaccination(Vaccination{Name: "Rabies", Date: "2024-05-31"})
+	}))
+	assert.Equal(t, 0, profiles.Active)
+	assert.Len(t, profiles.Profiles[1].Vaccinations, 1)
+	assert.Empty(t, profiles.Profiles[0].Vaccinations)
+
+	assert.False(t, profiles.Update("Rex", func(*Profile) {
+		t.Fatal("no pet is updated")
+	}))
+}
+
+func TestProfiles_Remove(t *testing.T) {
+	tests := []struct {
+		name           string
+		remove         string
+		active         int
+		expectedActive int
+		expectedNames  []string
+		expectedFound  bool
+	}{
+		{
+			name:           "remove pet before active",
+			remove:         "Max",
+			active:         2,
+			expectedActive: 1,
+			expectedNames:  []string{"Bella", "Luna"},
+			expectedFound:  true,
+		},
+		{
+			name:           "remove active pet",
+			remove:         "Luna",
+			active:         2,
+			expectedActive: 0,
+			expectedNames:  []string{"Max", "Bella"},
+			expectedFound:  true,
+		},
+		{
+			name:           "remove pet after active",
+			remove:         "Luna",
+			active:         1,
+			expectedActive: 1,
+			expectedNames:  []string{"Max", "Bella"},
+			expectedFound:  true,
+		},
+		{
+			name:           "unknown pet",
+			remove:         "Rex",
+			active:         1,
+			expectedActive: 1,
+			expectedNames:  []string{"Max", "Bella", "Luna"},
+			expectedFound:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := &Profiles{
+				Profiles: []Profile{{Name: "Max"}, {Name: "Bella"}, {Name: "Luna"}},
+				Active:   tt.active,
+			}
+
+			assert.Equal(t, tt.expectedFound, profiles.Remove(tt.remove))
+			assert.Equal(t, tt.expectedActive, profiles.Active)
+
+			names := make([]string, 0, len(profiles.Profiles))
+			for _, p := range profiles.Profiles {
+				names = append(names, p.Name)
+			}
+
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestProfiles_Mentioned(t *testing.T) {
+	profiles := &Profiles{Profiles: []Profile{{Name: "Max"}, {Name: "Mr Whiskers"}, {Name: ""}}}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{name: "single pet mentioned", text: "Why is max limping?", expected: 0},
+		{name: "multi-word name", text: "Mr. Whiskers doesn't eat", expected: 1},
+		{name: "name as part of a word", text: "What is the maximum dose?", expected: -1},
+		{name: "several pets mentioned", text: "Can Max and Mr Whiskers share food?", expected: -1},
+		{name: "no pets mentioned", text: "How often should I feed a puppy?", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, profiles.Mentioned(tt.text))
+		})
+	}
+}
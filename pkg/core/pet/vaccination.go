@@ -0,0 +1,100 @@
+package pet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const dateFormat = "2006-01-02"
+
+// Vaccination represents a record of a vaccination or a preventive treatment, such as deworming or flea and tick treatment.
+// Dates are stored in the YYYY-MM-DD format; NextDue and Clinic are optional.
+type Vaccination struct {
+	Name    string `json:"name"`
+	Date    string `json:"date"`
+	NextDue string `json:"next_due,omitempty"`
+	Clinic  string `json:"clinic,omitempty"`
+}
+
+// AddVaccination appends the vaccination or preventive treatment record to the pet's medical history.
+func (p *Profile) AddVaccination(v Vaccination) {
+	p.Vaccinations = append(p.Vaccinations, v)
+}
+
+// Latest returns the most recent record for every vaccine or treatment, ignoring case of their names.
+// Older records are superseded by newer ones, e.g. a booster shot replaces the previous dose of the same vaccine.
+// Records are returned in the order their vaccine or treatment first appears in the history.
+func (p Profile) Latest() []Vaccination {
+	latest := make([]Vaccination, 0, len(p.Vaccinations))
+	index := make(map[string]int, len(p.Vaccinations))
+
+	for _, v := range p.Vaccinations {
+		key := strings.ToLower(strings.TrimSpace(v.Name))
+
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(latest)
+			latest = append(latest, v)
+
+			continue
+		}
+
+		if v.Date >= latest[i].Date {
+			latest[i] = v
+		}
+	}
+
+	return latest
+}
+
+// Overdue returns the latest records of vaccines and treatments whose next due date is before the date of now.
+func (p Profile) Overdue(now time.Time) []Vaccination {
+	today := now.Format(dateFormat)
+
+	var overdue []Vaccination
+
+	for _, v := range p.Latest() {
+		if v.NextDue != "" && v.NextDue < today {
+			overdue = append(overdue, v)
+		}
+	}
+
+	return overdue
+}
+
+// PreventiveCare generates a summary of the pet's vaccinations and preventive treatments for the LLM prompt.
+// Each vaccine or treatment is listed once with its latest record, marking the ones that are overdue at now.
+// Returns an empty string if the pet has no records.
+func (p Profile) PreventiveCare(now time.Time) string {
+	latest := p.Latest()
+	if len(latest) == 0 {
+		return ""
+	}
+
+	today := now.Format(dateFormat)
+
+	var sb strings.Builder
+
+	sb.WriteString("Vaccinations and Preventive Treatments:\n")
+
+	for _, v := range latest {
+		fmt.Fprintf(&sb, "- %s: given %s", v.Name, v.Date)
+
+		if v.Clinic != "" {
+			fmt.Fprintf(&sb, " at %s", v.Clinic)
+		}
+
+		switch {
+		case v.NextDue == "":
+		case v.NextDue < today:
+			fmt.Fprintf(&sb, ", next due %s (OVERDUE)", v.NextDue)
+		default:
+			fmt.Fprintf(&sb, ", next due %s", v.NextDue)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,45 @@
+package pet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile_Overdue(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	profile := Profile{
+		Vaccinations: []Vaccination{
+			{Name: "Rabies", Date: "2023-05-01", NextDue: "2024-05-01"},
+			{Name: "Deworming", Date: "2025-01-01", NextDue: "2025-04-01"},
+			{Name: "rabies", Date: "2024-05-02", NextDue: "2027-05-02"},
+			{Name: "Leptospirosis", Date: "2024-06-15", NextDue: "2025-06-15"},
+			{Name: "Microchip check", Date: "2020-01-01"},
+		},
+	}
+
+	assert.Equal(t, []Vaccination{{Name: "Deworming", Date: "2025-01-01", NextDue: "2025-04-01"}}, profile.Overdue(now))
+	assert.Len(t, profile.Latest(), 4)
+	assert.Empty(t, Profile{}.Overdue(now))
+}
+
+func TestProfile_PreventiveCare(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, Profile{}.PreventiveCare(now))
+
+	profile := Profile{}
+	profile.AddVaccination(Vaccination{Name: "Rabies", Date: "2024-05-02", NextDue: "2027-05-02", Clinic: "City Vet"})
+	profile.AddVaccination(Vaccination{Name: "Deworming", Date: "2025-01-01", NextDue: "2025-04-01"})
+	profile.AddVaccination(Vaccination{Name: "Microchip check", Date: "2020-01-01"})
+
+	expected := `Vaccinations and Preventive Treatments:
+- Rabies: given 2024-05-02 at City Vet, next due 2027-05-02
+- Deworming: given 2025-01-01, next due 2025-04-01 (OVERDUE)
+- Microchip check: given 2020-01-01
+`
+
+	assert.Equal(t, expected, profile.PreventiveCare(now))
+}
@@ -0,0 +1,164 @@
+package pet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidWeight is returned when a weight can't be parsed into a positive value with a known unit.
+var ErrInvalidWeight = errors.New("invalid weight")
+
+const (
+	// maxWeightKg limits accepted weights to catch typos
+	maxWeightKg = 1000
+	// trendWindow defines how far back the weight trend looks from the latest entry
+	trendWindow = 180 * 24 * time.Hour
+	// stableThreshold defines the relative change in percent below which the weight is considered stable
+	stableThreshold = 1.0
+)
+
+// kilogramsPerUnit maps the supported weight units to their size in kilograms
+var kilogramsPerUnit = map[string]float64{
+	"kg": 1,
+	"g":  0.001,
+	"lb": 0.45359237,
+	"oz": 0.028349523125,
+}
+
+// unitAliases maps the spellings accepted from users to the supported weight units
+var unitAliases = map[string]string{
+	"kg": "kg", "kgs": "kg", "kilo": "kg", "kilos": "kg", "kilogram": "kg", "kilograms": "kg", "кг": "kg",
+	"g": "g", "gr": "g", "gram": "g", "grams": "g", "г": "g",
+	"lb": "lb", "lbs": "lb", "pound": "lb", "pounds": "lb",
+	"oz": "oz", "ounce": "oz", "ounces": "oz",
+}
+
+var weightPattern = regexp.MustCompile(`^(\d+(?:[.,]\d+)?)\s*(\pL+)$`)
+
+// WeightEntry represents a weight measurement of a pet at a point in time.
+type WeightEntry struct {
+	Time  time.Time `json:"time"`
+	Unit  string    `json:"unit"`
+	Value float64   `json:"value"`
+}
+
+// ParseWeight parses a weight such as "12.4kg", "12,4 kg" or "9 lbs" into a weight entry measured at t.
+// Returns ErrInvalidWeight if the value is not a positive number, the unit is missing or unknown, or the weight is unrealistic.
+func ParseWeight(s string, t time.Time) (WeightEntry, error) {
+	m := weightPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return WeightEntry{}, ErrInvalidWeight
+	}
+
+	unit, ok := unitAliases[m[2]]
+	if !ok {
+		return WeightEntry{}, fmt.Errorf("%w: unknown unit %q", ErrInvalidWeight, m[2])
+	}
+
+	value, err := strconv.ParseFloat(strings.Replace(m[1], ",", ".", 1), 64)
+	if err != nil {
+		return WeightEntry{}, fmt.Errorf("%w: %w", ErrInvalidWeight, err)
+	}
+
+	entry := WeightEntry{Time: t, Unit: unit, Value: value}
+
+	if value <= 0 || entry.Kilograms() > maxWeightKg {
+		return WeightEntry{}, ErrInvalidWeight
+	}
+
+	return entry, nil
+}
+
+// Kilograms returns the weight converted to kilograms.
+func (w WeightEntry) Kilograms() float64 {
+	return w.Value * kilogramsPerUnit[w.Unit]
+}
+
+// In returns the weight converted to the given unit.
+func (w WeightEntry) In(unit string) float64 {
+	return w.Kilograms() / kilogramsPerUnit[unit]
+}
+
+// String formats the weight with its unit, e.g. "12.4 kg".
+func (w WeightEntry) String() string {
+	return strconv.FormatFloat(w.Value, 'f', -1, 64) + " " + w.Unit
+}
+
+// AddWeight records a new weight measurement of the pet, keeping the history ordered by time.
+// The current weight of the profile is updated when the entry is the latest one.
+func (p *Profile) AddWeight(entry WeightEntry) {
+	i, _ := slices.BinarySearchFunc(p.WeightHistory, entry, func(a, b WeightEntry) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	p.WeightHistory = slices.Insert(p.WeightHistory, i, entry)
+
+	if i == len(p.WeightHistory)-1 {
+		p.Weight = entry.String()
+	}
+}
+
+// WeightTrend describes the change of the pet's weight between the latest entry and the earliest entry
+// within the trend window before it, e.g. "lost 8.0% in 2 months (13.5 kg → 12.4 kg)".
+// Returns an empty string if there are not enough entries to calculate the trend.
+func (p Profile) WeightTrend() string {
+	if len(p.WeightHistory) < 2 {
+		return ""
+	}
+
+	latest := p.WeightHistory[len(p.WeightHistory)-1]
+
+	base := latest
+	for _, entry := range p.WeightHistory {
+		if latest.Time.Sub(entry.Time) <= trendWindow {
+			base = entry
+			break
+		}
+	}
+
+	if !base.Time.Before(latest.Time) {
+		return ""
+	}
+
+	change := (latest.Kilograms() - base.Kilograms()) / base.Kilograms() * 100
+	period := formatPeriod(latest.Time.Sub(base.Time))
+	values := fmt.Sprintf("(%s → %s)", base, latest)
+
+	switch {
+	case math.Abs(change) < stableThreshold:
+		return fmt.Sprintf("stable over %s %s", period, values)
+	case change < 0:
+		return fmt.Sprintf("lost %.1f%% in %s %s", -change, period, values)
+	default:
+		return fmt.Sprintf("gained %.1f%% in %s %s", change, period, values)
+	}
+}
+
+// formatPeriod formats the duration in the largest fitting unit of days, weeks or months.
+func formatPeriod(d time.Duration) string {
+	days := int(math.Round(d.Hours() / 24))
+
+	switch {
+	case days < 14:
+		return plural(max(days, 1), "day")
+	case days < 60:
+		return plural(days/7, "week")
+	default:
+		return plural(days/30, "month")
+	}
+}
+
+// plural formats the count with the unit, adding "s" for counts other than one.
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,110 @@
+package pet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWeight(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    WeightEntry
+		wantErr bool
+	}{
+		{name: "kilograms", input: "12.4kg", want: WeightEntry{Time: now, Value: 12.4, Unit: "kg"}},
+		{name: "comma and space", input: "12,4 KG", want: WeightEntry{Time: now, Value: 12.4, Unit: "kg"}},
+		{name: "pounds", input: "9 lbs", want: WeightEntry{Time: now, Value: 9, Unit: "lb"}},
+		{name: "grams", input: "850g", want: WeightEntry{Time: now, Value: 850, Unit: "g"}},
+		{name: "missing unit", input: "12.4", wantErr: true},
+		{name: "unknown unit", input: "12 stones", wantErr: true},
+		{name: "zero", input: "0kg", wantErr: true},
+		{name: "unrealistic", input: "5000kg", wantErr: true},
+		{name: "not a number", input: "heavy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWeight(tt.input, now)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidWeight)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProfile_AddWeight(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	var p Profile
+
+	p.AddWeight(WeightEntry{Time: now, Value: 12.4, Unit: "kg"})
+	p.AddWeight(WeightEntry{Time: now.AddDate(0, -1, 0), Value: 13, Unit: "kg"})
+
+	assert.Equal(t, "12.4 kg", p.Weight)
+	assert.Equal(t, 13.0, p.WeightHistory[0].Value)
+
+	p.AddWeight(WeightEntry{Time: now.AddDate(0, 0, 1), Value: 27, Unit: "lb"})
+
+	assert.Equal(t, "27 lb", p.Weight)
+	assert.InDelta(t, 12.247, p.WeightHistory[2].Kilograms(), 0.001)
+	assert.InDelta(t, 27, p.WeightHistory[2].In("lb"), 0.001)
+}
+
+func TestProfile_WeightTrend(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		want    string
+		history []WeightEntry
+	}{
+		{name: "no history"},
+		{
+			name:    "single entry",
+			history: []WeightEntry{{Time: now, Value: 12, Unit: "kg"}},
+		},
+		{
+			name: "weight loss",
+			history: []WeightEntry{
+				{Time: now.AddDate(0, -8, 0), Value: 20, Unit: "kg"},
+				{Time: now.AddDate(0, -2, 0), Value: 13.5, Unit: "kg"},
+				{Time: now.AddDate(0, -1, 0), Value: 13, Unit: "kg"},
+				{Time: now, Value: 12.42, Unit: "kg"},
+			},
+			want: "lost 8.0% in 2 months (13.5 kg → 12.42 kg)",
+		},
+		{
+			name: "weight gain in other units",
+			history: []WeightEntry{
+				{Time: now.AddDate(0, 0, -21), Value: 4, Unit: "kg"},
+				{Time: now, Value: 9.7, Unit: "lb"},
+			},
+			want: "gained 10.0% in 3 weeks (4 kg → 9.7 lb)",
+		},
+		{
+			name: "stable weight",
+			history: []WeightEntry{
+				{Time: now.AddDate(0, 0, -5), Value: 4, Unit: "kg"},
+				{Time: now, Value: 4.02, Unit: "kg"},
+			},
+			want: "stable over 5 days (4 kg → 4.02 kg)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Profile{WeightHistory: tt.history}.WeightTrend())
+		})
+	}
+}
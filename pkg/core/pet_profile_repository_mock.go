@@ -24,6 +24,54 @@ func (_m *MockPetProfileRepository) EXPECT() *MockPetProfileRepository_Expecter
 	return &MockPetProfileRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddProfile provides a mock function with given fields: ctx, userID, profile
+func (_m *MockPetProfileRepository) AddProfile(ctx context.Context, userID string, profile *pet.Profile) error {
+	ret := _m.Called(ctx, userID, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pet.Profile) error); ok {
+		r0 = rf(ctx, userID, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPetProfileRepository_AddProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProfile'
+type MockPetProfileRepository_AddProfile_Call struct {
+	*mock.Call
+}
+
+// AddProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - profile *pet.Profile
+func (_e *MockPetProfileRepository_Expecter) AddProfile(ctx interface{}, userID interface{}, profile interface{}) *MockPetProfileRepository_AddProfile_Call {
+	return &MockPetProfileRepository_AddProfile_Call{Call: _e.mock.On("AddProfile", ctx, userID, profile)}
+}
+
+func (_c *MockPetProfileRepository_AddProfile_Call) Run(run func(ctx context.Context, userID string, profile *pet.Profile)) *MockPetProfileRepository_AddProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*pet.Profile))
+	})
+	return _c
+}
+
+func (_c *MockPetProfileRepository_AddProfile_Call) Return(_a0 error) *MockPetProfileRepository_AddProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPetProfileRepository_AddProfile_Call) RunAndReturn(run func(context.Context, string, *pet.Profile) error) *MockPetProfileRepository_AddProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCurrentProfile provides a mock function with given fields: ctx, userID
 func (_m *MockPetProfileRepository) GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error) {
 	ret := _m.Called(ctx, userID)
@@ -83,6 +131,113 @@ func (_c *MockPetProfileRepository_GetCurrentProfile_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetProfiles provides a mock function with given fields: ctx, userID
+func (_m *MockPetProfileRepository) GetProfiles(ctx context.Context, userID string) (*pet.Profiles, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfiles")
+	}
+
+	var r0 *pet.Profiles
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pet.Profiles, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pet.Profiles); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pet.Profiles)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPetProfileRepository_GetProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfiles'
+type MockPetProfileRepository_GetProfiles_Call struct {
+	*mock.Call
+}
+
+// GetProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockPetProfileRepository_Expecter) GetProfiles(ctx interface{}, userID interface{}) *MockPetProfileRepository_GetProfiles_Call {
+	return &MockPetProfileRepository_GetProfiles_Call{Call: _e.mock.On("GetProfiles", ctx, userID)}
+}
+
+func (_c *MockPetProfileRepository_GetProfiles_Call) Run(run func(ctx context.Context, userID string)) *MockPetProfileRepository_GetProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPetProfileRepository_GetProfiles_Call) Return(_a0 *pet.Profiles, _a1 error) *MockPetProfileRepository_GetProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPetProfileRepository_GetProfiles_Call) RunAndReturn(run func(context.Context, string) (*pet.Profiles, error)) *MockPetProfileRepository_GetProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProfile provides a mock function with given fields: ctx, userID, name
+func (_m *MockPetProfileRepository) RemoveProfile(ctx context.Context, userID string, name string) error {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPetProfileRepository_RemoveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProfile'
+type MockPetProfileRepository_RemoveProfile_Call struct {
+	*mock.Call
+}
+
+// RemoveProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockPetProfileRepository_Expecter) RemoveProfile(ctx interface{}, userID interface{}, name interface{}) *MockPetProfileRepository_RemoveProfile_Call {
+	return &MockPetProfileRepository_RemoveProfile_Call{Call: _e.mock.On("RemoveProfile", ctx, userID, name)}
+}
+
+func (_c *MockPetProfileRepository_RemoveProfile_Call) Run(run func(ctx context.Context, userID string, name string)) *MockPetProfileRepository_RemoveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPetProfileRepository_RemoveProfile_Call) Return(_a0 error) *MockPetProfileRepository_RemoveProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPetProfileRepository_RemoveProfile_Call) RunAndReturn(run func(context.Context, string, string) error) *MockPetProfileRepository_RemoveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveUserProfiles provides a mock function with given fields: ctx, userID
 func (_m *MockPetProfileRepository) RemoveUserProfiles(ctx context.Context, userID string) error {
 	ret := _m.Called(ctx, userID)
@@ -178,6 +333,103 @@ func (_c *MockPetProfileRepository_SaveProfile_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// SetActiveProfile provides a mock function with given fields: ctx, userID, name
+func (_m *MockPetProfileRepository) SetActiveProfile(ctx context.Context, userID string, name string) error {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActiveProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPetProfileRepository_SetActiveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActiveProfile'
+type MockPetProfileRepository_SetActiveProfile_Call struct {
+	*mock.Call
+}
+
+// SetActiveProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *MockPetProfileRepository_Expecter) SetActiveProfile(ctx interface{}, userID interface{}, name interface{}) *MockPetProfileRepository_SetActiveProfile_Call {
+	return &MockPetProfileRepository_SetActiveProfile_Call{Call: _e.mock.On("SetActiveProfile", ctx, userID, name)}
+}
+
+func (_c *MockPetProfileRepository_SetActiveProfile_Call) Run(run func(ctx context.Context, userID string, name string)) *MockPetProfileRepository_SetActiveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPetProfileRepository_SetActiveProfile_Call) Return(_a0 error) *MockPetProfileRepository_SetActiveProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPetProfileRepository_SetActiveProfile_Call) RunAndReturn(run func(context.Context, string, string) error) *MockPetProfileRepository_SetActiveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, userID, name, fn
+func (_m *MockPetProfileRepository) UpdateProfile(ctx context.Context, userID string, name string, fn func(*pet.Profile)) error {
+	ret := _m.Called(ctx, userID, name, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, func(*pet.Profile)) error); ok {
+		r0 = rf(ctx, userID, name, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPetProfileRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockPetProfileRepository_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+//   - fn func(*pet.Profile)
+func (_e *MockPetProfileRepository_Expecter) UpdateProfile(ctx interface{}, userID interface{}, name interface{}, fn interface{}) *MockPetProfileRepository_UpdateProfile_Call {
+	return &MockPetProfileRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, userID, name, fn)}
+}
+
+func (_c *MockPetProfileRepository_UpdateProfile_Call) Run(run func(ctx context.Context, userID string, name string, fn func(*pet.Profile))) *MockPetProfileRepository_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(func(*pet.Profile)))
+	})
+	return _c
+}
+
+func (_c *MockPetProfileRepository_UpdateProfile_Call) Return(_a0 error) *MockPetProfileRepository_UpdateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPetProfileRepository_UpdateProfile_Call) RunAndReturn(run func(context.Context, string, string, func(*pet.Profile)) error) *MockPetProfileRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPetProfileRepository creates a new instance of MockPetProfileRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPetProfileRepository(t interface {
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+)
+
+// ListPets retrieves all pet profiles of the specified user together with the active pet index.
+// Returns ErrProfileNotFound if the user has no pets, or an error if fetching the profiles fails.
+func (s *AIService) ListPets(ctx context.Context, userID string) (*pet.Profiles, error) {
+	profiles, err := s.profileRepo.GetProfiles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet profiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// SwitchPet makes the pet with the given name the active one, so following questions are answered in its context.
+// Returns ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (s *AIService) SwitchPet(ctx context.Context, userID, name string) error {
+	if err := s.profileRepo.SetActiveProfile(ctx, userID, name); err != nil {
+		return fmt.Errorf("failed to switch active pet: %w", err)
+	}
+
+	return nil
+}
+
+// RemovePet deletes the pet with the given name from the user's profiles.
+// Returns ErrProfileNotFound if the user has no pet with such name, or an error if the removal fails.
+func (s *AIService) RemovePet(ctx context.Context, userID, name string) error {
+	if err := s.profileRepo.RemoveProfile(ctx, userID, name); err != nil {
+		return fmt.Errorf("failed to remove pet: %w", err)
+	}
+
+	return nil
+}
+
+// petProfileFor selects the profile of the pet a question is about.
+// If the question mentions exactly one of the user's pets by name, that pet's profile is used for the question
+// without changing the active pet; otherwise the active pet is used.
+// Returns ErrProfileNotFound if the user has no pets, or an error if fetching profiles fails.
+func (s *AIService) petProfileFor(ctx context.Context, userID, question string) (*pet.Profile, error) {
+	profiles, err := s.profileRepo.GetProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := profiles.Mentioned(question); i != -1 {
+		slog.DebugContext(ctx, "using pet mentioned in question", slog.String("pet", profiles.Profiles[i].Name))
+
+		return &profiles.Profiles[i], nil
+	}
+
+	return profiles.Current(), nil
+}
+
+// profilePrompt formats the pet profile together with its recent weight trend and the summary of its vaccinations
+// and preventive treatments for including into the LLM prompt.
+func profilePrompt(profile *pet.Profile) string {
+	prompt := profile.String()
+
+	if trend := profile.WeightTrend(); trend != "" {
+		prompt += fmt.Sprintf("Weight Trend: %s\n", trend)
+	}
+
+	if care := profile.PreventiveCare(time.Now()); care != "" {
+		prompt += "\n" + care
+	}
+
+	return prompt + "\n\n"
+}
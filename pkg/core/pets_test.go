@@ -0,0 +1,157 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIService_ListPets(t *testing.T) {
+	profileRepo := NewMockPetProfileRepository(t)
+	svc := &AIService{profileRepo: profileRepo}
+
+	profiles := &pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}}
+	profileRepo.EXPECT().GetProfiles(context.Background(), "user1").Return(profiles, nil)
+	profileRepo.EXPECT().GetProfiles(context.Background(), "user2").Return(nil, ErrProfileNotFound)
+
+	got, err := svc.ListPets(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, profiles, got)
+
+	_, err = svc.ListPets(context.Background(), "user2")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestAIService_SwitchPet(t *testing.T) {
+	profileRepo := NewMockPetProfileRepository(t)
+	svc := &AIService{profileRepo: profileRepo}
+
+	profileRepo.EXPECT().SetActiveProfile(context.Background(), "user1", "Bella").Return(nil)
+	profileRepo.EXPECT().SetActiveProfile(context.Background(), "user1", "Rex").Return(ErrProfileNotFound)
+
+	assert.NoError(t, svc.SwitchPet(context.Background(), "user1", "Bella"))
+	assert.ErrorIs(t, svc.SwitchPet(context.Background(), "user1", "Rex"), ErrProfileNotFound)
+}
+
+func TestAIService_RemovePet(t *testing.T) {
+	profileRepo := NewMockPetProfileRepository(t)
+	svc := &AIService{profileRepo: profileRepo}
+
+	profileRepo.EXPECT().RemoveProfile(context.Background(), "user1", "Bella").Return(nil)
+	profileRepo.EXPECT().RemoveProfile(context.Background(), "user1", "Rex").Return(assert.AnError)
+
+	assert.NoError(t, svc.RemovePet(context.Background(), "user1", "Bella"))
+	assert.ErrorIs(t, svc.RemovePet(context.Background(), "user1", "Rex"), assert.AnError)
+}
+
+func TestAIService_petProfileFor(t *testing.T) {
+	tests := []struct {
+		name         string
+		question     string
+		setupMocks   func(repo *MockPetProfileRepository)
+		expectedName string
+		expectedErr  error
+	}{
+		{
+			name:     "active pet is used when no pet mentioned",
+			question: "How often should I brush teeth?",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetProfiles(context.Background(), "user1").
+					Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1}, nil)
+			},
+			expectedName: "Bella",
+		},
+		{
+			name:     "mentioned pet is used without switching active pet",
+			question: "Max is limping, what should I do?",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetProfiles(context.Background(), "user1").
+					Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1}, nil)
+			},
+			expectedName: "Max",
+		},
+		{
+			name:     "no pets",
+			question: "Max is limping",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetProfiles(context.Background(), "user1").Return(nil, ErrProfileNotFound)
+			},
+			expectedErr: ErrProfileNotFound,
+		},
+		{
+			name:     "failed to get profiles",
+			question: "Max is limping",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetProfiles(context.Background(), "user1").Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileRepo := NewMockPetProfileRepository(t)
+			tt.setupMocks(profileRepo)
+
+			svc := &AIService{profileRepo: profileRepo}
+
+			profile, err := svc.petProfileFor(context.Background(), "user1", tt.question)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, profile)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, profile.Name)
+		})
+	}
+}
+
+func TestAIService_prepareFollowUpPrompt_MentionedPet(t *testing.T) {
+	profileRepo := NewMockPetProfileRepository(t)
+	profileRepo.EXPECT().GetProfiles(context.Background(), "user1").
+		Return(&pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1}, nil)
+
+	conv := conversation.NewConversation("chat1")
+	conv.AddMessage("user", "Max is limping, what should I do?")
+	conv.AddMessage("assistant", "Let me ask a few questions.")
+	require.NoError(t, conv.StartFollowUpQuestions("Let me ask a few questions.", []message.Question{{Text: "Since when?"}}))
+
+	_, err := conv.AddQuestionAnswer("Yesterday")
+	require.NoError(t, err)
+
+	svc := &AIService{profileRepo: profileRepo}
+
+	turns, err := svc.prepareFollowUpPrompt(context.Background(), conv, &message.UserMessage{UserID: "user1", ChatID: "chat1"})
+	require.NoError(t, err)
+
+	assert.Contains(t, turns[len(turns)-1].Content, "Name: Max", "follow-up report is about the pet the question mentioned")
+}
+
+func TestProfilePrompt(t *testing.T) {
+	now := time.Now()
+	profile := &pet.Profile{
+		Name: "Max",
+		WeightHistory: []pet.WeightEntry{
+			{Time: now.AddDate(0, -2, 0), Value: 13.5, Unit: "kg"},
+			{Time: now, Value: 12.42, Unit: "kg"},
+		},
+		Vaccinations: []pet.Vaccination{{Name: "Rabies", Date: "2024-05-02"}},
+	}
+
+	prompt := profilePrompt(profile)
+
+	assert.Contains(t, prompt, "Name: Max")
+	assert.Contains(t, prompt, "Weight Trend: lost 8.0% in 2 months (13.5 kg → 12.42 kg)\n")
+	assert.Contains(t, prompt, "- Rabies: given 2024-05-02\n")
+	assert.NotContains(t, profilePrompt(&pet.Profile{Name: "Max"}), "Weight Trend")
+}
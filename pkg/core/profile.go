@@ -16,15 +16,36 @@ import (
 // It retrieves or creates a conversation, starts the questionnaire, and fetches the first question.
 // Returns the first question with possible answers or an error if any retrieval, initialization, or save operation fails.
 func (s *AIService) ProcessEditProfile(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
-	slog.DebugContext(ctx, "managing pet profile", "input", request.Text)
+	return s.startProfileQuestionnaire(ctx, request, false)
+}
+
+// ProcessAddPet initiates a pet profile questionnaire for adding another pet to the user's profiles.
+// Once completed, the new pet is stored next to existing ones and becomes the active pet.
+// Returns the first question with possible answers or an error if any retrieval, initialization, or save operation fails.
+func (s *AIService) ProcessAddPet(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	return s.startProfileQuestionnaire(ctx, request, true)
+}
+
+// startProfileQuestionnaire starts the pet profile questionnaire either for editing the active pet or for adding a new one.
+// Returns the first question with possible answers or an error if any retrieval, initialization, or save operation fails.
+func (s *AIService) startProfileQuestionnaire(ctx context.Context, request *message.UserMessage, newPet bool) (*message.Response, error) {
+	slog.DebugContext(ctx, "managing pet profile", "input", request.Text, "new_pet", newPet)
 
 	conv, err := s.repo.FindOrCreate(ctx, request.ChatID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get conversation: %w", err)
 	}
 
-	// Start pet profile questionnaire
-	if err := conv.StartProfileQuestions(ctx); err != nil {
+	// Start pet profile questionnaire, it is started again if another request changed the conversation meanwhile
+	start := func(c Conversation) error {
+		if newPet {
+			return c.StartNewPetQuestions(ctx)
+		}
+
+		return c.StartProfileQuestions(ctx)
+	}
+
+	if err := start(conv); err != nil {
 		return nil, fmt.Errorf("failed to start profile questions: %w", err)
 	}
 
@@ -35,7 +56,7 @@ func (s *AIService) ProcessEditProfile(ctx context.Context, request *message.Use
 	}
 
 	// Save conv state
-	if err := s.repo.Save(ctx, conv); err != nil {
+	if _, err := s.saveConversation(ctx, conv, start); err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
@@ -49,22 +70,19 @@ func (s *AIService) ProcessEditProfile(ctx context.Context, request *message.Use
 func (s *AIService) ProcessProfileAnswer(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
 	slog.DebugContext(ctx, "managing pet profile", "input", request.Text)
 
+	newPet := conv.GetState() == conversation.StateNewPetQuestioning
+
 	// Add answer to the current question
 	isComplete, err := conv.AddQuestionAnswer(request.Text)
-	switch {
-	case errors.Is(err, message.ErrTextTooLong):
-		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("I apologize, but your message is too long for me to process. Please try to make it shorter and more concise."), nil), nil
-	case errors.Is(err, message.ErrFutureDate):
-		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Provided date cannot be in the future. Please provide a valid date."), nil), nil
-	case errors.Is(err, message.ErrInvalidDates):
-		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Please provide a date in the valid format YYYY-MM-DD (e.g., 2023-12-31)"), nil), nil
-	case err != nil:
+	if resp := invalidAnswerResponse(ctx, err); resp != nil {
+		return resp, nil
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to add question answer: %w", err)
 	}
 
 	// If questionnaire is complete, return success message
 	if isComplete {
-		return s.handleCompletedProfile(ctx, conv, request)
+		return s.handleCompletedProfile(ctx, conv, request, newPet)
 	}
 
 	// Get the next question
@@ -74,7 +92,7 @@ func (s *AIService) ProcessProfileAnswer(ctx context.Context, conv Conversation,
 	}
 
 	// Save conversation state after adding answer
-	if err := s.repo.Save(ctx, conv); err != nil {
+	if _, err := s.saveConversation(ctx, conv, nil); err != nil {
 		return nil, fmt.Errorf("failed to save conversation state: %w", err)
 	}
 
@@ -82,9 +100,10 @@ func (s *AIService) ProcessProfileAnswer(ctx context.Context, conv Conversation,
 }
 
 // handleCompletedProfile finalizes the pet profile questionnaire and saves the profile and conversation state.
-// It retrieves the completed questionnaire results, generates a profile, and stores it in the profile repository.
+// It retrieves the completed questionnaire results, generates a profile, and stores it in the profile repository,
+// either as an additional pet when newPet is true or as a replacement of the active pet otherwise.
 // Returns a success response upon successful save or an error if any retrieval, creation, or save operation fails.
-func (s *AIService) handleCompletedProfile(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
+func (s *AIService) handleCompletedProfile(ctx context.Context, conv Conversation, request *message.UserMessage, newPet bool) (*message.Response, error) {
 	result, err := conv.GetQuestionnaireResult()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get questionnaire result: %w", err)
@@ -96,11 +115,17 @@ func (s *AIService) handleCompletedProfile(ctx context.Context, conv Conversatio
 	}
 
 	// Save conv state
-	if err := s.repo.Save(ctx, conv); err != nil {
+	if _, err := s.saveConversation(ctx, conv, nil); err != nil {
 		return nil, fmt.Errorf("failed to save conversation: %w", err)
 	}
 
-	if err := s.profileRepo.SaveProfile(ctx, request.UserID, &profile); err != nil {
+	if newPet {
+		err = s.profileRepo.AddProfile(ctx, request.UserID, &profile)
+	} else {
+		err = s.profileRepo.SaveProfile(ctx, request.UserID, &profile)
+	}
+
+	if err != nil {
 		return nil, fmt.Errorf("failed to save profile: %w", err)
 	}
 
@@ -141,3 +166,18 @@ func createProfile(result []conversation.QuestionAnswer) (pet.Profile, error) {
 	}
 	return profile, nil
 }
+
+// invalidAnswerResponse converts a validation error of a questionnaire answer into a message asking the user to correct it.
+// Returns nil if err is not a validation error.
+func invalidAnswerResponse(ctx context.Context, err error) *message.Response {
+	switch {
+	case errors.Is(err, message.ErrTextTooLong):
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("I apologize, but your message is too long for me to process. Please try to make it shorter and more concise."), nil)
+	case errors.Is(err, message.ErrFutureDate):
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Provided date cannot be in the future. Please provide a valid date."), nil)
+	case errors.Is(err, message.ErrInvalidDates):
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Please provide a date in the valid format YYYY-MM-DD (e.g., 2023-12-31)"), nil)
+	default:
+		return nil
+	}
+}
@@ -75,6 +75,25 @@ func TestProcessEditProfile(t *testing.T) {
 	}
 }
 
+func TestProcessAddPet(t *testing.T) {
+	mockRepo := NewMockConversationRepository(t)
+
+	conv := conversation.NewConversation("123")
+	mockRepo.EXPECT().FindOrCreate(mock.Anything, "123").Return(conv, nil)
+	mockRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(c *conversation.Conversation) bool {
+		return c.State == conversation.StateNewPetQuestioning
+	})).Return(nil)
+
+	service := &AIService{
+		repo: mockRepo,
+	}
+
+	response, err := service.ProcessAddPet(context.Background(), &message.UserMessage{ChatID: "123", Text: "/addpet"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "What is your pet's name?", response.Message)
+}
+
 func TestProcessProfileAnswer(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -122,6 +141,34 @@ func TestProcessProfileAnswer(t *testing.T) {
 			},
 			expectedText: "Pet profile saved successfully",
 		},
+		{
+			name: "new pet questionnaire completion adds profile",
+			request: &message.UserMessage{
+				ChatID: "123",
+				UserID: "user1",
+				Text:   "Wet food only",
+			},
+			conv: func() *conversation.Conversation {
+				conv := conversation.NewConversation("123")
+				if err := conv.StartNewPetQuestions(context.Background()); err != nil {
+					panic(err)
+				}
+
+				for _, answer := range []string{"Bella", "Cat", "Siamese", "2021-03-01", "Female", "4 kg", "yes", "low", "None"} {
+					_, _ = conv.AddQuestionAnswer(answer)
+				}
+
+				return conv
+			}(),
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				repo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+				profileRepo.EXPECT().AddProfile(mock.Anything, "user1", mock.MatchedBy(func(p *pet.Profile) bool {
+					return p.Name == "Bella" && p.Species == "Cat" && p.FoodPreferences == "Wet food only"
+				})).Return(nil)
+			},
+			expectedText: "Pet profile saved successfully",
+		},
 		{
 			name: "continue questioning",
 			request: &message.UserMessage{
@@ -0,0 +1,287 @@
+package reminder
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidSchedule is returned when the reminder definition can't be parsed.
+	ErrInvalidSchedule = errors.New("invalid reminder schedule")
+	// ErrEmptyText is returned when the reminder definition has no text.
+	ErrEmptyText = errors.New("reminder text is empty")
+)
+
+const maxTextLength = 200
+
+// Unit defines the calendar unit of a reminder interval
+type Unit string
+
+const (
+	UnitHour  Unit = "hour"
+	UnitDay   Unit = "day"
+	UnitWeek  Unit = "week"
+	UnitMonth Unit = "month"
+)
+
+// Schedule defines how often a reminder repeats and for how long.
+// Every and Unit define the interval between reminders; Until is the time after which the reminder stops,
+// zero Until means the reminder repeats until it is deleted.
+type Schedule struct {
+	Until time.Time `json:"until,omitempty"`
+	Unit  Unit      `json:"unit"`
+	Every int       `json:"every"`
+}
+
+// Next calculates the time of the next occurrence after t.
+// Months are added as calendar months, so a monthly reminder keeps its day of month when possible.
+func (s Schedule) Next(t time.Time) time.Time {
+	switch s.Unit {
+	case UnitHour:
+		return t.Add(time.Duration(s.Every) * time.Hour)
+	case UnitWeek:
+		return t.AddDate(0, 0, 7*s.Every)
+	case UnitMonth:
+		return t.AddDate(0, s.Every, 0)
+	default:
+		return t.AddDate(0, 0, s.Every)
+	}
+}
+
+// Reminder represents a recurring reminder about pet care, such as a medication or a flea treatment.
+// NextAt is the time of the next scheduled occurrence; SnoozedUntil, when set, is the time a snoozed
+// reminder is repeated regardless of the schedule. Version is incremented by every save,
+// so concurrent changes of the reminder are detected.
+type Reminder struct {
+	NextAt       time.Time `json:"next_at"`
+	SnoozedUntil time.Time `json:"snoozed_until,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	ChatID       string    `json:"chat_id"`
+	PetName      string    `json:"pet_name,omitempty"`
+	Text         string    `json:"text"`
+	Language     string    `json:"language,omitempty"`
+	Schedule     Schedule  `json:"schedule"`
+	Version      int64     `json:"version,omitempty"`
+}
+
+// DueAt returns the time the reminder should be sent next, taking a snooze into account.
+func (r *Reminder) DueAt() time.Time {
+	if !r.SnoozedUntil.IsZero() && r.SnoozedUntil.Before(r.NextAt) {
+		return r.SnoozedUntil
+	}
+
+	return r.NextAt
+}
+
+// Fire marks the due occurrence of the reminder as sent at now.
+// A snoozed occurrence clears the snooze, a scheduled one moves NextAt to the first occurrence after now.
+func (r *Reminder) Fire(now time.Time) {
+	if !r.SnoozedUntil.IsZero() && !r.SnoozedUntil.After(now) {
+		r.SnoozedUntil = time.Time{}
+
+		if r.NextAt.After(now) {
+			return
+		}
+	}
+
+	for !r.NextAt.After(now) {
+		r.NextAt = r.Schedule.Next(r.NextAt)
+	}
+}
+
+// Snooze postpones the current occurrence of the reminder by d from now.
+func (r *Reminder) Snooze(now time.Time, d time.Duration) {
+	r.SnoozedUntil = now.Add(d)
+}
+
+// Finished reports whether the reminder has no more occurrences to send.
+func (r *Reminder) Finished() bool {
+	return !r.Schedule.Until.IsZero() && r.NextAt.After(r.Schedule.Until) && r.SnoozedUntil.IsZero()
+}
+
+// String returns a short human readable description of the reminder schedule.
+func (s Schedule) String() string {
+	every := fmt.Sprintf("every %d %ss", s.Every, s.Unit)
+	if s.Every == 1 {
+		every = "every " + string(s.Unit)
+	}
+
+	if s.Until.IsZero() {
+		return every
+	}
+
+	return every + " until " + s.Until.Format("2006-01-02 15:04")
+}
+
+var (
+	timesPattern    = regexp.MustCompile(`^(once|twice|\d+ times) (?:a|per) (day|week|month)$`)
+	everyPattern    = regexp.MustCompile(`^every (?:(\d+) ?)?(h|hours?|d|days?|w|weeks?|months?)$`)
+	durationPattern = regexp.MustCompile(`^(\d+) ?(h|hours?|d|days?|w|weeks?|months?)$`)
+)
+
+// Parse creates a reminder definition from free text in the form "<text> <interval> [for <duration>]".
+// The interval can be "every 12h", "every 2 days", "daily", "weekly", "monthly" or "twice a day";
+// the optional duration, such as "for 7 days", limits how long the reminder repeats.
+// The first occurrence is scheduled one interval after now.
+// Returns the text of the reminder and its schedule, or ErrInvalidSchedule if the interval is missing or invalid.
+func Parse(definition string, now time.Time) (string, Schedule, error) {
+	words := strings.Fields(definition)
+	lower := strings.Fields(strings.ToLower(definition))
+
+	var (
+		schedule Schedule
+		duration Schedule
+		found    bool
+	)
+
+	// The duration is optional and placed at the end: "... for 7 days"
+	for i := len(lower) - 1; i >= 0 && i >= len(lower)-3; i-- {
+		if lower[i] != "for" {
+			continue
+		}
+
+		d, ok := parseDuration(strings.Join(lower[i+1:], " "))
+		if !ok {
+			return "", Schedule{}, fmt.Errorf("%w: unknown duration %q", ErrInvalidSchedule, strings.Join(words[i+1:], " "))
+		}
+
+		duration = d
+		words, lower = words[:i], lower[:i]
+
+		break
+	}
+
+	// The interval consists of up to 4 words before the duration: "every 12 hours", "2 times a day"
+	for n := 4; n >= 1 && !found; n-- {
+		if n > len(lower) {
+			continue
+		}
+
+		if s, ok := parseInterval(strings.Join(lower[len(lower)-n:], " ")); ok {
+			schedule = s
+			words = words[:len(words)-n]
+			found = true
+		}
+	}
+
+	if !found {
+		return "", Schedule{}, fmt.Errorf("%w: interval is missing", ErrInvalidSchedule)
+	}
+
+	text := strings.TrimSpace(strings.Join(words, " "))
+	if text == "" {
+		return "", Schedule{}, ErrEmptyText
+	}
+
+	if len([]rune(text)) > maxTextLength {
+		return "", Schedule{}, fmt.Errorf("%w: text is longer than %d characters", ErrInvalidSchedule, maxTextLength)
+	}
+
+	if duration.Every > 0 {
+		schedule.Until = duration.Next(now)
+	}
+
+	return text, schedule, nil
+}
+
+// parseInterval parses the interval part of the reminder definition.
+func parseInterval(s string) (Schedule, bool) {
+	switch s {
+	case "hourly":
+		return Schedule{Every: 1, Unit: UnitHour}, true
+	case "daily", "every day":
+		return Schedule{Every: 1, Unit: UnitDay}, true
+	case "weekly", "every week":
+		return Schedule{Every: 1, Unit: UnitWeek}, true
+	case "monthly", "every month":
+		return Schedule{Every: 1, Unit: UnitMonth}, true
+	}
+
+	if m := everyPattern.FindStringSubmatch(s); m != nil {
+		every := 1
+		if m[1] != "" {
+			every, _ = strconv.Atoi(m[1])
+		}
+
+		if every <= 0 {
+			return Schedule{}, false
+		}
+
+		return Schedule{Every: every, Unit: parseUnit(m[2])}, true
+	}
+
+	if m := timesPattern.FindStringSubmatch(s); m != nil {
+		times := 1
+
+		switch m[1] {
+		case "once":
+		case "twice":
+			times = 2
+		default:
+			times, _ = strconv.Atoi(strings.TrimSuffix(m[1], " times"))
+		}
+
+		return timesPer(times, parseUnit(m[2]))
+	}
+
+	return Schedule{}, false
+}
+
+// timesPer converts "N times per unit" into an interval of whole hours or days.
+func timesPer(times int, unit Unit) (Schedule, bool) {
+	if times <= 0 {
+		return Schedule{}, false
+	}
+
+	hours := map[Unit]int{UnitDay: 24, UnitWeek: 24 * 7, UnitMonth: 24 * 30}[unit]
+
+	if times == 1 {
+		return Schedule{Every: 1, Unit: unit}, true
+	}
+
+	if hours%times != 0 {
+		return Schedule{}, false
+	}
+
+	every := hours / times
+	if every%24 == 0 {
+		return Schedule{Every: every / 24, Unit: UnitDay}, true
+	}
+
+	return Schedule{Every: every, Unit: UnitHour}, true
+}
+
+// parseDuration parses the duration part of the reminder definition, such as "7 days".
+func parseDuration(s string) (Schedule, bool) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Schedule{}, false
+	}
+
+	n, err := strconv.Atoi(m[1])
+	if err != nil || n <= 0 {
+		return Schedule{}, false
+	}
+
+	return Schedule{Every: n, Unit: parseUnit(m[2])}, true
+}
+
+// parseUnit converts the unit abbreviation or name into Unit.
+func parseUnit(s string) Unit {
+	switch {
+	case s == "h" || strings.HasPrefix(s, "hour"):
+		return UnitHour
+	case s == "w" || strings.HasPrefix(s, "week"):
+		return UnitWeek
+	case strings.HasPrefix(s, "month"):
+		return UnitMonth
+	default:
+		return UnitDay
+	}
+}
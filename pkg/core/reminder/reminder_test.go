@@ -0,0 +1,157 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	now := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		definition   string
+		wantText     string
+		wantErr      error
+		wantSchedule Schedule
+	}{
+		{
+			name:         "twice a day for a week",
+			definition:   "give Rimadyl twice a day for 7 days",
+			wantText:     "give Rimadyl",
+			wantSchedule: Schedule{Every: 12, Unit: UnitHour, Until: now.AddDate(0, 0, 7)},
+		},
+		{
+			name:         "monthly",
+			definition:   "Flea treatment monthly",
+			wantText:     "Flea treatment",
+			wantSchedule: Schedule{Every: 1, Unit: UnitMonth},
+		},
+		{
+			name:         "every hours with abbreviation",
+			definition:   "eye drops every 8h for 2w",
+			wantText:     "eye drops",
+			wantSchedule: Schedule{Every: 8, Unit: UnitHour, Until: now.AddDate(0, 0, 14)},
+		},
+		{
+			name:         "every few days",
+			definition:   "clean the litter box every 3 days",
+			wantText:     "clean the litter box",
+			wantSchedule: Schedule{Every: 3, Unit: UnitDay},
+		},
+		{
+			name:         "three times a day",
+			definition:   "feed puppy 3 times a day",
+			wantText:     "feed puppy",
+			wantSchedule: Schedule{Every: 8, Unit: UnitHour},
+		},
+		{
+			name:         "once a week",
+			definition:   "brush teeth once a week for 3 months",
+			wantText:     "brush teeth",
+			wantSchedule: Schedule{Every: 1, Unit: UnitWeek, Until: now.AddDate(0, 3, 0)},
+		},
+		{
+			name:         "every week",
+			definition:   "weigh Max every week",
+			wantText:     "weigh Max",
+			wantSchedule: Schedule{Every: 1, Unit: UnitWeek},
+		},
+		{
+			name:       "missing interval",
+			definition: "give Rimadyl",
+			wantErr:    ErrInvalidSchedule,
+		},
+		{
+			name:       "invalid duration",
+			definition: "give Rimadyl daily for a while",
+			wantErr:    ErrInvalidSchedule,
+		},
+		{
+			name:       "uneven times per day",
+			definition: "give pill 5 times a day",
+			wantErr:    ErrInvalidSchedule,
+		},
+		{
+			name:       "missing text",
+			definition: "daily",
+			wantErr:    ErrEmptyText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, schedule, err := Parse(tt.definition, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantText, text)
+			assert.Equal(t, tt.wantSchedule, schedule)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	start := time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, start.Add(12*time.Hour), Schedule{Every: 12, Unit: UnitHour}.Next(start))
+	assert.Equal(t, start.AddDate(0, 0, 2), Schedule{Every: 2, Unit: UnitDay}.Next(start))
+	assert.Equal(t, start.AddDate(0, 0, 7), Schedule{Every: 1, Unit: UnitWeek}.Next(start))
+	assert.Equal(t, start.AddDate(0, 1, 0), Schedule{Every: 1, Unit: UnitMonth}.Next(start))
+}
+
+func TestSchedule_String(t *testing.T) {
+	until := time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "every day", Schedule{Every: 1, Unit: UnitDay}.String())
+	assert.Equal(t, "every 12 hours until 2024-01-31 08:00", Schedule{Every: 12, Unit: UnitHour, Until: until}.String())
+}
+
+func TestReminder_Lifecycle(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	r := &Reminder{
+		NextAt:   start.Add(12 * time.Hour),
+		Schedule: Schedule{Every: 12, Unit: UnitHour, Until: start.AddDate(0, 0, 1)},
+	}
+
+	assert.Equal(t, start.Add(12*time.Hour), r.DueAt())
+
+	// First occurrence is fired and snoozed for an hour
+	now := start.Add(12 * time.Hour)
+	r.Fire(now)
+	assert.Equal(t, start.Add(24*time.Hour), r.NextAt)
+	assert.False(t, r.Finished())
+
+	r.Snooze(now, time.Hour)
+	assert.Equal(t, now.Add(time.Hour), r.DueAt())
+
+	// Snoozed occurrence doesn't move the schedule
+	now = now.Add(time.Hour)
+	r.Fire(now)
+	assert.True(t, r.SnoozedUntil.IsZero())
+	assert.Equal(t, start.Add(24*time.Hour), r.NextAt)
+
+	// Last occurrence finishes the reminder
+	now = start.Add(24 * time.Hour)
+	r.Fire(now)
+	assert.True(t, r.Finished())
+
+	// Snoozing the last occurrence keeps the reminder until the snooze fires
+	r.Snooze(now, time.Hour)
+	assert.False(t, r.Finished())
+}
+
+func TestReminder_FireSkipsMissedOccurrences(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	r := &Reminder{NextAt: start, Schedule: Schedule{Every: 1, Unit: UnitDay}}
+
+	r.Fire(start.AddDate(0, 0, 3).Add(time.Hour))
+
+	assert.Equal(t, start.AddDate(0, 0, 4), r.NextAt)
+}
@@ -0,0 +1,301 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+)
+
+const (
+	// reminderPollInterval defines how often the scheduler checks for due reminders
+	reminderPollInterval = 30 * time.Second
+	// reminderBatchSize limits the number of reminders sent within a single scheduler run
+	reminderBatchSize = 100
+	// maxRemindersPerUser limits the number of active reminders a user can have
+	maxRemindersPerUser = 20
+	// reminderClaimTTL is the time a claimed reminder isn't sent by other schedulers,
+	// it is sent again after it if the scheduler stops before rescheduling the reminder
+	reminderClaimTTL = 5 * time.Minute
+)
+
+var (
+	// ErrReminderNotFound is returned when a reminder doesn't exist or belongs to another user.
+	ErrReminderNotFound = errors.New("reminder not found")
+	// ErrTooManyReminders is returned when the user reached the limit of active reminders.
+	ErrTooManyReminders = errors.New("too many reminders")
+	// ErrRemindersDisabled is returned when the service is created without a reminder repository.
+	ErrRemindersDisabled = errors.New("reminders are not configured")
+	// ErrReminderConflict is returned when a reminder is saved after it was changed or deleted concurrently.
+	ErrReminderConflict = errors.New("reminder was changed concurrently")
+)
+
+// ReminderRepository defines the interface for reminder storage operations
+type ReminderRepository interface {
+	// SaveReminder creates or updates the reminder and schedules it for its next due time.
+	// The reminder is saved only if the stored version matches its Version, which is incremented then,
+	// otherwise ErrReminderConflict is returned.
+	SaveReminder(ctx context.Context, r *reminder.Reminder) error
+	// GetReminder returns the reminder by its ID, or ErrReminderNotFound.
+	GetReminder(ctx context.Context, id string) (*reminder.Reminder, error)
+	// GetUserReminders returns all reminders of the user.
+	GetUserReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error)
+	// ClaimDueReminders returns up to limit reminders due at or before now and postpones them by ttl,
+	// so they aren't claimed by other schedulers until they are saved or ttl expires.
+	ClaimDueReminders(ctx context.Context, now time.Time, ttl time.Duration, limit int) ([]*reminder.Reminder, error)
+	// DeleteReminder removes the reminder.
+	DeleteReminder(ctx context.Context, r *reminder.Reminder) error
+}
+
+// ReminderNotifier delivers due reminders to users
+type ReminderNotifier interface {
+	NotifyReminder(ctx context.Context, r *reminder.Reminder) error
+}
+
+// WithReminderRepository enables reminders for the service using the provided repository.
+// Returns the service to allow chaining with the constructor.
+func (s *AIService) WithReminderRepository(repo ReminderRepository) *AIService {
+	s.reminderRepo = repo
+	return s
+}
+
+// AddReminder creates a recurring reminder from the definition in the request text, such as "give Rimadyl twice a day for 7 days".
+// The reminder is attached to the pet mentioned in the text or to the active pet of the user.
+// language is used to localize reminder notifications.
+// Returns the created reminder, reminder.ErrInvalidSchedule or reminder.ErrEmptyText if the definition can't be parsed,
+// ErrTooManyReminders if the user reached the limit, or an error if storing the reminder fails.
+func (s *AIService) AddReminder(ctx context.Context, request *message.UserMessage, language string) (*reminder.Reminder, error) {
+	if s.reminderRepo == nil {
+		return nil, ErrRemindersDisabled
+	}
+
+	now := time.Now()
+
+	text, schedule, err := reminder.Parse(request.Text, now)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.reminderRepo.GetUserReminders(ctx, request.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reminders: %w", err)
+	}
+
+	if len(existing) >= maxRemindersPerUser {
+		return nil, ErrTooManyReminders
+	}
+
+	petName, err := s.reminderPetName(ctx, request.UserID, text)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reminder.Reminder{
+		ID:        uuid.New().String(),
+		UserID:    request.UserID,
+		ChatID:    request.ChatID,
+		PetName:   petName,
+		Text:      text,
+		Language:  language,
+		Schedule:  schedule,
+		CreatedAt: now,
+		NextAt:    schedule.Next(now),
+	}
+
+	if err := s.reminderRepo.SaveReminder(ctx, r); err != nil {
+		return nil, fmt.Errorf("failed to save reminder: %w", err)
+	}
+
+	return r, nil
+}
+
+// ListReminders retrieves all active reminders of the user ordered by the time they are due.
+// Returns the reminders or an error if fetching them fails.
+func (s *AIService) ListReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error) {
+	if s.reminderRepo == nil {
+		return nil, ErrRemindersDisabled
+	}
+
+	reminders, err := s.reminderRepo.GetUserReminders(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reminders: %w", err)
+	}
+
+	slices.SortFunc(reminders, func(a, b *reminder.Reminder) int {
+		return a.DueAt().Compare(b.DueAt())
+	})
+
+	return reminders, nil
+}
+
+// CompleteReminder marks the last sent occurrence of the reminder as done, cancelling a pending snooze.
+// Returns ErrReminderNotFound if the reminder doesn't exist or belongs to another user, or an error if the update fails.
+func (s *AIService) CompleteReminder(ctx context.Context, userID, id string) error {
+	r, err := s.userReminder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if r.SnoozedUntil.IsZero() {
+		return nil
+	}
+
+	return s.updateReminder(ctx, r, func(r *reminder.Reminder) {
+		r.SnoozedUntil = time.Time{}
+	})
+}
+
+// SnoozeReminder repeats the last sent occurrence of the reminder after d, without changing its schedule.
+// Returns ErrReminderNotFound if the reminder doesn't exist or belongs to another user, or an error if the update fails.
+func (s *AIService) SnoozeReminder(ctx context.Context, userID, id string, d time.Duration) error {
+	r, err := s.userReminder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.updateReminder(ctx, r, func(r *reminder.Reminder) {
+		r.Snooze(time.Now(), d)
+	})
+}
+
+// DeleteReminder removes the reminder of the user.
+// Returns ErrReminderNotFound if the reminder doesn't exist or belongs to another user, or an error if removal fails.
+func (s *AIService) DeleteReminder(ctx context.Context, userID, id string) error {
+	r, err := s.userReminder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.reminderRepo.DeleteReminder(ctx, r); err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	return nil
+}
+
+// RunReminders periodically sends due reminders through the notifier until ctx is cancelled.
+// After a reminder is sent it is rescheduled to its next occurrence, or removed if it has no more occurrences.
+// Due reminders are claimed before they are sent, so running instances don't send the same reminder.
+// Returns nil when ctx is cancelled or ErrRemindersDisabled if reminders are not configured.
+func (s *AIService) RunReminders(ctx context.Context, notifier ReminderNotifier) error {
+	if s.reminderRepo == nil {
+		return ErrRemindersDisabled
+	}
+
+	ticker := time.NewTicker(reminderPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.sendDueReminders(ctx, notifier, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDueReminders claims reminders due at now, sends and reschedules them.
+// Failures are logged and don't stop processing of the other reminders.
+func (s *AIService) sendDueReminders(ctx context.Context, notifier ReminderNotifier, now time.Time) {
+	due, err := s.reminderRepo.ClaimDueReminders(ctx, now, reminderClaimTTL, reminderBatchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get due reminders", slog.Any("error", err))
+		return
+	}
+
+	for _, r := range due {
+		if err := notifier.NotifyReminder(ctx, r); err != nil {
+			slog.ErrorContext(ctx, "Failed to send reminder", slog.String("reminder_id", r.ID), slog.Any("error", err))
+		}
+
+		err := s.updateReminder(ctx, r, func(r *reminder.Reminder) {
+			r.Fire(now)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to reschedule reminder", slog.String("reminder_id", r.ID), slog.Any("error", err))
+		}
+	}
+}
+
+// updateReminder applies update to the reminder and stores it. If the reminder was changed concurrently,
+// e.g. the user snoozed it while the scheduler was sending it, update is applied again to its latest version.
+// Returns ErrReminderNotFound if the reminder was deleted, ErrReminderConflict if it is still changed concurrently
+// after maxSaveAttempts, or an error if storing the reminder fails.
+func (s *AIService) updateReminder(ctx context.Context, r *reminder.Reminder, update func(r *reminder.Reminder)) error {
+	for attempt := 1; ; attempt++ {
+		update(r)
+
+		err := s.storeReminder(ctx, r)
+		if !errors.Is(err, ErrReminderConflict) || attempt >= maxSaveAttempts {
+			return err
+		}
+
+		if r, err = s.reminderRepo.GetReminder(ctx, r.ID); err != nil {
+			return fmt.Errorf("failed to get reminder: %w", err)
+		}
+	}
+}
+
+// storeReminder saves the reminder, or deletes it if it has no more occurrences.
+func (s *AIService) storeReminder(ctx context.Context, r *reminder.Reminder) error {
+	if r.Finished() {
+		if err := s.reminderRepo.DeleteReminder(ctx, r); err != nil {
+			return fmt.Errorf("failed to delete finished reminder: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := s.reminderRepo.SaveReminder(ctx, r); err != nil {
+		return fmt.Errorf("failed to save reminder: %w", err)
+	}
+
+	return nil
+}
+
+// userReminder fetches the reminder and checks that it belongs to the user.
+func (s *AIService) userReminder(ctx context.Context, userID, id string) (*reminder.Reminder, error) {
+	if s.reminderRepo == nil {
+		return nil, ErrRemindersDisabled
+	}
+
+	r, err := s.reminderRepo.GetReminder(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	if r.UserID != userID {
+		return nil, ErrReminderNotFound
+	}
+
+	return r, nil
+}
+
+// reminderPetName selects the name of the pet the reminder is about: the pet mentioned in the text or the active one.
+// Returns an empty name if the user has no pets.
+func (s *AIService) reminderPetName(ctx context.Context, userID, text string) (string, error) {
+	profiles, err := s.profileRepo.GetProfiles(ctx, userID)
+	if errors.Is(err, ErrProfileNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get pet profiles: %w", err)
+	}
+
+	if i := profiles.Mentioned(text); i != -1 {
+		return profiles.Profiles[i].Name, nil
+	}
+
+	if current := profiles.Current(); current != nil {
+		return current.Name, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,304 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIService_AddReminder(t *testing.T) {
+	profiles := &pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 0}
+
+	tests := []struct {
+		setupMocks  func(repo *MockReminderRepository, profileRepo *MockPetProfileRepository)
+		expectedErr error
+		name        string
+		text        string
+		expectedPet string
+	}{
+		{
+			name: "reminder for active pet",
+			text: "give Rimadyl every 12h for 7 days",
+			setupMocks: func(repo *MockReminderRepository, profileRepo *MockPetProfileRepository) {
+				repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return(nil, nil)
+				profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(profiles, nil)
+				repo.EXPECT().SaveReminder(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedPet: "Max",
+		},
+		{
+			name: "reminder for mentioned pet",
+			text: "Bella flea treatment monthly",
+			setupMocks: func(repo *MockReminderRepository, profileRepo *MockPetProfileRepository) {
+				repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return(nil, nil)
+				profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(profiles, nil)
+				repo.EXPECT().SaveReminder(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedPet: "Bella",
+		},
+		{
+			name: "user without pets",
+			text: "walk the dog daily",
+			setupMocks: func(repo *MockReminderRepository, profileRepo *MockPetProfileRepository) {
+				repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return(nil, nil)
+				profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+				repo.EXPECT().SaveReminder(mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:        "invalid schedule",
+			text:        "give Rimadyl sometimes",
+			setupMocks:  func(_ *MockReminderRepository, _ *MockPetProfileRepository) {},
+			expectedErr: reminder.ErrInvalidSchedule,
+		},
+		{
+			name: "too many reminders",
+			text: "give Rimadyl daily",
+			setupMocks: func(repo *MockReminderRepository, _ *MockPetProfileRepository) {
+				repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return(make([]*reminder.Reminder, maxRemindersPerUser), nil)
+			},
+			expectedErr: ErrTooManyReminders,
+		},
+		{
+			name: "save error",
+			text: "give Rimadyl daily",
+			setupMocks: func(repo *MockReminderRepository, profileRepo *MockPetProfileRepository) {
+				repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return(nil, nil)
+				profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+				repo.EXPECT().SaveReminder(mock.Anything, mock.Anything).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockReminderRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+			svc := (&AIService{profileRepo: profileRepo}).WithReminderRepository(repo)
+
+			tt.setupMocks(repo, profileRepo)
+
+			got, err := svc.AddReminder(context.Background(), &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: tt.text}, "en")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, got.ID)
+			assert.Equal(t, "chat1", got.ChatID)
+			assert.Equal(t, "en", got.Language)
+			assert.Equal(t, tt.expectedPet, got.PetName)
+			assert.True(t, got.NextAt.After(got.CreatedAt))
+		})
+	}
+}
+
+func TestAIService_AddReminder_Disabled(t *testing.T) {
+	svc := &AIService{}
+
+	_, err := svc.AddReminder(context.Background(), &message.UserMessage{UserID: "user1", Text: "give Rimadyl daily"}, "en")
+	assert.ErrorIs(t, err, ErrRemindersDisabled)
+}
+
+func TestAIService_ListReminders(t *testing.T) {
+	repo := NewMockReminderRepository(t)
+	svc := (&AIService{}).WithReminderRepository(repo)
+
+	now := time.Now()
+	later := &reminder.Reminder{ID: "later", NextAt: now.Add(2 * time.Hour)}
+	sooner := &reminder.Reminder{ID: "sooner", NextAt: now.Add(3 * time.Hour), SnoozedUntil: now.Add(time.Hour)}
+
+	repo.EXPECT().GetUserReminders(mock.Anything, "user1").Return([]*reminder.Reminder{later, sooner}, nil)
+
+	got, err := svc.ListReminders(context.Background(), "user1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*reminder.Reminder{sooner, later}, got)
+}
+
+func TestAIService_ReminderActions(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		action      func(svc *AIService) error
+		check       func(t *testing.T, repo *MockReminderRepository)
+		stored      *reminder.Reminder
+		expectedErr error
+		name        string
+	}{
+		{
+			name:   "complete snoozed reminder",
+			stored: &reminder.Reminder{ID: "r1", UserID: "user1", NextAt: now.Add(time.Hour), SnoozedUntil: now.Add(time.Minute)},
+			action: func(svc *AIService) error { return svc.CompleteReminder(context.Background(), "user1", "r1") },
+			check: func(t *testing.T, repo *MockReminderRepository) {
+				repo.EXPECT().SaveReminder(mock.Anything, mock.MatchedBy(func(r *reminder.Reminder) bool {
+					return r.SnoozedUntil.IsZero()
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "complete reminder without snooze",
+			stored: &reminder.Reminder{ID: "r1", UserID: "user1", NextAt: now.Add(time.Hour)},
+			action: func(svc *AIService) error { return svc.CompleteReminder(context.Background(), "user1", "r1") },
+		},
+		{
+			name:   "snooze reminder",
+			stored: &reminder.Reminder{ID: "r1", UserID: "user1", NextAt: now.Add(12 * time.Hour)},
+			action: func(svc *AIService) error { return svc.SnoozeReminder(context.Background(), "user1", "r1", time.Hour) },
+			check: func(t *testing.T, repo *MockReminderRepository) {
+				repo.EXPECT().SaveReminder(mock.Anything, mock.MatchedBy(func(r *reminder.Reminder) bool {
+					return r.DueAt().Before(now.Add(2 * time.Hour))
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "delete reminder",
+			stored: &reminder.Reminder{ID: "r1", UserID: "user1"},
+			action: func(svc *AIService) error { return svc.DeleteReminder(context.Background(), "user1", "r1") },
+			check: func(t *testing.T, repo *MockReminderRepository) {
+				repo.EXPECT().DeleteReminder(mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:        "reminder of another user",
+			stored:      &reminder.Reminder{ID: "r1", UserID: "user2"},
+			action:      func(svc *AIService) error { return svc.DeleteReminder(context.Background(), "user1", "r1") },
+			expectedErr: ErrReminderNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockReminderRepository(t)
+			svc := (&AIService{}).WithReminderRepository(repo)
+
+			repo.EXPECT().GetReminder(mock.Anything, "r1").Return(tt.stored, nil)
+
+			if tt.check != nil {
+				tt.check(t, repo)
+			}
+
+			err := tt.action(svc)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAIService_sendDueReminders(t *testing.T) {
+	now := time.Now()
+	recurring := &reminder.Reminder{ID: "r1", NextAt: now.Add(-time.Minute), Schedule: reminder.Schedule{Unit: reminder.UnitHour, Every: 12}}
+	finished := &reminder.Reminder{ID: "r2", NextAt: now.Add(-time.Minute), Schedule: reminder.Schedule{Unit: reminder.UnitDay, Every: 1, Until: now}}
+
+	repo := NewMockReminderRepository(t)
+	notifier := NewMockReminderNotifier(t)
+	svc := (&AIService{}).WithReminderRepository(repo)
+
+	repo.EXPECT().ClaimDueReminders(mock.Anything, now, reminderClaimTTL, reminderBatchSize).Return([]*reminder.Reminder{recurring, finished}, nil)
+	notifier.EXPECT().NotifyReminder(mock.Anything, recurring).Return(nil)
+	notifier.EXPECT().NotifyReminder(mock.Anything, finished).Return(assert.AnError)
+	repo.EXPECT().SaveReminder(mock.Anything, recurring).Return(nil)
+	repo.EXPECT().DeleteReminder(mock.Anything, finished).Return(nil)
+
+	svc.sendDueReminders(context.Background(), notifier, now)
+
+	assert.True(t, recurring.NextAt.After(now))
+}
+
+func TestAIService_sendDueReminders_Conflict(t *testing.T) {
+	now := time.Now()
+	schedule := reminder.Schedule{Unit: reminder.UnitHour, Every: 12}
+	claimed := &reminder.Reminder{ID: "r1", NextAt: now.Add(-time.Minute), Schedule: schedule, Version: 1}
+	// The user snoozed the previous occurrence while the reminder was being sent
+	snoozed := &reminder.Reminder{ID: "r1", NextAt: now.Add(-time.Minute), SnoozedUntil: now.Add(time.Hour), Schedule: schedule, Version: 2}
+
+	repo := NewMockReminderRepository(t)
+	notifier := NewMockReminderNotifier(t)
+	svc := (&AIService{}).WithReminderRepository(repo)
+
+	repo.EXPECT().ClaimDueReminders(mock.Anything, now, reminderClaimTTL, reminderBatchSize).Return([]*reminder.Reminder{claimed}, nil)
+	notifier.EXPECT().NotifyReminder(mock.Anything, claimed).Return(nil)
+	repo.EXPECT().SaveReminder(mock.Anything, claimed).Return(ErrReminderConflict).Once()
+	repo.EXPECT().GetReminder(mock.Anything, "r1").Return(snoozed, nil).Once()
+	repo.EXPECT().SaveReminder(mock.Anything, snoozed).Return(nil).Once()
+
+	svc.sendDueReminders(context.Background(), notifier, now)
+
+	// The occurrence is rescheduled without losing the snooze
+	assert.True(t, snoozed.NextAt.After(now))
+	assert.Equal(t, now.Add(time.Hour), snoozed.SnoozedUntil)
+}
+
+func TestAIService_updateReminder(t *testing.T) {
+	tests := []struct {
+		setupMocks func(repo *MockReminderRepository, r *reminder.Reminder)
+		wantErr    error
+		name       string
+	}{
+		{
+			name: "saved",
+			setupMocks: func(repo *MockReminderRepository, r *reminder.Reminder) {
+				repo.EXPECT().SaveReminder(mock.Anything, r).Return(nil).Once()
+			},
+		},
+		{
+			name: "deleted concurrently",
+			setupMocks: func(repo *MockReminderRepository, r *reminder.Reminder) {
+				repo.EXPECT().SaveReminder(mock.Anything, r).Return(ErrReminderConflict).Once()
+				repo.EXPECT().GetReminder(mock.Anything, "r1").Return(nil, ErrReminderNotFound).Once()
+			},
+			wantErr: ErrReminderNotFound,
+		},
+		{
+			name: "conflicts exceed save attempts",
+			setupMocks: func(repo *MockReminderRepository, r *reminder.Reminder) {
+				repo.EXPECT().SaveReminder(mock.Anything, mock.Anything).Return(ErrReminderConflict).Times(maxSaveAttempts)
+				repo.EXPECT().GetReminder(mock.Anything, "r1").Return(&reminder.Reminder{ID: "r1"}, nil).Times(maxSaveAttempts - 1)
+			},
+			wantErr: ErrReminderConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reminder.Reminder{ID: "r1"}
+
+			repo := NewMockReminderRepository(t)
+			tt.setupMocks(repo, r)
+
+			svc := (&AIService{}).WithReminderRepository(repo)
+
+			err := svc.updateReminder(context.Background(), r, func(r *reminder.Reminder) {
+				r.Text = "updated"
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "updated", r.Text)
+		})
+	}
+}
+
+func TestAIService_RunReminders_Disabled(t *testing.T) {
+	svc := &AIService{}
+
+	assert.ErrorIs(t, svc.RunReminders(context.Background(), NewMockReminderNotifier(t)), ErrRemindersDisabled)
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+)
+
+// ErrBudgetDisabled is returned when the service is created without a budget tracker.
+var ErrBudgetDisabled = errors.New("budget is not configured")
+
+// BudgetTracker defines the interface for accounting LLM spend against the configured budget
+type BudgetTracker interface {
+	// GetSpend returns the current daily and monthly spend of the user and of all users, with the configured ceilings.
+	GetSpend(ctx context.Context, userID string) (*budget.Spend, error)
+	// RecordUsage prices the token usage and adds it to the spend of the user and of all users.
+	RecordUsage(ctx context.Context, userID string, usage []budget.Usage) error
+	// ResetUserSpend clears the spend of the user, the global spend is kept.
+	ResetUserSpend(ctx context.Context, userID string) error
+}
+
+// WithBudgetTracker enables spend accounting and spend ceilings for the service using the provided tracker.
+// Returns the service to allow chaining with the constructor.
+func (s *AIService) WithBudgetTracker(tracker BudgetTracker) *AIService {
+	s.budget = tracker
+	return s
+}
+
+// GetSpend returns the current daily and monthly spend of the user and of all users.
+// Returns ErrBudgetDisabled if the service has no budget tracker, or an error if fetching the spend fails.
+func (s *AIService) GetSpend(ctx context.Context, userID string) (*budget.Spend, error) {
+	if s.budget == nil {
+		return nil, ErrBudgetDisabled
+	}
+
+	spend, err := s.budget.GetSpend(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend: %w", err)
+	}
+
+	return spend, nil
+}
+
+// checkBudget verifies that neither the global nor the user's spend ceilings are reached.
+// It does nothing if the service has no budget tracker.
+// Returns ErrGlobalLimit if a global ceiling is reached, ErrUserBudget if a ceiling of the user is reached,
+// or an error if fetching the spend fails.
+func (s *AIService) checkBudget(ctx context.Context, userID string) error {
+	if s.budget == nil {
+		return nil
+	}
+
+	spend, err := s.budget.GetSpend(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get spend: %w", err)
+	}
+
+	if spend.GlobalExceeded() {
+		return ErrGlobalLimit
+	}
+
+	if spend.UserExceeded() {
+		return ErrUserBudget
+	}
+
+	return nil
+}
+
+// recordUsage adds the token usage collected by the recorder to the spend of the user.
+// It is recorded even if the request was cancelled, as the consumed tokens are billed anyway.
+// It does nothing if the service has no budget tracker.
+// Failures are logged, so accounting problems don't fail the user's request.
+func (s *AIService) recordUsage(ctx context.Context, userID string, rec *budget.Recorder) {
+	if s.budget == nil {
+		return
+	}
+
+	usage := rec.Usage()
+	if len(usage) == 0 {
+		return
+	}
+
+	if err := s.budget.RecordUsage(context.WithoutCancel(ctx), userID, usage); err != nil {
+		slog.ErrorContext(ctx, "Failed to record LLM usage", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIService_ProcessMessage_Budget(t *testing.T) {
+	request := &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: "Is chocolate dangerous for dogs?"}
+
+	tests := []struct {
+		setupMocks func(llm *MockLLM, tracker *MockBudgetTracker)
+		wantErr    error
+		name       string
+	}{
+		{
+			name: "usage of the request is recorded",
+			setupMocks: func(llm *MockLLM, tracker *MockBudgetTracker) {
+				tracker.EXPECT().GetSpend(mock.Anything, "user1").Return(&budget.Spend{GlobalDailyLimit: 10, GlobalDaily: 1}, nil)
+				llm.EXPECT().Analyze(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, _ []message.Turn) (*message.LLMResult, error) {
+						budget.Record(ctx, budget.Usage{Model: "media", InputTokens: 100})
+						budget.Record(ctx, budget.Usage{Model: "main", InputTokens: 200, OutputTokens: 50})
+
+						return &message.LLMResult{Text: "Yes, it is."}, nil
+					})
+				tracker.EXPECT().RecordUsage(mock.Anything, "user1", []budget.Usage{
+					{Model: "media", InputTokens: 100},
+					{Model: "main", InputTokens: 200, OutputTokens: 50},
+				}).Return(nil)
+			},
+		},
+		{
+			name: "recording failure does not fail the request",
+			setupMocks: func(llm *MockLLM, tracker *MockBudgetTracker) {
+				tracker.EXPECT().GetSpend(mock.Anything, "user1").Return(&budget.Spend{}, nil)
+				llm.EXPECT().Analyze(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, _ []message.Turn) (*message.LLMResult, error) {
+						budget.Record(ctx, budget.Usage{Model: "main", InputTokens: 200})
+						return &message.LLMResult{Text: "Yes, it is."}, nil
+					})
+				tracker.EXPECT().RecordUsage(mock.Anything, "user1", mock.Anything).Return(assert.AnError)
+			},
+		},
+		{
+			name: "global budget exhausted",
+			setupMocks: func(_ *MockLLM, tracker *MockBudgetTracker) {
+				tracker.EXPECT().GetSpend(mock.Anything, "user1").Return(&budget.Spend{GlobalMonthlyLimit: 100, GlobalMonthly: 100}, nil)
+			},
+			wantErr: ErrGlobalLimit,
+		},
+		{
+			name: "user budget exhausted",
+			setupMocks: func(_ *MockLLM, tracker *MockBudgetTracker) {
+				tracker.EXPECT().GetSpend(mock.Anything, "user1").Return(&budget.Spend{UserDailyLimit: 1, UserDaily: 1.2}, nil)
+			},
+			wantErr: ErrUserBudget,
+		},
+		{
+			name: "spend lookup fails",
+			setupMocks: func(_ *MockLLM, tracker *MockBudgetTracker) {
+				tracker.EXPECT().GetSpend(mock.Anything, "user1").Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := NewMockLLM(t)
+			tracker := NewMockBudgetTracker(t)
+			repo := NewMockConversationRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+
+			tt.setupMocks(llm, tracker)
+
+			repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(conversation.NewConversation("chat1"), nil)
+			repo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Maybe()
+			profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound).Maybe()
+
+			svc := NewAIService(llm, repo, profileRepo, nil).WithBudgetTracker(tracker)
+
+			resp, err := svc.ProcessMessage(context.Background(), request)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "Yes, it is.", resp.Message)
+		})
+	}
+}
+
+func TestAIService_GetSpend(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewAIService(NewMockLLM(t), nil, nil, nil).GetSpend(ctx, "user1")
+	assert.ErrorIs(t, err, ErrBudgetDisabled)
+
+	tracker := NewMockBudgetTracker(t)
+	tracker.EXPECT().GetSpend(ctx, "user1").Return(&budget.Spend{UserDaily: 0.5}, nil)
+
+	spend, err := NewAIService(NewMockLLM(t), nil, nil, nil).WithBudgetTracker(tracker).GetSpend(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, spend.UserDaily)
+
+	tracker.EXPECT().GetSpend(ctx, "user2").Return(nil, assert.AnError)
+
+	_, err = NewAIService(NewMockLLM(t), nil, nil, nil).WithBudgetTracker(tracker).GetSpend(ctx, "user2")
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+// StreamingLLM is implemented by LLM providers able to stream the answer text while it is being generated.
+// onText receives the full answer text generated so far every time it grows.
+type StreamingLLM interface {
+	AnalyzeStream(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error)
+	ReportStream(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error)
+}
+
+// streamHandlerKey is the context key holding the callback receiving streamed answer text.
+type streamHandlerKey struct{}
+
+// ProcessMessageStream processes the user's message like ProcessMessage, but streams the answer text to onText
+// while it is generated, if the configured LLM supports streaming.
+// onText receives the full answer text generated so far, the final response is still returned once processing completes.
+// Returns the final response or an error if processing fails.
+func (s *AIService) ProcessMessageStream(ctx context.Context, request *message.UserMessage, onText func(string)) (*message.Response, error) {
+	if onText != nil {
+		ctx = context.WithValue(ctx, streamHandlerKey{}, onText)
+	}
+
+	return s.ProcessMessage(ctx, request)
+}
+
+// analyze requests the analysis of the conversation turns from the LLM, streaming the answer text when the request
+// was started with ProcessMessageStream and the LLM supports streaming.
+// Returns the LLM result or an error if the LLM call fails.
+func (s *AIService) analyze(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	if streamLLM, onText, ok := s.streamer(ctx); ok {
+		result, err := streamLLM.AnalyzeStream(ctx, turns, onText)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stream analysis: %w", err)
+		}
+
+		return result, nil
+	}
+
+	return s.llm.Analyze(ctx, turns)
+}
+
+// report requests the final report for the conversation turns from the LLM, streaming the answer text when the request
+// was started with ProcessMessageStream and the LLM supports streaming.
+// Returns the LLM result or an error if the LLM call fails.
+func (s *AIService) report(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	if streamLLM, onText, ok := s.streamer(ctx); ok {
+		result, err := streamLLM.ReportStream(ctx, turns, onText)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stream report: %w", err)
+		}
+
+		return result, nil
+	}
+
+	return s.llm.Report(ctx, turns)
+}
+
+// streamer returns the streaming capable LLM and the stream callback of the request.
+// Returns false if the request is not streamed or the LLM does not support streaming.
+func (s *AIService) streamer(ctx context.Context) (StreamingLLM, func(string), bool) {
+	onText, ok := ctx.Value(streamHandlerKey{}).(func(string))
+	if !ok {
+		return nil, nil, false
+	}
+
+	streamLLM, ok := s.llm.(StreamingLLM)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return streamLLM, onText, true
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// streamingLLM combines LLM and StreamingLLM mocks to imitate a provider supporting streaming.
+type streamingLLM struct {
+	*MockLLM
+	*MockStreamingLLM
+}
+
+func TestAIService_ProcessMessageStream(t *testing.T) {
+	mockLLM := NewMockLLM(t)
+	mockStreamLLM := NewMockStreamingLLM(t)
+	mockRepo := NewMockConversationRepository(t)
+	mockProfileRepo := NewMockPetProfileRepository(t)
+
+	svc := NewAIService(streamingLLM{MockLLM: mockLLM, MockStreamingLLM: mockStreamLLM}, mockRepo, mockProfileRepo, nil)
+
+	conv := conversation.NewConversation("chat123")
+	mockRepo.EXPECT().FindOrCreate(mock.Anything, "chat123").Return(conv, nil)
+	mockRepo.EXPECT().Save(mock.Anything, conv).Return(nil)
+	mockProfileRepo.EXPECT().GetProfiles(mock.Anything, "user123").Return(nil, ErrProfileNotFound)
+	mockStreamLLM.EXPECT().AnalyzeStream(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ []message.Turn, onText func(string)) (*message.LLMResult, error) {
+			onText("Give")
+			onText("Give water")
+
+			return &message.LLMResult{Text: "Give water", Urgency: message.UrgencyMonitor}, nil
+		})
+
+	var streamed []string
+
+	resp, err := svc.ProcessMessageStream(context.Background(), &message.UserMessage{
+		UserID: "user123",
+		ChatID: "chat123",
+		Text:   "My cat is thirsty",
+	}, func(text string) {
+		streamed = append(streamed, text)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Give water", resp.Message)
+	assert.Equal(t, message.UrgencyMonitor, resp.Urgency)
+	assert.Equal(t, []string{"Give", "Give water"}, streamed)
+}
+
+func TestAIService_AnalyzeAndReportStreaming(t *testing.T) {
+	onText := func(string) {}
+	turns := []message.Turn{message.NewUserTurn("prompt", nil)}
+	streamCtx := context.WithValue(context.Background(), streamHandlerKey{}, onText)
+
+	t.Run("streams when requested and supported", func(t *testing.T) {
+		mockLLM := NewMockLLM(t)
+		mockStreamLLM := NewMockStreamingLLM(t)
+		svc := &AIService{llm: streamingLLM{MockLLM: mockLLM, MockStreamingLLM: mockStreamLLM}}
+
+		mockStreamLLM.EXPECT().AnalyzeStream(streamCtx, turns, mock.Anything).Return(&message.LLMResult{Text: "analysis"}, nil)
+		mockStreamLLM.EXPECT().ReportStream(streamCtx, turns, mock.Anything).Return(nil, assert.AnError)
+
+		result, err := svc.analyze(streamCtx, turns)
+		require.NoError(t, err)
+		assert.Equal(t, "analysis", result.Text)
+
+		_, err = svc.report(streamCtx, turns)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("regular calls without stream handler", func(t *testing.T) {
+		mockLLM := NewMockLLM(t)
+		svc := &AIService{llm: streamingLLM{MockLLM: mockLLM, MockStreamingLLM: NewMockStreamingLLM(t)}}
+
+		mockLLM.EXPECT().Report(context.Background(), turns).Return(&message.LLMResult{Text: "report"}, nil)
+
+		result, err := svc.report(context.Background(), turns)
+		require.NoError(t, err)
+		assert.Equal(t, "report", result.Text)
+	})
+
+	t.Run("regular calls when llm does not support streaming", func(t *testing.T) {
+		mockLLM := NewMockLLM(t)
+		svc := &AIService{llm: mockLLM}
+
+		mockLLM.EXPECT().Analyze(streamCtx, turns).Return(&message.LLMResult{Text: "analysis"}, nil)
+
+		result, err := svc.analyze(streamCtx, turns)
+		require.NoError(t, err)
+		assert.Equal(t, "analysis", result.Text)
+	})
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+)
+
+// summaryTimeout limits the summarization running in the background after the answer is returned
+const summaryTimeout = 2 * time.Minute
+
+// Summarizer is implemented by LLM providers able to fold conversation messages into a running summary,
+// usually with a cheaper model than the one answering questions.
+type Summarizer interface {
+	Summarize(ctx context.Context, summary, messages string) (string, error)
+}
+
+// summarizeHistory folds messages evicted from the history of the saved conversation into the conversation summary,
+// so context of long running discussions, e.g. multi-day health issues, is kept in the prompt.
+// Summarization runs in the background after the answer is saved, so the answer isn't delayed by it, and is limited
+// by summaryTimeout. The summary is merged into the latest version of the conversation, unless another request
+// already changed the summary meanwhile.
+// It does nothing if the LLM doesn't support summarization or there are no evicted messages.
+// Failures are logged and the messages are kept to be summarized with the next request.
+func (s *AIService) summarizeHistory(ctx context.Context, conv Conversation) {
+	summarizer, ok := s.llm.(Summarizer)
+	if !ok {
+		return
+	}
+
+	summary, pending := conv.PendingSummary()
+	if len(pending) == 0 {
+		return
+	}
+
+	pending = slices.Clone(pending)
+	id := conv.GetID()
+
+	s.summaries.Add(1)
+
+	go func() {
+		defer s.summaries.Done()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), summaryTimeout)
+		defer cancel()
+
+		s.foldSummary(ctx, summarizer, id, summary, pending)
+	}()
+}
+
+// foldSummary summarizes the pending messages of the conversation with the ID together with its summary
+// and saves the updated summary into the latest version of the conversation.
+// Failures are logged, the summary isn't saved if the conversation changed its summary or pending messages meanwhile.
+func (s *AIService) foldSummary(ctx context.Context, summarizer Summarizer, id, summary string, pending []conversation.Message) {
+	var messages strings.Builder
+	for _, msg := range pending {
+		fmt.Fprintf(&messages, "%s: %s\n", msg.Role, msg.Content)
+	}
+
+	updated, err := summarizer.Summarize(ctx, summary, messages.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to summarize conversation", slog.Any("error", err))
+		return
+	}
+
+	setSummary := func(c Conversation) error {
+		current, unsummarized := c.PendingSummary()
+		if current != summary || !isPrefix(pending, unsummarized) {
+			return ErrConversationConflict
+		}
+
+		c.SetSummary(updated, len(pending))
+
+		return nil
+	}
+
+	conv, err := s.repo.FindOrCreate(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get conversation to save its summary", slog.Any("error", err))
+		return
+	}
+
+	if err := setSummary(conv); err != nil {
+		slog.DebugContext(ctx, "Conversation summary changed meanwhile", slog.String("chat_id", id))
+		return
+	}
+
+	if _, err := s.saveConversation(ctx, conv, setSummary); err != nil {
+		slog.WarnContext(ctx, "Failed to save conversation summary", slog.Any("error", err))
+	}
+}
+
+// isPrefix reports whether the messages start with the prefix messages.
+func isPrefix(prefix, messages []conversation.Message) bool {
+	if len(prefix) > len(messages) {
+		return false
+	}
+
+	for i, msg := range prefix {
+		if msg.Role != messages[i].Role || msg.Content != messages[i].Content || !msg.Timestamp.Equal(messages[i].Timestamp) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,220 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// summarizingLLM combines LLM and Summarizer mocks to imitate a provider supporting summarization.
+type summarizingLLM struct {
+	*MockLLM
+	*MockSummarizer
+}
+
+// newLongConversation creates a conversation with the summary and the number of user messages.
+func newLongConversation(summary string, messages int) *conversation.Conversation {
+	conv := conversation.NewConversation("chat1")
+	conv.Summary = summary
+
+	for i := 0; i < messages; i++ {
+		conv.AddMessage("user", fmt.Sprintf("Text %d", i))
+	}
+
+	return conv
+}
+
+// cloneConversation returns a copy of the conversation as it is loaded from a repository.
+func cloneConversation(t *testing.T, conv *conversation.Conversation) *conversation.Conversation {
+	t.Helper()
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	clone, err := conversation.Unmarshal(data)
+	require.NoError(t, err)
+
+	return clone
+}
+
+func TestAIService_summarizeHistory(t *testing.T) {
+	conflict := fmt.Errorf("failed to save conversation: %w", ErrConversationConflict)
+
+	tests := []struct {
+		setupMocks func(s *MockSummarizer, repo *MockConversationRepository, conv *conversation.Conversation)
+		name       string
+		messages   int
+	}{
+		{
+			name:     "nothing to summarize",
+			messages: conversation.MaxMessageHistory,
+		},
+		{
+			name:     "evicted messages are folded into summary",
+			messages: conversation.MaxMessageHistory + 2,
+			setupMocks: func(s *MockSummarizer, repo *MockConversationRepository, conv *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\nuser: Text 1\n").Return("Updated summary", nil)
+
+				latest := cloneConversation(t, conv)
+
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil)
+				repo.EXPECT().Save(mock.Anything, latest).RunAndReturn(func(_ context.Context, c Conversation) error {
+					summary, pending := c.PendingSummary()
+					assert.Equal(t, "Updated summary", summary)
+					assert.Empty(t, pending)
+
+					return nil
+				})
+			},
+		},
+		{
+			name:     "summary is merged into conversation changed meanwhile",
+			messages: conversation.MaxMessageHistory + 1,
+			setupMocks: func(s *MockSummarizer, repo *MockConversationRepository, conv *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\n").Return("Updated summary", nil)
+
+				latest := cloneConversation(t, conv)
+				latest.AddMessage("user", "Another question")
+
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil)
+				repo.EXPECT().Save(mock.Anything, latest).RunAndReturn(func(_ context.Context, c Conversation) error {
+					summary, pending := c.PendingSummary()
+					assert.Equal(t, "Updated summary", summary)
+					require.Len(t, pending, 1, "messages evicted meanwhile are summarized later")
+					assert.Equal(t, "Text 1", pending[0].Content)
+
+					return nil
+				})
+			},
+		},
+		{
+			name:     "summary is merged again on save conflict",
+			messages: conversation.MaxMessageHistory + 1,
+			setupMocks: func(s *MockSummarizer, repo *MockConversationRepository, conv *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\n").Return("Updated summary", nil)
+
+				loaded := cloneConversation(t, conv)
+				latest := cloneConversation(t, conv)
+
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(loaded, nil).Once()
+				repo.EXPECT().Save(mock.Anything, loaded).Return(conflict).Once()
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(latest, nil).Once()
+				repo.EXPECT().Save(mock.Anything, latest).RunAndReturn(func(_ context.Context, c Conversation) error {
+					summary, pending := c.PendingSummary()
+					assert.Equal(t, "Updated summary", summary)
+					assert.Empty(t, pending)
+
+					return nil
+				})
+			},
+		},
+		{
+			name:     "summary changed meanwhile by another request",
+			messages: conversation.MaxMessageHistory + 1,
+			setupMocks: func(s *MockSummarizer, repo *MockConversationRepository, _ *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\n").Return("Updated summary", nil)
+
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(newLongConversation("Summary of another request", 0), nil)
+			},
+		},
+		{
+			name:     "failed summarization keeps messages",
+			messages: conversation.MaxMessageHistory + 1,
+			setupMocks: func(s *MockSummarizer, _ *MockConversationRepository, _ *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\n").Return("", assert.AnError)
+			},
+		},
+		{
+			name:     "failed loading of conversation",
+			messages: conversation.MaxMessageHistory + 1,
+			setupMocks: func(s *MockSummarizer, repo *MockConversationRepository, _ *conversation.Conversation) {
+				s.EXPECT().Summarize(mock.Anything, "Earlier summary", "user: Text 0\n").Return("Updated summary", nil)
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(nil, assert.AnError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := newLongConversation("Earlier summary", tt.messages)
+
+			summarizer := NewMockSummarizer(t)
+			repo := NewMockConversationRepository(t)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(summarizer, repo, conv)
+			}
+
+			svc := &AIService{llm: summarizingLLM{MockLLM: NewMockLLM(t), MockSummarizer: summarizer}, repo: repo}
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			svc.summarizeHistory(ctx, conv)
+			cancel()
+			svc.summaries.Wait()
+
+			summary, pending := conv.PendingSummary()
+			assert.Equal(t, "Earlier summary", summary, "conversation of the request isn't changed")
+			assert.Len(t, pending, tt.messages-conversation.MaxMessageHistory)
+		})
+	}
+}
+
+func TestAIService_summarizeHistory_NotSupported(t *testing.T) {
+	svc := &AIService{llm: NewMockLLM(t)}
+
+	conv := newLongConversation("", conversation.MaxMessageHistory+1)
+
+	svc.summarizeHistory(context.Background(), conv)
+	svc.summaries.Wait()
+
+	_, pending := conv.PendingSummary()
+	assert.Len(t, pending, 1)
+}
+
+func TestAIService_ProcessMessage_SummarizesAfterAnswer(t *testing.T) {
+	var calls []string
+
+	llm := NewMockLLM(t)
+	llm.EXPECT().Analyze(mock.Anything, mock.Anything).RunAndReturn(func(context.Context, []message.Turn) (*message.LLMResult, error) {
+		calls = append(calls, "analyze")
+		return &message.LLMResult{Text: "Answer"}, nil
+	})
+
+	summarizer := NewMockSummarizer(t)
+	summarizer.EXPECT().Summarize(mock.Anything, "", "user: Text 0\nuser: Text 1\n").RunAndReturn(func(context.Context, string, string) (string, error) {
+		calls = append(calls, "summarize")
+		return "Summary", nil
+	})
+
+	profileRepo := NewMockPetProfileRepository(t)
+	profileRepo.EXPECT().GetProfiles(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+
+	repo := newVersionedRepository()
+
+	require.NoError(t, repo.Save(context.Background(), newLongConversation("", conversation.MaxMessageHistory)))
+
+	svc := NewAIService(summarizingLLM{MockLLM: llm, MockSummarizer: summarizer}, repo, profileRepo, nil)
+
+	resp, err := svc.ProcessMessage(context.Background(), &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: "Question"})
+	require.NoError(t, err)
+	assert.Equal(t, "Answer", resp.Message)
+
+	svc.summaries.Wait()
+
+	assert.Equal(t, []string{"analyze", "summarize"}, calls, "summarization doesn't delay the answer")
+
+	saved, err := repo.FindByID(context.Background(), "chat1")
+	require.NoError(t, err)
+
+	summary, pending := saved.PendingSummary()
+	assert.Equal(t, "Summary", summary)
+	assert.Empty(t, pending, "messages evicted by the question and the answer are summarized")
+}
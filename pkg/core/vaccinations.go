@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/ksysoev/help-my-pet/pkg/i18n"
+)
+
+// ProcessAddVaccination starts the questionnaire for adding a vaccination or preventive treatment record to the active pet.
+// The record is added to this pet when the questionnaire is completed.
+// Returns the first question with possible answers, ErrProfileNotFound if the user has no pets,
+// or an error if any retrieval, initialization, or save operation fails.
+func (s *AIService) ProcessAddVaccination(ctx context.Context, request *message.UserMessage) (*message.Response, error) {
+	slog.DebugContext(ctx, "adding vaccination record", "input", request.Text)
+
+	profile, err := s.profileRepo.GetCurrentProfile(ctx, request.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet profile: %w", err)
+	}
+
+	conv, err := s.repo.FindOrCreate(ctx, request.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+
+	start := func(c Conversation) error {
+		return c.StartVaccinationQuestions(ctx, profile.Name)
+	}
+
+	if err := start(conv); err != nil {
+		return nil, fmt.Errorf("failed to start vaccination questions: %w", err)
+	}
+
+	question, err := conv.GetCurrentQuestion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get first question: %w", err)
+	}
+
+	if _, err := s.saveConversation(ctx, conv, start); err != nil {
+		return nil, fmt.Errorf("failed to save conversation: %w", err)
+	}
+
+	text := i18n.GetLocale(ctx).Sprintf("Adding a vaccination or preventive treatment record for %s.", profile.Name) + "\n\n" + question.Text
+
+	return message.NewResponse(text, question.Answers), nil
+}
+
+// ProcessVaccinationAnswer processes a user's response to a vaccination questionnaire question.
+// Once all questions are answered, the record is added to the profile of the pet the questionnaire was started for.
+// Returns the next question, a hint to correct an invalid answer, or a success response upon completion,
+// and an error if any operation fails during processing.
+func (s *AIService) ProcessVaccinationAnswer(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
+	isComplete, err := conv.AddQuestionAnswer(request.Text)
+	if resp := invalidAnswerResponse(ctx, err); resp != nil {
+		return resp, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to add question answer: %w", err)
+	}
+
+	if isComplete {
+		return s.handleCompletedVaccination(ctx, conv, request)
+	}
+
+	question, err := conv.GetCurrentQuestion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next question: %w", err)
+	}
+
+	if _, err := s.saveConversation(ctx, conv, nil); err != nil {
+		return nil, fmt.Errorf("failed to save conversation state: %w", err)
+	}
+
+	return message.NewResponse(question.Text, question.Answers), nil
+}
+
+// handleCompletedVaccination adds the record collected by the vaccination questionnaire to the profile of the pet
+// the questionnaire was started for, even if another pet was made active meanwhile.
+// Returns a success response, a notice if the pet was removed meanwhile, or an error if retrieving the results,
+// or saving the profile or conversation fails.
+func (s *AIService) handleCompletedVaccination(ctx context.Context, conv Conversation, request *message.UserMessage) (*message.Response, error) {
+	petName := conv.VaccinationPetName()
+
+	result, err := conv.GetQuestionnaireResult()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire result: %w", err)
+	}
+
+	vaccination, err := createVaccination(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vaccination record: %w", err)
+	}
+
+	if _, err := s.saveConversation(ctx, conv, nil); err != nil {
+		return nil, fmt.Errorf("failed to save conversation: %w", err)
+	}
+
+	// Questionnaires saved before the pet name was stored add the record to the active pet
+	if petName == "" {
+		profile, err := s.profileRepo.GetCurrentProfile(ctx, request.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pet profile: %w", err)
+		}
+
+		petName = profile.Name
+	}
+
+	err = s.profileRepo.UpdateProfile(ctx, request.UserID, petName, func(profile *pet.Profile) {
+		profile.AddVaccination(vaccination)
+	})
+
+	switch {
+	case errors.Is(err, ErrProfileNotFound):
+		return message.NewResponse(i18n.GetLocale(ctx).Sprintf("%s is no longer among your pets, so the record is not saved.", petName), []string{}), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return message.NewResponse(i18n.GetLocale(ctx).Sprintf("Record of %s saved for %s", vaccination.Name, petName), []string{}), nil
+}
+
+// createVaccination generates a vaccination record from a slice of QuestionAnswer results.
+// Returns the record and an error if a field in the input slice is unrecognized.
+func createVaccination(result []conversation.QuestionAnswer) (pet.Vaccination, error) {
+	var v pet.Vaccination
+
+	for _, qa := range result {
+		switch qa.Field {
+		case "vaccine_name":
+			v.Name = qa.Answer
+		case "vaccine_date":
+			v.Date = qa.Answer
+		case "vaccine_next_due":
+			v.NextDue = qa.Answer
+		case "vaccine_clinic":
+			v.Clinic = qa.Answer
+		default:
+			return pet.Vaccination{}, fmt.Errorf("unknown field %s", qa.Field)
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIService_ProcessAddVaccination(t *testing.T) {
+	tests := []struct {
+		setupMocks   func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository)
+		expectedErr  error
+		name         string
+		expectedText string
+	}{
+		{
+			name: "starts questionnaire for active pet",
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Max"}, nil)
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(conversation.NewConversation("chat1"), nil)
+				repo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(c *conversation.Conversation) bool {
+					return c.State == conversation.StateVaccinationQuestioning
+				})).Return(nil)
+			},
+			expectedText: "Adding a vaccination or preventive treatment record for Max.\n\nWhich vaccine or preventive treatment was given",
+		},
+		{
+			name: "user without pets",
+			setupMocks: func(_ *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+			},
+			expectedErr: ErrProfileNotFound,
+		},
+		{
+			name: "conversation error",
+			setupMocks: func(repo *MockConversationRepository, profileRepo *MockPetProfileRepository) {
+				profileRepo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Max"}, nil)
+				repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockConversationRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+			tt.setupMocks(repo, profileRepo)
+
+			svc := &AIService{repo: repo, profileRepo: profileRepo}
+
+			resp, err := svc.ProcessAddVaccination(context.Background(), &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: "/addvaccine"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, resp.Message, tt.expectedText)
+		})
+	}
+}
+
+func TestAIService_ProcessVaccinationAnswer(t *testing.T) {
+	tests := []struct {
+		setupMocks   func(profileRepo *MockPetProfileRepository, saved *pet.Profile)
+		name         string
+		petName      string
+		expectedText string
+		expectedPet  string
+	}{
+		{
+			name:    "record added to the pet the questionnaire was started for",
+			petName: "Max",
+			setupMocks: func(profileRepo *MockPetProfileRepository, saved *pet.Profile) {
+				profileRepo.EXPECT().UpdateProfile(mock.Anything, "user1", "Max", mock.Anything).
+					RunAndReturn(func(_ context.Context, _, _ string, fn func(*pet.Profile)) error {
+						fn(saved)
+						return nil
+					})
+			},
+			expectedText: "Record of Rabies saved for Max",
+			expectedPet:  "Max",
+		},
+		{
+			name: "questionnaire without pet name",
+			setupMocks: func(profileRepo *MockPetProfileRepository, saved *pet.Profile) {
+				profileRepo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Bella"}, nil)
+				profileRepo.EXPECT().UpdateProfile(mock.Anything, "user1", "Bella", mock.Anything).
+					RunAndReturn(func(_ context.Context, _, _ string, fn func(*pet.Profile)) error {
+						fn(saved)
+						return nil
+					})
+			},
+			expectedText: "Record of Rabies saved for Bella",
+			expectedPet:  "Bella",
+		},
+		{
+			name:    "pet removed during questionnaire",
+			petName: "Max",
+			setupMocks: func(profileRepo *MockPetProfileRepository, _ *pet.Profile) {
+				profileRepo.EXPECT().UpdateProfile(mock.Anything, "user1", "Max", mock.Anything).Return(ErrProfileNotFound)
+			},
+			expectedText: "Max is no longer among your pets, so the record is not saved.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockConversationRepository(t)
+			profileRepo := NewMockPetProfileRepository(t)
+			svc := &AIService{repo: repo, profileRepo: profileRepo}
+
+			conv := conversation.NewConversation("chat1")
+			require.NoError(t, conv.StartVaccinationQuestions(context.Background(), tt.petName))
+
+			saved := &pet.Profile{Name: tt.expectedPet}
+
+			repo.EXPECT().FindOrCreate(mock.Anything, "chat1").Return(conv, nil)
+			repo.EXPECT().Save(mock.Anything, conv).Return(nil)
+			tt.setupMocks(profileRepo, saved)
+
+			ask := func(text string) *message.Response {
+				resp, err := svc.ProcessMessage(context.Background(), &message.UserMessage{UserID: "user1", ChatID: "chat1", Text: text})
+				require.NoError(t, err)
+
+				return resp
+			}
+
+			assert.Contains(t, ask("Rabies").Message, "When was it given?")
+			assert.Equal(t, "Provided date cannot be in the future. Please provide a valid date.", ask("2999-01-01").Message)
+			assert.Contains(t, ask("2024-05-01").Message, "When is the next dose due?")
+			assert.Equal(t, []string{"skip"}, ask("2027-05-01").Answers)
+			assert.Equal(t, tt.expectedText, ask("skip").Message)
+			assert.Equal(t, conversation.StateNormal, conv.GetState())
+
+			if tt.expectedPet != "" {
+				assert.Equal(t, []pet.Vaccination{{Name: "Rabies", Date: "2024-05-01", NextDue: "2027-05-01"}}, saved.Vaccinations)
+			}
+		})
+	}
+}
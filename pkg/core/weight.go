@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+)
+
+// AddWeight records a weight measurement such as "12.4kg" for the user's active pet.
+// The entry is added within the repository update of that pet, so concurrent changes of the user's pets are not lost.
+// Returns the updated profile, pet.ErrInvalidWeight if the weight can't be parsed, ErrProfileNotFound if the user has no pets
+// or the pet was removed meanwhile, or an error if saving the profile fails.
+func (s *AIService) AddWeight(ctx context.Context, userID, weight string) (*pet.Profile, error) {
+	entry, err := pet.ParseWeight(weight, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepo.GetCurrentProfile(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet profile: %w", err)
+	}
+
+	var updated pet.Profile
+
+	err = s.profileRepo.UpdateProfile(ctx, userID, profile.Name, func(p *pet.Profile) {
+		p.AddWeight(entry)
+		updated = *p
+	})
+
+	switch {
+	case errors.Is(err, ErrProfileNotFound):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return &updated, nil
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIService_AddWeight(t *testing.T) {
+	tests := []struct {
+		setupMocks      func(repo *MockPetProfileRepository)
+		expectedErr     error
+		name            string
+		weight          string
+		expectedWeight  string
+		expectedHistory int
+	}{
+		{
+			name:   "weight recorded",
+			weight: "12.4kg",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Max", Weight: "13 kg"}, nil)
+				repo.EXPECT().UpdateProfile(mock.Anything, "user1", "Max", mock.Anything).
+					RunAndReturn(func(_ context.Context, _, _ string, fn func(profile *pet.Profile)) error {
+						// The stored profile may differ from the one read before the update
+						stored := &pet.Profile{Name: "Max", Weight: "13 kg", WeightHistory: []pet.WeightEntry{{Value: 13, Unit: "kg"}}}
+						fn(stored)
+
+						return nil
+					})
+			},
+			expectedWeight:  "12.4 kg",
+			expectedHistory: 2,
+		},
+		{
+			name:        "invalid weight",
+			weight:      "heavy",
+			setupMocks:  func(_ *MockPetProfileRepository) {},
+			expectedErr: pet.ErrInvalidWeight,
+		},
+		{
+			name:   "user without pets",
+			weight: "12.4kg",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(nil, ErrProfileNotFound)
+			},
+			expectedErr: ErrProfileNotFound,
+		},
+		{
+			name:   "pet removed before the update",
+			weight: "12.4kg",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Max"}, nil)
+				repo.EXPECT().UpdateProfile(mock.Anything, "user1", "Max", mock.Anything).Return(ErrProfileNotFound)
+			},
+			expectedErr: ErrProfileNotFound,
+		},
+		{
+			name:   "save error",
+			weight: "12.4kg",
+			setupMocks: func(repo *MockPetProfileRepository) {
+				repo.EXPECT().GetCurrentProfile(mock.Anything, "user1").Return(&pet.Profile{Name: "Max"}, nil)
+				repo.EXPECT().UpdateProfile(mock.Anything, "user1", "Max", mock.Anything).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockPetProfileRepository(t)
+			tt.setupMocks(repo)
+
+			svc := &AIService{profileRepo: repo}
+
+			profile, err := svc.AddWeight(context.Background(), "user1", tt.weight)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "Max", profile.Name)
+			assert.Equal(t, tt.expectedWeight, profile.Weight)
+			assert.Len(t, profile.WeightHistory, tt.expectedHistory)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package eval
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"go.yaml.in/yaml/v3"
+)
+
+var (
+	// ErrEmptyDataset is returned when the dataset has no cases.
+	ErrEmptyDataset = errors.New("dataset has no cases")
+	// ErrInvalidCase is returned when a case of the dataset can't be evaluated.
+	ErrInvalidCase = errors.New("invalid case")
+)
+
+// Dataset is a set of evaluation cases loaded from a YAML file
+type Dataset struct {
+	Cases []Case `yaml:"cases"`
+}
+
+// Case is a question asked about a pet in the context of a conversation, together with the expected properties of the answer.
+// Images are paths to image files attached to the question, relative to the dataset file.
+type Case struct {
+	Profile  *Profile         `yaml:"profile"`
+	Expect   Expect           `yaml:"expect"`
+	Name     string           `yaml:"name"`
+	Question string           `yaml:"question"`
+	History  []Turn           `yaml:"history"`
+	Images   []string         `yaml:"images"`
+	images   []*message.Image // images loaded from the files
+}
+
+// Turn is a message of the conversation history preceding the question of a case.
+// Role is either "user" or "assistant".
+type Turn struct {
+	Role    string `yaml:"role"`
+	Content string `yaml:"content"`
+}
+
+// Profile is the profile of the pet the question of a case is about
+type Profile struct {
+	Name            string `yaml:"name"`
+	Species         string `yaml:"species"`
+	Breed           string `yaml:"breed"`
+	DateOfBirth     string `yaml:"date_of_birth"`
+	Gender          string `yaml:"gender"`
+	Weight          string `yaml:"weight"`
+	Neutered        string `yaml:"neutered"`
+	Activity        string `yaml:"activity"`
+	ChronicDiseases string `yaml:"chronic_diseases"`
+	FoodPreferences string `yaml:"food_preferences"`
+}
+
+// Expect defines the assertions on the answer of a case, unset assertions are not checked.
+// RecommendsVet and AsksQuestions check whether the answer recommends to see a vet and asks follow-up questions,
+// Urgency checks the triage level and Language checks the language of the answer, e.g. "es".
+type Expect struct {
+	RecommendsVet *bool           `yaml:"recommends_vet" json:"recommends_vet,omitempty"`
+	AsksQuestions *bool           `yaml:"asks_questions" json:"asks_questions,omitempty"`
+	Urgency       message.Urgency `yaml:"urgency" json:"urgency,omitempty"`
+	Language      string          `yaml:"language" json:"language,omitempty"`
+}
+
+// LoadDataset reads the dataset from the YAML file and loads the images attached to its cases.
+// Unknown fields are rejected, so typos in assertions don't silently disable them.
+// Returns the dataset or an error if the file can't be read or a case is invalid.
+func LoadDataset(path string) (*Dataset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dataset: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var dataset Dataset
+	if err := dec.Decode(&dataset); err != nil {
+		return nil, fmt.Errorf("failed to parse dataset: %w", err)
+	}
+
+	if len(dataset.Cases) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	names := make(map[string]bool, len(dataset.Cases))
+
+	for i := range dataset.Cases {
+		c := &dataset.Cases[i]
+
+		if err := c.validate(); err != nil {
+			return nil, fmt.Errorf("case %d: %w", i+1, err)
+		}
+
+		if names[c.Name] {
+			return nil, fmt.Errorf("case %d: %w: duplicated name %q", i+1, ErrInvalidCase, c.Name)
+		}
+
+		names[c.Name] = true
+
+		if err := c.loadImages(filepath.Dir(path)); err != nil {
+			return nil, fmt.Errorf("case %q: %w", c.Name, err)
+		}
+	}
+
+	return &dataset, nil
+}
+
+// validate checks that the case has a name, a question and a history of known roles.
+func (c *Case) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCase)
+	}
+
+	if c.Question == "" {
+		return fmt.Errorf("%w: question is required", ErrInvalidCase)
+	}
+
+	for _, t := range c.History {
+		if t.Role != string(message.RoleUser) && t.Role != string(message.RoleAssistant) {
+			return fmt.Errorf("%w: unknown history role %q", ErrInvalidCase, t.Role)
+		}
+	}
+
+	return nil
+}
+
+// loadImages reads the images of the case from the files relative to dir.
+func (c *Case) loadImages(dir string) error {
+	for _, path := range c.Images {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read image: %w", err)
+		}
+
+		c.images = append(c.images, &message.Image{
+			MIME: http.DetectContentType(data),
+			Data: base64.StdEncoding.EncodeToString(data),
+		})
+	}
+
+	return nil
+}
+
+// turns returns the conversation history of the case as LLM turns.
+func (c *Case) turns() []message.Turn {
+	turns := make([]message.Turn, 0, len(c.History)+1)
+
+	for _, t := range c.History {
+		if t.Role == string(message.RoleAssistant) {
+			turns = append(turns, message.NewAssistantTurn(t.Content))
+		} else {
+			turns = append(turns, message.NewUserTurn(t.Content, nil))
+		}
+	}
+
+	return turns
+}
+
+// pet converts the profile into the pet profile included into the prompt.
+// Returns nil if the case has no profile.
+func (p *Profile) pet() *pet.Profile {
+	if p == nil {
+		return nil
+	}
+
+	return &pet.Profile{
+		Name:            p.Name,
+		Species:         p.Species,
+		Breed:           p.Breed,
+		DateOfBirth:     p.DateOfBirth,
+		Gender:          p.Gender,
+		Weight:          p.Weight,
+		Neutered:        p.Neutered,
+		Activity:        p.Activity,
+		ChronicDiseases: p.ChronicDiseases,
+		FoodPreferences: p.FoodPreferences,
+	}
+}
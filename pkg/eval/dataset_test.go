@@ -0,0 +1,121 @@
+package eval
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is the signature of a PNG file, enough for detecting its content type
+var pngHeader = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+func TestLoadDataset(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name: "valid dataset",
+			content: `cases:
+  - name: vomiting cat
+    profile:
+      name: Luna
+      species: cat
+      date_of_birth: "2020-05-01"
+    history:
+      - role: user
+        content: My cat is lethargic
+      - role: assistant
+        content: How long has it been going on?
+    question: She vomited twice today
+    images: [photo.png]
+    expect:
+      recommends_vet: true
+      asks_questions: false
+      urgency: see_vet_soon
+      language: en
+`,
+		},
+		{
+			name:    "unknown field",
+			content: "cases:\n  - name: a\n    question: q\n    expect:\n      recommend_vet: true\n",
+			wantErr: "failed to parse dataset: yaml: unmarshal errors:\n  line 5: field recommend_vet not found in type eval.Expect",
+		},
+		{
+			name:    "no cases",
+			content: "cases: []\n",
+			wantErr: "dataset has no cases",
+		},
+		{
+			name:    "missing name",
+			content: "cases:\n  - question: q\n",
+			wantErr: "case 1: invalid case: name is required",
+		},
+		{
+			name:    "missing question",
+			content: "cases:\n  - name: a\n",
+			wantErr: "case 1: invalid case: question is required",
+		},
+		{
+			name:    "duplicated name",
+			content: "cases:\n  - name: a\n    question: q\n  - name: a\n    question: q\n",
+			wantErr: "case 2: invalid case: duplicated name \"a\"",
+		},
+		{
+			name:    "unknown role",
+			content: "cases:\n  - name: a\n    question: q\n    history:\n      - role: system\n        content: c\n",
+			wantErr: "case 1: invalid case: unknown history role \"system\"",
+		},
+		{
+			name:    "missing image",
+			content: "cases:\n  - name: a\n    question: q\n    images: [missing.png]\n",
+			wantErr: "case \"a\": failed to read image: open ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "cases.yaml")
+
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "photo.png"), pngHeader, 0o600))
+
+			dataset, err := LoadDataset(path)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, dataset.Cases, 1)
+
+			c := dataset.Cases[0]
+			vet, questions := true, false
+
+			assert.Equal(t, Expect{RecommendsVet: &vet, AsksQuestions: &questions, Urgency: message.UrgencySeeVetSoon, Language: "en"}, c.Expect)
+			assert.Equal(t, &pet.Profile{Name: "Luna", Species: "cat", DateOfBirth: "2020-05-01"}, c.Profile.pet())
+			assert.Equal(t, []message.Turn{
+				message.NewUserTurn("My cat is lethargic", nil),
+				message.NewAssistantTurn("How long has it been going on?"),
+			}, c.turns())
+			assert.Equal(t, []*message.Image{{MIME: "image/png", Data: "iVBORw0KGgo="}}, c.images)
+		})
+	}
+}
+
+func TestLoadDataset_MissingFile(t *testing.T) {
+	_, err := LoadDataset(filepath.Join(t.TempDir(), "cases.yaml"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read dataset")
+}
@@ -0,0 +1,209 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+// vetKeywords are the words recommending a veterinarian in the languages of the bot, they are matched as substrings
+var vetKeywords = []string{"vet", "vét", "tierarzt", "tierärzt", "ветеринар", "ветклиник", "ветклінік", "weterynar", "dierenarts", "doktor haiwan", "수의사", "동물병원"}
+
+// Report holds the results of all cases of an evaluation run.
+// PromptVersion identifies the prompts of the evaluated LLM, if it reports them.
+type Report struct {
+	PromptVersion string   `json:"prompt_version,omitempty"`
+	Results       []Result `json:"results"`
+	Passed        int      `json:"passed"`
+	Failed        int      `json:"failed"`
+}
+
+// Result is the answer to a case with the failed assertions, a case passes when the LLM answered and all assertions hold.
+type Result struct {
+	Name      string          `json:"name"`
+	Model     string          `json:"model,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	Text      string          `json:"text"`
+	Urgency   message.Urgency `json:"urgency,omitempty"`
+	Language  string          `json:"language,omitempty"`
+	Questions []string        `json:"questions,omitempty"`
+	Failures  []string        `json:"failures,omitempty"`
+	Passed    bool            `json:"passed"`
+}
+
+// caseKey is the context key holding the name of the evaluated case.
+type caseKey struct{}
+
+// Run asks the LLM the question of every case of the dataset, the same way the bot asks a new question,
+// and checks the answers against the assertions of the cases. An LLM error fails the case without stopping the run.
+// Returns the report of the run.
+func Run(ctx context.Context, llm core.LLM, dataset *Dataset) *Report {
+	report := &Report{Results: make([]Result, 0, len(dataset.Cases))}
+
+	if versioner, ok := llm.(core.PromptVersioner); ok {
+		report.PromptVersion = versioner.PromptVersion()
+	}
+
+	for i := range dataset.Cases {
+		result := runCase(ctx, llm, &dataset.Cases[i])
+
+		if result.Passed {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// runCase asks the question of the case and checks the answer.
+func runCase(ctx context.Context, llm core.LLM, c *Case) Result {
+	ctx, _ = budget.WithRecorder(ctx)
+	ctx = context.WithValue(ctx, caseKey{}, c.Name)
+
+	turns := append(c.turns(), core.NewQuestionTurn(c.Profile.pet(), c.Question, c.images))
+
+	result := Result{Name: c.Name}
+
+	answer, err := llm.Analyze(ctx, turns)
+	result.Model = budget.LastModel(ctx)
+
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Text = answer.Text
+	result.Urgency = answer.Urgency
+
+	for _, q := range answer.Questions {
+		result.Questions = append(result.Questions, q.Text)
+	}
+
+	result.Language = DetectLanguage(answerText(answer))
+	result.Failures = check(c.Expect, answer, result.Language)
+	result.Passed = len(result.Failures) == 0
+
+	return result
+}
+
+// check verifies the assertions against the answer, language is the detected language of the answer.
+// Returns the descriptions of failed assertions.
+func check(expect Expect, answer *message.LLMResult, language string) []string {
+	var failures []string
+
+	if expect.RecommendsVet != nil {
+		if got := recommendsVet(answer); got != *expect.RecommendsVet {
+			failures = append(failures, fmt.Sprintf("recommends vet: expected %t, got %t", *expect.RecommendsVet, got))
+		}
+	}
+
+	if expect.AsksQuestions != nil {
+		if got := len(answer.Questions) > 0; got != *expect.AsksQuestions {
+			failures = append(failures, fmt.Sprintf("asks questions: expected %t, got %t", *expect.AsksQuestions, got))
+		}
+	}
+
+	if expect.Urgency != "" && answer.Urgency != expect.Urgency {
+		failures = append(failures, fmt.Sprintf("urgency: expected %s, got %s", expect.Urgency, valueOrNone(string(answer.Urgency))))
+	}
+
+	if expect.Language != "" {
+		want, _, _ := strings.Cut(strings.ToLower(expect.Language), "-")
+
+		if language != want {
+			failures = append(failures, fmt.Sprintf("language: expected %s, got %s", want, valueOrNone(language)))
+		}
+	}
+
+	return failures
+}
+
+// recommendsVet reports whether the answer recommends to see a vet: it is triaged as needing a vet
+// or mentions a veterinarian in the text or the follow-up questions.
+func recommendsVet(answer *message.LLMResult) bool {
+	if answer.Urgency == message.UrgencyEmergency || answer.Urgency == message.UrgencySeeVetSoon {
+		return true
+	}
+
+	text := strings.ToLower(answerText(answer))
+
+	for _, keyword := range vetKeywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// answerText returns the text of the answer followed by its follow-up questions.
+func answerText(answer *message.LLMResult) string {
+	parts := []string{answer.Text}
+
+	for _, q := range answer.Questions {
+		parts = append(parts, q.Text)
+	}
+
+	return strings.TrimSpace(strings.Join(parts, "\n"))
+}
+
+// valueOrNone returns the value or "none" if it is empty, for readable failure messages.
+func valueOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+
+	return value
+}
+
+// WriteSummary writes a human readable pass/fail line for every case followed by the totals.
+// Returns an error if writing fails.
+func (r *Report) WriteSummary(w io.Writer) error {
+	var sb strings.Builder
+
+	for _, result := range r.Results {
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(&sb, "FAIL %s: %s\n", result.Name, result.Error)
+		case !result.Passed:
+			fmt.Fprintf(&sb, "FAIL %s: %s\n", result.Name, strings.Join(result.Failures, "; "))
+		default:
+			fmt.Fprintf(&sb, "PASS %s\n", result.Name)
+		}
+	}
+
+	fmt.Fprintf(&sb, "\n%d passed, %d failed", r.Passed, r.Failed)
+
+	if r.PromptVersion != "" {
+		fmt.Fprintf(&sb, " (prompt version %s)", r.PromptVersion)
+	}
+
+	sb.WriteString("\n")
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// WriteJSON writes the report as indented JSON, so results of different prompt or model versions can be diffed.
+// Returns an error if writing fails.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		answer *message.LLMResult
+		err    error
+		name   string
+		expect Expect
+		want   Result
+	}{
+		{
+			name:   "all assertions hold",
+			expect: Expect{RecommendsVet: &yes, AsksQuestions: &no, Urgency: message.UrgencyEmergency, Language: "es"},
+			answer: &message.LLMResult{Text: "Lleva a tu perro al veterinario de urgencias ahora, es muy grave.", Urgency: message.UrgencyEmergency},
+			want: Result{
+				Name:     "case",
+				Model:    "sonnet",
+				Text:     "Lleva a tu perro al veterinario de urgencias ahora, es muy grave.",
+				Urgency:  message.UrgencyEmergency,
+				Language: "es",
+				Passed:   true,
+			},
+		},
+		{
+			name:   "assertions fail",
+			expect: Expect{RecommendsVet: &yes, AsksQuestions: &yes, Urgency: message.UrgencyEmergency, Language: "es-ES"},
+			answer: &message.LLMResult{Text: "It is fine, keep feeding the dog as usual.", Urgency: message.UrgencyInformational},
+			want: Result{
+				Name:     "case",
+				Model:    "sonnet",
+				Text:     "It is fine, keep feeding the dog as usual.",
+				Urgency:  message.UrgencyInformational,
+				Language: "en",
+				Failures: []string{
+					"recommends vet: expected true, got false",
+					"asks questions: expected true, got false",
+					"urgency: expected emergency, got informational",
+					"language: expected es, got en",
+				},
+			},
+		},
+		{
+			name:   "questions are asked",
+			expect: Expect{AsksQuestions: &yes, RecommendsVet: &no},
+			answer: &message.LLMResult{Questions: []message.Question{{Text: "How old is your cat?"}}},
+			want: Result{
+				Name:      "case",
+				Model:     "sonnet",
+				Language:  "en",
+				Questions: []string{"How old is your cat?"},
+				Passed:    true,
+			},
+		},
+		{
+			name: "llm fails",
+			err:  assert.AnError,
+			want: Result{Name: "case", Model: "sonnet", Error: assert.AnError.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := core.NewMockLLM(t)
+			llm.EXPECT().Analyze(mock.Anything, []message.Turn{
+				message.NewUserTurn("My dog ate chocolate", nil),
+				message.NewAssistantTurn("How much?"),
+				core.NewQuestionTurn(nil, "A whole bar", nil),
+			}).RunAndReturn(func(ctx context.Context, _ []message.Turn) (*message.LLMResult, error) {
+				budget.Record(ctx, budget.Usage{Model: "sonnet"})
+				return tt.answer, tt.err
+			})
+
+			dataset := &Dataset{Cases: []Case{{
+				Name:     "case",
+				Question: "A whole bar",
+				History:  []Turn{{Role: "user", Content: "My dog ate chocolate"}, {Role: "assistant", Content: "How much?"}},
+				Expect:   tt.expect,
+			}}}
+
+			report := Run(context.Background(), llm, dataset)
+
+			require.Len(t, report.Results, 1)
+			assert.Equal(t, tt.want, report.Results[0])
+
+			if tt.want.Passed {
+				assert.Equal(t, 1, report.Passed)
+			} else {
+				assert.Equal(t, 1, report.Failed)
+			}
+		})
+	}
+}
+
+func TestReport_WriteSummary(t *testing.T) {
+	report := &Report{
+		PromptVersion: "abc123",
+		Results: []Result{
+			{Name: "passing", Passed: true},
+			{Name: "failing", Failures: []string{"urgency: expected emergency, got monitor", "language: expected es, got en"}},
+			{Name: "erroring", Error: "rate limited"},
+		},
+		Passed: 1,
+		Failed: 2,
+	}
+
+	var out bytes.Buffer
+
+	require.NoError(t, report.WriteSummary(&out))
+	assert.Equal(t, `PASS passing
+FAIL failing: urgency: expected emergency, got monitor; language: expected es, got en
+FAIL erroring: rate limited
+
+1 passed, 2 failed (prompt version abc123)
+`, out.String())
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	report := &Report{
+		Results: []Result{{Name: "passing", Text: "Hi", Language: "en", Passed: true}},
+		Passed:  1,
+	}
+
+	var out bytes.Buffer
+
+	require.NoError(t, report.WriteJSON(&out))
+	assert.JSONEq(t, `{
+		"results": [{"name": "passing", "text": "Hi", "language": "en", "passed": true}],
+		"passed": 1,
+		"failed": 0
+	}`, out.String())
+}
@@ -0,0 +1,99 @@
+package eval
+
+import (
+	"strings"
+	"unicode"
+)
+
+// stopwords are frequent short words identifying the languages of the bot written in the Latin script
+var stopwords = map[string][]string{
+	"en": {"the", "and", "is", "are", "of", "to", "your", "it", "with", "for", "that", "you", "if", "this", "can"},
+	"es": {"el", "los", "las", "y", "es", "que", "en", "su", "con", "por", "para", "una", "si", "tu", "muy", "puede"},
+	"fr": {"le", "les", "et", "est", "des", "que", "vous", "votre", "pour", "une", "avec", "dans", "il", "si", "pas"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "sie", "ihr", "ihre", "mit", "für", "ein", "eine", "wenn", "auf"},
+	"it": {"il", "lo", "gli", "e", "è", "di", "che", "per", "un", "con", "non", "del", "della", "se", "suo"},
+	"pt": {"o", "os", "e", "é", "que", "não", "um", "uma", "com", "para", "do", "da", "seu", "sua", "se"},
+	"nl": {"de", "het", "een", "en", "is", "van", "niet", "je", "uw", "met", "voor", "dat", "op", "als", "zijn"},
+	"ca": {"el", "els", "les", "i", "és", "que", "amb", "per", "un", "una", "no", "del", "si", "seu", "molt"},
+	"pl": {"i", "w", "jest", "nie", "się", "na", "z", "że", "to", "do", "jak", "lub", "jeśli", "może", "twój"},
+	"tr": {"ve", "bir", "bu", "için", "ile", "da", "de", "çok", "ama", "eğer", "olarak", "veya", "gibi", "değil"},
+	"ms": {"dan", "yang", "anda", "untuk", "ini", "itu", "dengan", "tidak", "di", "ke", "adalah", "jika", "atau"},
+}
+
+// DetectLanguage guesses the language of the text among the languages supported by the bot.
+// Korean and Cyrillic languages are recognized by their letters, languages written in the Latin script
+// by the number of their frequent words. It is a heuristic for asserting the language of answers,
+// not a general purpose language detector.
+// Returns the ISO 639-1 code of the language or an empty string if it can't be recognized.
+func DetectLanguage(text string) string {
+	var hangul, cyrillic, latin int
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+
+	switch {
+	case hangul > cyrillic && hangul > latin:
+		return "ko"
+	case cyrillic > latin:
+		return detectCyrillic(text)
+	default:
+		return detectLatin(text)
+	}
+}
+
+// detectCyrillic distinguishes Russian, Ukrainian and Belarusian by the letters specific to them.
+func detectCyrillic(text string) string {
+	text = strings.ToLower(text)
+
+	switch {
+	case strings.ContainsRune(text, 'ў'):
+		return "be"
+	case strings.ContainsAny(text, "їєґ"):
+		return "uk"
+	case strings.ContainsRune(text, 'і'):
+		// Belarusian has no и, Ukrainian uses both letters
+		if strings.ContainsRune(text, 'и') {
+			return "uk"
+		}
+
+		return "be"
+	default:
+		return "ru"
+	}
+}
+
+// detectLatin returns the language with the most frequent words in the text, or an empty string if there are none.
+func detectLatin(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	counts := make(map[string]int, len(words))
+	for _, w := range words {
+		counts[w]++
+	}
+
+	best, bestScore := "", 0
+
+	// Languages are checked in a fixed order, so ties are resolved deterministically
+	for _, lang := range []string{"en", "es", "fr", "de", "it", "pt", "nl", "ca", "pl", "tr", "ms"} {
+		score := 0
+		for _, w := range stopwords[lang] {
+			score += counts[w]
+		}
+
+		if score > bestScore {
+			best, bestScore = lang, score
+		}
+	}
+
+	return best
+}
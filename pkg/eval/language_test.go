@@ -0,0 +1,31 @@
+package eval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "english", text: "Your dog is fine, but keep an eye on the wound and call the vet if it gets worse.", want: "en"},
+		{name: "spanish", text: "Tu perro está bien, pero vigila la herida y llama al veterinario si empeora. Es muy importante que no la lama.", want: "es"},
+		{name: "french", text: "Votre chien va bien, mais surveillez la plaie et appelez le vétérinaire si elle s'aggrave.", want: "fr"},
+		{name: "german", text: "Ihr Hund ist gesund, aber wenn die Wunde schlimmer wird, gehen Sie mit ihm zum Tierarzt.", want: "de"},
+		{name: "russian", text: "Ваша собака здорова, но следите за раной и обратитесь к ветеринару.", want: "ru"},
+		{name: "ukrainian", text: "Ваш собака здоровий, але стежте за раною і зверніться до ветеринара.", want: "uk"},
+		{name: "belarusian", text: "Ваш сабака здаровы, але сачыце за ранай і звярніцеся да ветэрынара.", want: "be"},
+		{name: "korean", text: "강아지는 괜찮지만 상처가 악화되면 수의사에게 데려가세요.", want: "ko"},
+		{name: "unknown", text: "12345", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectLanguage(tt.text))
+		})
+	}
+}
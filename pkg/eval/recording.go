@@ -0,0 +1,139 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+// reportSuffix distinguishes recorded reports from recorded analyses of the same case
+const reportSuffix = "#report"
+
+// ErrNotRecorded is returned when the recording has no response for the evaluated case.
+var ErrNotRecorded = errors.New("response is not recorded")
+
+// Recording is an LLM answering evaluation cases with responses recorded by the name of the case,
+// it makes evaluation reproducible offline and serves as a fake LLM in tests.
+// A recording created with NewRecording passes requests to the wrapped LLM and records its responses,
+// a recording loaded with LoadRecording only replays them.
+type Recording struct {
+	llm       core.LLM
+	Responses map[string]*message.LLMResult `json:"responses"`
+	Version   string                        `json:"prompt_version,omitempty"`
+	mu        sync.Mutex
+}
+
+// NewRecording creates a recording of the responses of the LLM.
+// Returns the recording passing requests to the LLM.
+func NewRecording(llm core.LLM) *Recording {
+	rec := &Recording{
+		llm:       llm,
+		Responses: make(map[string]*message.LLMResult),
+	}
+
+	if versioner, ok := llm.(core.PromptVersioner); ok {
+		rec.Version = versioner.PromptVersion()
+	}
+
+	return rec
+}
+
+// LoadRecording reads the responses recorded with Save from the file for replaying them.
+// Returns the recording or an error if the file can't be read or parsed.
+func LoadRecording(path string) (*Recording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	var rec Recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("failed to parse recording: %w", err)
+	}
+
+	if rec.Responses == nil {
+		rec.Responses = make(map[string]*message.LLMResult)
+	}
+
+	return &rec, nil
+}
+
+// PromptVersion returns the version of the prompts the responses were recorded with.
+func (r *Recording) PromptVersion() string {
+	return r.Version
+}
+
+// Save writes the recorded responses to the file as indented JSON.
+// Returns an error if serialization or writing fails.
+func (r *Recording) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal recording: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write recording: %w", err)
+	}
+
+	return nil
+}
+
+// Analyze returns the analysis recorded for the evaluated case, recording it first if the recording wraps an LLM.
+// Returns ErrNotRecorded if there is no recorded analysis, or the error of the wrapped LLM.
+func (r *Recording) Analyze(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	return r.respond(ctx, "", func(llm core.LLM) (*message.LLMResult, error) {
+		return llm.Analyze(ctx, turns)
+	})
+}
+
+// Report returns the report recorded for the evaluated case, recording it first if the recording wraps an LLM.
+// Returns ErrNotRecorded if there is no recorded report, or the error of the wrapped LLM.
+func (r *Recording) Report(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	return r.respond(ctx, reportSuffix, func(llm core.LLM) (*message.LLMResult, error) {
+		return llm.Report(ctx, turns)
+	})
+}
+
+// respond records the response of call or replays the recorded one, the response is stored under the name
+// of the evaluated case followed by suffix.
+func (r *Recording) respond(ctx context.Context, suffix string, call func(core.LLM) (*message.LLMResult, error)) (*message.LLMResult, error) {
+	name, ok := ctx.Value(caseKey{}).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: request is not an evaluation case", ErrNotRecorded)
+	}
+
+	key := name + suffix
+
+	if r.llm == nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		result, ok := r.Responses[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrNotRecorded, key)
+		}
+
+		return result, nil
+	}
+
+	result, err := call(r.llm)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Responses[key] = result
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package eval
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecording_RecordAndReplay(t *testing.T) {
+	analysis := &message.LLMResult{Text: "See a vet", Urgency: message.UrgencySeeVetSoon}
+	report := &message.LLMResult{Text: "Summary"}
+
+	llm := core.NewMockLLM(t)
+	llm.EXPECT().Analyze(mock.Anything, mock.Anything).Return(analysis, nil).Once()
+	llm.EXPECT().Report(mock.Anything, mock.Anything).Return(report, nil).Once()
+
+	ctx := context.WithValue(context.Background(), caseKey{}, "limping dog")
+	rec := NewRecording(llm)
+
+	got, err := rec.Analyze(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, analysis, got)
+
+	got, err = rec.Report(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, report, got)
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	require.NoError(t, rec.Save(path))
+
+	replay, err := LoadRecording(path)
+	require.NoError(t, err)
+
+	got, err = replay.Analyze(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, analysis, got)
+
+	got, err = replay.Report(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, report, got)
+
+	_, err = replay.Analyze(context.WithValue(context.Background(), caseKey{}, "other"), nil)
+	assert.ErrorIs(t, err, ErrNotRecorded)
+
+	_, err = replay.Analyze(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrNotRecorded)
+}
+
+func TestRecording_LLMFails(t *testing.T) {
+	llm := core.NewMockLLM(t)
+	llm.EXPECT().Analyze(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	rec := NewRecording(llm)
+
+	_, err := rec.Analyze(context.WithValue(context.Background(), caseKey{}, "case"), nil)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, rec.Responses)
+}
+
+func TestLoadRecording_Errors(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.json")
+
+	require.NoError(t, os.WriteFile(invalid, []byte("{"), 0o600))
+
+	_, err := LoadRecording(filepath.Join(dir, "missing.json"))
+	assert.ErrorContains(t, err, "failed to read recording")
+
+	_, err = LoadRecording(invalid)
+	assert.ErrorContains(t, err, "failed to parse recording")
+}
@@ -52,645 +52,1058 @@ func init() {
 }
 
 var messageKeyToIndex = map[string]int{
-	"<b>Help My Pet Bot Commands</b>:\n/start - Start the conversation with the bot\n/terms - View the Terms and Conditions of the service\n/editprofile - Update your pet's profile information, such as name, age, breed, etc. This information helps the bot provide more accurate advice.\n/cancel - Cancel the current questionnaire, if any is in progress (e.g., when you want to start over or change your question)\n/help - View this help message": 4,
-	"<b>Terms and Conditions</b>\n<i>Last updated: 30.01.2025</i>\n\nThank you for using our veterinary advice chatbot (“the Service”). By accessing or using this Service, you agree to be bound by the following terms and conditions (“Terms”). If you do not agree to these Terms, please discontinue use immediately.\n\n<b>1. Nature of the Service</b>\n1.1 The Service provides general information, guidance, and suggestions for pet care, including (but not limited to) diet, behavior, and training.\n1.2 The Service is not a substitute for professional veterinary diagnosis, treatment, or care. Always seek the advice of a licensed veterinarian for any questions regarding your pet’s health.\n\n<b>2. No Veterinary-Client-Patient Relationship</b>\n2.1 Using the Service or engaging with our AI assistant does not create a veterinarian-client-patient relationship.\n2.2 Any advice or guidance provided by the Service is based on limited information and should only be considered general information.\n\n<b>3. Limitation of Liability</b>\n3.1 You acknowledge and agree that use of the Service is at your own risk.\n3.2 Under no circumstances shall the owners, developers, or licensors of the Service be liable for any direct, indirect, incidental, special, or consequential damages arising out of or in connection with your access to or use of the Service.\n3.3 You understand that decisions regarding your pet’s care and any resulting outcomes are your sole responsibility. If you have any doubt about the well-being of your pet or its health, you should immediately consult a licensed veterinarian.\n\n<b>4. No Warranty</b>\n4.1 The Service is provided on an “as is” and “as available” basis without warranties of any kind, whether express or implied.\n4.2 We do not warrant that the Service will be uninterrupted, error-free, secure, or free from viruses.\n\n<b>5. User Responsibilities</b>\n5.1 You are responsible for providing accurate and complete information about your pet when seeking advice.\n5.2 You must ensure that all questions, descriptions, and data you provide do not violate any third-party rights or local laws.\n\n<b>6. International Use</b>\n6.1 The Service is intended for global use. You are responsible for compliance with all applicable local laws and regulations in your jurisdiction.\n6.2 We do not guarantee that the Service or any of its content is appropriate or permissible in any specific country or region.\n\n<b>7. Modifications</b>\n7.1 We reserve the right to modify or replace these Terms at any time.\n7.2 If we make material changes, we will post the updated Terms and indicate the date of the latest revision at the top of this document.\n\n<b>8. Governing Law and Dispute Resolution</b>\n8.1 These Terms shall be governed by and construed in accordance with the laws applicable in the jurisdiction of the Service provider’s principal place of business, without regard to conflict-of-law principles.\n8.2 Any dispute arising from or relating to these Terms shall be resolved through amicable negotiation and, if necessary, by binding arbitration or litigation in the applicable courts.\n\n<b>9. Acceptance of Terms</b>\n9.1 By continuing to access or use the Service, you acknowledge that you have read, understood, and agree to be bound by these Terms.\n9.2 If you do not agree, you must cease using the Service immediately.\n\nIf you have any questions or concerns regarding these Terms, or if you need further clarification, please contact at <i>k.sysoev@me.com</i>.": 3,
-	"Does your pet have any chronic diseases?":                                                                     33,
-	"How would you describe your pet's activity level?":                                                            29,
-	"I apologize, but your message is too long for me to process. Please try to make it shorter and more concise.": 6,
-	"Is your pet spayed or neutered?":                                                                              26,
-	"Pet profile saved successfully":                                                                               15,
-	"Please provide a date in the valid format YYYY-MM-DD (e.g., 2023-12-31)":                                      14,
-	"Please, provide at least one photo":                                                                           10,
-	"Please, provide no more than %d photo(s)":                                                                     11,
-	"Please, provide your question in text format along with photo(s)":                                             9,
-	"Provided date cannot be in the future. Please provide a valid date.":                                          13,
-	"Questionary is cancelled":                                                                                     0,
-	"Sorry, I cannot process videos, audio, or documents. Please send your question as text only.":                 5,
-	"Sorry, I encountered an error while processing your request. Please try again later.":                         12,
-	"Unknown command": 1,
-	"We have reached our daily request limit. Please come back tomorrow when our budget is refreshed.": 8,
-	"Welcome to Help My Pet Bot! 🐾\n\nI'm your personal pet care assistant, ready to provide guidance for your furry friends. I can help with:\n\n- Health concerns and symptom assessment\n- Behavior questions and training techniques\n- Diet and nutrition recommendations\n- General pet care and wellness advice\n\nSimply type your question or concern about your pet. You can also include photos to help me better understand your situation.\n\nRemember, while I offer helpful guidance based on reliable veterinary knowledge, I'm not a replacement for professional veterinary care. Always consult a veterinarian for serious medical concerns.\n\nWhat pet question can I help you with today?": 2,
-	"What are your pet's food preferences or dietary restrictions?": 34,
-	"What breed is your pet?":    20,
-	"What is your pet's gender?": 22,
-	"What is your pet's name?":   16,
-	"What is your pet's weight? Please specify the weight followed by the unit, e.g., 5 kg": 25,
-	"What type of pet do you have?": 17,
-	"When was your pet born? Please enter the date in the format YYYY-MM-DD (e.g., 2010-12-31).": 21,
-	"You have reached the maximum number of requests per hour. Please try again later.":          7,
-	"cat":    19,
-	"dog":    18,
-	"female": 24,
-	"high":   32,
-	"low":    30,
-	"male":   23,
-	"medium": 31,
-	"no":     28,
-	"yes":    27,
+	"%s is no longer among your pets, so the record is not saved.": 59,
+	"%s was due on %s": 46,
+	"<b>Help My Pet Bot Commands</b>:\n/start - Start the conversation with the bot\n/terms - View the Terms and Conditions of the service\n/editprofile - Update your pet's profile information, such as name, age, breed, etc. This information helps the bot provide more accurate advice.\n/addpet - Add profile of another pet, if you have more than one\n/pets - List your pets and see which one is currently selected\n/switchpet - Select the pet your next questions are about\n/removepet - Remove a pet profile\n/weight - Record your pet's current weight, e.g. /weight 12.4kg\n/weightchart - See a chart of your pet's weight over time\n/vaccines - List overdue vaccinations and preventive treatments of your pets\n/addvaccine - Add a vaccination or preventive treatment record for your pet\n/remind - Set a recurring reminder, e.g. /remind give Rimadyl every 12h for 7 days\n/reminders - List your reminders and delete the ones you don't need\n/cancel - Cancel the current questionnaire, if any is in progress (e.g., when you want to start over or change your question)\n/help - View this help message": 11,
+	"<b>Terms and Conditions</b>\n<i>Last updated: 30.01.2025</i>\n\nThank you for using our veterinary advice chatbot (“the Service”). By accessing or using this Service, you agree to be bound by the following terms and conditions (“Terms”). If you do not agree to these Terms, please discontinue use immediately.\n\n<b>1. Nature of the Service</b>\n1.1 The Service provides general information, guidance, and suggestions for pet care, including (but not limited to) diet, behavior, and training.\n1.2 The Service is not a substitute for professional veterinary diagnosis, treatment, or care. Always seek the advice of a licensed veterinarian for any questions regarding your pet’s health.\n\n<b>2. No Veterinary-Client-Patient Relationship</b>\n2.1 Using the Service or engaging with our AI assistant does not create a veterinarian-client-patient relationship.\n2.2 Any advice or guidance provided by the Service is based on limited information and should only be considered general information.\n\n<b>3. Limitation of Liability</b>\n3.1 You acknowledge and agree that use of the Service is at your own risk.\n3.2 Under no circumstances shall the owners, developers, or licensors of the Service be liable for any direct, indirect, incidental, special, or consequential damages arising out of or in connection with your access to or use of the Service.\n3.3 You understand that decisions regarding your pet’s care and any resulting outcomes are your sole responsibility. If you have any doubt about the well-being of your pet or its health, you should immediately consult a licensed veterinarian.\n\n<b>4. No Warranty</b>\n4.1 The Service is provided on an “as is” and “as available” basis without warranties of any kind, whether express or implied.\n4.2 We do not warrant that the Service will be uninterrupted, error-free, secure, or free from viruses.\n\n<b>5. User Responsibilities</b>\n5.1 You are responsible for providing accurate and complete information about your pet when seeking advice.\n5.2 You must ensure that all questions, descriptions, and data you provide do not violate any third-party rights or local laws.\n\n<b>6. International Use</b>\n6.1 The Service is intended for global use. You are responsible for compliance with all applicable local laws and regulations in your jurisdiction.\n6.2 We do not guarantee that the Service or any of its content is appropriate or permissible in any specific country or region.\n\n<b>7. Modifications</b>\n7.1 We reserve the right to modify or replace these Terms at any time.\n7.2 If we make material changes, we will post the updated Terms and indicate the date of the latest revision at the top of this document.\n\n<b>8. Governing Law and Dispute Resolution</b>\n8.1 These Terms shall be governed by and construed in accordance with the laws applicable in the jurisdiction of the Service provider’s principal place of business, without regard to conflict-of-law principles.\n8.2 Any dispute arising from or relating to these Terms shall be resolved through amicable negotiation and, if necessary, by binding arbitration or litigation in the applicable courts.\n\n<b>9. Acceptance of Terms</b>\n9.1 By continuing to access or use the Service, you acknowledge that you have read, understood, and agree to be bound by these Terms.\n9.2 If you do not agree, you must cease using the Service immediately.\n\nIf you have any questions or concerns regarding these Terms, or if you need further clarification, please contact at <i>k.sysoev@me.com</i>.": 10,
+	"Adding a vaccination or preventive treatment record for %s.": 58,
+	"Does your pet have any chronic diseases?":                    78,
+	"Done": 33,
+	"How would you describe your pet's activity level?":                                                            74,
+	"I apologize, but your message is too long for me to process. Please try to make it shorter and more concise.": 2,
+	"I couldn't find a pet named %s. Use /pets to see your pets.":                                                  21,
+	"I'll remind you again in an hour":                                                                             37,
+	"Is your pet spayed or neutered?":                                                                              71,
+	"Marked as done":                                                                                               36,
+	"Next: %s":                                                                                                     41,
+	"No vaccinations or preventive treatments are overdue. Use /addvaccine to add a new record.": 47,
+	"Overdue vaccinations and preventive treatments:":                                            48,
+	"Pet profile saved successfully":                                                             55,
+	"Please contact your veterinarian to schedule them, then use /addvaccine to record them.":    49,
+	"Please provide a date in the valid format YYYY-MM-DD (e.g., 2023-12-31)":                    57,
+	"Please send the weight with its unit, e.g. /weight 12.4kg or /weight 9 lbs":                 54,
+	"Please, provide at least one photo":                                                         27,
+	"Please, provide no more than %d photo(s)":                                                   28,
+	"Please, provide your question in text format along with photo(s)":                           26,
+	"Profile of %s has been removed.":                                                            24,
+	"Provided date cannot be in the future. Please provide a valid date.":                        56,
+	"Questionary is cancelled":                                                                   0,
+	"Record of %s saved for %s":                                                                  60,
+	"Reminder deleted":                                                                           38,
+	"Reminder set: %s, %s.\nNext reminder: %s":                                                   31,
+	"Reminder: %s":                           32,
+	"Reminders are not available right now.": 30,
+	"Snooze 1h":                              34,
+	"Sorry the answer didn't help. What was wrong with it? Reply to this message with a short comment, or just ignore it.":                                                     14,
+	"Sorry, I cannot process videos, audio, or documents. Please send your question as text only.":                                                                             17,
+	"Sorry, I encountered an error while processing your request. Please try again later.":                                                                                     7,
+	"Tell me what to remind you about and how often, for example:\n/remind give Rimadyl every 12h for 7 days\n/remind flea treatment monthly\n/remind brush teeth twice a day": 42,
+	"Thank you for your feedback!":                                                                     13,
+	"Thank you, your feedback helps us improve the answers.":                                           16,
+	"There are no weight entries for %s yet. Use /weight to add one, e.g. /weight 12.4kg":              52,
+	"This answer can no longer be rated.":                                                              12,
+	"This reminder no longer exists.":                                                                  35,
+	"Unknown command":                                                                                  8,
+	"Use /switchpet to select the pet your questions are about.":                                       19,
+	"Use /weightchart to see how it changes over time.":                                                51,
+	"We have reached our daily request limit. Please come back tomorrow when our budget is refreshed.": 5,
+	"Weight history of %s":                                                                             53,
+	"Weight of %s recorded: %s.":                                                                       50,
+	"Welcome to Help My Pet Bot! 🐾\n\nI'm your personal pet care assistant, ready to provide guidance for your furry friends. I can help with:\n\n- Health concerns and symptom assessment\n- Behavior questions and training techniques\n- Diet and nutrition recommendations\n- General pet care and wellness advice\n\nSimply type your question or concern about your pet. You can also include photos to help me better understand your situation.\n\nRemember, while I offer helpful guidance based on reliable veterinary knowledge, I'm not a replacement for professional veterinary care. Always consult a veterinarian for serious medical concerns.\n\nWhat pet question can I help you with today?": 9,
+	"What are your pet's food preferences or dietary restrictions?": 79,
+	"What breed is your pet?":    65,
+	"What is your pet's gender?": 67,
+	"What is your pet's name?":   61,
+	"What is your pet's weight? Please specify the weight followed by the unit, e.g., 5 kg": 70,
+	"What type of pet do you have?": 62,
+	"What was wrong?":               15,
+	"When is the next dose due? Please enter the date in the format YYYY-MM-DD, or skip if you don't know.": 83,
+	"When was it given? Please enter the date in the format YYYY-MM-DD (e.g., 2024-05-31).":                 82,
+	"When was your pet born? Please enter the date in the format YYYY-MM-DD (e.g., 2010-12-31).":            66,
+	"Which clinic gave it?":                       84,
+	"Which pet profile would you like to remove?": 23,
+	"Which pet would you like to ask about?":      20,
+	"Which vaccine or preventive treatment was given (e.g., rabies, deworming, flea treatment)?":              81,
+	"You don't have any pet profiles yet. Use /editprofile or /addpet to create one.":                         25,
+	"You don't have any reminders. Use /remind to create one, e.g. /remind give Rimadyl every 12h for 7 days": 39,
+	"You have reached the maximum number of requests per hour. Please try again later.":                       3,
+	"You have too many reminders. Use /reminders to delete the ones you don't need.":                          29,
+	"You have used up your question allowance for now. Please try again later.":                               4,
+	"Your conversation and pet profiles have been removed.":                                                   1,
+	"Your conversation was changed by another message while I was processing this one. Please send it again.": 6,
+	"Your pets:":                       18,
+	"Your questions are now about %s.": 22,
+	"Your reminders:":                  40,
+	"cat":                              64,
+	"dog":                              63,
+	"female":                           69,
+	"high":                             77,
+	"low":                              75,
+	"male":                             68,
+	"medium":                           76,
+	"no":                               73,
+	"skip":                             80,
+	"yes":                              72,
+	"⚠️ We recommend a visit to your veterinarian within the next day or two.":                                                 44,
+	"🏥 Find an emergency vet nearby":                                                                                           45,
+	"🚨 EMERGENCY: your pet may need immediate veterinary care. Contact your veterinarian or the nearest emergency clinic now.": 43,
 }
 
-var be_BYIndex = []uint32{ // 36 elements
+var be_BYIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000022, 0x00000044, 0x000005ec,
-	0x00001f73, 0x0000226d, 0x00002361, 0x0000244e,
-	0x00002500, 0x000025b8, 0x00002648, 0x000026b1,
-	0x0000270f, 0x000027bd, 0x0000286e, 0x00002902,
-	0x00002953, 0x00002993, 0x000029bf, 0x000029cc,
-	0x000029d3, 0x00002a07, 0x00002abd, 0x00002aee,
-	0x00002b01, 0x00002b0e, 0x00002bbd, 0x00002c22,
-	0x00002c29, 0x00002c2e, 0x00002c88, 0x00002c93,
+	0x00000000, 0x00000022, 0x00000073, 0x00000160,
+	0x00000212, 0x00000292, 0x0000034a, 0x00000411,
+	0x000004bf, 0x000004e1, 0x00000a89, 0x00002410,
+	0x00002b45, 0x00002b81, 0x00002ba8, 0x00002c95,
+	0x00002cb2, 0x00002d0f, 0x00002e03, 0x00002e20,
+	0x00002ea0, 0x00002ee7, 0x00002f81, 0x00002fc5,
+	0x00003016, 0x00003050, 0x000030f7, 0x00003187,
+	0x000031f0, 0x0000324e, 0x000032df, 0x00003313,
 	// Entry 20 - 3F
-	0x00002ca2, 0x00002caf, 0x00002d07, 0x00002d99,
-} // Size: 168 bytes
+	0x00003369, 0x0000337f, 0x0000338c, 0x000033ad,
+	0x000033e0, 0x0000340b, 0x0000343e, 0x0000345e,
+	0x00003513, 0x0000352e, 0x00003546, 0x00003611,
+	0x00003738, 0x000037c3, 0x0000381f, 0x00003840,
+	0x00003904, 0x0000396a, 0x00003a07, 0x00003a42,
+	0x00003ab4, 0x00003b69, 0x00003b9c, 0x00003c13,
+	0x00003c64, 0x00003d15, 0x00003da9, 0x00003e33,
+	0x00003eb7, 0x00003efd, 0x00003f3d, 0x00003f69,
+	// Entry 40 - 5F
+	0x00003f76, 0x00003f7d, 0x00003fb1, 0x00004067,
+	0x00004098, 0x000040ab, 0x000040b8, 0x00004167,
+	0x000041cc, 0x000041d3, 0x000041d8, 0x00004232,
+	0x0000423d, 0x0000424c, 0x00004259, 0x000042b1,
+	0x00004343, 0x00004358, 0x0000440d, 0x0000449b,
+	0x0000453b, 0x0000456f,
+} // Size: 368 bytes
 
-const be_BYData string = "" + // Size: 11673 bytes
-	"\x02Апытанне адмянена\x02Невядомая каманда\x02Сардэчна запрашаем у Help " +
-	"My Pet Bot! 🐾\x0a\x0aЯ ваш асабісты асістэнт па даглядзе за домашнімі жы" +
-	"вёламі, гатовы дапамагчы вашым пухнатым сябрам. Я магу дапамагчы з:\x0a" +
-	"\x0a- Праблемамі здароўя і ацэнкай сімптомаў\x0a- Пытаннямі паводзінаў і" +
-	" тэхнікай дрэсіравкі\x0a- Рэкамендацыямі па харчаванню і харчаванню\x0a-" +
-	" Агульнымі парадамі па даглядзе за домашнімі жывёламі і здароўем\x0a\x0a" +
-	"Проста ўвядзіце ваша пытанне або праблему з вашым пухнатым сябрам. Вы т" +
-	"аксама можаце дадаць фотаздымкі, каб дапамагчы мне лепей разумець ваша " +
-	"сітуацыю.\x0a\x0aПамятайце, што, хаця я прапаную карысныя парады на асн" +
-	"ове надзейнай ветэрынарнай ведамасці, я не замена прафесійнай ветэрынар" +
-	"най дапамозе. Заўсёды кансультуйцеся з ветэрынарам па серыёзным медычны" +
-	"м пытанням.\x0a\x0aЯкім пытаннем або праблемай з домашнімі жывёламі я м" +
-	"агу вам дапамагчы сёння?\x02<b>Умовы і Палажэнні</b>\x0a<i>Апошняе абна" +
-	"ўленне: 30.01.2025</i>\x0a\x0aДзякуй за выкарыстанне нашага чат-бота дл" +
-	"я ветэрынарных кансультацый («Сэрвіс»). Доступ да гэтага Сэрвісу або яг" +
-	"о выкарыстанне азначае вашу згоду з наступнымі ўмовамі і палажэннямі («" +
-	"Умовы»). Калі вы не згодныя з гэтымі Умовамі, калі ласка, неадкладна сп" +
-	"ыніце выкарыстанне.\x0a\x0a<b>1. Характар Сэрвісу</b>\x0a1.1 Сэрвіс пра" +
-	"дастаўляе агульную інфармацыю, рэкамендацыі і парады па догляду за хатн" +
-	"імі жывёламі, уключаючы (але не абмяжоўваючыся) харчаванне, паводзіны і" +
-	" дрэсіроўку.\x0a1.2 Сэрвіс не з'яўляецца заменай прафесійнай ветэрынарна" +
-	"й дыягностыкі, лячэння або догляду. Заўсёды звяртайцеся за парадай да л" +
-	"іцэнзаванага ветэрынара па любых пытаннях, якія тычацца здароўя вашага " +
-	"хатняга жывёлы.\x0a\x0a<b>2. Адсутнасць адносін ветэрынар-кліент-пацыен" +
-	"т</b>\x0a2.1 Выкарыстанне Сэрвісу або ўзаемадзеянне з нашым AI-памочнік" +
-	"ам не стварае адносін ветэрынар-кліент-пацыент.\x0a2.2 Любыя парады або" +
-	" рэкамендацыі, прадастаўленыя Сэрвісам, заснаваны на абмежаванай інфарма" +
-	"цыі і павінны разглядацца толькі як агульная інфармацыя.\x0a\x0a<b>3. А" +
-	"бмежаванне адказнасці</b>\x0a3.1 Вы прызнаеце і згаджаецеся, што выкары" +
-	"станне Сэрвісу ажыццяўляецца на ваш уласны рызыка.\x0a3.2 Ні пры якіх а" +
-	"бставінах уладальнікі, распрацоўшчыкі або ліцэнзіяры Сэрвісу не нясуць " +
-	"адказнасці за любыя прамыя, ускосныя, выпадковыя, спецыяльныя або насту" +
-	"пныя страты, якія ўзнікаюць у сувязі з вашым доступам да Сэрвісу або яг" +
-	"о выкарыстаннем.\x0a3.3 Вы разумееце, што рашэнні адносна догляду за ва" +
-	"шым хатнім жывёлам і любыя вынікі, якія вынікаюць з гэтага, з'яўляюцца " +
-	"вашай асабістай адказнасцю. Калі ў вас ёсць сумневы адносна дабрабыту в" +
-	"ашага хатняга жывёлы або яго здароўя, вы павінны неадкладна звярнуцца д" +
-	"а ліцэнзаванага ветэрынара.\x0a\x0a<b>4. Адсутнасць гарантый</b>\x0a4.1" +
-	" Сэрвіс прадастаўляецца на ўмовах «як ёсць» і «як даступна» без якіх-неб" +
-	"удзь гарантый, выказаных або маўклівых.\x0a4.2 Мы не гарантуем, што Сэр" +
-	"віс будзе бесперапынным, без памылак, бяспечным або без вірусаў.\x0a" +
-	"\x0a<b>5. Абавязкі карыстальніка</b>\x0a5.1 Вы нясеце адказнасць за прад" +
-	"астаўленне дакладнай і поўнай інфармацыі пра вашага хатняга жывёлы пры " +
-	"запыце парады.\x0a5.2 Вы павінны пераканацца, што ўсе пытанні, апісанні" +
-	" і дадзеныя, якія вы прадастаўляеце, не парушаюць правы трэціх асоб або " +
-	"мясцовыя законы.\x0a\x0a<b>6. Міжнароднае выкарыстанне</b>\x0a6.1 Сэрві" +
-	"с прызначаны для глабальнага выкарыстання. Вы нясеце адказнасць за выка" +
-	"нанне ўсіх прымяняльных мясцовых законаў і правілаў у вашай юрысдыкцыі." +
-	"\x0a6.2 Мы не гарантуем, што Сэрвіс або любы яго змест з'яўляецца адпаве" +
-	"дным або дапушчальным у якой-небудзь канкрэтнай краіне або рэгіёне.\x0a" +
-	"\x0a<b>7. Змены</b>\x0a7.1 Мы пакідаем за сабой права змяняць або замяня" +
-	"ць гэтыя Умовы ў любы час.\x0a7.2 Калі мы ўнясем істотныя змены, мы апу" +
-	"блікуем абноўленыя Умовы і ўкажам дату апошняй рэдакцыі ў верхняй частц" +
-	"ы гэтага дакумента.\x0a\x0a<b>8. Прымяняльнае права і вырашэнне спрэчак" +
-	"</b>\x0a8.1 Гэтыя Умовы рэгулююцца і тлумачацца ў адпаведнасці з законам" +
-	"і, якія прымяняюцца ў юрысдыкцыі асноўнага месца вядзення бізнесу паста" +
-	"ўшчыка Сэрвісу, без уліку прынцыпаў канфлікту законаў.\x0a8.2 Любыя спр" +
-	"эчкі, якія ўзнікаюць з гэтых Умоў або ў сувязі з імі, павінны вырашацца" +
-	" шляхам сяброўскіх перамоў і, пры неабходнасці, шляхам абавязковага арбі" +
-	"тражу або судовага разбору ў адпаведных судах.\x0a\x0a<b>9. Прыняцце Ум" +
-	"оў</b>\x0a9.1 Працягваючы доступ да Сэрвісу або яго выкарыстанне, вы пр" +
-	"ызнаеце, што прачыталі, зразумелі і згаджаецеся з гэтымі Умовамі.\x0a9." +
-	"2 Калі вы не згодныя, вы павінны неадкладна спыніць выкарыстанне Сэрвісу" +
-	".\x0a\x0aКалі ў вас ёсць якія-небудзь пытанні або праблемы адносна гэтых" +
-	" Умоў, або калі вам патрэбна дадатковая інфармацыя, калі ласка, звяжыцес" +
-	"я па адрасе <i>k.sysoev@me.com</i>.\x02<b>Каманды Help My Pet Bot</b>:" +
-	"\x0a/start - Пачаць размовы з ботам\x0a/terms - Праглядзець Умовы і Пала" +
-	"жэнні паслугі\x0a/editprofile - Абнавіце інфармацыю пра профіль вашага " +
-	"пухнатага сябра, такую як імя, узрост, расу і г.д. Гэтая інфармацыя дап" +
-	"амагае боту прадастаўляць болей дакладныя парады.\x0a/cancel - Адмяніць" +
-	" бягучае апытанне, калі яно ўжо ў працэсе (напрыклад, калі вы хочаце пач" +
-	"аць зноў або змяніць ваша пытанне)\x0a/help - Праглядзець гэтае паведам" +
-	"ленне\x02Прабачце, я не магу апрацаваць відэа, аўдыё або дакументы. Кал" +
-	"і ласка, паспрабуйце адправіць ваша пытанне толькі ў тэкставым фармаце." +
-	"\x02Прабачце, але ваша паведамленне занадта доўгае для апрацоўкі. Калі л" +
-	"аска, паспрабуйце зрабіць яго карацейшым і больш лаканічным.\x02Вы дася" +
-	"гнулі максімальнай колькасці запытаў на гадзіну. Калі ласка, паспрабуйц" +
-	"е яшчэ раз пазней.\x02Мы дасягнулі нашай штодзённай мяжы запытаў. Калі " +
-	"ласка, вярніцеся заўтра, калі наш бюджэт абноўлены.\x02Калі ласка, прад" +
-	"астаўце ваша пытанне ў тэкставым фармаце разам з фотаздымкамі\x02Калі л" +
-	"аска, прадастаўце па крайняй меры адзін фотаздымак\x02Калі ласка, прада" +
-	"стаўце не больш за %[1]d фотаздымкаў\x02Прабачце, я ўзнёс памылку пры а" +
-	"працоўцы вашага запыту. Калі ласка, паспрабуйце яшчэ раз пазней.\x02Пра" +
-	"дстаўленая дата не можа быць у будучыні. Калі ласка, прадастаўце дату ў" +
-	" дапушчальным фармаце.\x02Калі ласка, прадастаўце дату ў дапушчальным фа" +
-	"рмаце ГГГГ-ММ-ДД (напрыклад, 2023-12-31)\x02Профіль пухнатага сябра пас" +
-	"пяхова захаваны\x02Як зваліце вашага пухнатага сябра?\x02Якога тыпу жыв" +
-	"ёлу у вас?\x02сабака\x02кот\x02Якой расы ваш пухнаты сябар?\x02Калі нар" +
-	"адзіўся ваш пухнаты сябар? Калі ласка, увядзіце дату ў фармаце ГГГГ-ММ-" +
-	"ДД (напрыклад, 2010-12-31).\x02Якога ваш пухнатага сябра?\x02мужчынскі" +
-	"\x02жаночы\x02Які вага вашага пухнатага сябра? Калі ласка, пазначце вагу" +
-	", наступнае за адзінка, напрыклад, 5 кг\x02Ці быў ваш пухнаты сябар стэр" +
-	"ылізаваны або кастраваны?\x02так\x02не\x02Як вы апішаце актыўнасць ваша" +
-	"га пухнатага сябра?\x02нізкі\x02сярэдні\x02высокі\x02Ці мае ваш пухнаты" +
-	" сябар хронічныя захворванні?\x02Якія ў вашага пухнатага сябра перавагі " +
-	"ў харчаванні або дыетычныя абмежаванні?"
+const be_BYData string = "" + // Size: 17775 bytes
+	"\x02Апытанне адмянена\x02Вашу размову і профілі гадаванцаў выдалена.\x02" +
+	"Прабачце, але ваша паведамленне занадта доўгае для апрацоўкі. Калі ласк" +
+	"а, паспрабуйце зрабіць яго карацейшым і больш лаканічным.\x02Вы дасягну" +
+	"лі максімальнай колькасці запытаў на гадзіну. Калі ласка, паспрабуйце я" +
+	"шчэ раз пазней.\x02Вы вычарпалі даступны ліміт пытанняў. Калі ласка, па" +
+	"спрабуйце пазней.\x02Мы дасягнулі нашай штодзённай мяжы запытаў. Калі л" +
+	"аска, вярніцеся заўтра, калі наш бюджэт абноўлены.\x02Пакуль я апрацоўв" +
+	"аў гэта паведамленне, размову змяніла іншае паведамленне. Калі ласка, д" +
+	"ашліце яго яшчэ раз.\x02Прабачце, я ўзнёс памылку пры апрацоўцы вашага " +
+	"запыту. Калі ласка, паспрабуйце яшчэ раз пазней.\x02Невядомая каманда" +
+	"\x02Сардэчна запрашаем у Help My Pet Bot! 🐾\x0a\x0aЯ ваш асабісты асістэ" +
+	"нт па даглядзе за домашнімі жывёламі, гатовы дапамагчы вашым пухнатым с" +
+	"ябрам. Я магу дапамагчы з:\x0a\x0a- Праблемамі здароўя і ацэнкай сімпто" +
+	"маў\x0a- Пытаннямі паводзінаў і тэхнікай дрэсіравкі\x0a- Рэкамендацыямі" +
+	" па харчаванню і харчаванню\x0a- Агульнымі парадамі па даглядзе за домаш" +
+	"німі жывёламі і здароўем\x0a\x0aПроста ўвядзіце ваша пытанне або прабле" +
+	"му з вашым пухнатым сябрам. Вы таксама можаце дадаць фотаздымкі, каб да" +
+	"памагчы мне лепей разумець ваша сітуацыю.\x0a\x0aПамятайце, што, хаця я" +
+	" прапаную карысныя парады на аснове надзейнай ветэрынарнай ведамасці, я " +
+	"не замена прафесійнай ветэрынарнай дапамозе. Заўсёды кансультуйцеся з в" +
+	"етэрынарам па серыёзным медычным пытанням.\x0a\x0aЯкім пытаннем або пра" +
+	"блемай з домашнімі жывёламі я магу вам дапамагчы сёння?\x02<b>Умовы і П" +
+	"алажэнні</b>\x0a<i>Апошняе абнаўленне: 30.01.2025</i>\x0a\x0aДзякуй за " +
+	"выкарыстанне нашага чат-бота для ветэрынарных кансультацый («Сэрвіс»). " +
+	"Доступ да гэтага Сэрвісу або яго выкарыстанне азначае вашу згоду з наст" +
+	"упнымі ўмовамі і палажэннямі («Умовы»). Калі вы не згодныя з гэтымі Умо" +
+	"вамі, калі ласка, неадкладна спыніце выкарыстанне.\x0a\x0a<b>1. Характа" +
+	"р Сэрвісу</b>\x0a1.1 Сэрвіс прадастаўляе агульную інфармацыю, рэкаменда" +
+	"цыі і парады па догляду за хатнімі жывёламі, уключаючы (але не абмяжоўв" +
+	"аючыся) харчаванне, паводзіны і дрэсіроўку.\x0a1.2 Сэрвіс не з'яўляецца" +
+	" заменай прафесійнай ветэрынарнай дыягностыкі, лячэння або догляду. Заўс" +
+	"ёды звяртайцеся за парадай да ліцэнзаванага ветэрынара па любых пытання" +
+	"х, якія тычацца здароўя вашага хатняга жывёлы.\x0a\x0a<b>2. Адсутнасць " +
+	"адносін ветэрынар-кліент-пацыент</b>\x0a2.1 Выкарыстанне Сэрвісу або ўз" +
+	"аемадзеянне з нашым AI-памочнікам не стварае адносін ветэрынар-кліент-п" +
+	"ацыент.\x0a2.2 Любыя парады або рэкамендацыі, прадастаўленыя Сэрвісам, " +
+	"заснаваны на абмежаванай інфармацыі і павінны разглядацца толькі як агу" +
+	"льная інфармацыя.\x0a\x0a<b>3. Абмежаванне адказнасці</b>\x0a3.1 Вы пры" +
+	"знаеце і згаджаецеся, што выкарыстанне Сэрвісу ажыццяўляецца на ваш ула" +
+	"сны рызыка.\x0a3.2 Ні пры якіх абставінах уладальнікі, распрацоўшчыкі а" +
+	"бо ліцэнзіяры Сэрвісу не нясуць адказнасці за любыя прамыя, ускосныя, в" +
+	"ыпадковыя, спецыяльныя або наступныя страты, якія ўзнікаюць у сувязі з " +
+	"вашым доступам да Сэрвісу або яго выкарыстаннем.\x0a3.3 Вы разумееце, ш" +
+	"то рашэнні адносна догляду за вашым хатнім жывёлам і любыя вынікі, якія" +
+	" вынікаюць з гэтага, з'яўляюцца вашай асабістай адказнасцю. Калі ў вас ё" +
+	"сць сумневы адносна дабрабыту вашага хатняга жывёлы або яго здароўя, вы" +
+	" павінны неадкладна звярнуцца да ліцэнзаванага ветэрынара.\x0a\x0a<b>4. " +
+	"Адсутнасць гарантый</b>\x0a4.1 Сэрвіс прадастаўляецца на ўмовах «як ёсц" +
+	"ь» і «як даступна» без якіх-небудзь гарантый, выказаных або маўклівых." +
+	"\x0a4.2 Мы не гарантуем, што Сэрвіс будзе бесперапынным, без памылак, бя" +
+	"спечным або без вірусаў.\x0a\x0a<b>5. Абавязкі карыстальніка</b>\x0a5.1" +
+	" Вы нясеце адказнасць за прадастаўленне дакладнай і поўнай інфармацыі пр" +
+	"а вашага хатняга жывёлы пры запыце парады.\x0a5.2 Вы павінны пераканацц" +
+	"а, што ўсе пытанні, апісанні і дадзеныя, якія вы прадастаўляеце, не пар" +
+	"ушаюць правы трэціх асоб або мясцовыя законы.\x0a\x0a<b>6. Міжнароднае " +
+	"выкарыстанне</b>\x0a6.1 Сэрвіс прызначаны для глабальнага выкарыстання." +
+	" Вы нясеце адказнасць за выкананне ўсіх прымяняльных мясцовых законаў і " +
+	"правілаў у вашай юрысдыкцыі.\x0a6.2 Мы не гарантуем, што Сэрвіс або люб" +
+	"ы яго змест з'яўляецца адпаведным або дапушчальным у якой-небудзь канкр" +
+	"этнай краіне або рэгіёне.\x0a\x0a<b>7. Змены</b>\x0a7.1 Мы пакідаем за " +
+	"сабой права змяняць або замяняць гэтыя Умовы ў любы час.\x0a7.2 Калі мы" +
+	" ўнясем істотныя змены, мы апублікуем абноўленыя Умовы і ўкажам дату апо" +
+	"шняй рэдакцыі ў верхняй частцы гэтага дакумента.\x0a\x0a<b>8. Прымяняль" +
+	"нае права і вырашэнне спрэчак</b>\x0a8.1 Гэтыя Умовы рэгулююцца і тлума" +
+	"чацца ў адпаведнасці з законамі, якія прымяняюцца ў юрысдыкцыі асноўнаг" +
+	"а месца вядзення бізнесу пастаўшчыка Сэрвісу, без уліку прынцыпаў канфл" +
+	"ікту законаў.\x0a8.2 Любыя спрэчкі, якія ўзнікаюць з гэтых Умоў або ў с" +
+	"увязі з імі, павінны вырашацца шляхам сяброўскіх перамоў і, пры неабход" +
+	"насці, шляхам абавязковага арбітражу або судовага разбору ў адпаведных " +
+	"судах.\x0a\x0a<b>9. Прыняцце Умоў</b>\x0a9.1 Працягваючы доступ да Сэрв" +
+	"ісу або яго выкарыстанне, вы прызнаеце, што прачыталі, зразумелі і згад" +
+	"жаецеся з гэтымі Умовамі.\x0a9.2 Калі вы не згодныя, вы павінны неадкла" +
+	"дна спыніць выкарыстанне Сэрвісу.\x0a\x0aКалі ў вас ёсць якія-небудзь п" +
+	"ытанні або праблемы адносна гэтых Умоў, або калі вам патрэбна дадаткова" +
+	"я інфармацыя, калі ласка, звяжыцеся па адрасе <i>k.sysoev@me.com</i>." +
+	"\x02<b>Каманды Help My Pet Bot</b>:\x0a/start - Пачаць размовы з ботам" +
+	"\x0a/terms - Праглядзець Умовы і Палажэнні паслугі\x0a/editprofile - Абн" +
+	"авіце інфармацыю пра профіль вашага пухнатага сябра, такую як імя, узро" +
+	"ст, расу і г.д. Гэтая інфармацыя дапамагае боту прадастаўляць болей дак" +
+	"ладныя парады.\x0a/addpet - Дадаць профіль яшчэ аднаго гадаванца, калі " +
+	"ў вас іх некалькі\x0a/pets - Паказаць вашых гадаванцаў і выбранага зара" +
+	"з\x0a/switchpet - Выбраць гадаванца, пра якога будуць наступныя пытанні" +
+	"\x0a/removepet - Выдаліць профіль гадаванца\x0a/weight - Запісаць бягучу" +
+	"ю вагу гадаванца, напрыклад /weight 12.4kg\x0a/weightchart - Праглядзец" +
+	"ь графік вагі гадаванца\x0a/vaccines - Паказаць пратэрмінаваныя прышчэп" +
+	"кі і прафілактычныя апрацоўкі вашых гадаванцаў\x0a/addvaccine - Дадаць " +
+	"запіс пра прышчэпку або прафілактычную апрацоўку гадаванца\x0a/remind -" +
+	" Стварыць паўторны напамін, напрыклад /remind give Rimadyl every 12h for" +
+	" 7 days\x0a/reminders - Паказаць напаміны і выдаліць непатрэбныя\x0a/can" +
+	"cel - Адмяніць бягучае апытанне, калі яно ўжо ў працэсе (напрыклад, калі" +
+	" вы хочаце пачаць зноў або змяніць ваша пытанне)\x0a/help - Праглядзець " +
+	"гэтае паведамленне\x02Гэты адказ больш нельга ацаніць.\x02Дзякуй за ваш" +
+	" водгук!\x02Шкада, што адказ не дапамог. Што з ім было не так? Адкажыце " +
+	"на гэта паведамленне кароткім каментарыем або проста праігнаруйце яго." +
+	"\x02Што было не так?\x02Дзякуй, ваш водгук дапамагае нам паляпшаць адказ" +
+	"ы.\x02Прабачце, я не магу апрацаваць відэа, аўдыё або дакументы. Калі л" +
+	"аска, паспрабуйце адправіць ваша пытанне толькі ў тэкставым фармаце." +
+	"\x02Вашы гадаванцы:\x02Выкарыстоўвайце /switchpet, каб выбраць гадаванца" +
+	", пра якога вашы пытанні.\x02Пра якога гадаванца вы хочаце спытаць?\x02Я" +
+	" не знайшоў гадаванца з імем %[1]s. Выкарыстоўвайце /pets, каб убачыць с" +
+	"ваіх гадаванцаў.\x02Цяпер вашы пытанні пра гадаванца %[1]s.\x02Профіль " +
+	"якога гадаванца вы хочаце выдаліць?\x02Профіль гадаванца %[1]s выдалены" +
+	".\x02У вас яшчэ няма профіляў гадаванцаў. Выкарыстоўвайце /editprofile а" +
+	"бо /addpet, каб стварыць профіль.\x02Калі ласка, прадастаўце ваша пытан" +
+	"не ў тэкставым фармаце разам з фотаздымкамі\x02Калі ласка, прадастаўце " +
+	"па крайняй меры адзін фотаздымак\x02Калі ласка, прадастаўце не больш за" +
+	" %[1]d фотаздымкаў\x02У вас занадта шмат напамінаў. Выкарыстоўвайце /rem" +
+	"inders, каб выдаліць непатрэбныя.\x02Напаміны зараз недаступныя.\x02Напа" +
+	"мін створаны: %[1]s, %[2]s.\x0aНаступны напамін: %[3]s\x02Напамін: %[1]" +
+	"s\x02Гатова\x02Адкласці на 1 гадз\x02Гэтага напаміну больш няма.\x02Адзн" +
+	"ачана як выкананае\x02Я нагадаю зноў праз гадзіну\x02Напамін выдалены" +
+	"\x02У вас няма напамінаў. Выкарыстоўвайце /remind, каб стварыць напамін," +
+	" напрыклад: /remind give Rimadyl every 12h for 7 days\x02Вашы напаміны:" +
+	"\x02Наступны: %[1]s\x02Напішыце, пра што і як часта вам нагадваць, напры" +
+	"клад:\x0a/remind give Rimadyl every 12h for 7 days\x0a/remind flea trea" +
+	"tment monthly\x0a/remind brush teeth twice a day\x02🚨 ТЭРМІНОВА: вашаму " +
+	"гадаванцу можа спатрэбіцца неадкладная ветэрынарная дапамога. Звяжыцеся" +
+	" з ветэрынарам або бліжэйшай кругласутачнай клінікай прама зараз.\x02⚠️ " +
+	"Рэкамендуем наведаць ветэрынара на працягу бліжэйшых аднаго-двух дзён." +
+	"\x02🏥 Знайсці ветклініку неадкладнай дапамогі побач\x02%[1]s: тэрмін быў" +
+	" %[2]s\x02Пратэрмінаваных прышчэпак і прафілактычных апрацовак няма. Вык" +
+	"арыстоўвайце /addvaccine, каб дадаць новы запіс.\x02Пратэрмінаваныя пры" +
+	"шчэпкі і прафілактычныя апрацоўкі:\x02Звяжыцеся з ветэрынарам, каб запі" +
+	"сацца, а потым выкарыстоўвайце /addvaccine, каб унесці іх.\x02Вага гада" +
+	"ванца %[1]s запісана: %[2]s.\x02Выкарыстоўвайце /weightchart, каб убачы" +
+	"ць, як яна змяняецца з часам.\x02Для гадаванца %[1]s яшчэ няма запісаў " +
+	"вагі. Выкарыстоўвайце /weight, каб дадаць запіс, напрыклад /weight 12.4" +
+	"kg\x02Гісторыя вагі гадаванца %[1]s\x02Дашліце вагу з адзінкай вымярэння" +
+	", напрыклад /weight 12.4kg або /weight 9 lbs\x02Профіль пухнатага сябра " +
+	"паспяхова захаваны\x02Прадстаўленая дата не можа быць у будучыні. Калі " +
+	"ласка, прадастаўце дату ў дапушчальным фармаце.\x02Калі ласка, прадаста" +
+	"ўце дату ў дапушчальным фармаце ГГГГ-ММ-ДД (напрыклад, 2023-12-31)\x02Д" +
+	"адаём запіс пра прышчэпку або прафілактычную апрацоўку для гадаванца %[" +
+	"1]s.\x02Гадаванца %[1]s больш няма сярод вашых гадаванцаў, таму запіс не" +
+	" захаваны.\x02Запіс «%[1]s» захаваны для гадаванца %[2]s\x02Як зваліце в" +
+	"ашага пухнатага сябра?\x02Якога тыпу жывёлу у вас?\x02сабака\x02кот\x02" +
+	"Якой расы ваш пухнаты сябар?\x02Калі нарадзіўся ваш пухнаты сябар? Калі" +
+	" ласка, увядзіце дату ў фармаце ГГГГ-ММ-ДД (напрыклад, 2010-12-31).\x02Я" +
+	"кога ваш пухнатага сябра?\x02мужчынскі\x02жаночы\x02Які вага вашага пух" +
+	"натага сябра? Калі ласка, пазначце вагу, наступнае за адзінка, напрыкла" +
+	"д, 5 кг\x02Ці быў ваш пухнаты сябар стэрылізаваны або кастраваны?\x02та" +
+	"к\x02не\x02Як вы апішаце актыўнасць вашага пухнатага сябра?\x02нізкі" +
+	"\x02сярэдні\x02высокі\x02Ці мае ваш пухнаты сябар хронічныя захворванні?" +
+	"\x02Якія ў вашага пухнатага сябра перавагі ў харчаванні або дыетычныя аб" +
+	"межаванні?\x02прапусціць\x02Якую прышчэпку або прафілактычную апрацоўку" +
+	" зрабілі (напрыклад, ад шаленства, ад глістоў, ад блох)?\x02Калі гэта бы" +
+	"ло зроблена? Увядзіце дату ў фармаце ГГГГ-ММ-ДД (напрыклад, 2024-05-31)" +
+	".\x02Калі наступная доза? Увядзіце дату ў фармаце ГГГГ-ММ-ДД або прапусц" +
+	"іце, калі не ведаеце.\x02У якой клініцы гэта зрабілі?"
 
-var ca_ESIndex = []uint32{ // 36 elements
+var ca_ESIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000021, 0x00000033, 0x00000346,
-	0x00001153, 0x0000133a, 0x000013a8, 0x00001420,
-	0x0000147d, 0x000014ee, 0x0000153f, 0x0000156d,
-	0x0000159e, 0x0000160c, 0x00001664, 0x000016be,
-	0x000016e5, 0x00001709, 0x00001725, 0x00001729,
-	0x0000172d, 0x0000174e, 0x000017c1, 0x000017e9,
-	0x000017f0, 0x000017f8, 0x00001861, 0x00001891,
-	0x00001895, 0x00001898, 0x000018d2, 0x000018d7,
+	0x00000000, 0x00000021, 0x00000066, 0x000000de,
+	0x0000013b, 0x0000018a, 0x000001fb, 0x0000025a,
+	0x000002c8, 0x000002da, 0x000005ed, 0x000013fa,
+	0x000018cc, 0x000018f3, 0x00001911, 0x00001992,
+	0x000019a3, 0x000019e1, 0x00001a4f, 0x00001a63,
+	0x00001ab6, 0x00001ada, 0x00001b33, 0x00001b5d,
+	0x00001b83, 0x00001ba5, 0x00001bfe, 0x00001c4f,
+	0x00001c7d, 0x00001cae, 0x00001d01, 0x00001d33,
 	// Entry 20 - 3F
-	0x000018de, 0x000018e2, 0x00001910, 0x0000196c,
-} // Size: 168 bytes
+	0x00001d6e, 0x00001d81, 0x00001d85, 0x00001d91,
+	0x00001db4, 0x00001dc5, 0x00001df1, 0x00001e06,
+	0x00001e74, 0x00001e8b, 0x00001e99, 0x00001f49,
+	0x00001fe9, 0x00002030, 0x0000205d, 0x00002073,
+	0x000020de, 0x0000210c, 0x00002172, 0x00002191,
+	0x000021cc, 0x00002235, 0x0000224f, 0x00002296,
+	0x000022bd, 0x00002315, 0x0000236f, 0x000023b9,
+	0x00002408, 0x0000242c, 0x00002450, 0x0000246c,
+	// Entry 40 - 5F
+	0x00002470, 0x00002474, 0x00002495, 0x00002508,
+	0x00002530, 0x00002537, 0x0000253f, 0x000025a8,
+	0x000025d8, 0x000025dc, 0x000025df, 0x00002619,
+	0x0000261e, 0x00002625, 0x00002629, 0x00002657,
+	0x000026b3, 0x000026b8, 0x0000272a, 0x00002786,
+	0x000027e6, 0x00002808,
+} // Size: 368 bytes
 
-const ca_ESData string = "" + // Size: 6508 bytes
-	"\x02El qüestionari s'ha cancel·lat\x02Ordre desconeguda\x02Benvingut a H" +
-	"elp My Pet Bot! 🐾\x0a\x0aSóc el teu assistent personal de cura de mascot" +
-	"es, preparat per proporcionar orientació per als teus amics peluts. Puc " +
-	"ajudar amb:\x0a\x0a- Preocupacions de salut i avaluació de símptomes\x0a" +
-	"- Preguntes de comportament i tècniques d'entrenament\x0a- Recomanacions" +
-	" de dieta i nutrició\x0a- Consells generals de cura de mascotes i benest" +
-	"ar\x0a\x0aSimplement escriu la teva pregunta o preocupació sobre la teva" +
-	" mascota. També pots incloure fotos per ajudar-me a entendre millor la t" +
-	"eva situació.\x0a\x0aRecorda, tot i que oferesc orientació útil basada e" +
-	"n coneixements veterinaris fiables, no sóc un substitut de la cura veter" +
-	"inària professional. Consulta sempre un veterinari per a preocupacions m" +
-	"èdiques serioses.\x0a\x0aAmb quina pregunta de mascotes et puc ajudar a" +
-	"vui?\x02<b>Termes i Condicions</b>\x0a<i>Última actualització: 30.01.202" +
-	"5</i>\x0a\x0aGràcies per utilitzar el nostre chatbot de consells veterin" +
-	"aris (“el Servei”). En accedir o utilitzar aquest Servei, acceptes estar" +
-	" subjecte als següents termes i condicions (“Termes”). Si no estàs d'aco" +
-	"rd amb aquests Termes, si us plau, deixa d'utilitzar-lo immediatament." +
-	"\x0a\x0a<b>1. Naturalesa del Servei</b>\x0a1.1 El Servei proporciona inf" +
-	"ormació general, orientació i suggeriments per a la cura de mascotes, in" +
-	"cloent (però no limitat a) dieta, comportament i entrenament.\x0a1.2 El " +
-	"Servei no és un substitut del diagnòstic, tractament o cura veterinària " +
-	"professional. Sempre busca el consell d'un veterinari llicenciat per a q" +
-	"ualsevol pregunta sobre la salut de la teva mascota.\x0a\x0a<b>2. No hi " +
-	"ha Relació Veterinari-Client-Pacient</b>\x0a2.1 Utilitzar el Servei o in" +
-	"teractuar amb el nostre assistent d'IA no crea una relació veterinari-cl" +
-	"ient-pacient.\x0a2.2 Qualsevol consell o orientació proporcionada pel Se" +
-	"rvei es basa en informació limitada i només s'ha de considerar com a inf" +
-	"ormació general.\x0a\x0a<b>3. Limitació de Responsabilitat</b>\x0a3.1 Re" +
-	"coneixes i acceptes que l'ús del Servei és sota el teu propi risc.\x0a3." +
-	"2 En cap cas els propietaris, desenvolupadors o llicenciadors del Servei" +
-	" seran responsables de danys directes, indirectes, incidentals, especial" +
-	"s o conseqüents derivats de o en connexió amb el teu accés o ús del Serv" +
-	"ei.\x0a3.3 Entens que les decisions sobre la cura de la teva mascota i q" +
-	"ualsevol resultat resultant són la teva única responsabilitat. Si tens a" +
-	"lgun dubte sobre el benestar de la teva mascota o la seva salut, hauries" +
-	" de consultar immediatament un veterinari llicenciat.\x0a\x0a<b>4. Sense" +
-	" Garantia</b>\x0a4.1 El Servei es proporciona “tal com és”, i “segons di" +
-	"sponibilitat”, sense garanties de cap tipus, ja siguin expresses o implí" +
-	"cites.\x0a4.2 No garantim que el Servei serà ininterromput, lliure d'err" +
-	"ors, segur o lliure de virus.\x0a\x0a<b>5. Responsabilitats de l'Usuari<" +
-	"/b>\x0a5.1 Ets responsable de proporcionar informació precisa i completa" +
-	" sobre la teva mascota quan busquis consell.\x0a5.2 Has d'assegurar-te q" +
-	"ue totes les preguntes, descripcions i dades que proporciones no violen " +
-	"cap dret de tercers o lleis locals.\x0a\x0a<b>6. Ús Internacional</b>" +
-	"\x0a6.1 El Servei està destinat a ús global. Ets responsable de complir " +
-	"amb totes les lleis i regulacions locals aplicables a la teva jurisdicci" +
-	"ó.\x0a6.2 No garantim que el Servei o qualsevol del seu contingut sigui" +
-	" apropiat o permès en cap país o regió específica.\x0a\x0a<b>7. Modifica" +
-	"cions</b>\x0a7.1 Ens reservem el dret de modificar o reemplaçar aquests " +
-	"Termes en qualsevol moment.\x0a7.2 Si fem canvis materials, publicarem e" +
-	"ls Termes actualitzats i indicarem la data de l'última revisió a la part" +
-	" superior d'aquest document.\x0a\x0a<b>8. Llei Aplicable i Resolució de " +
-	"Conflictes</b>\x0a8.1 Aquests Termes es regiran i interpretaran d'acord " +
-	"amb les lleis aplicables a la jurisdicció del proveïdor del Servei, sens" +
-	"e tenir en compte els principis de conflicte de lleis.\x0a8.2 Qualsevol " +
-	"disputa derivada de o relacionada amb aquests Termes es resoldrà mitjanç" +
-	"ant negociació amistosa i, si és necessari, per arbitratge vinculant o l" +
-	"itigi als tribunals aplicables.\x0a\x0a<b>9. Acceptació dels Termes</b>" +
-	"\x0a9.1 En continuar accedint o utilitzant el Servei, reconeixes que has" +
-	" llegit, entès i acceptes estar subjecte a aquests Termes.\x0a9.2 Si no " +
-	"estàs d'acord, has de deixar d'utilitzar el Servei immediatament.\x0a" +
-	"\x0aSi tens alguna pregunta o preocupació sobre aquests Termes, o si nec" +
-	"essites més aclariments, si us plau, contacta a <i>k.sysoev@me.com</i>." +
-	"\x02<b>Comandes de Help My Pet Bot</b>:\x0a/start - Inicia la conversa a" +
-	"mb el bot\x0a/terms - Mostra els Termes i Condicions del servei\x0a/edit" +
-	"profile - Actualitza la informació del perfil de la teva mascota, com ar" +
-	"a el nom, l'edat, la raça, etc. Aquesta informació ajuda el bot a propor" +
-	"cionar consells més precisos.\x0a/cancel - Cancel·la el qüestionari actu" +
-	"al, si n'hi ha un en curs (per exemple, quan vulguis començar de nou o c" +
-	"anviar la teva pregunta)\x0a/help - Mostra aquest missatge d'ajuda\x02Ho" +
-	" sento, no puc processar vídeos, àudio o documents. Si us plau, envia la" +
-	" teva pregunta només com a text.\x02Ho sento, però el teu missatge és ma" +
-	"ssa llarg per a mi per processar. Si us plau, intenta fer-lo més curt i " +
-	"concís.\x02Has arribat al nombre màxim de peticions per hora. Si us plau" +
-	", torna-ho a provar més tard.\x02Hem arribat al nostre límit diari de pe" +
-	"ticions. Si us plau, torna demà quan el nostre pressupost es refresqui." +
+const ca_ESData string = "" + // Size: 10248 bytes
+	"\x02El qüestionari s'ha cancel·lat\x02S'han eliminat la teva conversa i " +
+	"els perfils de les teves mascotes.\x02Ho sento, però el teu missatge és " +
+	"massa llarg per a mi per processar. Si us plau, intenta fer-lo més curt " +
+	"i concís.\x02Has arribat al nombre màxim de peticions per hora. Si us pl" +
+	"au, torna-ho a provar més tard.\x02Has esgotat el teu límit de preguntes" +
+	" de moment. Torna-ho a provar més tard.\x02Hem arribat al nostre límit d" +
+	"iari de peticions. Si us plau, torna demà quan el nostre pressupost es r" +
+	"efresqui.\x02La teva conversa ha canviat per un altre missatge mentre pr" +
+	"ocessava aquest. Torna a enviar-lo.\x02Ho sento, he trobat un error ment" +
+	"re processava la teva sol·licitud. Si us plau, torna-ho a provar més tar" +
+	"d.\x02Ordre desconeguda\x02Benvingut a Help My Pet Bot! 🐾\x0a\x0aSóc el " +
+	"teu assistent personal de cura de mascotes, preparat per proporcionar or" +
+	"ientació per als teus amics peluts. Puc ajudar amb:\x0a\x0a- Preocupacio" +
+	"ns de salut i avaluació de símptomes\x0a- Preguntes de comportament i tè" +
+	"cniques d'entrenament\x0a- Recomanacions de dieta i nutrició\x0a- Consel" +
+	"ls generals de cura de mascotes i benestar\x0a\x0aSimplement escriu la t" +
+	"eva pregunta o preocupació sobre la teva mascota. També pots incloure fo" +
+	"tos per ajudar-me a entendre millor la teva situació.\x0a\x0aRecorda, to" +
+	"t i que oferesc orientació útil basada en coneixements veterinaris fiabl" +
+	"es, no sóc un substitut de la cura veterinària professional. Consulta se" +
+	"mpre un veterinari per a preocupacions mèdiques serioses.\x0a\x0aAmb qui" +
+	"na pregunta de mascotes et puc ajudar avui?\x02<b>Termes i Condicions</b" +
+	">\x0a<i>Última actualització: 30.01.2025</i>\x0a\x0aGràcies per utilitza" +
+	"r el nostre chatbot de consells veterinaris (“el Servei”). En accedir o " +
+	"utilitzar aquest Servei, acceptes estar subjecte als següents termes i c" +
+	"ondicions (“Termes”). Si no estàs d'acord amb aquests Termes, si us plau" +
+	", deixa d'utilitzar-lo immediatament.\x0a\x0a<b>1. Naturalesa del Servei" +
+	"</b>\x0a1.1 El Servei proporciona informació general, orientació i sugge" +
+	"riments per a la cura de mascotes, incloent (però no limitat a) dieta, c" +
+	"omportament i entrenament.\x0a1.2 El Servei no és un substitut del diagn" +
+	"òstic, tractament o cura veterinària professional. Sempre busca el cons" +
+	"ell d'un veterinari llicenciat per a qualsevol pregunta sobre la salut d" +
+	"e la teva mascota.\x0a\x0a<b>2. No hi ha Relació Veterinari-Client-Pacie" +
+	"nt</b>\x0a2.1 Utilitzar el Servei o interactuar amb el nostre assistent " +
+	"d'IA no crea una relació veterinari-client-pacient.\x0a2.2 Qualsevol con" +
+	"sell o orientació proporcionada pel Servei es basa en informació limitad" +
+	"a i només s'ha de considerar com a informació general.\x0a\x0a<b>3. Limi" +
+	"tació de Responsabilitat</b>\x0a3.1 Reconeixes i acceptes que l'ús del S" +
+	"ervei és sota el teu propi risc.\x0a3.2 En cap cas els propietaris, dese" +
+	"nvolupadors o llicenciadors del Servei seran responsables de danys direc" +
+	"tes, indirectes, incidentals, especials o conseqüents derivats de o en c" +
+	"onnexió amb el teu accés o ús del Servei.\x0a3.3 Entens que les decision" +
+	"s sobre la cura de la teva mascota i qualsevol resultat resultant són la" +
+	" teva única responsabilitat. Si tens algun dubte sobre el benestar de la" +
+	" teva mascota o la seva salut, hauries de consultar immediatament un vet" +
+	"erinari llicenciat.\x0a\x0a<b>4. Sense Garantia</b>\x0a4.1 El Servei es " +
+	"proporciona “tal com és”, i “segons disponibilitat”, sense garanties de " +
+	"cap tipus, ja siguin expresses o implícites.\x0a4.2 No garantim que el S" +
+	"ervei serà ininterromput, lliure d'errors, segur o lliure de virus.\x0a" +
+	"\x0a<b>5. Responsabilitats de l'Usuari</b>\x0a5.1 Ets responsable de pro" +
+	"porcionar informació precisa i completa sobre la teva mascota quan busqu" +
+	"is consell.\x0a5.2 Has d'assegurar-te que totes les preguntes, descripci" +
+	"ons i dades que proporciones no violen cap dret de tercers o lleis local" +
+	"s.\x0a\x0a<b>6. Ús Internacional</b>\x0a6.1 El Servei està destinat a ús" +
+	" global. Ets responsable de complir amb totes les lleis i regulacions lo" +
+	"cals aplicables a la teva jurisdicció.\x0a6.2 No garantim que el Servei " +
+	"o qualsevol del seu contingut sigui apropiat o permès en cap país o regi" +
+	"ó específica.\x0a\x0a<b>7. Modificacions</b>\x0a7.1 Ens reservem el dre" +
+	"t de modificar o reemplaçar aquests Termes en qualsevol moment.\x0a7.2 S" +
+	"i fem canvis materials, publicarem els Termes actualitzats i indicarem l" +
+	"a data de l'última revisió a la part superior d'aquest document.\x0a\x0a" +
+	"<b>8. Llei Aplicable i Resolució de Conflictes</b>\x0a8.1 Aquests Termes" +
+	" es regiran i interpretaran d'acord amb les lleis aplicables a la jurisd" +
+	"icció del proveïdor del Servei, sense tenir en compte els principis de c" +
+	"onflicte de lleis.\x0a8.2 Qualsevol disputa derivada de o relacionada am" +
+	"b aquests Termes es resoldrà mitjançant negociació amistosa i, si és nec" +
+	"essari, per arbitratge vinculant o litigi als tribunals aplicables.\x0a" +
+	"\x0a<b>9. Acceptació dels Termes</b>\x0a9.1 En continuar accedint o util" +
+	"itzant el Servei, reconeixes que has llegit, entès i acceptes estar subj" +
+	"ecte a aquests Termes.\x0a9.2 Si no estàs d'acord, has de deixar d'utili" +
+	"tzar el Servei immediatament.\x0a\x0aSi tens alguna pregunta o preocupac" +
+	"ió sobre aquests Termes, o si necessites més aclariments, si us plau, co" +
+	"ntacta a <i>k.sysoev@me.com</i>.\x02<b>Comandes de Help My Pet Bot</b>:" +
+	"\x0a/start - Inicia la conversa amb el bot\x0a/terms - Mostra els Termes" +
+	" i Condicions del servei\x0a/editprofile - Actualitza la informació del " +
+	"perfil de la teva mascota, com ara el nom, l'edat, la raça, etc. Aquesta" +
+	" informació ajuda el bot a proporcionar consells més precisos.\x0a/addpe" +
+	"t - Afegeix el perfil d'una altra mascota, si en tens més d'una\x0a/pets" +
+	" - Mostra les teves mascotes i quina està seleccionada\x0a/switchpet - T" +
+	"ria la mascota sobre la qual seran les properes preguntes\x0a/removepet " +
+	"- Elimina el perfil d'una mascota\x0a/weight - Registra el pes actual de" +
+	" la teva mascota, p. ex. /weight 12.4kg\x0a/weightchart - Mostra un gràf" +
+	"ic del pes de la teva mascota al llarg del temps\x0a/vaccines - Mostra l" +
+	"es vacunes i els tractaments preventius endarrerits de les teves mascote" +
+	"s\x0a/addvaccine - Afegeix un registre de vacuna o tractament preventiu " +
+	"de la teva mascota\x0a/remind - Crea un recordatori periòdic, p. ex. /re" +
+	"mind give Rimadyl every 12h for 7 days\x0a/reminders - Mostra els teus r" +
+	"ecordatoris i elimina els que no necessitis\x0a/cancel - Cancel·la el qü" +
+	"estionari actual, si n'hi ha un en curs (per exemple, quan vulguis comen" +
+	"çar de nou o canviar la teva pregunta)\x0a/help - Mostra aquest missatg" +
+	"e d'ajuda\x02Aquesta resposta ja no es pot valorar.\x02Gràcies per la te" +
+	"va opinió!\x02Sentim que la resposta no t'hagi ajudat. Què hi fallava? R" +
+	"espon a aquest missatge amb un comentari breu, o simplement ignora'l." +
+	"\x02Què hi fallava?\x02Gràcies, la teva opinió ens ajuda a millorar les " +
+	"respostes.\x02Ho sento, no puc processar vídeos, àudio o documents. Si u" +
+	"s plau, envia la teva pregunta només com a text.\x02Les teves mascotes:" +
+	"\x02Fes servir /switchpet per triar la mascota sobre la qual són les tev" +
+	"es preguntes.\x02Sobre quina mascota vols preguntar?\x02No he trobat cap" +
+	" mascota anomenada %[1]s. Fes servir /pets per veure les teves mascotes." +
+	"\x02Ara les teves preguntes són sobre %[1]s.\x02Quin perfil de mascota v" +
+	"ols eliminar?\x02S'ha eliminat el perfil de %[1]s.\x02Encara no tens cap" +
+	" perfil de mascota. Fes servir /editprofile o /addpet per crear-ne un." +
 	"\x02Si us plau, proporciona la teva pregunta en format de text juntament" +
 	" amb foto(s)\x02Si us plau, proporciona com a mínim una foto\x02Si us pl" +
-	"au, proporciona no més de %[1]d foto(s)\x02Ho sento, he trobat un error " +
-	"mentre processava la teva sol·licitud. Si us plau, torna-ho a provar més" +
-	" tard.\x02La data proporcionada no pot ser en el futur. Si us plau, prop" +
+	"au, proporciona no més de %[1]d foto(s)\x02Tens massa recordatoris. Fes " +
+	"servir /reminders per eliminar els que no necessitis.\x02Els recordatori" +
+	"s no estan disponibles ara mateix.\x02Recordatori creat: %[1]s, %[2]s." +
+	"\x0aProper recordatori: %[3]s\x02Recordatori: %[1]s\x02Fet\x02Posposa 1 " +
+	"h\x02Aquest recordatori ja no existeix.\x02Marcat com a fet\x02T'ho torn" +
+	"aré a recordar d'aquí a una hora\x02Recordatori eliminat\x02No tens cap " +
+	"recordatori. Fes servir /remind per crear-ne un, p. ex. /remind give Rim" +
+	"adyl every 12h for 7 days\x02Els teus recordatoris:\x02Proper: %[1]s\x02" +
+	"Digues-me què t'he de recordar i amb quina freqüència, per exemple:\x0a/" +
+	"remind give Rimadyl every 12h for 7 days\x0a/remind flea treatment month" +
+	"ly\x0a/remind brush teeth twice a day\x02🚨 URGÈNCIA: la teva mascota pot" +
+	" necessitar atenció veterinària immediata. Contacta ara amb el teu veter" +
+	"inari o amb la clínica d'urgències més propera.\x02⚠️ Et recomanem visit" +
+	"ar el teu veterinari en els propers dos dies.\x02🏥 Troba un veterinari d" +
+	"'urgències a prop\x02%[1]s tocava el %[2]s\x02No hi ha cap vacuna ni tra" +
+	"ctament preventiu endarrerit. Fes servir /addvaccine per afegir un regis" +
+	"tre nou.\x02Vacunes i tractaments preventius endarrerits:\x02Contacta am" +
+	"b el teu veterinari per programar-los i després fes servir /addvaccine p" +
+	"er registrar-los.\x02Pes de %[1]s registrat: %[2]s.\x02Fes servir /weigh" +
+	"tchart per veure com canvia amb el temps.\x02Encara no hi ha cap registr" +
+	"e de pes de %[1]s. Fes servir /weight per afegir-ne un, p. ex. /weight 1" +
+	"2.4kg\x02Historial de pes de %[1]s\x02Envia el pes amb la seva unitat, p" +
+	". ex. /weight 12.4kg o /weight 9 lbs\x02Perfil de mascota guardat correc" +
+	"tament\x02La data proporcionada no pot ser en el futur. Si us plau, prop" +
 	"orciona una data vàlida.\x02Si us plau, proporciona una data en el forma" +
-	"t vàlid AAAA-MM-DD (per exemple, 2023-12-31)\x02Perfil de mascota guarda" +
-	"t correctament\x02Quin és el nom de la teva mascota?\x02Quin tipus de ma" +
-	"scota tens?\x02gos\x02gat\x02Quina raça és la teva mascota?\x02Quan va n" +
-	"éixer la teva mascota? Si us plau, introdueix la data en el format AAAA" +
-	"-MM-DD (per exemple, 2010-12-31).\x02Quin és el gènere de la teva mascot" +
-	"a?\x02mascle\x02femella\x02Quin és el pes de la teva mascota? Si us plau" +
-	", especifica el pes seguit de la unitat, per exemple, 5 kg\x02La teva ma" +
-	"scota està esterilitzada o castrada?\x02sí\x02no\x02Com descriuries el n" +
-	"ivell d'activitat de la teva mascota?\x02baix\x02mitjà\x02alt\x02La teva" +
-	" mascota té alguna malaltia crònica?\x02Quines són les preferències alim" +
-	"entàries o restriccions dietètiques de la teva mascota?"
+	"t vàlid AAAA-MM-DD (per exemple, 2023-12-31)\x02S'està afegint un regist" +
+	"re de vacuna o tractament preventiu per a %[1]s.\x02%[1]s ja no és entre" +
+	" les teves mascotes, així que el registre no s'ha desat.\x02Registre de " +
+	"%[1]s desat per a %[2]s\x02Quin és el nom de la teva mascota?\x02Quin ti" +
+	"pus de mascota tens?\x02gos\x02gat\x02Quina raça és la teva mascota?\x02" +
+	"Quan va néixer la teva mascota? Si us plau, introdueix la data en el for" +
+	"mat AAAA-MM-DD (per exemple, 2010-12-31).\x02Quin és el gènere de la tev" +
+	"a mascota?\x02mascle\x02femella\x02Quin és el pes de la teva mascota? Si" +
+	" us plau, especifica el pes seguit de la unitat, per exemple, 5 kg\x02La" +
+	" teva mascota està esterilitzada o castrada?\x02sí\x02no\x02Com descriur" +
+	"ies el nivell d'activitat de la teva mascota?\x02baix\x02mitjà\x02alt" +
+	"\x02La teva mascota té alguna malaltia crònica?\x02Quines són les prefer" +
+	"ències alimentàries o restriccions dietètiques de la teva mascota?\x02o" +
+	"met\x02Quina vacuna o tractament preventiu se li va administrar (p. ex.," +
+	" ràbia, desparasitació, tractament antipuces)?\x02Quan se li va administ" +
+	"rar? Introdueix la data en el format AAAA-MM-DD (p. ex., 2024-05-31)." +
+	"\x02Quan toca la propera dosi? Introdueix la data en el format AAAA-MM-D" +
+	"D, o omet-ho si no ho saps.\x02Quina clínica el va administrar?"
 
-var de_DEIndex = []uint32{ // 36 elements
+var de_DEIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x0000001d, 0x00000030, 0x000003ad,
-	0x00001349, 0x00001532, 0x000015a6, 0x00001638,
-	0x0000169f, 0x00001713, 0x00001754, 0x0000177b,
-	0x000017ab, 0x00001821, 0x00001880, 0x000018cf,
-	0x000018f6, 0x0000190f, 0x00001932, 0x00001937,
-	0x0000193d, 0x0000195c, 0x000019c4, 0x000019ed,
-	0x000019f7, 0x00001a00, 0x00001a60, 0x00001a8e,
-	0x00001a91, 0x00001a96, 0x00001ada, 0x00001ae2,
+	0x00000000, 0x0000001d, 0x00000059, 0x000000eb,
+	0x00000152, 0x000001b2, 0x00000226, 0x000002a6,
+	0x0000031c, 0x0000032f, 0x000006ac, 0x00001648,
+	0x00001b6b, 0x00001b9a, 0x00001bb9, 0x00001c5a,
+	0x00001c6a, 0x00001ca6, 0x00001d1a, 0x00001d2a,
+	0x00001d82, 0x00001db3, 0x00001e12, 0x00001e3d,
+	0x00001e6c, 0x00001e91, 0x00001ef7, 0x00001f38,
+	0x00001f5f, 0x00001f8f, 0x00001ff0, 0x0000201b,
 	// Entry 20 - 3F
-	0x00001ae9, 0x00001aee, 0x00001b17, 0x00001b6a,
-} // Size: 168 bytes
+	0x0000205d, 0x0000206f, 0x00002078, 0x00002087,
+	0x000020ae, 0x000020c4, 0x000020ec, 0x00002101,
+	0x0000217c, 0x0000218f, 0x0000219f, 0x0000224e,
+	0x000022ec, 0x00002346, 0x00002379, 0x00002394,
+	0x00002417, 0x0000244d, 0x000024bd, 0x000024e3,
+	0x00002536, 0x000025ab, 0x000025c5, 0x00002617,
+	0x0000263e, 0x0000269d, 0x000026ec, 0x0000273d,
+	0x00002796, 0x000027bb, 0x000027d4, 0x000027f7,
+	// Entry 40 - 5F
+	0x000027fc, 0x00002802, 0x00002821, 0x00002889,
+	0x000028b2, 0x000028bc, 0x000028c5, 0x00002925,
+	0x00002953, 0x00002956, 0x0000295b, 0x0000299f,
+	0x000029a7, 0x000029ae, 0x000029b3, 0x000029dc,
+	0x00002a2f, 0x00002a3d, 0x00002aa3, 0x00002b02,
+	0x00002b96, 0x00002bb5,
+} // Size: 368 bytes
 
-const de_DEData string = "" + // Size: 7018 bytes
-	"\x02Fragebogen wurde abgebrochen\x02Unbekannter Befehl\x02Willkommen bei" +
-	" Help My Pet Bot! 🐾\x0a\x0aIch bin Ihr persönlicher Assistent für die Ha" +
-	"ustierpflege und stehe bereit, um Ihnen bei Ihren pelzigen Freunden zu h" +
-	"elfen. Ich kann Ihnen bei folgenden Themen helfen:\x0a\x0a- Gesundheitsp" +
-	"robleme und Symptombewertung\x0a- Verhaltensfragen und Trainingsmethoden" +
-	"\x0a- Ernährungs- und Ernährungsempfehlungen\x0a- Allgemeine Ratschläge " +
-	"zur Haustierpflege und zum Wohlbefinden\x0a\x0aGeben Sie einfach Ihre Fr" +
-	"age oder Ihr Anliegen zu Ihrem Haustier ein. Sie können auch Fotos hinzu" +
-	"fügen, um mir zu helfen, Ihre Situation besser zu verstehen.\x0a\x0aDenk" +
-	"en Sie daran, dass ich hilfreiche Ratschläge auf der Grundlage zuverläss" +
-	"iger veterinärmedizinischer Kenntnisse anbiete, aber kein Ersatz für pro" +
-	"fessionelle tierärztliche Versorgung bin. Konsultieren Sie bei ernsthaft" +
-	"en medizinischen Problemen immer einen Tierarzt.\x0a\x0aMit welcher Haus" +
-	"tierfrage kann ich Ihnen heute helfen?\x02<b>Allgemeine Geschäftsbedingu" +
-	"ngen</b>\x0a<i>Zuletzt aktualisiert: 30.01.2025</i>\x0a\x0aVielen Dank, " +
-	"dass Sie unseren Chatbot für tierärztliche Beratung („der Dienst“) nutze" +
-	"n. Durch den Zugriff auf oder die Nutzung dieses Dienstes erklären Sie s" +
-	"ich mit den folgenden Bedingungen („Bedingungen“) einverstanden. Wenn Si" +
-	"e diesen Bedingungen nicht zustimmen, stellen Sie die Nutzung bitte sofo" +
-	"rt ein.\x0a\x0a<b>1. Art des Dienstes</b>\x0a1.1 Der Dienst bietet allge" +
-	"meine Informationen, Anleitungen und Vorschläge zur Pflege von Haustiere" +
-	"n, einschließlich (aber nicht beschränkt auf) Ernährung, Verhalten und T" +
-	"raining.\x0a1.2 Der Dienst ist kein Ersatz für eine professionelle tierä" +
-	"rztliche Diagnose, Behandlung oder Pflege. Suchen Sie bei Fragen zur Ges" +
-	"undheit Ihres Haustieres immer den Rat eines zugelassenen Tierarztes." +
-	"\x0a\x0a<b>2. Keine tierärztliche Beziehung</b>\x0a2.1 Die Nutzung des D" +
-	"ienstes oder die Interaktion mit unserem KI-Assistenten begründet keine " +
-	"tierärztliche Beziehung.\x0a2.2 Alle vom Dienst bereitgestellten Ratschl" +
-	"äge oder Anleitungen basieren auf begrenzten Informationen und sollten " +
-	"nur als allgemeine Informationen betrachtet werden.\x0a\x0a<b>3. Haftung" +
-	"sbeschränkung</b>\x0a3.1 Sie erkennen an und stimmen zu, dass die Nutzun" +
-	"g des Dienstes auf eigenes Risiko erfolgt.\x0a3.2 Unter keinen Umständen" +
-	" haften die Eigentümer, Entwickler oder Lizenzgeber des Dienstes für dir" +
-	"ekte, indirekte, zufällige, besondere oder Folgeschäden, die sich aus de" +
-	"m Zugriff auf oder der Nutzung des Dienstes ergeben.\x0a3.3 Sie verstehe" +
-	"n, dass Entscheidungen bezüglich der Pflege Ihres Haustieres und alle da" +
-	"raus resultierenden Ergebnisse in Ihrer alleinigen Verantwortung liegen." +
-	" Wenn Sie Zweifel am Wohlbefinden oder der Gesundheit Ihres Haustieres h" +
-	"aben, sollten Sie sofort einen zugelassenen Tierarzt konsultieren.\x0a" +
-	"\x0a<b>4. Keine Gewährleistung</b>\x0a4.1 Der Dienst wird „wie besehen“ " +
-	"und „wie verfügbar“ ohne jegliche ausdrückliche oder stillschweigende Ge" +
-	"währleistungen bereitgestellt.\x0a4.2 Wir gewährleisten nicht, dass der " +
-	"Dienst ununterbrochen, fehlerfrei, sicher oder virenfrei ist.\x0a\x0a<b>" +
-	"5. Benutzerverantwortlichkeiten</b>\x0a5.1 Sie sind dafür verantwortlich" +
-	", genaue und vollständige Informationen über Ihr Haustier bereitzustelle" +
-	"n, wenn Sie Rat suchen.\x0a5.2 Sie müssen sicherstellen, dass alle von I" +
-	"hnen bereitgestellten Fragen, Beschreibungen und Daten keine Rechte Drit" +
-	"ter oder lokale Gesetze verletzen.\x0a\x0a<b>6. Internationale Nutzung</" +
-	"b>\x0a6.1 Der Dienst ist für die weltweite Nutzung vorgesehen. Sie sind " +
-	"für die Einhaltung aller geltenden lokalen Gesetze und Vorschriften in I" +
-	"hrer Gerichtsbarkeit verantwortlich.\x0a6.2 Wir garantieren nicht, dass " +
-	"der Dienst oder dessen Inhalte in einem bestimmten Land oder einer besti" +
-	"mmten Region angemessen oder zulässig sind.\x0a\x0a<b>7. Änderungen</b>" +
-	"\x0a7.1 Wir behalten uns das Recht vor, diese Bedingungen jederzeit zu ä" +
-	"ndern oder zu ersetzen.\x0a7.2 Wenn wir wesentliche Änderungen vornehmen" +
-	", werden wir die aktualisierten Bedingungen veröffentlichen und das Datu" +
-	"m der letzten Überarbeitung oben in diesem Dokument angeben.\x0a\x0a<b>8" +
-	". Anwendbares Recht und Streitbeilegung</b>\x0a8.1 Diese Bedingungen unt" +
-	"erliegen den Gesetzen des Hauptgeschäftssitzes des Dienstanbieters und w" +
-	"erden in Übereinstimmung mit diesen ausgelegt, ohne Rücksicht auf kollis" +
-	"ionsrechtliche Grundsätze.\x0a8.2 Alle Streitigkeiten, die sich aus oder" +
-	" im Zusammenhang mit diesen Bedingungen ergeben, werden durch gütliche V" +
-	"erhandlungen und, falls erforderlich, durch verbindliche Schiedsverfahre" +
-	"n oder Gerichtsverfahren in den zuständigen Gerichten beigelegt.\x0a\x0a" +
-	"<b>9. Annahme der Bedingungen</b>\x0a9.1 Durch den weiteren Zugriff auf " +
-	"oder die Nutzung des Dienstes bestätigen Sie, dass Sie diese Bedingungen" +
-	" gelesen, verstanden und akzeptiert haben.\x0a9.2 Wenn Sie nicht zustimm" +
-	"en, müssen Sie die Nutzung des Dienstes sofort einstellen.\x0a\x0aWenn S" +
-	"ie Fragen oder Bedenken zu diesen Bedingungen haben oder weitere Klarste" +
-	"llungen benötigen, kontaktieren Sie uns bitte unter <i>k.sysoev@me.com</" +
-	"i>.\x02<b>Help My Pet Bot Befehle</b>:\x0a/start - Starten Sie das Gespr" +
-	"äch mit dem Bot\x0a/terms - Anzeigen der Nutzungsbedingungen des Dienst" +
-	"es\x0a/editprofile - Aktualisieren Sie die Profilinformationen Ihres Hau" +
-	"stieres, wie Name, Alter, Rasse usw. Diese Informationen helfen dem Bot," +
-	" genauere Ratschläge zu geben.\x0a/cancel - Beenden Sie den aktuellen Fr" +
-	"agebogen, falls einer in Bearbeitung ist (z. B. wenn Sie von vorne begin" +
-	"nen oder Ihre Frage ändern möchten)\x0a/help - Anzeigen dieser Hilfemeld" +
-	"ung\x02Entschuldigung, ich kann keine Videos, Audios oder Dokumente vera" +
-	"rbeiten. Bitte senden Sie Ihre Frage nur als Text.\x02Es tut mir leid, a" +
-	"ber Ihre Nachricht ist zu lang für mich, um sie zu verarbeiten. Bitte ve" +
-	"rsuchen Sie, sie kürzer und prägnanter zu gestalten.\x02Sie haben die ma" +
-	"ximale Anzahl von Anfragen pro Stunde erreicht. Bitte versuchen Sie es s" +
-	"päter erneut.\x02Wir haben unser tägliches Anfrage-Limit erreicht. Bitte" +
-	" kommen Sie morgen wieder, wenn unser Budget erneuert wird.\x02Bitte geb" +
-	"en Sie Ihre Frage im Textformat zusammen mit Foto(s) an\x02Bitte geben S" +
-	"ie mindestens ein Foto an\x02Bitte geben Sie nicht mehr als %[1]d Foto(s" +
-	") an\x02Entschuldigung, bei der Verarbeitung Ihrer Anfrage ist ein Fehle" +
-	"r aufgetreten. Bitte versuchen Sie es später erneut.\x02Das angegebene D" +
-	"atum kann nicht in der Zukunft liegen. Bitte geben Sie ein gültiges Datu" +
-	"m an.\x02Bitte geben Sie ein Datum im gültigen Format JJJJ-MM-TT an (z. " +
-	"B. 2023-12-31)\x02Haustierprofil erfolgreich gespeichert\x02Wie heißt Ih" +
-	"r Haustier?\x02Welche Art von Haustier haben Sie?\x02Hund\x02Katze\x02We" +
-	"lche Rasse hat Ihr Haustier?\x02Wann wurde Ihr Haustier geboren? Bitte g" +
-	"eben Sie das Datum im Format JJJJ-MM-TT ein (z. B. 2010-12-31).\x02Was i" +
-	"st das Geschlecht Ihres Haustieres?\x02männlich\x02weiblich\x02Wie viel " +
-	"wiegt Ihr Haustier? Bitte geben Sie das Gewicht gefolgt von der Einheit " +
-	"an, z. B. 5 kg\x02Ist Ihr Haustier kastriert oder sterilisiert?\x02ja" +
-	"\x02nein\x02Wie würden Sie das Aktivitätsniveau Ihres Haustieres beschre" +
-	"iben?\x02niedrig\x02mittel\x02hoch\x02Hat Ihr Haustier chronische Krankh" +
-	"eiten?\x02Was sind die Futtervorlieben oder diätetischen Einschränkungen" +
-	" Ihres Haustieres?"
+const de_DEData string = "" + // Size: 11189 bytes
+	"\x02Fragebogen wurde abgebrochen\x02Ihre Unterhaltung und Ihre Haustierp" +
+	"rofile wurden entfernt.\x02Es tut mir leid, aber Ihre Nachricht ist zu l" +
+	"ang für mich, um sie zu verarbeiten. Bitte versuchen Sie, sie kürzer und" +
+	" prägnanter zu gestalten.\x02Sie haben die maximale Anzahl von Anfragen " +
+	"pro Stunde erreicht. Bitte versuchen Sie es später erneut.\x02Sie haben " +
+	"Ihr Kontingent an Fragen vorerst aufgebraucht. Bitte versuchen Sie es sp" +
+	"äter erneut.\x02Wir haben unser tägliches Anfrage-Limit erreicht. Bitte" +
+	" kommen Sie morgen wieder, wenn unser Budget erneuert wird.\x02Ihre Unte" +
+	"rhaltung wurde durch eine andere Nachricht geändert, während ich diese b" +
+	"earbeitet habe. Bitte senden Sie sie erneut.\x02Entschuldigung, bei der " +
+	"Verarbeitung Ihrer Anfrage ist ein Fehler aufgetreten. Bitte versuchen S" +
+	"ie es später erneut.\x02Unbekannter Befehl\x02Willkommen bei Help My Pet" +
+	" Bot! 🐾\x0a\x0aIch bin Ihr persönlicher Assistent für die Haustierpflege" +
+	" und stehe bereit, um Ihnen bei Ihren pelzigen Freunden zu helfen. Ich k" +
+	"ann Ihnen bei folgenden Themen helfen:\x0a\x0a- Gesundheitsprobleme und " +
+	"Symptombewertung\x0a- Verhaltensfragen und Trainingsmethoden\x0a- Ernähr" +
+	"ungs- und Ernährungsempfehlungen\x0a- Allgemeine Ratschläge zur Haustier" +
+	"pflege und zum Wohlbefinden\x0a\x0aGeben Sie einfach Ihre Frage oder Ihr" +
+	" Anliegen zu Ihrem Haustier ein. Sie können auch Fotos hinzufügen, um mi" +
+	"r zu helfen, Ihre Situation besser zu verstehen.\x0a\x0aDenken Sie daran" +
+	", dass ich hilfreiche Ratschläge auf der Grundlage zuverlässiger veterin" +
+	"ärmedizinischer Kenntnisse anbiete, aber kein Ersatz für professionelle" +
+	" tierärztliche Versorgung bin. Konsultieren Sie bei ernsthaften medizini" +
+	"schen Problemen immer einen Tierarzt.\x0a\x0aMit welcher Haustierfrage k" +
+	"ann ich Ihnen heute helfen?\x02<b>Allgemeine Geschäftsbedingungen</b>" +
+	"\x0a<i>Zuletzt aktualisiert: 30.01.2025</i>\x0a\x0aVielen Dank, dass Sie" +
+	" unseren Chatbot für tierärztliche Beratung („der Dienst“) nutzen. Durch" +
+	" den Zugriff auf oder die Nutzung dieses Dienstes erklären Sie sich mit " +
+	"den folgenden Bedingungen („Bedingungen“) einverstanden. Wenn Sie diesen" +
+	" Bedingungen nicht zustimmen, stellen Sie die Nutzung bitte sofort ein." +
+	"\x0a\x0a<b>1. Art des Dienstes</b>\x0a1.1 Der Dienst bietet allgemeine I" +
+	"nformationen, Anleitungen und Vorschläge zur Pflege von Haustieren, eins" +
+	"chließlich (aber nicht beschränkt auf) Ernährung, Verhalten und Training" +
+	".\x0a1.2 Der Dienst ist kein Ersatz für eine professionelle tierärztlich" +
+	"e Diagnose, Behandlung oder Pflege. Suchen Sie bei Fragen zur Gesundheit" +
+	" Ihres Haustieres immer den Rat eines zugelassenen Tierarztes.\x0a\x0a<b" +
+	">2. Keine tierärztliche Beziehung</b>\x0a2.1 Die Nutzung des Dienstes od" +
+	"er die Interaktion mit unserem KI-Assistenten begründet keine tierärztli" +
+	"che Beziehung.\x0a2.2 Alle vom Dienst bereitgestellten Ratschläge oder A" +
+	"nleitungen basieren auf begrenzten Informationen und sollten nur als all" +
+	"gemeine Informationen betrachtet werden.\x0a\x0a<b>3. Haftungsbeschränku" +
+	"ng</b>\x0a3.1 Sie erkennen an und stimmen zu, dass die Nutzung des Diens" +
+	"tes auf eigenes Risiko erfolgt.\x0a3.2 Unter keinen Umständen haften die" +
+	" Eigentümer, Entwickler oder Lizenzgeber des Dienstes für direkte, indir" +
+	"ekte, zufällige, besondere oder Folgeschäden, die sich aus dem Zugriff a" +
+	"uf oder der Nutzung des Dienstes ergeben.\x0a3.3 Sie verstehen, dass Ent" +
+	"scheidungen bezüglich der Pflege Ihres Haustieres und alle daraus result" +
+	"ierenden Ergebnisse in Ihrer alleinigen Verantwortung liegen. Wenn Sie Z" +
+	"weifel am Wohlbefinden oder der Gesundheit Ihres Haustieres haben, sollt" +
+	"en Sie sofort einen zugelassenen Tierarzt konsultieren.\x0a\x0a<b>4. Kei" +
+	"ne Gewährleistung</b>\x0a4.1 Der Dienst wird „wie besehen“ und „wie verf" +
+	"ügbar“ ohne jegliche ausdrückliche oder stillschweigende Gewährleistung" +
+	"en bereitgestellt.\x0a4.2 Wir gewährleisten nicht, dass der Dienst ununt" +
+	"erbrochen, fehlerfrei, sicher oder virenfrei ist.\x0a\x0a<b>5. Benutzerv" +
+	"erantwortlichkeiten</b>\x0a5.1 Sie sind dafür verantwortlich, genaue und" +
+	" vollständige Informationen über Ihr Haustier bereitzustellen, wenn Sie " +
+	"Rat suchen.\x0a5.2 Sie müssen sicherstellen, dass alle von Ihnen bereitg" +
+	"estellten Fragen, Beschreibungen und Daten keine Rechte Dritter oder lok" +
+	"ale Gesetze verletzen.\x0a\x0a<b>6. Internationale Nutzung</b>\x0a6.1 De" +
+	"r Dienst ist für die weltweite Nutzung vorgesehen. Sie sind für die Einh" +
+	"altung aller geltenden lokalen Gesetze und Vorschriften in Ihrer Gericht" +
+	"sbarkeit verantwortlich.\x0a6.2 Wir garantieren nicht, dass der Dienst o" +
+	"der dessen Inhalte in einem bestimmten Land oder einer bestimmten Region" +
+	" angemessen oder zulässig sind.\x0a\x0a<b>7. Änderungen</b>\x0a7.1 Wir b" +
+	"ehalten uns das Recht vor, diese Bedingungen jederzeit zu ändern oder zu" +
+	" ersetzen.\x0a7.2 Wenn wir wesentliche Änderungen vornehmen, werden wir " +
+	"die aktualisierten Bedingungen veröffentlichen und das Datum der letzten" +
+	" Überarbeitung oben in diesem Dokument angeben.\x0a\x0a<b>8. Anwendbares" +
+	" Recht und Streitbeilegung</b>\x0a8.1 Diese Bedingungen unterliegen den " +
+	"Gesetzen des Hauptgeschäftssitzes des Dienstanbieters und werden in Über" +
+	"einstimmung mit diesen ausgelegt, ohne Rücksicht auf kollisionsrechtlich" +
+	"e Grundsätze.\x0a8.2 Alle Streitigkeiten, die sich aus oder im Zusammenh" +
+	"ang mit diesen Bedingungen ergeben, werden durch gütliche Verhandlungen " +
+	"und, falls erforderlich, durch verbindliche Schiedsverfahren oder Gerich" +
+	"tsverfahren in den zuständigen Gerichten beigelegt.\x0a\x0a<b>9. Annahme" +
+	" der Bedingungen</b>\x0a9.1 Durch den weiteren Zugriff auf oder die Nutz" +
+	"ung des Dienstes bestätigen Sie, dass Sie diese Bedingungen gelesen, ver" +
+	"standen und akzeptiert haben.\x0a9.2 Wenn Sie nicht zustimmen, müssen Si" +
+	"e die Nutzung des Dienstes sofort einstellen.\x0a\x0aWenn Sie Fragen ode" +
+	"r Bedenken zu diesen Bedingungen haben oder weitere Klarstellungen benöt" +
+	"igen, kontaktieren Sie uns bitte unter <i>k.sysoev@me.com</i>.\x02<b>Hel" +
+	"p My Pet Bot Befehle</b>:\x0a/start - Starten Sie das Gespräch mit dem B" +
+	"ot\x0a/terms - Anzeigen der Nutzungsbedingungen des Dienstes\x0a/editpro" +
+	"file - Aktualisieren Sie die Profilinformationen Ihres Haustieres, wie N" +
+	"ame, Alter, Rasse usw. Diese Informationen helfen dem Bot, genauere Rats" +
+	"chläge zu geben.\x0a/addpet - Fügen Sie das Profil eines weiteren Hausti" +
+	"eres hinzu, wenn Sie mehrere haben\x0a/pets - Zeigen Sie Ihre Haustiere " +
+	"an und welches gerade ausgewählt ist\x0a/switchpet - Wählen Sie das Haus" +
+	"tier aus, um das es in Ihren nächsten Fragen geht\x0a/removepet - Entfer" +
+	"nen Sie ein Haustierprofil\x0a/weight - Tragen Sie das aktuelle Gewicht " +
+	"Ihres Haustieres ein, z. B. /weight 12.4kg\x0a/weightchart - Sehen Sie e" +
+	"in Diagramm des Gewichts Ihres Haustieres im Zeitverlauf\x0a/vaccines - " +
+	"Zeigen Sie überfällige Impfungen und vorbeugende Behandlungen Ihrer Haus" +
+	"tiere an\x0a/addvaccine - Fügen Sie einen Eintrag einer Impfung oder vor" +
+	"beugenden Behandlung hinzu\x0a/remind - Richten Sie eine wiederkehrende " +
+	"Erinnerung ein, z. B. /remind give Rimadyl every 12h for 7 days\x0a/remi" +
+	"nders - Zeigen Sie Ihre Erinnerungen an und löschen Sie nicht benötigte" +
+	"\x0a/cancel - Beenden Sie den aktuellen Fragebogen, falls einer in Bearb" +
+	"eitung ist (z. B. wenn Sie von vorne beginnen oder Ihre Frage ändern möc" +
+	"hten)\x0a/help - Anzeigen dieser Hilfemeldung\x02Diese Antwort kann nich" +
+	"t mehr bewertet werden.\x02Vielen Dank für Ihr Feedback!\x02Schade, dass" +
+	" die Antwort nicht geholfen hat. Was war falsch daran? Antworten Sie auf" +
+	" diese Nachricht mit einem kurzen Kommentar oder ignorieren Sie sie einf" +
+	"ach.\x02Was war falsch?\x02Danke, Ihr Feedback hilft uns, die Antworten " +
+	"zu verbessern.\x02Entschuldigung, ich kann keine Videos, Audios oder Dok" +
+	"umente verarbeiten. Bitte senden Sie Ihre Frage nur als Text.\x02Ihre Ha" +
+	"ustiere:\x02Verwenden Sie /switchpet, um das Haustier auszuwählen, um da" +
+	"s es in Ihren Fragen geht.\x02Zu welchem Haustier möchten Sie Fragen ste" +
+	"llen?\x02Ich konnte kein Haustier namens %[1]s finden. Verwenden Sie /pe" +
+	"ts, um Ihre Haustiere zu sehen.\x02Ihre Fragen beziehen sich jetzt auf %" +
+	"[1]s.\x02Welches Haustierprofil möchten Sie entfernen?\x02Das Profil von" +
+	" %[1]s wurde entfernt.\x02Sie haben noch keine Haustierprofile. Verwende" +
+	"n Sie /editprofile oder /addpet, um eines zu erstellen.\x02Bitte geben S" +
+	"ie Ihre Frage im Textformat zusammen mit Foto(s) an\x02Bitte geben Sie m" +
+	"indestens ein Foto an\x02Bitte geben Sie nicht mehr als %[1]d Foto(s) an" +
+	"\x02Sie haben zu viele Erinnerungen. Verwenden Sie /reminders, um die ni" +
+	"cht benötigten zu löschen.\x02Erinnerungen sind gerade nicht verfügbar." +
+	"\x02Erinnerung eingerichtet: %[1]s, %[2]s.\x0aNächste Erinnerung: %[3]s" +
+	"\x02Erinnerung: %[1]s\x02Erledigt\x021 Std. später\x02Diese Erinnerung e" +
+	"xistiert nicht mehr.\x02Als erledigt markiert\x02Ich erinnere Sie in ein" +
+	"er Stunde erneut\x02Erinnerung gelöscht\x02Sie haben keine Erinnerungen." +
+	" Verwenden Sie /remind, um eine zu erstellen, z. B. /remind give Rimadyl" +
+	" every 12h for 7 days\x02Ihre Erinnerungen:\x02Nächste: %[1]s\x02Sagen S" +
+	"ie mir, woran und wie oft ich Sie erinnern soll, zum Beispiel:\x0a/remin" +
+	"d give Rimadyl every 12h for 7 days\x0a/remind flea treatment monthly" +
+	"\x0a/remind brush teeth twice a day\x02🚨 NOTFALL: Ihr Haustier benötigt " +
+	"möglicherweise sofortige tierärztliche Hilfe. Wenden Sie sich jetzt an I" +
+	"hren Tierarzt oder die nächste Notfallklinik.\x02⚠️ Wir empfehlen einen " +
+	"Besuch bei Ihrem Tierarzt in den nächsten ein bis zwei Tagen.\x02🏥 Tierä" +
+	"rztlichen Notdienst in der Nähe finden\x02%[1]s war am %[2]s fällig\x02K" +
+	"eine Impfungen oder vorbeugenden Behandlungen sind überfällig. Verwenden" +
+	" Sie /addvaccine, um einen neuen Eintrag hinzuzufügen.\x02Überfällige Im" +
+	"pfungen und vorbeugende Behandlungen:\x02Bitte vereinbaren Sie einen Ter" +
+	"min bei Ihrem Tierarzt und verwenden Sie danach /addvaccine, um sie einz" +
+	"utragen.\x02Gewicht von %[1]s eingetragen: %[2]s.\x02Verwenden Sie /weig" +
+	"htchart, um zu sehen, wie es sich im Laufe der Zeit verändert.\x02Für %[" +
+	"1]s gibt es noch keine Gewichtseinträge. Verwenden Sie /weight, um einen" +
+	" hinzuzufügen, z. B. /weight 12.4kg\x02Gewichtsverlauf von %[1]s\x02Bitt" +
+	"e senden Sie das Gewicht mit Einheit, z. B. /weight 12.4kg oder /weight " +
+	"9 lbs\x02Haustierprofil erfolgreich gespeichert\x02Das angegebene Datum " +
+	"kann nicht in der Zukunft liegen. Bitte geben Sie ein gültiges Datum an." +
+	"\x02Bitte geben Sie ein Datum im gültigen Format JJJJ-MM-TT an (z. B. 20" +
+	"23-12-31)\x02Eintrag einer Impfung oder vorbeugenden Behandlung für %[1]" +
+	"s wird hinzugefügt.\x02%[1]s gehört nicht mehr zu Ihren Haustieren, dahe" +
+	"r wurde der Eintrag nicht gespeichert.\x02Eintrag %[1]s für %[2]s gespei" +
+	"chert\x02Wie heißt Ihr Haustier?\x02Welche Art von Haustier haben Sie?" +
+	"\x02Hund\x02Katze\x02Welche Rasse hat Ihr Haustier?\x02Wann wurde Ihr Ha" +
+	"ustier geboren? Bitte geben Sie das Datum im Format JJJJ-MM-TT ein (z. B" +
+	". 2010-12-31).\x02Was ist das Geschlecht Ihres Haustieres?\x02männlich" +
+	"\x02weiblich\x02Wie viel wiegt Ihr Haustier? Bitte geben Sie das Gewicht" +
+	" gefolgt von der Einheit an, z. B. 5 kg\x02Ist Ihr Haustier kastriert od" +
+	"er sterilisiert?\x02ja\x02nein\x02Wie würden Sie das Aktivitätsniveau Ih" +
+	"res Haustieres beschreiben?\x02niedrig\x02mittel\x02hoch\x02Hat Ihr Haus" +
+	"tier chronische Krankheiten?\x02Was sind die Futtervorlieben oder diätet" +
+	"ischen Einschränkungen Ihres Haustieres?\x02überspringen\x02Welche Impfu" +
+	"ng oder vorbeugende Behandlung wurde gegeben (z. B. Tollwut, Entwurmung," +
+	" Flohbehandlung)?\x02Wann wurde sie gegeben? Bitte geben Sie das Datum i" +
+	"m Format JJJJ-MM-TT ein (z. B. 2024-05-31).\x02Wann ist die nächste Dosi" +
+	"s fällig? Bitte geben Sie das Datum im Format JJJJ-MM-TT ein oder übersp" +
+	"ringen Sie die Frage, wenn Sie es nicht wissen.\x02Welche Klinik hat sie" +
+	" gegeben?"
 
-var en_GBIndex = []uint32{ // 36 elements
+var en_GBIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000019, 0x00000029, 0x000002ca,
-	0x00001065, 0x00001218, 0x00001275, 0x000012e2,
-	0x00001334, 0x00001395, 0x000013d6, 0x000013f9,
-	0x00001425, 0x0000147a, 0x000014be, 0x00001506,
-	0x00001525, 0x0000153e, 0x0000155c, 0x00001560,
-	0x00001564, 0x0000157c, 0x000015d7, 0x000015f2,
-	0x000015f7, 0x000015fe, 0x00001654, 0x00001674,
-	0x00001678, 0x0000167b, 0x000016ad, 0x000016b1,
+	0x00000000, 0x00000019, 0x0000004f, 0x000000bc,
+	0x0000010e, 0x00000158, 0x000001b9, 0x00000221,
+	0x00000276, 0x00000286, 0x00000527, 0x000012c2,
+	0x000016fa, 0x0000171e, 0x0000173b, 0x000017b0,
+	0x000017c0, 0x000017f7, 0x00001854, 0x0000185f,
+	0x0000189a, 0x000018c1, 0x00001900, 0x00001924,
+	0x00001950, 0x00001973, 0x000019c3, 0x00001a04,
+	0x00001a27, 0x00001a53, 0x00001aa2, 0x00001ac9,
 	// Entry 20 - 3F
-	0x000016b8, 0x000016bd, 0x000016e6, 0x00001724,
-} // Size: 168 bytes
+	0x00001afa, 0x00001b0a, 0x00001b0f, 0x00001b19,
+	0x00001b39, 0x00001b48, 0x00001b69, 0x00001b7a,
+	0x00001be2, 0x00001bf2, 0x00001bfe, 0x00001ca4,
+	0x00001d20, 0x00001d6d, 0x00001d8f, 0x00001da6,
+	0x00001e01, 0x00001e31, 0x00001e89, 0x00001eaa,
+	0x00001edc, 0x00001f33, 0x00001f4b, 0x00001f96,
+	0x00001fb5, 0x00001ff9, 0x00002041, 0x00002080,
+	0x000020c0, 0x000020e0, 0x000020f9, 0x00002117,
+	// Entry 40 - 5F
+	0x0000211b, 0x0000211f, 0x00002137, 0x00002192,
+	0x000021ad, 0x000021b2, 0x000021b9, 0x0000220f,
+	0x0000222f, 0x00002233, 0x00002236, 0x00002268,
+	0x0000226c, 0x00002273, 0x00002278, 0x000022a1,
+	0x000022df, 0x000022e4, 0x0000233f, 0x00002395,
+	0x000023fb, 0x00002411,
+} // Size: 368 bytes
 
-const en_GBData string = "" + // Size: 5924 bytes
-	"\x02Questionary is cancelled\x02Unknown command\x02Welcome to Help My Pe" +
-	"t Bot! 🐾\x0a\x0aI'm your personal pet care assistant, ready to provide g" +
-	"uidance for your furry friends. I can help with:\x0a\x0a- Health concern" +
-	"s and symptom assessment\x0a- Behavior questions and training techniques" +
-	"\x0a- Diet and nutrition recommendations\x0a- General pet care and welln" +
-	"ess advice\x0a\x0aSimply type your question or concern about your pet. Y" +
-	"ou can also include photos to help me better understand your situation." +
-	"\x0a\x0aRemember, while I offer helpful guidance based on reliable veter" +
-	"inary knowledge, I'm not a replacement for professional veterinary care." +
-	" Always consult a veterinarian for serious medical concerns.\x0a\x0aWhat" +
-	" pet question can I help you with today?\x02<b>Terms and Conditions</b>" +
-	"\x0a<i>Last updated: 30.01.2025</i>\x0a\x0aThank you for using our veter" +
-	"inary advice chatbot (“the Service”). By accessing or using this Service" +
-	", you agree to be bound by the following terms and conditions (“Terms”)." +
-	" If you do not agree to these Terms, please discontinue use immediately." +
-	"\x0a\x0a<b>1. Nature of the Service</b>\x0a1.1 The Service provides gene" +
-	"ral information, guidance, and suggestions for pet care, including (but " +
-	"not limited to) diet, behavior, and training.\x0a1.2 The Service is not " +
-	"a substitute for professional veterinary diagnosis, treatment, or care. " +
-	"Always seek the advice of a licensed veterinarian for any questions rega" +
-	"rding your pet’s health.\x0a\x0a<b>2. No Veterinary-Client-Patient Relat" +
-	"ionship</b>\x0a2.1 Using the Service or engaging with our AI assistant d" +
-	"oes not create a veterinarian-client-patient relationship.\x0a2.2 Any ad" +
-	"vice or guidance provided by the Service is based on limited information" +
-	" and should only be considered general information.\x0a\x0a<b>3. Limitat" +
-	"ion of Liability</b>\x0a3.1 You acknowledge and agree that use of the Se" +
-	"rvice is at your own risk.\x0a3.2 Under no circumstances shall the owner" +
-	"s, developers, or licensors of the Service be liable for any direct, ind" +
-	"irect, incidental, special, or consequential damages arising out of or i" +
-	"n connection with your access to or use of the Service.\x0a3.3 You under" +
-	"stand that decisions regarding your pet’s care and any resulting outcome" +
-	"s are your sole responsibility. If you have any doubt about the well-bei" +
-	"ng of your pet or its health, you should immediately consult a licensed " +
-	"veterinarian.\x0a\x0a<b>4. No Warranty</b>\x0a4.1 The Service is provide" +
-	"d on an “as is” and “as available” basis without warranties of any kind," +
-	" whether express or implied.\x0a4.2 We do not warrant that the Service w" +
-	"ill be uninterrupted, error-free, secure, or free from viruses.\x0a\x0a<" +
-	"b>5. User Responsibilities</b>\x0a5.1 You are responsible for providing " +
-	"accurate and complete information about your pet when seeking advice." +
-	"\x0a5.2 You must ensure that all questions, descriptions, and data you p" +
-	"rovide do not violate any third-party rights or local laws.\x0a\x0a<b>6." +
-	" International Use</b>\x0a6.1 The Service is intended for global use. Yo" +
-	"u are responsible for compliance with all applicable local laws and regu" +
-	"lations in your jurisdiction.\x0a6.2 We do not guarantee that the Servic" +
-	"e or any of its content is appropriate or permissible in any specific co" +
-	"untry or region.\x0a\x0a<b>7. Modifications</b>\x0a7.1 We reserve the ri" +
-	"ght to modify or replace these Terms at any time.\x0a7.2 If we make mate" +
-	"rial changes, we will post the updated Terms and indicate the date of th" +
-	"e latest revision at the top of this document.\x0a\x0a<b>8. Governing La" +
-	"w and Dispute Resolution</b>\x0a8.1 These Terms shall be governed by and" +
-	" construed in accordance with the laws applicable in the jurisdiction of" +
-	" the Service provider’s principal place of business, without regard to c" +
-	"onflict-of-law principles.\x0a8.2 Any dispute arising from or relating t" +
-	"o these Terms shall be resolved through amicable negotiation and, if nec" +
-	"essary, by binding arbitration or litigation in the applicable courts." +
-	"\x0a\x0a<b>9. Acceptance of Terms</b>\x0a9.1 By continuing to access or " +
-	"use the Service, you acknowledge that you have read, understood, and agr" +
-	"ee to be bound by these Terms.\x0a9.2 If you do not agree, you must ceas" +
-	"e using the Service immediately.\x0a\x0aIf you have any questions or con" +
-	"cerns regarding these Terms, or if you need further clarification, pleas" +
-	"e contact at <i>k.sysoev@me.com</i>.\x02<b>Help My Pet Bot Commands</b>:" +
-	"\x0a/start - Start the conversation with the bot\x0a/terms - View the Te" +
-	"rms and Conditions of the service\x0a/editprofile - Update your pet's pr" +
-	"ofile information, such as name, age, breed, etc. This information helps" +
-	" the bot provide more accurate advice.\x0a/cancel - Cancel the current q" +
-	"uestionnaire, if any is in progress (e.g., when you want to start over o" +
-	"r change your question)\x0a/help - View this help message\x02Sorry, I ca" +
-	"nnot process videos, audio, or documents. Please send your question as t" +
-	"ext only.\x02I apologize, but your message is too long for me to process" +
-	". Please try to make it shorter and more concise.\x02You have reached th" +
-	"e maximum number of requests per hour. Please try again later.\x02We hav" +
-	"e reached our daily request limit. Please come back tomorrow when our bu" +
-	"dget is refreshed.\x02Please, provide your question in text format along" +
-	" with photo(s)\x02Please, provide at least one photo\x02Please, provide " +
-	"no more than %[1]d photo(s)\x02Sorry, I encountered an error while proce" +
-	"ssing your request. Please try again later.\x02Provided date cannot be i" +
-	"n the future. Please provide a valid date.\x02Please provide a date in t" +
-	"he valid format YYYY-MM-DD (e.g., 2023-12-31)\x02Pet profile saved succe" +
-	"ssfully\x02What is your pet's name?\x02What type of pet do you have?\x02" +
-	"dog\x02cat\x02What breed is your pet?\x02When was your pet born? Please " +
-	"enter the date in the format YYYY-MM-DD (e.g., 2010-12-31).\x02What is y" +
-	"our pet's gender?\x02male\x02female\x02What is your pet's weight? Please" +
-	" specify the weight followed by the unit, e.g., 5 kg\x02Is your pet spay" +
-	"ed or neutered?\x02yes\x02no\x02How would you describe your pet's activi" +
-	"ty level?\x02low\x02medium\x02high\x02Does your pet have any chronic dis" +
-	"eases?\x02What are your pet's food preferences or dietary restrictions?"
+const en_GBData string = "" + // Size: 9233 bytes
+	"\x02Questionary is cancelled\x02Your conversation and pet profiles have " +
+	"been removed.\x02I apologize, but your message is too long for me to pro" +
+	"cess. Please try to make it shorter and more concise.\x02You have reache" +
+	"d the maximum number of requests per hour. Please try again later.\x02Yo" +
+	"u have used up your question allowance for now. Please try again later." +
+	"\x02We have reached our daily request limit. Please come back tomorrow w" +
+	"hen our budget is refreshed.\x02Your conversation was changed by another" +
+	" message while I was processing this one. Please send it again.\x02Sorry" +
+	", I encountered an error while processing your request. Please try again" +
+	" later.\x02Unknown command\x02Welcome to Help My Pet Bot! 🐾\x0a\x0aI'm y" +
+	"our personal pet care assistant, ready to provide guidance for your furr" +
+	"y friends. I can help with:\x0a\x0a- Health concerns and symptom assessm" +
+	"ent\x0a- Behavior questions and training techniques\x0a- Diet and nutrit" +
+	"ion recommendations\x0a- General pet care and wellness advice\x0a\x0aSim" +
+	"ply type your question or concern about your pet. You can also include p" +
+	"hotos to help me better understand your situation.\x0a\x0aRemember, whil" +
+	"e I offer helpful guidance based on reliable veterinary knowledge, I'm n" +
+	"ot a replacement for professional veterinary care. Always consult a vete" +
+	"rinarian for serious medical concerns.\x0a\x0aWhat pet question can I he" +
+	"lp you with today?\x02<b>Terms and Conditions</b>\x0a<i>Last updated: 30" +
+	".01.2025</i>\x0a\x0aThank you for using our veterinary advice chatbot (“" +
+	"the Service”). By accessing or using this Service, you agree to be bound" +
+	" by the following terms and conditions (“Terms”). If you do not agree to" +
+	" these Terms, please discontinue use immediately.\x0a\x0a<b>1. Nature of" +
+	" the Service</b>\x0a1.1 The Service provides general information, guidan" +
+	"ce, and suggestions for pet care, including (but not limited to) diet, b" +
+	"ehavior, and training.\x0a1.2 The Service is not a substitute for profes" +
+	"sional veterinary diagnosis, treatment, or care. Always seek the advice " +
+	"of a licensed veterinarian for any questions regarding your pet’s health" +
+	".\x0a\x0a<b>2. No Veterinary-Client-Patient Relationship</b>\x0a2.1 Usin" +
+	"g the Service or engaging with our AI assistant does not create a veteri" +
+	"narian-client-patient relationship.\x0a2.2 Any advice or guidance provid" +
+	"ed by the Service is based on limited information and should only be con" +
+	"sidered general information.\x0a\x0a<b>3. Limitation of Liability</b>" +
+	"\x0a3.1 You acknowledge and agree that use of the Service is at your own" +
+	" risk.\x0a3.2 Under no circumstances shall the owners, developers, or li" +
+	"censors of the Service be liable for any direct, indirect, incidental, s" +
+	"pecial, or consequential damages arising out of or in connection with yo" +
+	"ur access to or use of the Service.\x0a3.3 You understand that decisions" +
+	" regarding your pet’s care and any resulting outcomes are your sole resp" +
+	"onsibility. If you have any doubt about the well-being of your pet or it" +
+	"s health, you should immediately consult a licensed veterinarian.\x0a" +
+	"\x0a<b>4. No Warranty</b>\x0a4.1 The Service is provided on an “as is” a" +
+	"nd “as available” basis without warranties of any kind, whether express " +
+	"or implied.\x0a4.2 We do not warrant that the Service will be uninterrup" +
+	"ted, error-free, secure, or free from viruses.\x0a\x0a<b>5. User Respons" +
+	"ibilities</b>\x0a5.1 You are responsible for providing accurate and comp" +
+	"lete information about your pet when seeking advice.\x0a5.2 You must ens" +
+	"ure that all questions, descriptions, and data you provide do not violat" +
+	"e any third-party rights or local laws.\x0a\x0a<b>6. International Use</" +
+	"b>\x0a6.1 The Service is intended for global use. You are responsible fo" +
+	"r compliance with all applicable local laws and regulations in your juri" +
+	"sdiction.\x0a6.2 We do not guarantee that the Service or any of its cont" +
+	"ent is appropriate or permissible in any specific country or region.\x0a" +
+	"\x0a<b>7. Modifications</b>\x0a7.1 We reserve the right to modify or rep" +
+	"lace these Terms at any time.\x0a7.2 If we make material changes, we wil" +
+	"l post the updated Terms and indicate the date of the latest revision at" +
+	" the top of this document.\x0a\x0a<b>8. Governing Law and Dispute Resolu" +
+	"tion</b>\x0a8.1 These Terms shall be governed by and construed in accord" +
+	"ance with the laws applicable in the jurisdiction of the Service provide" +
+	"r’s principal place of business, without regard to conflict-of-law princ" +
+	"iples.\x0a8.2 Any dispute arising from or relating to these Terms shall " +
+	"be resolved through amicable negotiation and, if necessary, by binding a" +
+	"rbitration or litigation in the applicable courts.\x0a\x0a<b>9. Acceptan" +
+	"ce of Terms</b>\x0a9.1 By continuing to access or use the Service, you a" +
+	"cknowledge that you have read, understood, and agree to be bound by thes" +
+	"e Terms.\x0a9.2 If you do not agree, you must cease using the Service im" +
+	"mediately.\x0a\x0aIf you have any questions or concerns regarding these " +
+	"Terms, or if you need further clarification, please contact at <i>k.syso" +
+	"ev@me.com</i>.\x02<b>Help My Pet Bot Commands</b>:\x0a/start - Start the" +
+	" conversation with the bot\x0a/terms - View the Terms and Conditions of " +
+	"the service\x0a/editprofile - Update your pet's profile information, suc" +
+	"h as name, age, breed, etc. This information helps the bot provide more " +
+	"accurate advice.\x0a/addpet - Add profile of another pet, if you have mo" +
+	"re than one\x0a/pets - List your pets and see which one is currently sel" +
+	"ected\x0a/switchpet - Select the pet your next questions are about\x0a/r" +
+	"emovepet - Remove a pet profile\x0a/weight - Record your pet's current w" +
+	"eight, e.g. /weight 12.4kg\x0a/weightchart - See a chart of your pet's w" +
+	"eight over time\x0a/vaccines - List overdue vaccinations and preventive " +
+	"treatments of your pets\x0a/addvaccine - Add a vaccination or preventive" +
+	" treatment record for your pet\x0a/remind - Set a recurring reminder, e." +
+	"g. /remind give Rimadyl every 12h for 7 days\x0a/reminders - List your r" +
+	"eminders and delete the ones you don't need\x0a/cancel - Cancel the curr" +
+	"ent questionnaire, if any is in progress (e.g., when you want to start o" +
+	"ver or change your question)\x0a/help - View this help message\x02This a" +
+	"nswer can no longer be rated.\x02Thank you for your feedback!\x02Sorry t" +
+	"he answer didn't help. What was wrong with it? Reply to this message wit" +
+	"h a short comment, or just ignore it.\x02What was wrong?\x02Thank you, y" +
+	"our feedback helps us improve the answers.\x02Sorry, I cannot process vi" +
+	"deos, audio, or documents. Please send your question as text only.\x02Yo" +
+	"ur pets:\x02Use /switchpet to select the pet your questions are about." +
+	"\x02Which pet would you like to ask about?\x02I couldn't find a pet name" +
+	"d %[1]s. Use /pets to see your pets.\x02Your questions are now about %[1" +
+	"]s.\x02Which pet profile would you like to remove?\x02Profile of %[1]s h" +
+	"as been removed.\x02You don't have any pet profiles yet. Use /editprofil" +
+	"e or /addpet to create one.\x02Please, provide your question in text for" +
+	"mat along with photo(s)\x02Please, provide at least one photo\x02Please," +
+	" provide no more than %[1]d photo(s)\x02You have too many reminders. Use" +
+	" /reminders to delete the ones you don't need.\x02Reminders are not avai" +
+	"lable right now.\x02Reminder set: %[1]s, %[2]s.\x0aNext reminder: %[3]s" +
+	"\x02Reminder: %[1]s\x02Done\x02Snooze 1h\x02This reminder no longer exis" +
+	"ts.\x02Marked as done\x02I'll remind you again in an hour\x02Reminder de" +
+	"leted\x02You don't have any reminders. Use /remind to create one, e.g. /" +
+	"remind give Rimadyl every 12h for 7 days\x02Your reminders:\x02Next: %[1" +
+	"]s\x02Tell me what to remind you about and how often, for example:\x0a/r" +
+	"emind give Rimadyl every 12h for 7 days\x0a/remind flea treatment monthl" +
+	"y\x0a/remind brush teeth twice a day\x02🚨 EMERGENCY: your pet may need i" +
+	"mmediate veterinary care. Contact your veterinarian or the nearest emerg" +
+	"ency clinic now.\x02⚠️ We recommend a visit to your veterinarian within " +
+	"the next day or two.\x02🏥 Find an emergency vet nearby\x02%[1]s was due " +
+	"on %[2]s\x02No vaccinations or preventive treatments are overdue. Use /a" +
+	"ddvaccine to add a new record.\x02Overdue vaccinations and preventive tr" +
+	"eatments:\x02Please contact your veterinarian to schedule them, then use" +
+	" /addvaccine to record them.\x02Weight of %[1]s recorded: %[2]s.\x02Use " +
+	"/weightchart to see how it changes over time.\x02There are no weight ent" +
+	"ries for %[1]s yet. Use /weight to add one, e.g. /weight 12.4kg\x02Weigh" +
+	"t history of %[1]s\x02Please send the weight with its unit, e.g. /weight" +
+	" 12.4kg or /weight 9 lbs\x02Pet profile saved successfully\x02Provided d" +
+	"ate cannot be in the future. Please provide a valid date.\x02Please prov" +
+	"ide a date in the valid format YYYY-MM-DD (e.g., 2023-12-31)\x02Adding a" +
+	" vaccination or preventive treatment record for %[1]s.\x02%[1]s is no lo" +
+	"nger among your pets, so the record is not saved.\x02Record of %[1]s sav" +
+	"ed for %[2]s\x02What is your pet's name?\x02What type of pet do you have" +
+	"?\x02dog\x02cat\x02What breed is your pet?\x02When was your pet born? Pl" +
+	"ease enter the date in the format YYYY-MM-DD (e.g., 2010-12-31).\x02What" +
+	" is your pet's gender?\x02male\x02female\x02What is your pet's weight? P" +
+	"lease specify the weight followed by the unit, e.g., 5 kg\x02Is your pet" +
+	" spayed or neutered?\x02yes\x02no\x02How would you describe your pet's a" +
+	"ctivity level?\x02low\x02medium\x02high\x02Does your pet have any chroni" +
+	"c diseases?\x02What are your pet's food preferences or dietary restricti" +
+	"ons?\x02skip\x02Which vaccine or preventive treatment was given (e.g., r" +
+	"abies, deworming, flea treatment)?\x02When was it given? Please enter th" +
+	"e date in the format YYYY-MM-DD (e.g., 2024-05-31).\x02When is the next " +
+	"dose due? Please enter the date in the format YYYY-MM-DD, or skip if you" +
+	" don't know.\x02Which clinic gave it?"
 
-var es_ESIndex = []uint32{ // 36 elements
+var es_ESIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000017, 0x0000002b, 0x00000340,
-	0x0000120d, 0x000013e8, 0x00001450, 0x000014c4,
-	0x00001528, 0x000015a2, 0x000015eb, 0x00001614,
-	0x00001644, 0x000016a7, 0x00001703, 0x0000175f,
-	0x00001785, 0x000017a9, 0x000017c8, 0x000017ce,
-	0x000017d3, 0x000017ee, 0x0000185d, 0x00001882,
-	0x00001888, 0x0000188f, 0x000018f7, 0x00001923,
-	0x00001927, 0x0000192a, 0x00001965, 0x0000196a,
+	0x00000000, 0x00000017, 0x00000059, 0x000000cd,
+	0x00000131, 0x0000017d, 0x000001f7, 0x00000258,
+	0x000002bb, 0x000002cf, 0x000005e4, 0x000014b1,
+	0x00001955, 0x0000197c, 0x00001997, 0x00001a1f,
+	0x00001a2e, 0x00001a67, 0x00001acf, 0x00001add,
+	0x00001b23, 0x00001b4b, 0x00001b9c, 0x00001bc1,
+	0x00001bec, 0x00001c10, 0x00001c64, 0x00001cad,
+	0x00001cd6, 0x00001d06, 0x00001d5a, 0x00001d93,
 	// Entry 20 - 3F
-	0x00001970, 0x00001975, 0x000019a4, 0x000019fb,
-} // Size: 168 bytes
+	0x00001dd3, 0x00001de7, 0x00001ded, 0x00001dfa,
+	0x00001e1a, 0x00001e2d, 0x00001e5a, 0x00001e71,
+	0x00001ed7, 0x00001eea, 0x00001efa, 0x00001fa9,
+	0x00002045, 0x00002097, 0x000020c7, 0x000020de,
+	0x00002144, 0x00002172, 0x000021ce, 0x000021ef,
+	0x00002225, 0x00002285, 0x000022a0, 0x000022e4,
+	0x0000230a, 0x00002366, 0x000023c2, 0x00002408,
+	0x00002456, 0x0000247c, 0x000024a0, 0x000024bf,
+	// Entry 40 - 5F
+	0x000024c5, 0x000024ca, 0x000024e5, 0x00002554,
+	0x00002579, 0x0000257f, 0x00002586, 0x000025ee,
+	0x0000261a, 0x0000261e, 0x00002621, 0x0000265c,
+	0x00002661, 0x00002667, 0x0000266c, 0x0000269b,
+	0x000026f2, 0x000026f9, 0x00002769, 0x000027c1,
+	0x0000282a, 0x00002846,
+} // Size: 368 bytes
 
-const es_ESData string = "" + // Size: 6651 bytes
-	"\x02Cuestionario cancelado\x02Comando desconocido\x02¡Bienvenido a Help " +
-	"My Pet Bot! 🐾\x0a\x0aSoy tu asistente personal de cuidado de mascotas, l" +
-	"isto para brindar orientación para tus amigos peludos. Puedo ayudar con:" +
-	"\x0a\x0a- Preocupaciones de salud y evaluación de síntomas\x0a- Pregunta" +
-	"s de comportamiento y técnicas de entrenamiento\x0a- Recomendaciones de " +
-	"dieta y nutrición\x0a- Consejos generales de cuidado y bienestar de masc" +
-	"otas\x0a\x0aSimplemente escribe tu pregunta o inquietud sobre tu mascota" +
-	". También puedes incluir fotos para que pueda entender mejor tu situació" +
-	"n.\x0a\x0aRecuerda, aunque ofrezco orientación útil basada en conocimien" +
-	"tos veterinarios confiables, no soy un reemplazo para la atención veteri" +
-	"naria profesional. Siempre consulta a un veterinario para problemas médi" +
-	"cos graves.\x0a\x0a¿Con qué pregunta sobre mascotas puedo ayudarte hoy?" +
-	"\x02<b>Términos y Condiciones</b>\x0a<i>Última actualización: 30.01.2025" +
-	"</i>\x0a\x0aGracias por usar nuestro chatbot de asesoramiento veterinari" +
-	"o (“el Servicio”). Al acceder o usar este Servicio, usted acepta estar s" +
-	"ujeto a los siguientes términos y condiciones (“Términos”). Si no está d" +
-	"e acuerdo con estos Términos, por favor, deje de usarlo inmediatamente." +
-	"\x0a\x0a<b>1. Naturaleza del Servicio</b>\x0a1.1 El Servicio proporciona" +
-	" información general, orientación y sugerencias para el cuidado de masco" +
-	"tas, incluyendo (pero no limitado a) dieta, comportamiento y entrenamien" +
-	"to.\x0a1.2 El Servicio no es un sustituto del diagnóstico, tratamiento o" +
-	" cuidado veterinario profesional. Siempre busque el consejo de un veteri" +
-	"nario licenciado para cualquier pregunta sobre la salud de su mascota." +
-	"\x0a\x0a<b>2. No hay Relación Veterinario-Cliente-Paciente</b>\x0a2.1 El" +
-	" uso del Servicio o la interacción con nuestro asistente de IA no crea u" +
-	"na relación veterinario-cliente-paciente.\x0a2.2 Cualquier consejo o ori" +
-	"entación proporcionada por el Servicio se basa en información limitada y" +
-	" solo debe considerarse como información general.\x0a\x0a<b>3. Limitació" +
-	"n de Responsabilidad</b>\x0a3.1 Usted reconoce y acepta que el uso del S" +
-	"ervicio es bajo su propio riesgo.\x0a3.2 Bajo ninguna circunstancia los " +
-	"propietarios, desarrolladores o licenciantes del Servicio serán responsa" +
-	"bles de cualquier daño directo, indirecto, incidental, especial o consec" +
-	"uente que surja de o en conexión con su acceso o uso del Servicio.\x0a3." +
-	"3 Usted entiende que las decisiones sobre el cuidado de su mascota y cua" +
-	"lquier resultado resultante son su responsabilidad exclusiva. Si tiene a" +
-	"lguna duda sobre el bienestar de su mascota o su salud, debe consultar i" +
-	"nmediatamente a un veterinario licenciado.\x0a\x0a<b>4. Sin Garantía</b>" +
-	"\x0a4.1 El Servicio se proporciona “tal cual”, y “según disponibilidad”," +
-	" sin garantías de ningún tipo, ya sean expresas o implícitas.\x0a4.2 No " +
-	"garantizamos que el Servicio será ininterrumpido, libre de errores, segu" +
-	"ro o libre de virus.\x0a\x0a<b>5. Responsabilidades del Usuario</b>\x0a5" +
-	".1 Usted es responsable de proporcionar información precisa y completa s" +
-	"obre su mascota al buscar asesoramiento.\x0a5.2 Debe asegurarse de que t" +
-	"odas las preguntas, descripciones y datos que proporcione no violen los " +
-	"derechos de terceros ni las leyes locales.\x0a\x0a<b>6. Uso Internaciona" +
-	"l</b>\x0a6.1 El Servicio está destinado para uso global. Usted es respon" +
-	"sable de cumplir con todas las leyes y regulaciones locales aplicables e" +
-	"n su jurisdicción.\x0a6.2 No garantizamos que el Servicio o cualquiera d" +
-	"e sus contenidos sean apropiados o permisibles en cualquier país o regió" +
-	"n específica.\x0a\x0a<b>7. Modificaciones</b>\x0a7.1 Nos reservamos el d" +
-	"erecho de modificar o reemplazar estos Términos en cualquier momento." +
-	"\x0a7.2 Si realizamos cambios materiales, publicaremos los Términos actu" +
-	"alizados e indicaremos la fecha de la última revisión en la parte superi" +
-	"or de este documento.\x0a\x0a<b>8. Ley Aplicable y Resolución de Disputa" +
-	"s</b>\x0a8.1 Estos Términos se regirán e interpretarán de acuerdo con la" +
-	"s leyes aplicables en la jurisdicción del lugar principal de negocios de" +
-	"l proveedor del Servicio, sin tener en cuenta los principios de conflict" +
-	"o de leyes.\x0a8.2 Cualquier disputa que surja de o esté relacionada con" +
-	" estos Términos se resolverá mediante negociación amistosa y, si es nece" +
-	"sario, mediante arbitraje vinculante o litigio en los tribunales aplicab" +
-	"les.\x0a\x0a<b>9. Aceptación de los Términos</b>\x0a9.1 Al continuar acc" +
-	"ediendo o usando el Servicio, usted reconoce que ha leído, entendido y a" +
-	"cepta estar sujeto a estos Términos.\x0a9.2 Si no está de acuerdo, debe " +
-	"dejar de usar el Servicio inmediatamente.\x0a\x0aSi tiene alguna pregunt" +
-	"a o inquietud sobre estos Términos, o si necesita más aclaraciones, por " +
-	"favor contacte a <i>k.sysoev@me.com</i>.\x02<b>Comandos de Help My Pet B" +
-	"ot</b>:\x0a/start - Iniciar la conversación con el bot\x0a/terms - Ver l" +
-	"os Términos y Condiciones del servicio\x0a/editprofile - Actualizar la i" +
-	"nformación del perfil de tu mascota, como nombre, edad, raza, etc. Esta " +
-	"información ayuda al bot a proporcionar consejos más precisos.\x0a/cance" +
-	"l - Cancelar el cuestionario actual, si hay alguno en progreso (por ejem" +
-	"plo, cuando quieras empezar de nuevo o cambiar tu pregunta)\x0a/help - V" +
-	"er este mensaje de ayuda\x02Lo siento, no puedo procesar videos, audio o" +
-	" documentos. Por favor, envía tu pregunta solo como texto.\x02Lo siento," +
-	" pero tu mensaje es demasiado largo para que lo procese. Por favor, inte" +
-	"nta hacerlo más corto y conciso.\x02Ha alcanzado el número máximo de sol" +
-	"icitudes por hora. Por favor, inténtelo de nuevo más tarde.\x02Hemos alc" +
-	"anzado nuestro límite diario de solicitudes. Por favor, vuelva mañana cu" +
-	"ando se actualice nuestro presupuesto.\x02Por favor, proporcione su preg" +
-	"unta en formato de texto junto con foto(s)\x02Por favor, proporcione al " +
-	"menos una foto\x02Por favor, proporcione no más de %[1]d foto(s)\x02Lo s" +
-	"iento, encontré un error al procesar su solicitud. Por favor, inténtelo " +
-	"de nuevo más tarde.\x02La fecha proporcionada no puede ser en el futuro." +
-	" Por favor, proporcione una fecha válida.\x02Por favor, proporcione una " +
-	"fecha en el formato válido AAAA-MM-DD (por ejemplo, 2023-12-31)\x02Perfi" +
-	"l de mascota guardado con éxito\x02¿Cuál es el nombre de tu mascota?\x02" +
-	"¿Qué tipo de mascota tienes?\x02perro\x02gato\x02¿Qué raza es tu mascot" +
-	"a?\x02¿Cuándo nació tu mascota? Por favor, introduce la fecha en el form" +
-	"ato AAAA-MM-DD (por ejemplo, 2010-12-31).\x02¿Cuál es el género de tu ma" +
-	"scota?\x02macho\x02hembra\x02¿Cuál es el peso de tu mascota? Por favor, " +
-	"especifica el peso seguido de la unidad, por ejemplo, 5 kg\x02¿Tu mascot" +
-	"a está esterilizada o castrada?\x02sí\x02no\x02¿Cómo describirías el niv" +
-	"el de actividad de tu mascota?\x02baja\x02media\x02alta\x02¿Tu mascota t" +
-	"iene alguna enfermedad crónica?\x02¿Cuáles son las preferencias alimenti" +
-	"cias o restricciones dietéticas de tu mascota?"
+const es_ESData string = "" + // Size: 10310 bytes
+	"\x02Cuestionario cancelado\x02Se han eliminado tu conversación y los per" +
+	"files de tus mascotas.\x02Lo siento, pero tu mensaje es demasiado largo " +
+	"para que lo procese. Por favor, intenta hacerlo más corto y conciso.\x02" +
+	"Ha alcanzado el número máximo de solicitudes por hora. Por favor, intént" +
+	"elo de nuevo más tarde.\x02Has agotado tu cupo de preguntas por ahora. I" +
+	"nténtalo de nuevo más tarde.\x02Hemos alcanzado nuestro límite diario de" +
+	" solicitudes. Por favor, vuelva mañana cuando se actualice nuestro presu" +
+	"puesto.\x02Tu conversación cambió por otro mensaje mientras procesaba es" +
+	"te. Envíalo de nuevo, por favor.\x02Lo siento, encontré un error al proc" +
+	"esar su solicitud. Por favor, inténtelo de nuevo más tarde.\x02Comando d" +
+	"esconocido\x02¡Bienvenido a Help My Pet Bot! 🐾\x0a\x0aSoy tu asistente p" +
+	"ersonal de cuidado de mascotas, listo para brindar orientación para tus " +
+	"amigos peludos. Puedo ayudar con:\x0a\x0a- Preocupaciones de salud y eva" +
+	"luación de síntomas\x0a- Preguntas de comportamiento y técnicas de entre" +
+	"namiento\x0a- Recomendaciones de dieta y nutrición\x0a- Consejos general" +
+	"es de cuidado y bienestar de mascotas\x0a\x0aSimplemente escribe tu preg" +
+	"unta o inquietud sobre tu mascota. También puedes incluir fotos para que" +
+	" pueda entender mejor tu situación.\x0a\x0aRecuerda, aunque ofrezco orie" +
+	"ntación útil basada en conocimientos veterinarios confiables, no soy un " +
+	"reemplazo para la atención veterinaria profesional. Siempre consulta a u" +
+	"n veterinario para problemas médicos graves.\x0a\x0a¿Con qué pregunta so" +
+	"bre mascotas puedo ayudarte hoy?\x02<b>Términos y Condiciones</b>\x0a<i>" +
+	"Última actualización: 30.01.2025</i>\x0a\x0aGracias por usar nuestro ch" +
+	"atbot de asesoramiento veterinario (“el Servicio”). Al acceder o usar es" +
+	"te Servicio, usted acepta estar sujeto a los siguientes términos y condi" +
+	"ciones (“Términos”). Si no está de acuerdo con estos Términos, por favor" +
+	", deje de usarlo inmediatamente.\x0a\x0a<b>1. Naturaleza del Servicio</b" +
+	">\x0a1.1 El Servicio proporciona información general, orientación y suge" +
+	"rencias para el cuidado de mascotas, incluyendo (pero no limitado a) die" +
+	"ta, comportamiento y entrenamiento.\x0a1.2 El Servicio no es un sustitut" +
+	"o del diagnóstico, tratamiento o cuidado veterinario profesional. Siempr" +
+	"e busque el consejo de un veterinario licenciado para cualquier pregunta" +
+	" sobre la salud de su mascota.\x0a\x0a<b>2. No hay Relación Veterinario-" +
+	"Cliente-Paciente</b>\x0a2.1 El uso del Servicio o la interacción con nue" +
+	"stro asistente de IA no crea una relación veterinario-cliente-paciente." +
+	"\x0a2.2 Cualquier consejo o orientación proporcionada por el Servicio se" +
+	" basa en información limitada y solo debe considerarse como información " +
+	"general.\x0a\x0a<b>3. Limitación de Responsabilidad</b>\x0a3.1 Usted rec" +
+	"onoce y acepta que el uso del Servicio es bajo su propio riesgo.\x0a3.2 " +
+	"Bajo ninguna circunstancia los propietarios, desarrolladores o licencian" +
+	"tes del Servicio serán responsables de cualquier daño directo, indirecto" +
+	", incidental, especial o consecuente que surja de o en conexión con su a" +
+	"cceso o uso del Servicio.\x0a3.3 Usted entiende que las decisiones sobre" +
+	" el cuidado de su mascota y cualquier resultado resultante son su respon" +
+	"sabilidad exclusiva. Si tiene alguna duda sobre el bienestar de su masco" +
+	"ta o su salud, debe consultar inmediatamente a un veterinario licenciado" +
+	".\x0a\x0a<b>4. Sin Garantía</b>\x0a4.1 El Servicio se proporciona “tal c" +
+	"ual”, y “según disponibilidad”, sin garantías de ningún tipo, ya sean ex" +
+	"presas o implícitas.\x0a4.2 No garantizamos que el Servicio será ininter" +
+	"rumpido, libre de errores, seguro o libre de virus.\x0a\x0a<b>5. Respons" +
+	"abilidades del Usuario</b>\x0a5.1 Usted es responsable de proporcionar i" +
+	"nformación precisa y completa sobre su mascota al buscar asesoramiento." +
+	"\x0a5.2 Debe asegurarse de que todas las preguntas, descripciones y dato" +
+	"s que proporcione no violen los derechos de terceros ni las leyes locale" +
+	"s.\x0a\x0a<b>6. Uso Internacional</b>\x0a6.1 El Servicio está destinado " +
+	"para uso global. Usted es responsable de cumplir con todas las leyes y r" +
+	"egulaciones locales aplicables en su jurisdicción.\x0a6.2 No garantizamo" +
+	"s que el Servicio o cualquiera de sus contenidos sean apropiados o permi" +
+	"sibles en cualquier país o región específica.\x0a\x0a<b>7. Modificacione" +
+	"s</b>\x0a7.1 Nos reservamos el derecho de modificar o reemplazar estos T" +
+	"érminos en cualquier momento.\x0a7.2 Si realizamos cambios materiales, " +
+	"publicaremos los Términos actualizados e indicaremos la fecha de la últi" +
+	"ma revisión en la parte superior de este documento.\x0a\x0a<b>8. Ley Apl" +
+	"icable y Resolución de Disputas</b>\x0a8.1 Estos Términos se regirán e i" +
+	"nterpretarán de acuerdo con las leyes aplicables en la jurisdicción del " +
+	"lugar principal de negocios del proveedor del Servicio, sin tener en cue" +
+	"nta los principios de conflicto de leyes.\x0a8.2 Cualquier disputa que s" +
+	"urja de o esté relacionada con estos Términos se resolverá mediante nego" +
+	"ciación amistosa y, si es necesario, mediante arbitraje vinculante o lit" +
+	"igio en los tribunales aplicables.\x0a\x0a<b>9. Aceptación de los Términ" +
+	"os</b>\x0a9.1 Al continuar accediendo o usando el Servicio, usted recono" +
+	"ce que ha leído, entendido y acepta estar sujeto a estos Términos.\x0a9." +
+	"2 Si no está de acuerdo, debe dejar de usar el Servicio inmediatamente." +
+	"\x0a\x0aSi tiene alguna pregunta o inquietud sobre estos Términos, o si " +
+	"necesita más aclaraciones, por favor contacte a <i>k.sysoev@me.com</i>." +
+	"\x02<b>Comandos de Help My Pet Bot</b>:\x0a/start - Iniciar la conversac" +
+	"ión con el bot\x0a/terms - Ver los Términos y Condiciones del servicio" +
+	"\x0a/editprofile - Actualizar la información del perfil de tu mascota, c" +
+	"omo nombre, edad, raza, etc. Esta información ayuda al bot a proporciona" +
+	"r consejos más precisos.\x0a/addpet - Añadir el perfil de otra mascota, " +
+	"si tienes más de una\x0a/pets - Ver tus mascotas y cuál está seleccionad" +
+	"a\x0a/switchpet - Elegir la mascota sobre la que serán tus próximas preg" +
+	"untas\x0a/removepet - Eliminar el perfil de una mascota\x0a/weight - Reg" +
+	"istrar el peso actual de tu mascota, p. ej. /weight 12.4kg\x0a/weightcha" +
+	"rt - Ver un gráfico del peso de tu mascota a lo largo del tiempo\x0a/vac" +
+	"cines - Ver las vacunas y tratamientos preventivos atrasados de tus masc" +
+	"otas\x0a/addvaccine - Añadir un registro de vacuna o tratamiento prevent" +
+	"ivo de tu mascota\x0a/remind - Crear un recordatorio periódico, p. ej. /" +
+	"remind give Rimadyl every 12h for 7 days\x0a/reminders - Ver tus recorda" +
+	"torios y eliminar los que no necesites\x0a/cancel - Cancelar el cuestion" +
+	"ario actual, si hay alguno en progreso (por ejemplo, cuando quieras empe" +
+	"zar de nuevo o cambiar tu pregunta)\x0a/help - Ver este mensaje de ayuda" +
+	"\x02Esta respuesta ya no se puede valorar.\x02¡Gracias por tu opinión!" +
+	"\x02Lamentamos que la respuesta no te haya ayudado. ¿Qué falló? Responde" +
+	" a este mensaje con un breve comentario o simplemente ignóralo.\x02¿Qué " +
+	"falló?\x02Gracias, tu opinión nos ayuda a mejorar las respuestas.\x02Lo " +
+	"siento, no puedo procesar videos, audio o documentos. Por favor, envía t" +
+	"u pregunta solo como texto.\x02Tus mascotas:\x02Usa /switchpet para eleg" +
+	"ir la mascota sobre la que son tus preguntas.\x02¿Sobre qué mascota quie" +
+	"res preguntar?\x02No he encontrado ninguna mascota llamada %[1]s. Usa /p" +
+	"ets para ver tus mascotas.\x02Ahora tus preguntas son sobre %[1]s.\x02¿Q" +
+	"ué perfil de mascota quieres eliminar?\x02Se ha eliminado el perfil de %" +
+	"[1]s.\x02Todavía no tienes perfiles de mascotas. Usa /editprofile o /add" +
+	"pet para crear uno.\x02Por favor, proporcione su pregunta en formato de " +
+	"texto junto con foto(s)\x02Por favor, proporcione al menos una foto\x02P" +
+	"or favor, proporcione no más de %[1]d foto(s)\x02Tienes demasiados recor" +
+	"datorios. Usa /reminders para eliminar los que no necesites.\x02Los reco" +
+	"rdatorios no están disponibles en este momento.\x02Recordatorio creado: " +
+	"%[1]s, %[2]s.\x0aPróximo recordatorio: %[3]s\x02Recordatorio: %[1]s\x02H" +
+	"echo\x02Posponer 1 h\x02Este recordatorio ya no existe.\x02Marcado como " +
+	"hecho\x02Te lo recordaré de nuevo dentro de una hora\x02Recordatorio eli" +
+	"minado\x02No tienes recordatorios. Usa /remind para crear uno, p. ej. /r" +
+	"emind give Rimadyl every 12h for 7 days\x02Tus recordatorios:\x02Próximo" +
+	": %[1]s\x02Dime qué quieres que te recuerde y con qué frecuencia, por ej" +
+	"emplo:\x0a/remind give Rimadyl every 12h for 7 days\x0a/remind flea trea" +
+	"tment monthly\x0a/remind brush teeth twice a day\x02🚨 EMERGENCIA: tu mas" +
+	"cota puede necesitar atención veterinaria inmediata. Contacta ahora con " +
+	"tu veterinario o con la clínica de urgencias más cercana.\x02⚠️ Te recom" +
+	"endamos visitar a tu veterinario en los próximos uno o dos días.\x02🏥 Bu" +
+	"scar un veterinario de urgencias cercano\x02%[1]s vencía el %[2]s\x02No " +
+	"hay vacunas ni tratamientos preventivos atrasados. Usa /addvaccine para " +
+	"añadir un nuevo registro.\x02Vacunas y tratamientos preventivos atrasado" +
+	"s:\x02Contacta con tu veterinario para programarlos y después usa /addva" +
+	"ccine para registrarlos.\x02Peso de %[1]s registrado: %[2]s.\x02Usa /wei" +
+	"ghtchart para ver cómo cambia con el tiempo.\x02Todavía no hay registros" +
+	" de peso de %[1]s. Usa /weight para añadir uno, p. ej. /weight 12.4kg" +
+	"\x02Historial de peso de %[1]s\x02Envía el peso con su unidad, p. ej. /w" +
+	"eight 12.4kg o /weight 9 lbs\x02Perfil de mascota guardado con éxito\x02" +
+	"La fecha proporcionada no puede ser en el futuro. Por favor, proporcione" +
+	" una fecha válida.\x02Por favor, proporcione una fecha en el formato vál" +
+	"ido AAAA-MM-DD (por ejemplo, 2023-12-31)\x02Añadiendo un registro de vac" +
+	"una o tratamiento preventivo para %[1]s.\x02%[1]s ya no está entre tus m" +
+	"ascotas, así que el registro no se ha guardado.\x02Registro de %[1]s gua" +
+	"rdado para %[2]s\x02¿Cuál es el nombre de tu mascota?\x02¿Qué tipo de ma" +
+	"scota tienes?\x02perro\x02gato\x02¿Qué raza es tu mascota?\x02¿Cuándo na" +
+	"ció tu mascota? Por favor, introduce la fecha en el formato AAAA-MM-DD (" +
+	"por ejemplo, 2010-12-31).\x02¿Cuál es el género de tu mascota?\x02macho" +
+	"\x02hembra\x02¿Cuál es el peso de tu mascota? Por favor, especifica el p" +
+	"eso seguido de la unidad, por ejemplo, 5 kg\x02¿Tu mascota está esterili" +
+	"zada o castrada?\x02sí\x02no\x02¿Cómo describirías el nivel de actividad" +
+	" de tu mascota?\x02baja\x02media\x02alta\x02¿Tu mascota tiene alguna enf" +
+	"ermedad crónica?\x02¿Cuáles son las preferencias alimenticias o restricc" +
+	"iones dietéticas de tu mascota?\x02omitir\x02¿Qué vacuna o tratamiento p" +
+	"reventivo se le aplicó (p. ej., rabia, desparasitación, tratamiento anti" +
+	"pulgas)?\x02¿Cuándo se aplicó? Introduce la fecha en el formato AAAA-MM-" +
+	"DD (p. ej., 2024-05-31).\x02¿Cuándo toca la próxima dosis? Introduce la " +
+	"fecha en el formato AAAA-MM-DD u omítela si no lo sabes.\x02¿Qué clínica" +
+	" lo aplicó?"
 
-var fr_FRIndex = []uint32{ // 36 elements
+var fr_FRIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x0000001d, 0x0000002f, 0x000003ff,
-	0x0000138b, 0x0000147d, 0x00001505, 0x00001588,
-	0x000015e3, 0x00001652, 0x0000169b, 0x000016bf,
-	0x000016ee, 0x00001757, 0x000017aa, 0x000017fa,
-	0x00001826, 0x00001855, 0x00001881, 0x00001887,
-	0x0000188c, 0x000018be, 0x00001930, 0x00001960,
-	0x00001966, 0x0000196e, 0x000019e0, 0x00001a0f,
-	0x00001a13, 0x00001a17, 0x00001a64, 0x00001a6b,
+	0x00000000, 0x0000001d, 0x00000064, 0x000000e7,
+	0x00000142, 0x0000019d, 0x0000020c, 0x0000027e,
+	0x000002e7, 0x000002f9, 0x000006c9, 0x00001655,
+	0x00001b12, 0x00001b3f, 0x00001b57, 0x00001bf0,
+	0x00001c0d, 0x00001c46, 0x00001cce, 0x00001cdc,
+	0x00001d23, 0x00001d61, 0x00001db2, 0x00001ddd,
+	0x00001e0d, 0x00001e33, 0x00001e91, 0x00001eda,
+	0x00001efe, 0x00001f2d, 0x00001f8d, 0x00001fc1,
 	// Entry 20 - 3F
-	0x00001a71, 0x00001a79, 0x00001ab4, 0x00001b20,
-} // Size: 168 bytes
+	0x00001ff7, 0x00002006, 0x0000200b, 0x0000201a,
+	0x00002033, 0x00002046, 0x0000206c, 0x0000207d,
+	0x000020ed, 0x000020fb, 0x0000210c, 0x000021c3,
+	0x0000226e, 0x000022d2, 0x00002308, 0x00002325,
+	0x00002398, 0x000023c7, 0x00002429, 0x0000244d,
+	0x0000248b, 0x000024fc, 0x00002519, 0x0000256c,
+	0x00002598, 0x000025eb, 0x0000263b, 0x00002677,
+	0x000026d2, 0x00002701, 0x00002730, 0x0000275c,
+	// Entry 40 - 5F
+	0x00002762, 0x00002767, 0x00002799, 0x0000280b,
+	0x0000283b, 0x00002841, 0x00002849, 0x000028bb,
+	0x000028ea, 0x000028ee, 0x000028f2, 0x0000293f,
+	0x00002946, 0x0000294c, 0x00002954, 0x0000298f,
+	0x000029fb, 0x00002a02, 0x00002a6d, 0x00002ad1,
+	0x00002b4a, 0x00002b6c,
+} // Size: 368 bytes
 
-const fr_FRData string = "" + // Size: 6944 bytes
-	"\x02Le questionnaire est annulé\x02Commande inconnue\x02Bienvenue sur He" +
-	"lp My Pet Bot! 🐾\x0a\x0aJe suis votre assistant personnel pour les soins" +
-	" des animaux de compagnie, prêt à vous guider pour vos amis à fourrure. " +
-	"Je peux vous aider avec :\x0a\x0a- Les préoccupations de santé et l'éval" +
-	"uation des symptômes\x0a- Questions de comportement et techniques de dre" +
-	"ssage\x0a- Recommandations en matière de régime alimentaire et de nutrit" +
-	"ion\x0a- Conseils généraux sur les soins et le bien-être des animaux de " +
-	"compagnie\x0a\x0aIl vous suffit de taper votre question ou votre préoccu" +
-	"pation concernant votre animal de compagnie. Vous pouvez également inclu" +
-	"re des photos pour m'aider à mieux comprendre votre situation.\x0a\x0aN'" +
-	"oubliez pas que, bien que je propose des conseils utiles basés sur des c" +
-	"onnaissances vétérinaires fiables, je ne remplace pas les soins vétérina" +
-	"ires professionnels. Consultez toujours un vétérinaire pour des problème" +
-	"s médicaux graves.\x0a\x0aAvec quelle question sur les animaux de compag" +
-	"nie puis-je vous aider aujourd'hui?\x02<b>Conditions générales</b>\x0a<i" +
-	">Dernière mise à jour : 30.01.2025</i>\x0a\x0aMerci d'utiliser notre cha" +
-	"tbot de conseils vétérinaires (« le Service »). En accédant à ce Service" +
-	" ou en l'utilisant, vous acceptez d'être lié par les conditions générale" +
-	"s suivantes (« Conditions »). Si vous n'acceptez pas ces Conditions, veu" +
-	"illez cesser immédiatement d'utiliser le Service.\x0a\x0a<b>1. Nature du" +
-	" Service</b>\x0a1.1 Le Service fournit des informations générales, des c" +
-	"onseils et des suggestions pour les soins des animaux de compagnie, y co" +
-	"mpris (mais sans s'y limiter) l'alimentation, le comportement et le dres" +
-	"sage.\x0a1.2 Le Service ne remplace pas un diagnostic, un traitement ou " +
-	"des soins vétérinaires professionnels. Consultez toujours un vétérinaire" +
-	" agréé pour toute question concernant la santé de votre animal.\x0a\x0a<" +
-	"b>2. Absence de relation vétérinaire-client-patient</b>\x0a2.1 L'utilisa" +
-	"tion du Service ou l'interaction avec notre assistant IA ne crée pas de " +
-	"relation vétérinaire-client-patient.\x0a2.2 Tout conseil ou orientation " +
-	"fourni par le Service est basé sur des informations limitées et doit êtr" +
-	"e considéré uniquement comme des informations générales.\x0a\x0a<b>3. Li" +
-	"mitation de responsabilité</b>\x0a3.1 Vous reconnaissez et acceptez que " +
-	"l'utilisation du Service se fait à vos propres risques.\x0a3.2 En aucun " +
-	"cas, les propriétaires, développeurs ou concédants de licence du Service" +
-	" ne seront responsables des dommages directs, indirects, accessoires, sp" +
-	"éciaux ou consécutifs résultant de ou en relation avec votre accès ou u" +
+const fr_FRData string = "" + // Size: 11116 bytes
+	"\x02Le questionnaire est annulé\x02Votre conversation et les profils de " +
+	"vos animaux ont été supprimés.\x02Je m'excuse, mais votre message est tr" +
+	"op long pour que je puisse le traiter. Essayez de le raccourcir et de le" +
+	" rendre plus concis.\x02Vous avez atteint le nombre maximum de requêtes " +
+	"par heure. Veuillez réessayer plus tard.\x02Vous avez épuisé votre quota" +
+	" de questions pour le moment. Veuillez réessayer plus tard.\x02Nous avon" +
+	"s atteint notre limite de demandes quotidiennes. Revenez demain lorsque " +
+	"notre budget sera rafraîchi.\x02Votre conversation a été modifiée par un" +
+	" autre message pendant que je traitais celui-ci. Veuillez le renvoyer." +
+	"\x02Désolé, j'ai rencontré une erreur lors du traitement de votre demand" +
+	"e. Veuillez réessayer plus tard.\x02Commande inconnue\x02Bienvenue sur H" +
+	"elp My Pet Bot! 🐾\x0a\x0aJe suis votre assistant personnel pour les soin" +
+	"s des animaux de compagnie, prêt à vous guider pour vos amis à fourrure." +
+	" Je peux vous aider avec :\x0a\x0a- Les préoccupations de santé et l'éva" +
+	"luation des symptômes\x0a- Questions de comportement et techniques de dr" +
+	"essage\x0a- Recommandations en matière de régime alimentaire et de nutri" +
+	"tion\x0a- Conseils généraux sur les soins et le bien-être des animaux de" +
+	" compagnie\x0a\x0aIl vous suffit de taper votre question ou votre préocc" +
+	"upation concernant votre animal de compagnie. Vous pouvez également incl" +
+	"ure des photos pour m'aider à mieux comprendre votre situation.\x0a\x0aN" +
+	"'oubliez pas que, bien que je propose des conseils utiles basés sur des " +
+	"connaissances vétérinaires fiables, je ne remplace pas les soins vétérin" +
+	"aires professionnels. Consultez toujours un vétérinaire pour des problèm" +
+	"es médicaux graves.\x0a\x0aAvec quelle question sur les animaux de compa" +
+	"gnie puis-je vous aider aujourd'hui?\x02<b>Conditions générales</b>\x0a<" +
+	"i>Dernière mise à jour : 30.01.2025</i>\x0a\x0aMerci d'utiliser notre ch" +
+	"atbot de conseils vétérinaires (« le Service »). En accédant à ce Servic" +
+	"e ou en l'utilisant, vous acceptez d'être lié par les conditions général" +
+	"es suivantes (« Conditions »). Si vous n'acceptez pas ces Conditions, ve" +
+	"uillez cesser immédiatement d'utiliser le Service.\x0a\x0a<b>1. Nature d" +
+	"u Service</b>\x0a1.1 Le Service fournit des informations générales, des " +
+	"conseils et des suggestions pour les soins des animaux de compagnie, y c" +
+	"ompris (mais sans s'y limiter) l'alimentation, le comportement et le dre" +
+	"ssage.\x0a1.2 Le Service ne remplace pas un diagnostic, un traitement ou" +
+	" des soins vétérinaires professionnels. Consultez toujours un vétérinair" +
+	"e agréé pour toute question concernant la santé de votre animal.\x0a\x0a" +
+	"<b>2. Absence de relation vétérinaire-client-patient</b>\x0a2.1 L'utilis" +
+	"ation du Service ou l'interaction avec notre assistant IA ne crée pas de" +
+	" relation vétérinaire-client-patient.\x0a2.2 Tout conseil ou orientation" +
+	" fourni par le Service est basé sur des informations limitées et doit êt" +
+	"re considéré uniquement comme des informations générales.\x0a\x0a<b>3. L" +
+	"imitation de responsabilité</b>\x0a3.1 Vous reconnaissez et acceptez que" +
+	" l'utilisation du Service se fait à vos propres risques.\x0a3.2 En aucun" +
+	" cas, les propriétaires, développeurs ou concédants de licence du Servic" +
+	"e ne seront responsables des dommages directs, indirects, accessoires, s" +
+	"péciaux ou consécutifs résultant de ou en relation avec votre accès ou u" +
 	"tilisation du Service.\x0a3.3 Vous comprenez que les décisions concernan" +
 	"t les soins de votre animal et les résultats qui en découlent sont de vo" +
 	"tre seule responsabilité. Si vous avez des doutes sur le bien-être ou la" +
@@ -728,536 +1141,901 @@ const fr_FRData string = "" + // Size: 6944 bytes
 	"e.com</i>.\x02<b>Commandes Help My Pet Bot</b> :\x0a/start - Démarrer la" +
 	" conversation avec le bot\x0a/terms - Afficher les conditions générales " +
 	"du service\x0a/editprofile - Mettre à jour les informations du profil de" +
-	" votre animal, telles que le nom, l'âge, la\x02Désolé, je ne peux pas tr" +
-	"aiter les vidéos, l'audio ou les documents. Veuillez envoyer votre quest" +
-	"ion sous forme de texte uniquement.\x02Je m'excuse, mais votre message e" +
-	"st trop long pour que je puisse le traiter. Essayez de le raccourcir et " +
-	"de le rendre plus concis.\x02Vous avez atteint le nombre maximum de requ" +
-	"êtes par heure. Veuillez réessayer plus tard.\x02Nous avons atteint not" +
-	"re limite de demandes quotidiennes. Revenez demain lorsque notre budget " +
-	"sera rafraîchi.\x02Veuillez fournir votre question au format texte accom" +
+	" votre animal, telles que le nom, l'âge, la race, etc. Ces informations " +
+	"aident le bot à fournir des conseils plus précis.\x0a/addpet - Ajouter l" +
+	"e profil d'un autre animal, si vous en avez plusieurs\x0a/pets - Affiche" +
+	"r vos animaux et celui qui est sélectionné\x0a/switchpet - Choisir l'ani" +
+	"mal concerné par vos prochaines questions\x0a/removepet - Supprimer le p" +
+	"rofil d'un animal\x0a/weight - Enregistrer le poids actuel de votre anim" +
+	"al, par ex. /weight 12.4kg\x0a/weightchart - Voir un graphique de l'évol" +
+	"ution du poids de votre animal\x0a/vaccines - Afficher les vaccins et tr" +
+	"aitements préventifs en retard de vos animaux\x0a/addvaccine - Ajouter u" +
+	"n vaccin ou un traitement préventif pour votre animal\x0a/remind - Créer" +
+	" un rappel récurrent, par ex. /remind give Rimadyl every 12h for 7 days" +
+	"\x0a/reminders - Afficher vos rappels et supprimer ceux dont vous n'avez" +
+	" pas besoin\x0a/cancel - Annuler le questionnaire en cours, s'il y en a " +
+	"un (par ex. lorsque vous voulez recommencer ou changer de question)\x0a/" +
+	"help - Afficher ce message d'aide\x02Cette réponse ne peut plus être éva" +
+	"luée.\x02Merci pour votre avis !\x02Désolé que la réponse ne vous ait pa" +
+	"s aidé. Qu'est-ce qui n'allait pas ? Répondez à ce message par un court " +
+	"commentaire, ou ignorez-le simplement.\x02Qu'est-ce qui n'allait pas ?" +
+	"\x02Merci, votre avis nous aide à améliorer les réponses.\x02Désolé, je " +
+	"ne peux pas traiter les vidéos, l'audio ou les documents. Veuillez envoy" +
+	"er votre question sous forme de texte uniquement.\x02Vos animaux :\x02Ut" +
+	"ilisez /switchpet pour choisir l'animal concerné par vos questions.\x02À" +
+	" propos de quel animal souhaitez-vous poser vos questions ?\x02Je n'ai t" +
+	"rouvé aucun animal nommé %[1]s. Utilisez /pets pour voir vos animaux." +
+	"\x02Vos questions concernent maintenant %[1]s.\x02Quel profil d'animal s" +
+	"ouhaitez-vous supprimer ?\x02Le profil de %[1]s a été supprimé.\x02Vous " +
+	"n'avez encore aucun profil d'animal. Utilisez /editprofile ou /addpet po" +
+	"ur en créer un.\x02Veuillez fournir votre question au format texte accom" +
 	"pagnée de photo(s)\x02Veuillez fournir au moins une photo\x02Veuillez ne" +
-	" pas fournir plus de %[1]d photo(s)\x02Désolé, j'ai rencontré une erreur" +
-	" lors du traitement de votre demande. Veuillez réessayer plus tard.\x02L" +
-	"a date fournie ne peut pas être dans le futur. Veuillez fournir une date" +
-	" valide.\x02Veuillez fournir une date au format valide AAAA-MM-JJ (par e" +
-	"xemple, 2023-12-31)\x02Profil de l'animal enregistré avec succès\x02Quel" +
-	" est le nom de votre animal de compagnie ?\x02Quel type d'animal de comp" +
-	"agnie avez-vous ?\x02chien\x02chat\x02Quelle est la race de votre animal" +
-	" de compagnie ?\x02Quand est né votre animal de compagnie ? Veuillez ent" +
-	"rer la date au format AAAA-MM-JJ (par exemple, 2010-12-31).\x02Quel est " +
-	"le sexe de votre animal de compagnie ?\x02mâle\x02femelle\x02Quel est le" +
-	" poids de votre animal de compagnie ? Veuillez spécifier le poids suivi " +
-	"de l'unité, par exemple 5 kg\x02Votre animal de compagnie est-il stérili" +
-	"sé ?\x02oui\x02non\x02Comment décririez-vous le niveau d'activité de vot" +
-	"re animal de compagnie ?\x02faible\x02moyen\x02élevé\x02Votre animal de " +
-	"compagnie a-t-il des maladies chroniques ?\x02Quelles sont les préférenc" +
-	"es alimentaires ou les restrictions alimentaires de votre animal de comp" +
-	"agnie ?"
+	" pas fournir plus de %[1]d photo(s)\x02Vous avez trop de rappels. Utilis" +
+	"ez /reminders pour supprimer ceux dont vous n'avez pas besoin.\x02Les ra" +
+	"ppels ne sont pas disponibles pour le moment.\x02Rappel créé : %[1]s, %[" +
+	"2]s.\x0aProchain rappel : %[3]s\x02Rappel : %[1]s\x02Fait\x02Reporter d'" +
+	"1 h\x02Ce rappel n'existe plus.\x02Marqué comme fait\x02Je vous le rappe" +
+	"llerai dans une heure\x02Rappel supprimé\x02Vous n'avez aucun rappel. Ut" +
+	"ilisez /remind pour en créer un, par ex. /remind give Rimadyl every 12h " +
+	"for 7 days\x02Vos rappels :\x02Prochain : %[1]s\x02Dites-moi ce que je d" +
+	"ois vous rappeler et à quelle fréquence, par exemple :\x0a/remind give R" +
+	"imadyl every 12h for 7 days\x0a/remind flea treatment monthly\x0a/remind" +
+	" brush teeth twice a day\x02🚨 URGENCE : votre animal a peut-être besoin " +
+	"de soins vétérinaires immédiats. Contactez dès maintenant votre vétérina" +
+	"ire ou la clinique d'urgence la plus proche.\x02⚠️ Nous vous recommandon" +
+	"s de consulter votre vétérinaire dans les un à deux prochains jours.\x02" +
+	"🏥 Trouver un vétérinaire d'urgence à proximité\x02%[1]s était prévu l" +
+	"e %[2]s\x02Aucun vaccin ni traitement préventif n'est en retard. Utilise" +
+	"z /addvaccine pour ajouter un nouvel enregistrement.\x02Vaccins et trait" +
+	"ements préventifs en retard :\x02Contactez votre vétérinaire pour les pl" +
+	"anifier, puis utilisez /addvaccine pour les enregistrer.\x02Poids de %[1" +
+	"]s enregistré : %[2]s.\x02Utilisez /weightchart pour voir son évolution " +
+	"dans le temps.\x02Il n'y a pas encore de poids enregistré pour %[1]s. Ut" +
+	"ilisez /weight pour en ajouter un, par ex. /weight 12.4kg\x02Historique " +
+	"du poids de %[1]s\x02Veuillez envoyer le poids avec son unité, par ex. /" +
+	"weight 12.4kg ou /weight 9 lbs\x02Profil de l'animal enregistré avec suc" +
+	"cès\x02La date fournie ne peut pas être dans le futur. Veuillez fournir " +
+	"une date valide.\x02Veuillez fournir une date au format valide AAAA-MM-J" +
+	"J (par exemple, 2023-12-31)\x02Ajout d'un vaccin ou d'un traitement prév" +
+	"entif pour %[1]s.\x02%[1]s ne fait plus partie de vos animaux, l'enregis" +
+	"trement n'a donc pas été sauvegardé.\x02Enregistrement de %[1]s sauvegar" +
+	"dé pour %[2]s\x02Quel est le nom de votre animal de compagnie ?\x02Quel " +
+	"type d'animal de compagnie avez-vous ?\x02chien\x02chat\x02Quelle est la" +
+	" race de votre animal de compagnie ?\x02Quand est né votre animal de com" +
+	"pagnie ? Veuillez entrer la date au format AAAA-MM-JJ (par exemple, 2010" +
+	"-12-31).\x02Quel est le sexe de votre animal de compagnie ?\x02mâle\x02f" +
+	"emelle\x02Quel est le poids de votre animal de compagnie ? Veuillez spéc" +
+	"ifier le poids suivi de l'unité, par exemple 5 kg\x02Votre animal de com" +
+	"pagnie est-il stérilisé ?\x02oui\x02non\x02Comment décririez-vous le niv" +
+	"eau d'activité de votre animal de compagnie ?\x02faible\x02moyen\x02élev" +
+	"é\x02Votre animal de compagnie a-t-il des maladies chroniques ?\x02Quel" +
+	"les sont les préférences alimentaires ou les restrictions alimentaires d" +
+	"e votre animal de compagnie ?\x02passer\x02Quel vaccin ou traitement pré" +
+	"ventif a été administré (par ex. rage, vermifuge, traitement antipuces) " +
+	"?\x02Quand a-t-il été administré ? Veuillez saisir la date au format AAA" +
+	"A-MM-JJ (par ex. 2024-05-31).\x02Quand la prochaine dose est-elle prévue" +
+	" ? Veuillez saisir la date au format AAAA-MM-JJ, ou passez si vous ne sa" +
+	"vez pas.\x02Quelle clinique l'a administré ?"
 
-var it_ITIndex = []uint32{ // 36 elements
+var it_ITIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000017, 0x0000002b, 0x0000036a,
-	0x000011e3, 0x000013d3, 0x00001440, 0x000014b7,
-	0x00001501, 0x00001575, 0x000015bb, 0x000015df,
-	0x0000160a, 0x0000166e, 0x000016bf, 0x00001713,
-	0x00001747, 0x00001772, 0x00001795, 0x0000179a,
-	0x000017a0, 0x000017c9, 0x00001840, 0x0000186c,
-	0x00001874, 0x0000187c, 0x000018ed, 0x00001928,
-	0x0000192c, 0x0000192f, 0x00001975, 0x0000197b,
+	0x00000000, 0x00000017, 0x0000005d, 0x000000d4,
+	0x0000011e, 0x00000166, 0x000001da, 0x00000244,
+	0x000002a8, 0x000002bc, 0x000005fb, 0x00001474,
+	0x00001952, 0x00001981, 0x0000199d, 0x00001a24,
+	0x00001a35, 0x00001a70, 0x00001add, 0x00001aed,
+	0x00001b39, 0x00001b59, 0x00001bab, 0x00001bd0,
+	0x00001bf9, 0x00001c1f, 0x00001c75, 0x00001cbb,
+	0x00001cdf, 0x00001d0a, 0x00001d59, 0x00001d87,
 	// Entry 20 - 3F
-	0x00001981, 0x00001986, 0x000019b5, 0x00001a10,
-} // Size: 168 bytes
+	0x00001dc6, 0x00001dd8, 0x00001dde, 0x00001def,
+	0x00001e12, 0x00001e25, 0x00001e4a, 0x00001e5f,
+	0x00001ec1, 0x00001ed4, 0x00001ee4, 0x00001f8b,
+	0x00002029, 0x00002072, 0x000020a9, 0x000020c8,
+	0x00002132, 0x00002161, 0x000021b4, 0x000021d5,
+	0x00002208, 0x0000226d, 0x00002287, 0x000022ce,
+	0x00002302, 0x00002353, 0x000023a7, 0x000023ee,
+	0x0000243b, 0x0000245d, 0x00002488, 0x000024ab,
+	// Entry 40 - 5F
+	0x000024b0, 0x000024b6, 0x000024df, 0x00002556,
+	0x00002582, 0x0000258a, 0x00002592, 0x00002603,
+	0x0000263e, 0x00002642, 0x00002645, 0x0000268b,
+	0x00002691, 0x00002697, 0x0000269c, 0x000026cb,
+	0x00002726, 0x0000272c, 0x00002795, 0x000027f2,
+	0x0000285d, 0x0000287f,
+} // Size: 368 bytes
 
-const it_ITData string = "" + // Size: 6672 bytes
-	"\x02Questionario annullato\x02Comando sconosciuto\x02Benvenuto in Help M" +
-	"y Pet Bot! 🐾\x0a\x0aSono il tuo assistente personale per la cura degli a" +
-	"nimali domestici, pronto a fornire indicazioni per i tuoi amici pelosi. " +
-	"Posso aiutare con:\x0a\x0a- Preoccupazioni per la salute e valutazione d" +
-	"ei sintomi\x0a- Domande sul comportamento e tecniche di addestramento" +
-	"\x0a- Raccomandazioni su dieta e nutrizione\x0a- Consigli generali sulla" +
-	" cura e il benessere degli animali domestici\x0a\x0aBasta digitare la tu" +
-	"a domanda o preoccupazione sul tuo animale domestico. Puoi anche include" +
-	"re foto per aiutarmi a capire meglio la tua situazione.\x0a\x0aRicorda, " +
-	"sebbene offra utili indicazioni basate su una conoscenza veterinaria aff" +
-	"idabile, non sono un sostituto della cura veterinaria professionale. Con" +
-	"sulta sempre un veterinario per gravi preoccupazioni mediche.\x0a\x0aCon" +
-	" quale domanda sull'animale domestico posso aiutarti oggi?\x02<b>Termini" +
-	" e Condizioni</b>\x0a<i>Ultimo aggiornamento: 30.01.2025</i>\x0a\x0aGraz" +
-	"ie per aver utilizzato il nostro chatbot di consulenza veterinaria (“il " +
-	"Servizio”). Accedendo o utilizzando questo Servizio, accetti di essere v" +
-	"incolato dai seguenti termini e condizioni (“Termini”). Se non accetti q" +
-	"uesti Termini, interrompi immediatamente l'uso.\x0a\x0a<b>1. Natura del " +
-	"Servizio</b>\x0a1.1 Il Servizio fornisce informazioni generali, orientam" +
-	"enti e suggerimenti per la cura degli animali domestici, inclusi (ma non" +
-	" limitati a) dieta, comportamento e addestramento.\x0a1.2 Il Servizio no" +
-	"n è un sostituto della diagnosi, trattamento o cura veterinaria professi" +
-	"onale. Cerca sempre il parere di un veterinario autorizzato per qualsias" +
-	"i domanda riguardante la salute del tuo animale domestico.\x0a\x0a<b>2. " +
-	"Nessuna Relazione Veterinario-Cliente-Paziente</b>\x0a2.1 L'utilizzo del" +
-	" Servizio o l'interazione con il nostro assistente AI non crea una relaz" +
-	"ione veterinario-cliente-paziente.\x0a2.2 Qualsiasi consiglio o orientam" +
-	"ento fornito dal Servizio si basa su informazioni limitate e deve essere" +
-	" considerato solo come informazione generale.\x0a\x0a<b>3. Limitazione d" +
-	"i Responsabilità</b>\x0a3.1 Riconosci e accetti che l'uso del Servizio è" +
-	" a tuo rischio.\x0a3.2 In nessun caso i proprietari, sviluppatori o lice" +
-	"nziatari del Servizio saranno responsabili per danni diretti, indiretti," +
-	" incidentali, speciali o consequenziali derivanti da o in connessione co" +
-	"n il tuo accesso o utilizzo del Servizio.\x0a3.3 Comprendi che le decisi" +
-	"oni riguardanti la cura del tuo animale domestico e qualsiasi risultato " +
-	"risultante sono di tua esclusiva responsabilità. Se hai dubbi sul beness" +
-	"ere del tuo animale domestico o sulla sua salute, dovresti consultare im" +
-	"mediatamente un veterinario autorizzato.\x0a\x0a<b>4. Nessuna Garanzia</" +
-	"b>\x0a4.1 Il Servizio è fornito “così com'è”, e “come disponibile”, senz" +
-	"a garanzie di alcun tipo, espresse o implicite.\x0a4.2 Non garantiamo ch" +
-	"e il Servizio sarà ininterrotto, privo di errori, sicuro o privo di viru" +
-	"s.\x0a\x0a<b>5. Responsabilità dell'Utente</b>\x0a5.1 Sei responsabile d" +
-	"i fornire informazioni accurate e complete sul tuo animale domestico qua" +
-	"ndo cerchi consigli.\x0a5.2 Devi assicurarti che tutte le domande, descr" +
-	"izioni e dati che fornisci non violino i diritti di terzi o le leggi loc" +
-	"ali.\x0a\x0a<b>6. Uso Internazionale</b>\x0a6.1 Il Servizio è destinato " +
-	"all'uso globale. Sei responsabile del rispetto di tutte le leggi e regol" +
-	"amenti locali applicabili nella tua giurisdizione.\x0a6.2 Non garantiamo" +
-	" che il Servizio o qualsiasi suo contenuto sia appropriato o consentito " +
-	"in qualsiasi paese o regione specifica.\x0a\x0a<b>7. Modifiche</b>\x0a7." +
-	"1 Ci riserviamo il diritto di modificare o sostituire questi Termini in " +
-	"qualsiasi momento.\x0a7.2 Se apportiamo modifiche sostanziali, pubbliche" +
-	"remo i Termini aggiornati e indicheremo la data dell'ultima revisione in" +
-	" cima a questo documento.\x0a\x0a<b>8. Legge Applicabile e Risoluzione d" +
-	"elle Controversie</b>\x0a8.1 Questi Termini saranno regolati e interpret" +
-	"ati in conformità con le leggi applicabili nella giurisdizione della sed" +
-	"e principale del fornitore del Servizio, senza riguardo ai principi di c" +
-	"onflitto di leggi.\x0a8.2 Qualsiasi controversia derivante da o relativa" +
-	" a questi Termini sarà risolta attraverso negoziazione amichevole e, se " +
-	"necessario, mediante arbitrato vincolante o contenzioso nei tribunali co" +
-	"mpetenti.\x0a\x0a<b>9. Accettazione dei Termini</b>\x0a9.1 Continuando a" +
-	"d accedere o utilizzare il Servizio, riconosci di aver letto, compreso e" +
-	" accettato di essere vincolato da questi Termini.\x0a9.2 Se non sei d'ac" +
-	"cordo, devi cessare immediatamente l'uso del Servizio.\x0a\x0aSe hai dom" +
-	"ande o dubbi riguardanti questi Termini, o se hai bisogno di ulteriori c" +
-	"hiarimenti, contattaci a <i>k.sysoev@me.com</i>.\x02<b>Comandi di Help M" +
-	"y Pet Bot</b>:\x0a/start - Avvia la conversazione con il bot\x0a/terms -" +
-	" Visualizza i Termini e Condizioni del servizio\x0a/editprofile - Aggior" +
-	"na le informazioni del profilo del tuo animale domestico, come nome, età" +
-	", razza, ecc. Queste informazioni aiutano il bot a fornire consigli più " +
-	"accurati.\x0a/cancel - Annulla il questionario attuale, se ce n'è uno in" +
-	" corso (ad esempio, quando vuoi ricominciare da capo o cambiare la tua d" +
-	"omanda)\x0a/help - Visualizza questo messaggio di aiuto\x02Spiacente, no" +
-	"n posso elaborare video, audio o documenti. Si prega di inviare la tua d" +
-	"omanda solo come testo.\x02Mi scuso, ma il tuo messaggio è troppo lungo " +
-	"per essere elaborato. Per favore, prova a renderlo più breve e conciso." +
-	"\x02Hai raggiunto il numero massimo di richieste per ora. Riprova più ta" +
-	"rdi.\x02Abbiamo raggiunto il nostro limite giornaliero di richieste. Tor" +
-	"na domani quando il nostro budget sarà aggiornato.\x02Si prega di fornir" +
-	"e la tua domanda in formato testuale insieme a foto\x02Si prega di forni" +
-	"re almeno una foto\x02Si prega di non fornire più di %[1]d foto\x02Spiac" +
-	"ente, ho riscontrato un errore durante l'elaborazione della tua richiest" +
-	"a. Riprova più tardi.\x02La data fornita non può essere nel futuro. Si p" +
-	"rega di fornire una data valida.\x02Si prega di fornire una data nel for" +
-	"mato valido AAAA-MM-GG (ad esempio, 2023-12-31)\x02Profilo dell'animale " +
-	"domestico salvato con successo\x02Qual è il nome del tuo animale domesti" +
-	"co?\x02Che tipo di animale domestico hai?\x02cane\x02gatto\x02Quale razz" +
-	"a è il tuo animale domestico?\x02Quando è nato il tuo animale domestico?" +
-	" Si prega di inserire la data nel formato AAAA-MM-GG (ad esempio, 2010-1" +
-	"2-31).\x02Qual è il sesso del tuo animale domestico?\x02maschio\x02femmi" +
-	"na\x02Qual è il peso del tuo animale domestico? Si prega di specificare " +
-	"il peso seguito dall'unità, ad esempio, 5 kg\x02Il tuo animale domestico" +
-	" è stato sterilizzato o castrato?\x02sì\x02no\x02Come descriveresti il l" +
-	"ivello di attività del tuo animale domestico?\x02basso\x02medio\x02alto" +
-	"\x02Il tuo animale domestico ha malattie croniche?\x02Quali sono le pref" +
-	"erenze alimentari o le restrizioni dietetiche del tuo animale domestico?"
+const it_ITData string = "" + // Size: 10367 bytes
+	"\x02Questionario annullato\x02La tua conversazione e i profili dei tuoi " +
+	"animali sono stati rimossi.\x02Mi scuso, ma il tuo messaggio è troppo lu" +
+	"ngo per essere elaborato. Per favore, prova a renderlo più breve e conci" +
+	"so.\x02Hai raggiunto il numero massimo di richieste per ora. Riprova più" +
+	" tardi.\x02Per ora hai esaurito le domande a tua disposizione. Riprova p" +
+	"iù tardi.\x02Abbiamo raggiunto il nostro limite giornaliero di richieste" +
+	". Torna domani quando il nostro budget sarà aggiornato.\x02La tua conver" +
+	"sazione è stata modificata da un altro messaggio mentre elaboravo questo" +
+	". Invialo di nuovo.\x02Spiacente, ho riscontrato un errore durante l'ela" +
+	"borazione della tua richiesta. Riprova più tardi.\x02Comando sconosciuto" +
+	"\x02Benvenuto in Help My Pet Bot! 🐾\x0a\x0aSono il tuo assistente person" +
+	"ale per la cura degli animali domestici, pronto a fornire indicazioni pe" +
+	"r i tuoi amici pelosi. Posso aiutare con:\x0a\x0a- Preoccupazioni per la" +
+	" salute e valutazione dei sintomi\x0a- Domande sul comportamento e tecni" +
+	"che di addestramento\x0a- Raccomandazioni su dieta e nutrizione\x0a- Con" +
+	"sigli generali sulla cura e il benessere degli animali domestici\x0a\x0a" +
+	"Basta digitare la tua domanda o preoccupazione sul tuo animale domestico" +
+	". Puoi anche includere foto per aiutarmi a capire meglio la tua situazio" +
+	"ne.\x0a\x0aRicorda, sebbene offra utili indicazioni basate su una conosc" +
+	"enza veterinaria affidabile, non sono un sostituto della cura veterinari" +
+	"a professionale. Consulta sempre un veterinario per gravi preoccupazioni" +
+	" mediche.\x0a\x0aCon quale domanda sull'animale domestico posso aiutarti" +
+	" oggi?\x02<b>Termini e Condizioni</b>\x0a<i>Ultimo aggiornamento: 30.01." +
+	"2025</i>\x0a\x0aGrazie per aver utilizzato il nostro chatbot di consulen" +
+	"za veterinaria (“il Servizio”). Accedendo o utilizzando questo Servizio," +
+	" accetti di essere vincolato dai seguenti termini e condizioni (“Termini" +
+	"”). Se non accetti questi Termini, interrompi immediatamente l'uso." +
+	"\x0a\x0a<b>1. Natura del Servizio</b>\x0a1.1 Il Servizio fornisce inform" +
+	"azioni generali, orientamenti e suggerimenti per la cura degli animali d" +
+	"omestici, inclusi (ma non limitati a) dieta, comportamento e addestramen" +
+	"to.\x0a1.2 Il Servizio non è un sostituto della diagnosi, trattamento o " +
+	"cura veterinaria professionale. Cerca sempre il parere di un veterinario" +
+	" autorizzato per qualsiasi domanda riguardante la salute del tuo animale" +
+	" domestico.\x0a\x0a<b>2. Nessuna Relazione Veterinario-Cliente-Paziente<" +
+	"/b>\x0a2.1 L'utilizzo del Servizio o l'interazione con il nostro assiste" +
+	"nte AI non crea una relazione veterinario-cliente-paziente.\x0a2.2 Quals" +
+	"iasi consiglio o orientamento fornito dal Servizio si basa su informazio" +
+	"ni limitate e deve essere considerato solo come informazione generale." +
+	"\x0a\x0a<b>3. Limitazione di Responsabilità</b>\x0a3.1 Riconosci e accet" +
+	"ti che l'uso del Servizio è a tuo rischio.\x0a3.2 In nessun caso i propr" +
+	"ietari, sviluppatori o licenziatari del Servizio saranno responsabili pe" +
+	"r danni diretti, indiretti, incidentali, speciali o consequenziali deriv" +
+	"anti da o in connessione con il tuo accesso o utilizzo del Servizio.\x0a" +
+	"3.3 Comprendi che le decisioni riguardanti la cura del tuo animale domes" +
+	"tico e qualsiasi risultato risultante sono di tua esclusiva responsabili" +
+	"tà. Se hai dubbi sul benessere del tuo animale domestico o sulla sua sal" +
+	"ute, dovresti consultare immediatamente un veterinario autorizzato.\x0a" +
+	"\x0a<b>4. Nessuna Garanzia</b>\x0a4.1 Il Servizio è fornito “così com'è”" +
+	", e “come disponibile”, senza garanzie di alcun tipo, espresse o implici" +
+	"te.\x0a4.2 Non garantiamo che il Servizio sarà ininterrotto, privo di er" +
+	"rori, sicuro o privo di virus.\x0a\x0a<b>5. Responsabilità dell'Utente</" +
+	"b>\x0a5.1 Sei responsabile di fornire informazioni accurate e complete s" +
+	"ul tuo animale domestico quando cerchi consigli.\x0a5.2 Devi assicurarti" +
+	" che tutte le domande, descrizioni e dati che fornisci non violino i dir" +
+	"itti di terzi o le leggi locali.\x0a\x0a<b>6. Uso Internazionale</b>\x0a" +
+	"6.1 Il Servizio è destinato all'uso globale. Sei responsabile del rispet" +
+	"to di tutte le leggi e regolamenti locali applicabili nella tua giurisdi" +
+	"zione.\x0a6.2 Non garantiamo che il Servizio o qualsiasi suo contenuto s" +
+	"ia appropriato o consentito in qualsiasi paese o regione specifica.\x0a" +
+	"\x0a<b>7. Modifiche</b>\x0a7.1 Ci riserviamo il diritto di modificare o " +
+	"sostituire questi Termini in qualsiasi momento.\x0a7.2 Se apportiamo mod" +
+	"ifiche sostanziali, pubblicheremo i Termini aggiornati e indicheremo la " +
+	"data dell'ultima revisione in cima a questo documento.\x0a\x0a<b>8. Legg" +
+	"e Applicabile e Risoluzione delle Controversie</b>\x0a8.1 Questi Termini" +
+	" saranno regolati e interpretati in conformità con le leggi applicabili " +
+	"nella giurisdizione della sede principale del fornitore del Servizio, se" +
+	"nza riguardo ai principi di conflitto di leggi.\x0a8.2 Qualsiasi controv" +
+	"ersia derivante da o relativa a questi Termini sarà risolta attraverso n" +
+	"egoziazione amichevole e, se necessario, mediante arbitrato vincolante o" +
+	" contenzioso nei tribunali competenti.\x0a\x0a<b>9. Accettazione dei Ter" +
+	"mini</b>\x0a9.1 Continuando ad accedere o utilizzare il Servizio, ricono" +
+	"sci di aver letto, compreso e accettato di essere vincolato da questi Te" +
+	"rmini.\x0a9.2 Se non sei d'accordo, devi cessare immediatamente l'uso de" +
+	"l Servizio.\x0a\x0aSe hai domande o dubbi riguardanti questi Termini, o " +
+	"se hai bisogno di ulteriori chiarimenti, contattaci a <i>k.sysoev@me.com" +
+	"</i>.\x02<b>Comandi di Help My Pet Bot</b>:\x0a/start - Avvia la convers" +
+	"azione con il bot\x0a/terms - Visualizza i Termini e Condizioni del serv" +
+	"izio\x0a/editprofile - Aggiorna le informazioni del profilo del tuo anim" +
+	"ale domestico, come nome, età, razza, ecc. Queste informazioni aiutano i" +
+	"l bot a fornire consigli più accurati.\x0a/addpet - Aggiungi il profilo " +
+	"di un altro animale, se ne hai più di uno\x0a/pets - Visualizza i tuoi a" +
+	"nimali e quello attualmente selezionato\x0a/switchpet - Scegli l'animale" +
+	" a cui si riferiranno le prossime domande\x0a/removepet - Rimuovi il pro" +
+	"filo di un animale\x0a/weight - Registra il peso attuale del tuo animale" +
+	", ad es. /weight 12.4kg\x0a/weightchart - Visualizza un grafico del peso" +
+	" del tuo animale nel tempo\x0a/vaccines - Visualizza le vaccinazioni e i" +
+	" trattamenti preventivi scaduti dei tuoi animali\x0a/addvaccine - Aggiun" +
+	"gi una vaccinazione o un trattamento preventivo del tuo animale\x0a/remi" +
+	"nd - Imposta un promemoria ricorrente, ad es. /remind give Rimadyl every" +
+	" 12h for 7 days\x0a/reminders - Visualizza i tuoi promemoria ed elimina " +
+	"quelli che non ti servono\x0a/cancel - Annulla il questionario attuale, " +
+	"se ce n'è uno in corso (ad esempio, quando vuoi ricominciare da capo o c" +
+	"ambiare la tua domanda)\x0a/help - Visualizza questo messaggio di aiuto" +
+	"\x02Questa risposta non può più essere valutata.\x02Grazie per il tuo fe" +
+	"edback!\x02Ci dispiace che la risposta non ti abbia aiutato. Cosa non an" +
+	"dava? Rispondi a questo messaggio con un breve commento, oppure ignoralo" +
+	".\x02Cosa non andava?\x02Grazie, il tuo feedback ci aiuta a migliorare l" +
+	"e risposte.\x02Spiacente, non posso elaborare video, audio o documenti. " +
+	"Si prega di inviare la tua domanda solo come testo.\x02I tuoi animali:" +
+	"\x02Usa /switchpet per scegliere l'animale a cui si riferiscono le tue d" +
+	"omande.\x02Di quale animale vuoi chiedere?\x02Non ho trovato nessun anim" +
+	"ale di nome %[1]s. Usa /pets per vedere i tuoi animali.\x02Ora le tue do" +
+	"mande riguardano %[1]s.\x02Quale profilo di animale vuoi rimuovere?\x02I" +
+	"l profilo di %[1]s è stato rimosso.\x02Non hai ancora nessun profilo di " +
+	"animale. Usa /editprofile o /addpet per crearne uno.\x02Si prega di forn" +
+	"ire la tua domanda in formato testuale insieme a foto\x02Si prega di for" +
+	"nire almeno una foto\x02Si prega di non fornire più di %[1]d foto\x02Hai" +
+	" troppi promemoria. Usa /reminders per eliminare quelli che non ti servo" +
+	"no.\x02I promemoria non sono disponibili al momento.\x02Promemoria impos" +
+	"tato: %[1]s, %[2]s.\x0aProssimo promemoria: %[3]s\x02Promemoria: %[1]s" +
+	"\x02Fatto\x02Posticipa di 1 h\x02Questo promemoria non esiste più.\x02Se" +
+	"gnato come fatto\x02Te lo ricorderò di nuovo tra un'ora\x02Promemoria el" +
+	"iminato\x02Non hai promemoria. Usa /remind per crearne uno, ad es. /remi" +
+	"nd give Rimadyl every 12h for 7 days\x02I tuoi promemoria:\x02Prossimo: " +
+	"%[1]s\x02Dimmi cosa devo ricordarti e con quale frequenza, ad esempio:" +
+	"\x0a/remind give Rimadyl every 12h for 7 days\x0a/remind flea treatment " +
+	"monthly\x0a/remind brush teeth twice a day\x02🚨 EMERGENZA: il tuo animal" +
+	"e potrebbe aver bisogno di cure veterinarie immediate. Contatta subito i" +
+	"l tuo veterinario o la clinica di emergenza più vicina.\x02⚠️ Ti consigl" +
+	"iamo una visita dal veterinario entro uno o due giorni.\x02🏥 Trova un ve" +
+	"terinario di emergenza nelle vicinanze\x02%[1]s era in scadenza il %[2]s" +
+	"\x02Nessuna vaccinazione o trattamento preventivo è scaduto. Usa /addvac" +
+	"cine per aggiungere un nuovo record.\x02Vaccinazioni e trattamenti preve" +
+	"ntivi scaduti:\x02Contatta il tuo veterinario per programmarli, poi usa " +
+	"/addvaccine per registrarli.\x02Peso di %[1]s registrato: %[2]s.\x02Usa " +
+	"/weightchart per vedere come cambia nel tempo.\x02Non ci sono ancora pes" +
+	"i registrati per %[1]s. Usa /weight per aggiungerne uno, ad es. /weight " +
+	"12.4kg\x02Storico del peso di %[1]s\x02Invia il peso con la sua unità, a" +
+	"d es. /weight 12.4kg o /weight 9 lbs\x02Profilo dell'animale domestico s" +
+	"alvato con successo\x02La data fornita non può essere nel futuro. Si pre" +
+	"ga di fornire una data valida.\x02Si prega di fornire una data nel forma" +
+	"to valido AAAA-MM-GG (ad esempio, 2023-12-31)\x02Aggiunta di una vaccina" +
+	"zione o di un trattamento preventivo per %[1]s.\x02%[1]s non è più tra i" +
+	" tuoi animali, quindi il record non è stato salvato.\x02Record di %[1]s " +
+	"salvato per %[2]s\x02Qual è il nome del tuo animale domestico?\x02Che ti" +
+	"po di animale domestico hai?\x02cane\x02gatto\x02Quale razza è il tuo an" +
+	"imale domestico?\x02Quando è nato il tuo animale domestico? Si prega di " +
+	"inserire la data nel formato AAAA-MM-GG (ad esempio, 2010-12-31).\x02Qua" +
+	"l è il sesso del tuo animale domestico?\x02maschio\x02femmina\x02Qual è " +
+	"il peso del tuo animale domestico? Si prega di specificare il peso segui" +
+	"to dall'unità, ad esempio, 5 kg\x02Il tuo animale domestico è stato ster" +
+	"ilizzato o castrato?\x02sì\x02no\x02Come descriveresti il livello di att" +
+	"ività del tuo animale domestico?\x02basso\x02medio\x02alto\x02Il tuo ani" +
+	"male domestico ha malattie croniche?\x02Quali sono le preferenze aliment" +
+	"ari o le restrizioni dietetiche del tuo animale domestico?\x02salta\x02Q" +
+	"uale vaccino o trattamento preventivo è stato somministrato (ad es. rabb" +
+	"ia, sverminazione, antipulci)?\x02Quando è stato somministrato? Inserisc" +
+	"i la data nel formato AAAA-MM-GG (ad es. 2024-05-31).\x02Quando è previs" +
+	"ta la prossima dose? Inserisci la data nel formato AAAA-MM-GG, oppure sa" +
+	"lta se non lo sai.\x02Quale clinica l'ha somministrato?"
 
-var ko_KRIndex = []uint32{ // 36 elements
+var ko_KRIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000020, 0x00000036, 0x000003a0,
-	0x0000132c, 0x0000151f, 0x000015a1, 0x000015fd,
-	0x00001659, 0x000016b5, 0x000016fb, 0x0000172e,
-	0x0000175f, 0x000017c6, 0x0000181f, 0x00001871,
-	0x000018b1, 0x000018dc, 0x00001915, 0x00001919,
-	0x00001923, 0x0000194e, 0x000019cb, 0x000019f6,
-	0x000019fd, 0x00001a04, 0x00001a72, 0x00001a99,
-	0x00001a9d, 0x00001aa7, 0x00001ae9, 0x00001af0,
+	0x00000000, 0x00000020, 0x00000062, 0x000000be,
+	0x0000011a, 0x00000188, 0x000001e4, 0x00000257,
+	0x000002be, 0x000002d4, 0x0000063e, 0x000015ca,
+	0x00001af3, 0x00001b28, 0x00001b4d, 0x00001bf5,
+	0x00001c10, 0x00001c68, 0x00001cea, 0x00001cfc,
+	0x00001d3f, 0x00001d74, 0x00001de9, 0x00001e11,
+	0x00001e49, 0x00001e76, 0x00001ee9, 0x00001f2f,
+	0x00001f62, 0x00001f93, 0x00001ff3, 0x00002023,
 	// Entry 20 - 3F
-	0x00001af7, 0x00001afe, 0x00001b37, 0x00001b88,
-} // Size: 168 bytes
+	0x00002067, 0x00002075, 0x0000207c, 0x00002096,
+	0x000020ca, 0x000020e7, 0x00002111, 0x00002131,
+	0x000021a5, 0x000021b1, 0x000021bf, 0x0000226b,
+	0x00002319, 0x00002369, 0x00002393, 0x000023aa,
+	0x00002425, 0x00002456, 0x000024af, 0x000024e0,
+	0x00002526, 0x0000258f, 0x000025a6, 0x000025fc,
+	0x0000263c, 0x00002695, 0x000026e7, 0x0000272d,
+	0x0000278c, 0x000027bb, 0x000027e6, 0x0000281f,
+	// Entry 40 - 5F
+	0x00002823, 0x0000282d, 0x00002858, 0x000028d5,
+	0x00002900, 0x00002907, 0x0000290e, 0x0000297c,
+	0x000029a3, 0x000029a7, 0x000029b1, 0x000029f3,
+	0x000029fa, 0x00002a01, 0x00002a08, 0x00002a41,
+	0x00002a92, 0x00002a9f, 0x00002b00, 0x00002b5a,
+	0x00002bd7, 0x00002bf9,
+} // Size: 368 bytes
 
-const ko_KRData string = "" + // Size: 7048 bytes
-	"\x02질문이 취소되었습니다\x02알 수 없는 명령\x02Help My Pet Bot에 오신 것을 환영합니다! 🐾\x0a\x0a저" +
-	"는 당신의 개를 위한 개인적인 반려동물 돌보미로, 당신의 털친구에 대한 지침을 제공할 준비가 되어 있습니다. 다음과 같은 사항" +
-	"에 대해 도와드릴 수 있습니다:\x0a\x0a- 건강 관련 문제 및 증상 평가\x0a- 행동 문제 및 훈련 기술\x0a- 식이" +
-	" 및 영양 권장사항\x0a- 일반적인 반려동물 돌보미 및 웰빙 조언\x0a\x0a반려동물에 대한 궁금증이나 질문을 입력하세요. 상" +
-	"황을 더 잘 이해하기 위해 사진을 첨부할 수도 있습니다.\x0a\x0a신뢰할 수 있는 수의학적 지식을 바탕으로 유용한 지침을 " +
-	"제공하지만, 전문적인 수의사 치료의 대체가 아닙니다. 심각한 의료 문제에 대해서는 항상 수의사와 상담하십시오.\x0a\x0a오" +
-	"늘 어떤 반려동물 질문을 도와드릴까요?\x02<b>이용 약관</b>\x0a<i>마지막 업데이트: 2025.01.30</i>" +
-	"\x0a\x0a저희 수의학 조언 챗봇(“서비스”)을 이용해 주셔서 감사합니다. 이 서비스를 이용하거나 접근함으로써, 귀하는 다음 " +
-	"이용 약관(“약관”)에 동의하는 것으로 간주됩니다. 이 약관에 동의하지 않으시면 즉시 이용을 중단해 주십시오.\x0a\x0a<" +
-	"b>1. 서비스의 성격</b>\x0a1.1 이 서비스는 반려동물 관리에 대한 일반 정보, 지침 및 제안을 제공합니다. 여기에는 (" +
-	"제한되지 않음) 식단, 행동 및 훈련이 포함됩니다.\x0a1.2 이 서비스는 전문 수의학 진단, 치료 또는 관리를 대체하지 않" +
-	"습니다. 반려동물의 건강에 관한 질문이 있을 경우 항상 면허가 있는 수의사의 조언을 구하십시오.\x0a\x0a<b>2. 수의사" +
-	"-고객-환자 관계 없음</b>\x0a2.1 이 서비스를 이용하거나 AI 도우미와 상호작용한다고 해서 수의사-고객-환자 관계가 형성" +
-	"되는 것은 아닙니다.\x0a2.2 이 서비스에서 제공하는 모든 조언이나 지침은 제한된 정보에 기반한 것이며 일반 정보로만 간주" +
-	"되어야 합니다.\x0a\x0a<b>3. 책임의 제한</b>\x0a3.1 귀하는 이 서비스를 이용하는 것이 전적으로 귀하의 책임" +
-	"임을 인정하고 동의합니다.\x0a3.2 서비스의 소유자, 개발자 또는 라이선스 제공자는 서비스에 대한 접근 또는 이용과 관련하" +
-	"여 발생하는 직접적, 간접적, 부수적, 특별 또는 결과적 손해에 대해 어떠한 경우에도 책임을 지지 않습니다.\x0a3.3 귀하" +
-	"는 반려동물 관리에 대한 결정과 그로 인한 결과가 전적으로 귀하의 책임임을 이해합니다. 반려동물의 건강이나 안녕에 대해 의심이" +
-	" 있을 경우 즉시 면허가 있는 수의사와 상담해야 합니다.\x0a\x0a<b>4. 보증 없음</b>\x0a4.1 이 서비스는 명시적" +
-	"이든 묵시적이든 어떠한 종류의 보증 없이 “있는 그대로” 및 “이용 가능한 상태로” 제공됩니다.\x0a4.2 우리는 이 서비스" +
-	"가 중단되지 않거나, 오류가 없거나, 안전하거나, 바이러스가 없음을 보증하지 않습니다.\x0a\x0a<b>5. 사용자 책임</" +
-	"b>\x0a5.1 귀하는 조언을 구할 때 반려동물에 대한 정확하고 완전한 정보를 제공할 책임이 있습니다.\x0a5.2 귀하는 제공" +
-	"하는 모든 질문, 설명 및 데이터가 제3자의 권리나 현지 법률을 위반하지 않도록 해야 합니다.\x0a\x0a<b>6. 국제적 " +
-	"사용</b>\x0a6.1 이 서비스는 전 세계적으로 사용하기 위한 것입니다. 귀하는 귀하의 관할 구역에서 적용되는 모든 현지 " +
-	"법률 및 규정을 준수할 책임이 있습니다.\x0a6.2 우리는 이 서비스나 그 어떤 콘텐츠가 특정 국가나 지역에서 적절하거나 허" +
-	"용된다는 것을 보증하지 않습니다.\x0a\x0a<b>7. 수정</b>\x0a7.1 우리는 언제든지 이 약관을 수정하거나 대체할" +
-	" 권리를 보유합니다.\x0a7.2 중요한 변경 사항이 있을 경우, 업데이트된 약관을 게시하고 이 문서 상단에 최신 개정 날짜를 표" +
-	"시할 것입니다.\x0a\x0a<b>8. 준거법 및 분쟁 해결</b>\x0a8.1 이 약관은 서비스 제공자의 주요 사업장 관할 " +
-	"구역에서 적용되는 법률에 따라 규율되고 해석됩니다. 법률 충돌 원칙은 적용되지 않습니다.\x0a8.2 이 약관에서 발생하거나 " +
-	"이와 관련된 모든 분쟁은 우호적인 협상을 통해 해결되며, 필요시 구속력 있는 중재 또는 해당 법원에서의 소송을 통해 해결됩니다" +
-	".\x0a\x0a<b>9. 약관의 수락</b>\x0a9.1 서비스를 계속 이용하거나 접근함으로써, 귀하는 이 약관을 읽고 이해하였" +
-	"으며 이에 구속되는 것에 동의함을 인정합니다.\x0a9.2 동의하지 않으시면 즉시 서비스를 이용을 중단해야 합니다.\x0a" +
-	"\x0a이 약관에 관한 질문이나 우려 사항이 있거나 추가 설명이 필요하시면 <i>k.sysoev@me.com</i>으로 연락해 주" +
-	"십시오.\x02<b>Help My Pet Bot 명령어</b>:\x0a/start - 봇과 대화를 시작합니다\x0a/terms" +
-	" - 서비스의 이용 약관을 확인합니다\x0a/editprofile - 애완동물의 프로필 정보(이름, 나이, 품종 등)를 업데이트합" +
-	"니다. 이 정보는 봇이 더 정확한 조언을 제공하는 데 도움이 됩니다.\x0a/cancel - 진행 중인 현재 설문을 취소합니다" +
-	"(예: 처음부터 다시 시작하거나 질문을 변경하려는 경우)\x0a/help - 이 도움말 메시지를 확인합니다\x02죄송합니다만, 비" +
-	"디오, 오디오 또는 문서를 처리할 수 없습니다. 질문을 텍스트로만 보내 주세요.\x02죄송합니다만, 메시지가 너무 깁니다. 짧" +
-	"고 간결하게 작성해 주세요.\x02시간당 요청 횟수 제한에 도달했습니다. 나중에 다시 시도해 주세요.\x02일일 요청 한도에 " +
-	"도달했습니다. 예산이 갱신되는 내일 다시 오세요.\x02텍스트 형식으로 질문과 함께 사진을 제공해 주세요\x02최소한 한 장의" +
-	" 사진을 제공해 주세요\x02사진을 %[1]d장 이하로 제공해 주세요\x02죄송합니다. 요청 처리 중 오류가 발생했습니다. 나중에" +
-	" 다시 시도해 주세요.\x02제공된 날짜는 미래일 수 없습니다. 유효한 날짜를 제공해 주세요.\x02유효한 형식인 YYYY-MM-" +
-	"DD(예: 2023-12-31)로 날짜를 제공해 주세요.\x02애완동물 프로필이 성공적으로 저장되었습니다\x02애완동물의 이름은 " +
-	"무엇입니까?\x02어떤 종류의 애완동물을 가지고 계십니까?\x02개\x02고양이\x02애완동물의 품종은 무엇입니까?\x02애완" +
-	"동물이 태어난 날짜는 언제입니까? YYYY-MM-DD(예: 2010-12-31) 형식으로 날짜를 입력해 주세요.\x02애완동물" +
-	"의 성별은 무엇입니까?\x02수컷\x02암컷\x02애완동물의 몸무게는 얼마입니까? 몸무게를 지정하고 단위를 붙여 주세요. 예:" +
-	" 5 kg\x02애완동물을 중성화했습니까?\x02예\x02아니요\x02애완동물의 활동 수준을 어떻게 설명하겠습니까?\x02낮음" +
-	"\x02중간\x02높음\x02애완동물이 만성 질병을 가지고 있습니까?\x02애완동물의 음식 선호도 또는 식이 제한 사항은 무엇입니" +
-	"까?"
+const ko_KRData string = "" + // Size: 11257 bytes
+	"\x02질문이 취소되었습니다\x02대화 내용과 반려동물 프로필이 삭제되었습니다.\x02죄송합니다만, 메시지가 너무 깁니다. 짧고 " +
+	"간결하게 작성해 주세요.\x02시간당 요청 횟수 제한에 도달했습니다. 나중에 다시 시도해 주세요.\x02현재 사용할 수 있는 " +
+	"질문 한도를 모두 사용했습니다. 나중에 다시 시도해 주세요.\x02일일 요청 한도에 도달했습니다. 예산이 갱신되는 내일 다시 " +
+	"오세요.\x02이 메시지를 처리하는 동안 다른 메시지로 대화가 변경되었습니다. 다시 보내 주세요.\x02죄송합니다. 요청 처리" +
+	" 중 오류가 발생했습니다. 나중에 다시 시도해 주세요.\x02알 수 없는 명령\x02Help My Pet Bot에 오신 것을 환영" +
+	"합니다! 🐾\x0a\x0a저는 당신의 개를 위한 개인적인 반려동물 돌보미로, 당신의 털친구에 대한 지침을 제공할 준비가 되어 " +
+	"있습니다. 다음과 같은 사항에 대해 도와드릴 수 있습니다:\x0a\x0a- 건강 관련 문제 및 증상 평가\x0a- 행동 문제 " +
+	"및 훈련 기술\x0a- 식이 및 영양 권장사항\x0a- 일반적인 반려동물 돌보미 및 웰빙 조언\x0a\x0a반려동물에 대한 궁" +
+	"금증이나 질문을 입력하세요. 상황을 더 잘 이해하기 위해 사진을 첨부할 수도 있습니다.\x0a\x0a신뢰할 수 있는 수의학적 " +
+	"지식을 바탕으로 유용한 지침을 제공하지만, 전문적인 수의사 치료의 대체가 아닙니다. 심각한 의료 문제에 대해서는 항상 수의사와" +
+	" 상담하십시오.\x0a\x0a오늘 어떤 반려동물 질문을 도와드릴까요?\x02<b>이용 약관</b>\x0a<i>마지막 업데이트: 2" +
+	"025.01.30</i>\x0a\x0a저희 수의학 조언 챗봇(“서비스”)을 이용해 주셔서 감사합니다. 이 서비스를 이용하거나 접근" +
+	"함으로써, 귀하는 다음 이용 약관(“약관”)에 동의하는 것으로 간주됩니다. 이 약관에 동의하지 않으시면 즉시 이용을 중단해 주" +
+	"십시오.\x0a\x0a<b>1. 서비스의 성격</b>\x0a1.1 이 서비스는 반려동물 관리에 대한 일반 정보, 지침 및 제안" +
+	"을 제공합니다. 여기에는 (제한되지 않음) 식단, 행동 및 훈련이 포함됩니다.\x0a1.2 이 서비스는 전문 수의학 진단, 치" +
+	"료 또는 관리를 대체하지 않습니다. 반려동물의 건강에 관한 질문이 있을 경우 항상 면허가 있는 수의사의 조언을 구하십시오." +
+	"\x0a\x0a<b>2. 수의사-고객-환자 관계 없음</b>\x0a2.1 이 서비스를 이용하거나 AI 도우미와 상호작용한다고 해서" +
+	" 수의사-고객-환자 관계가 형성되는 것은 아닙니다.\x0a2.2 이 서비스에서 제공하는 모든 조언이나 지침은 제한된 정보에 기반한" +
+	" 것이며 일반 정보로만 간주되어야 합니다.\x0a\x0a<b>3. 책임의 제한</b>\x0a3.1 귀하는 이 서비스를 이용하는 것" +
+	"이 전적으로 귀하의 책임임을 인정하고 동의합니다.\x0a3.2 서비스의 소유자, 개발자 또는 라이선스 제공자는 서비스에 대한 " +
+	"접근 또는 이용과 관련하여 발생하는 직접적, 간접적, 부수적, 특별 또는 결과적 손해에 대해 어떠한 경우에도 책임을 지지 않습" +
+	"니다.\x0a3.3 귀하는 반려동물 관리에 대한 결정과 그로 인한 결과가 전적으로 귀하의 책임임을 이해합니다. 반려동물의 건강" +
+	"이나 안녕에 대해 의심이 있을 경우 즉시 면허가 있는 수의사와 상담해야 합니다.\x0a\x0a<b>4. 보증 없음</b>" +
+	"\x0a4.1 이 서비스는 명시적이든 묵시적이든 어떠한 종류의 보증 없이 “있는 그대로” 및 “이용 가능한 상태로” 제공됩니다." +
+	"\x0a4.2 우리는 이 서비스가 중단되지 않거나, 오류가 없거나, 안전하거나, 바이러스가 없음을 보증하지 않습니다.\x0a" +
+	"\x0a<b>5. 사용자 책임</b>\x0a5.1 귀하는 조언을 구할 때 반려동물에 대한 정확하고 완전한 정보를 제공할 책임이 있" +
+	"습니다.\x0a5.2 귀하는 제공하는 모든 질문, 설명 및 데이터가 제3자의 권리나 현지 법률을 위반하지 않도록 해야 합니다." +
+	"\x0a\x0a<b>6. 국제적 사용</b>\x0a6.1 이 서비스는 전 세계적으로 사용하기 위한 것입니다. 귀하는 귀하의 관할 " +
+	"구역에서 적용되는 모든 현지 법률 및 규정을 준수할 책임이 있습니다.\x0a6.2 우리는 이 서비스나 그 어떤 콘텐츠가 특정 " +
+	"국가나 지역에서 적절하거나 허용된다는 것을 보증하지 않습니다.\x0a\x0a<b>7. 수정</b>\x0a7.1 우리는 언제든지" +
+	" 이 약관을 수정하거나 대체할 권리를 보유합니다.\x0a7.2 중요한 변경 사항이 있을 경우, 업데이트된 약관을 게시하고 이 문서" +
+	" 상단에 최신 개정 날짜를 표시할 것입니다.\x0a\x0a<b>8. 준거법 및 분쟁 해결</b>\x0a8.1 이 약관은 서비스 제" +
+	"공자의 주요 사업장 관할 구역에서 적용되는 법률에 따라 규율되고 해석됩니다. 법률 충돌 원칙은 적용되지 않습니다.\x0a8.2" +
+	" 이 약관에서 발생하거나 이와 관련된 모든 분쟁은 우호적인 협상을 통해 해결되며, 필요시 구속력 있는 중재 또는 해당 법원에서의 " +
+	"소송을 통해 해결됩니다.\x0a\x0a<b>9. 약관의 수락</b>\x0a9.1 서비스를 계속 이용하거나 접근함으로써, 귀하는" +
+	" 이 약관을 읽고 이해하였으며 이에 구속되는 것에 동의함을 인정합니다.\x0a9.2 동의하지 않으시면 즉시 서비스를 이용을 중단해" +
+	"야 합니다.\x0a\x0a이 약관에 관한 질문이나 우려 사항이 있거나 추가 설명이 필요하시면 <i>k.sysoev@me.com" +
+	"</i>으로 연락해 주십시오.\x02<b>Help My Pet Bot 명령어</b>:\x0a/start - 봇과 대화를 시작합니다" +
+	"\x0a/terms - 서비스의 이용 약관을 확인합니다\x0a/editprofile - 애완동물의 프로필 정보(이름, 나이, 품종" +
+	" 등)를 업데이트합니다. 이 정보는 봇이 더 정확한 조언을 제공하는 데 도움이 됩니다.\x0a/addpet - 반려동물이 여러 마" +
+	"리라면 다른 반려동물의 프로필을 추가합니다\x0a/pets - 반려동물 목록과 현재 선택된 반려동물을 확인합니다\x0a/swi" +
+	"tchpet - 다음 질문의 대상이 될 반려동물을 선택합니다\x0a/removepet - 반려동물 프로필을 삭제합니다\x0a/we" +
+	"ight - 반려동물의 현재 체중을 기록합니다. 예: /weight 12.4kg\x0a/weightchart - 반려동물의 체중 " +
+	"변화 그래프를 확인합니다\x0a/vaccines - 반려동물의 기한이 지난 예방접종 및 예방 치료를 확인합니다\x0a/addv" +
+	"accine - 반려동물의 예방접종 또는 예방 치료 기록을 추가합니다\x0a/remind - 반복 알림을 설정합니다. 예: /re" +
+	"mind give Rimadyl every 12h for 7 days\x0a/reminders - 알림 목록을 확인하고 필요 없는" +
+	" 알림을 삭제합니다\x0a/cancel - 진행 중인 현재 설문을 취소합니다(예: 처음부터 다시 시작하거나 질문을 변경하려는 경우" +
+	")\x0a/help - 이 도움말 메시지를 확인합니다\x02이 답변은 더 이상 평가할 수 없습니다.\x02의견을 주셔서 감사합니다" +
+	"!\x02답변이 도움이 되지 않아 죄송합니다. 무엇이 문제였나요? 이 메시지에 짧은 의견으로 답장하시거나 그냥 무시하셔도 됩니다." +
+	"\x02무엇이 문제였나요?\x02감사합니다. 보내주신 의견은 답변을 개선하는 데 도움이 됩니다.\x02죄송합니다만, 비디오, 오디" +
+	"오 또는 문서를 처리할 수 없습니다. 질문을 텍스트로만 보내 주세요.\x02내 반려동물:\x02/switchpet 명령으로 질" +
+	"문할 반려동물을 선택하세요.\x02어떤 반려동물에 대해 질문하시겠어요?\x02%[1]s(이)라는 반려동물을 찾을 수 없습니다." +
+	" /pets 명령으로 반려동물 목록을 확인하세요.\x02이제 %[1]s에 대해 질문합니다.\x02어떤 반려동물 프로필을 삭제하시겠" +
+	"어요?\x02%[1]s의 프로필이 삭제되었습니다.\x02아직 반려동물 프로필이 없습니다. /editprofile 또는 /add" +
+	"pet 명령으로 프로필을 만드세요.\x02텍스트 형식으로 질문과 함께 사진을 제공해 주세요\x02최소한 한 장의 사진을 제공해 주" +
+	"세요\x02사진을 %[1]d장 이하로 제공해 주세요\x02알림이 너무 많습니다. /reminders 명령으로 필요 없는 알림을" +
+	" 삭제하세요.\x02지금은 알림을 사용할 수 없습니다.\x02알림이 설정되었습니다: %[1]s, %[2]s.\x0a다음 알림: %" +
+	"[3]s\x02알림: %[1]s\x02완료\x021시간 후 다시 알림\x02이 알림은 더 이상 존재하지 않습니다.\x02완료로 표" +
+	"시했습니다\x021시간 후에 다시 알려 드릴게요\x02알림이 삭제되었습니다\x02알림이 없습니다. /remind 명령으로 알림" +
+	"을 만드세요. 예: /remind give Rimadyl every 12h for 7 days\x02내 알림:\x02다음: %" +
+	"[1]s\x02무엇을 얼마나 자주 알려 드릴지 알려 주세요. 예:\x0a/remind give Rimadyl every 12h f" +
+	"or 7 days\x0a/remind flea treatment monthly\x0a/remind brush teeth twice" +
+	" a day\x02🚨 응급: 반려동물에게 즉시 수의사의 치료가 필요할 수 있습니다. 지금 바로 담당 수의사나 가까운 응급 동물병원" +
+	"에 연락하세요.\x02⚠️ 하루나 이틀 안에 수의사를 방문하시기를 권장합니다.\x02🏥 가까운 응급 동물병원 찾기\x02%[1" +
+	"]s: 예정일 %[2]s\x02기한이 지난 예방접종이나 예방 치료가 없습니다. /addvaccine 명령으로 새 기록을 추가하세요" +
+	".\x02기한이 지난 예방접종 및 예방 치료:\x02수의사에게 연락해 일정을 잡은 후 /addvaccine 명령으로 기록하세요." +
+	"\x02%[1]s의 체중이 기록되었습니다: %[2]s.\x02/weightchart 명령으로 시간에 따른 변화를 확인하세요." +
+	"\x02%[1]s의 체중 기록이 아직 없습니다. /weight 명령으로 추가하세요. 예: /weight 12.4kg\x02%[1]" +
+	"s의 체중 기록\x02체중을 단위와 함께 보내 주세요. 예: /weight 12.4kg 또는 /weight 9 lbs\x02애완동" +
+	"물 프로필이 성공적으로 저장되었습니다\x02제공된 날짜는 미래일 수 없습니다. 유효한 날짜를 제공해 주세요.\x02유효한 형식" +
+	"인 YYYY-MM-DD(예: 2023-12-31)로 날짜를 제공해 주세요.\x02%[1]s의 예방접종 또는 예방 치료 기록을 " +
+	"추가합니다.\x02%[1]s(이)가 더 이상 반려동물 목록에 없어 기록이 저장되지 않았습니다.\x02%[2]s의 %[1]s 기" +
+	"록이 저장되었습니다\x02애완동물의 이름은 무엇입니까?\x02어떤 종류의 애완동물을 가지고 계십니까?\x02개\x02고양이" +
+	"\x02애완동물의 품종은 무엇입니까?\x02애완동물이 태어난 날짜는 언제입니까? YYYY-MM-DD(예: 2010-12-31) 형" +
+	"식으로 날짜를 입력해 주세요.\x02애완동물의 성별은 무엇입니까?\x02수컷\x02암컷\x02애완동물의 몸무게는 얼마입니까? " +
+	"몸무게를 지정하고 단위를 붙여 주세요. 예: 5 kg\x02애완동물을 중성화했습니까?\x02예\x02아니요\x02애완동물의 활" +
+	"동 수준을 어떻게 설명하겠습니까?\x02낮음\x02중간\x02높음\x02애완동물이 만성 질병을 가지고 있습니까?\x02애완동물" +
+	"의 음식 선호도 또는 식이 제한 사항은 무엇입니까?\x02건너뛰기\x02어떤 예방접종이나 예방 치료를 받았나요? (예: 광견병" +
+	", 구충, 벼룩 치료)\x02언제 받았나요? 날짜를 YYYY-MM-DD 형식으로 입력하세요 (예: 2024-05-31).\x02다" +
+	"음 접종 예정일은 언제인가요? 날짜를 YYYY-MM-DD 형식으로 입력하거나, 모르시면 건너뛰세요.\x02어느 병원에서 받았나" +
+	"요?"
 
-var ms_MYIndex = []uint32{ // 36 elements
+var ms_MYIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000018, 0x00000030, 0x00000390,
-	0x000012f3, 0x000014b4, 0x0000151e, 0x00001593,
-	0x000015e4, 0x00001645, 0x00001686, 0x000016b2,
-	0x000016e1, 0x00001734, 0x00001785, 0x000017d2,
-	0x000017fc, 0x00001820, 0x0000184e, 0x00001855,
-	0x0000185c, 0x00001882, 0x000018f0, 0x00001917,
-	0x0000191e, 0x00001928, 0x00001989, 0x000019ba,
-	0x000019bd, 0x000019c3, 0x00001a0c, 0x00001a13,
+	0x00000000, 0x00000018, 0x00000056, 0x000000cb,
+	0x0000011c, 0x00000171, 0x000001d2, 0x00000233,
+	0x00000286, 0x0000029e, 0x000005fe, 0x00001561,
+	0x00001a26, 0x00001a4c, 0x00001a71, 0x00001ae7,
+	0x00001afa, 0x00001b3f, 0x00001ba9, 0x00001bc1,
+	0x00001c08, 0x00001c39, 0x00001ca1, 0x00001cc2,
+	0x00001cfa, 0x00001d19, 0x00001d7d, 0x00001dbe,
+	0x00001dea, 0x00001e19, 0x00001e7e, 0x00001ea7,
 	// Entry 20 - 3F
-	0x00001a1d, 0x00001a24, 0x00001a66, 0x00001aa7,
-} // Size: 168 bytes
+	0x00001ee9, 0x00001efb, 0x00001f03, 0x00001f0f,
+	0x00001f30, 0x00001f4b, 0x00001f7d, 0x00001f93,
+	0x00002005, 0x00002016, 0x00002028, 0x000020da,
+	0x00002172, 0x000021be, 0x000021eb, 0x00002207,
+	0x00002271, 0x000022a3, 0x0000230c, 0x0000232b,
+	0x00002372, 0x000023cc, 0x000023e0, 0x0000242a,
+	0x00002454, 0x000024a5, 0x000024f2, 0x00002530,
+	0x00002582, 0x000025a3, 0x000025c7, 0x000025f5,
+	// Entry 40 - 5F
+	0x000025fc, 0x00002603, 0x00002629, 0x00002697,
+	0x000026be, 0x000026c5, 0x000026cf, 0x00002730,
+	0x00002761, 0x00002764, 0x0000276a, 0x000027b3,
+	0x000027ba, 0x000027c4, 0x000027cb, 0x0000280d,
+	0x0000284e, 0x00002856, 0x000028b4, 0x0000290a,
+	0x00002973, 0x00002996,
+} // Size: 368 bytes
 
-const ms_MYData string = "" + // Size: 6823 bytes
-	"\x02Soal selidik dibatalkan\x02Perintah tidak dikenali\x02Selamat datang" +
-	" ke Help My Pet Bot! 🐾\x0a\x0aSaya adalah pembantu penjagaan haiwan kesa" +
-	"yangan peribadi anda, bersedia untuk memberikan panduan untuk rakan berb" +
-	"ulu anda. Saya boleh membantu dengan:\x0a\x0a- Kebimbangan kesihatan dan" +
-	" penilaian gejala\x0a- Soalan tingkah laku dan teknik latihan\x0a- Cadan" +
-	"gan diet dan pemakanan\x0a- Nasihat penjagaan haiwan kesayangan dan kece" +
-	"rgasan\x0a\x0aHanya taip soalan atau kebimbangan anda tentang haiwan kes" +
-	"ayangan anda. Anda juga boleh menyertakan gambar untuk membantu saya mem" +
-	"ahami situasi anda dengan lebih baik.\x0a\x0aIngat, walaupun saya menawa" +
-	"rkan panduan yang membantu berdasarkan pengetahuan veterinar yang boleh " +
-	"dipercayai, saya bukan pengganti penjagaan veterinar profesional. Sentia" +
-	"sa berunding dengan seorang doktor haiwan untuk kebimbangan perubatan ya" +
-	"ng serius.\x0a\x0aDengan soalan haiwan kesayangan apa yang saya boleh me" +
-	"mbantu anda hari ini?\x02<b>Terma dan Syarat</b>\x0a<i>Dikemaskini terak" +
-	"hir: 30.01.2025</i>\x0a\x0aTerima kasih kerana menggunakan chatbot nasih" +
-	"at veterinar kami (“Perkhidmatan”). Dengan mengakses atau menggunakan Pe" +
-	"rkhidmatan ini, anda bersetuju untuk terikat dengan terma dan syarat ber" +
-	"ikut (“Terma”). Jika anda tidak bersetuju dengan Terma ini, sila hentika" +
-	"n penggunaan dengan segera.\x0a\x0a<b>1. Sifat Perkhidmatan</b>\x0a1.1 P" +
-	"erkhidmatan menyediakan maklumat umum, panduan, dan cadangan untuk penja" +
-	"gaan haiwan peliharaan, termasuk (tetapi tidak terhad kepada) diet, ting" +
-	"kah laku, dan latihan.\x0a1.2 Perkhidmatan ini bukan pengganti untuk dia" +
-	"gnosis, rawatan, atau penjagaan veterinar profesional. Sentiasa dapatkan" +
-	" nasihat daripada doktor haiwan berlesen untuk sebarang soalan mengenai " +
-	"kesihatan haiwan peliharaan anda.\x0a\x0a<b>2. Tiada Hubungan Veterinar-" +
-	"Pelanggan-Pesakit</b>\x0a2.1 Menggunakan Perkhidmatan atau berinteraksi " +
-	"dengan pembantu AI kami tidak mewujudkan hubungan veterinar-pelanggan-pe" +
-	"sakit.\x0a2.2 Sebarang nasihat atau panduan yang diberikan oleh Perkhidm" +
-	"atan adalah berdasarkan maklumat terhad dan hanya boleh dianggap sebagai" +
-	" maklumat umum.\x0a\x0a<b>3. Had Tanggungjawab</b>\x0a3.1 Anda mengakui " +
-	"dan bersetuju bahawa penggunaan Perkhidmatan adalah atas risiko anda sen" +
-	"diri.\x0a3.2 Dalam apa jua keadaan, pemilik, pembangun, atau pemberi les" +
-	"en Perkhidmatan tidak akan bertanggungjawab atas sebarang kerosakan lang" +
-	"sung, tidak langsung, sampingan, khas, atau berbangkit yang timbul darip" +
-	"ada atau berkaitan dengan akses atau penggunaan Perkhidmatan anda.\x0a3." +
-	"3 Anda memahami bahawa keputusan mengenai penjagaan haiwan peliharaan an" +
-	"da dan sebarang hasil yang terhasil adalah tanggungjawab anda sendiri. J" +
-	"ika anda mempunyai sebarang keraguan tentang kesejahteraan atau kesihata" +
-	"n haiwan peliharaan anda, anda harus segera berunding dengan doktor haiw" +
-	"an berlesen.\x0a\x0a<b>4. Tiada Jaminan</b>\x0a4.1 Perkhidmatan disediak" +
-	"an atas dasar “seadanya” dan “sebagaimana tersedia” tanpa sebarang jamin" +
-	"an, sama ada nyata atau tersirat.\x0a4.2 Kami tidak menjamin bahawa Perk" +
-	"hidmatan akan tidak terganggu, bebas ralat, selamat, atau bebas daripada" +
-	" virus.\x0a\x0a<b>5. Tanggungjawab Pengguna</b>\x0a5.1 Anda bertanggungj" +
-	"awab untuk memberikan maklumat yang tepat dan lengkap mengenai haiwan pe" +
-	"liharaan anda semasa mendapatkan nasihat.\x0a5.2 Anda mesti memastikan b" +
-	"ahawa semua soalan, penerangan, dan data yang anda berikan tidak melangg" +
-	"ar sebarang hak pihak ketiga atau undang-undang tempatan.\x0a\x0a<b>6. P" +
-	"enggunaan Antarabangsa</b>\x0a6.1 Perkhidmatan ini bertujuan untuk pengg" +
-	"unaan global. Anda bertanggungjawab untuk mematuhi semua undang-undang d" +
-	"an peraturan tempatan yang berkenaan di bidang kuasa anda.\x0a6.2 Kami t" +
-	"idak menjamin bahawa Perkhidmatan atau mana-mana kandungannya sesuai ata" +
-	"u dibenarkan di mana-mana negara atau wilayah tertentu.\x0a\x0a<b>7. Pen" +
-	"gubahsuaian</b>\x0a7.1 Kami berhak untuk mengubah atau menggantikan Term" +
-	"a ini pada bila-bila masa.\x0a7.2 Jika kami membuat perubahan material, " +
-	"kami akan menyiarkan Terma yang dikemas kini dan menunjukkan tarikh sema" +
-	"kan terkini di bahagian atas dokumen ini.\x0a\x0a<b>8. Undang-undang dan" +
-	" Penyelesaian Pertikaian</b>\x0a8.1 Terma ini akan ditadbir oleh dan dit" +
-	"afsirkan mengikut undang-undang yang terpakai di bidang kuasa tempat per" +
-	"niagaan utama penyedia Perkhidmatan, tanpa mengambil kira prinsip konfli" +
-	"k undang-undang.\x0a8.2 Sebarang pertikaian yang timbul daripada atau be" +
-	"rkaitan dengan Terma ini akan diselesaikan melalui rundingan secara baik" +
-	" dan, jika perlu, melalui timbang tara atau litigasi yang mengikat di ma" +
-	"hkamah yang berkenaan.\x0a\x0a<b>9. Penerimaan Terma</b>\x0a9.1 Dengan t" +
-	"erus mengakses atau menggunakan Perkhidmatan, anda mengakui bahawa anda " +
-	"telah membaca, memahami, dan bersetuju untuk terikat dengan Terma ini." +
-	"\x0a9.2 Jika anda tidak bersetuju, anda mesti berhenti menggunakan Perkh" +
-	"idmatan dengan segera.\x0a\x0aJika anda mempunyai sebarang soalan atau k" +
-	"ebimbangan mengenai Terma ini, atau jika anda memerlukan penjelasan lanj" +
-	"ut, sila hubungi di <i>k.sysoev@me.com</i>.\x02<b>Perintah Help My Pet B" +
-	"ot</b>:\x0a/start - Mula perbualan dengan bot\x0a/terms - Lihat Terma da" +
-	"n Syarat perkhidmatan\x0a/editprofile - Kemaskini maklumat profil haiwan" +
-	" peliharaan anda, seperti nama, umur, bangsa, dan lain-lain. Maklumat in" +
-	"i membantu bot memberikan nasihat yang lebih tepat.\x0a/cancel - Batal s" +
-	"oal selidik semasa, jika ada dalam proses (contohnya, apabila anda ingin" +
-	" memulakan semula atau menukar soalan anda)\x0a/help - Lihat mesej bantu" +
-	"an ini\x02Maaf, saya tidak dapat memproses video, audio, atau dokumen. S" +
-	"ila hantar soalan anda sebagai teks sahaja.\x02Saya minta maaf, tetapi m" +
-	"esej anda terlalu panjang untuk saya proses. Sila cuba membuatnya lebih " +
-	"pendek dan ringkas.\x02Anda telah mencapai jumlah permintaan maksimum se" +
-	"tiap jam. Sila cuba lagi nanti.\x02Kami telah mencapai had permintaan ha" +
-	"rian kami. Sila kembali esok apabila bajet kami disegarkan.\x02Sila beri" +
-	"kan soalan anda dalam format teks bersama dengan gambar\x02Sila berikan " +
-	"sekurang-kurangnya satu gambar\x02Sila berikan tidak lebih daripada %[1]" +
-	"d gambar\x02Maaf, saya mengalami ralat semasa memproses permintaan anda." +
-	" Sila cuba lagi nanti.\x02Tarikh yang diberikan tidak boleh di masa hada" +
-	"pan. Sila berikan tarikh yang sah.\x02Sila berikan tarikh dalam format y" +
-	"ang sah YYYY-MM-DD (contohnya, 2023-12-31)\x02Profil haiwan peliharaan b" +
-	"erjaya disimpan\x02Apakah nama haiwan peliharaan anda?\x02Jenis haiwan p" +
-	"eliharaan apa yang anda miliki?\x02anjing\x02kucing\x02Apakah bangsa hai" +
-	"wan peliharaan anda?\x02Bila haiwan peliharaan anda dilahirkan? Sila mas" +
-	"ukkan tarikh dalam format YYYY-MM-DD (contohnya, 2010-12-31).\x02Apakah " +
-	"jantina haiwan peliharaan anda?\x02lelaki\x02perempuan\x02Berapakah bera" +
-	"t haiwan peliharaan anda? Sila nyatakan berat diikuti dengan unit, conto" +
-	"hnya, 5 kg\x02Adakah haiwan peliharaan anda telah dimandulkan?\x02ya\x02" +
-	"tidak\x02Bagaimana anda akan menggambarkan tahap aktiviti haiwan pelihar" +
-	"aan anda?\x02rendah\x02sederhana\x02tinggi\x02Adakah haiwan peliharaan a" +
-	"nda mempunyai sebarang penyakit kronik?\x02Apakah pilihan makanan haiwan" +
-	" peliharaan anda atau sekatan diet?"
+const ms_MYData string = "" + // Size: 10646 bytes
+	"\x02Soal selidik dibatalkan\x02Perbualan dan profil haiwan peliharaan an" +
+	"da telah dipadamkan.\x02Saya minta maaf, tetapi mesej anda terlalu panja" +
+	"ng untuk saya proses. Sila cuba membuatnya lebih pendek dan ringkas.\x02" +
+	"Anda telah mencapai jumlah permintaan maksimum setiap jam. Sila cuba lag" +
+	"i nanti.\x02Anda telah menggunakan semua had soalan anda buat masa ini. " +
+	"Sila cuba lagi kemudian.\x02Kami telah mencapai had permintaan harian ka" +
+	"mi. Sila kembali esok apabila bajet kami disegarkan.\x02Perbualan anda t" +
+	"elah diubah oleh mesej lain semasa saya memproses mesej ini. Sila hantar" +
+	" semula.\x02Maaf, saya mengalami ralat semasa memproses permintaan anda." +
+	" Sila cuba lagi nanti.\x02Perintah tidak dikenali\x02Selamat datang ke H" +
+	"elp My Pet Bot! 🐾\x0a\x0aSaya adalah pembantu penjagaan haiwan kesayanga" +
+	"n peribadi anda, bersedia untuk memberikan panduan untuk rakan berbulu a" +
+	"nda. Saya boleh membantu dengan:\x0a\x0a- Kebimbangan kesihatan dan peni" +
+	"laian gejala\x0a- Soalan tingkah laku dan teknik latihan\x0a- Cadangan d" +
+	"iet dan pemakanan\x0a- Nasihat penjagaan haiwan kesayangan dan kecergasa" +
+	"n\x0a\x0aHanya taip soalan atau kebimbangan anda tentang haiwan kesayang" +
+	"an anda. Anda juga boleh menyertakan gambar untuk membantu saya memahami" +
+	" situasi anda dengan lebih baik.\x0a\x0aIngat, walaupun saya menawarkan " +
+	"panduan yang membantu berdasarkan pengetahuan veterinar yang boleh diper" +
+	"cayai, saya bukan pengganti penjagaan veterinar profesional. Sentiasa be" +
+	"runding dengan seorang doktor haiwan untuk kebimbangan perubatan yang se" +
+	"rius.\x0a\x0aDengan soalan haiwan kesayangan apa yang saya boleh membant" +
+	"u anda hari ini?\x02<b>Terma dan Syarat</b>\x0a<i>Dikemaskini terakhir: " +
+	"30.01.2025</i>\x0a\x0aTerima kasih kerana menggunakan chatbot nasihat ve" +
+	"terinar kami (“Perkhidmatan”). Dengan mengakses atau menggunakan Perkhid" +
+	"matan ini, anda bersetuju untuk terikat dengan terma dan syarat berikut " +
+	"(“Terma”). Jika anda tidak bersetuju dengan Terma ini, sila hentikan pen" +
+	"ggunaan dengan segera.\x0a\x0a<b>1. Sifat Perkhidmatan</b>\x0a1.1 Perkhi" +
+	"dmatan menyediakan maklumat umum, panduan, dan cadangan untuk penjagaan " +
+	"haiwan peliharaan, termasuk (tetapi tidak terhad kepada) diet, tingkah l" +
+	"aku, dan latihan.\x0a1.2 Perkhidmatan ini bukan pengganti untuk diagnosi" +
+	"s, rawatan, atau penjagaan veterinar profesional. Sentiasa dapatkan nasi" +
+	"hat daripada doktor haiwan berlesen untuk sebarang soalan mengenai kesih" +
+	"atan haiwan peliharaan anda.\x0a\x0a<b>2. Tiada Hubungan Veterinar-Pelan" +
+	"ggan-Pesakit</b>\x0a2.1 Menggunakan Perkhidmatan atau berinteraksi denga" +
+	"n pembantu AI kami tidak mewujudkan hubungan veterinar-pelanggan-pesakit" +
+	".\x0a2.2 Sebarang nasihat atau panduan yang diberikan oleh Perkhidmatan " +
+	"adalah berdasarkan maklumat terhad dan hanya boleh dianggap sebagai makl" +
+	"umat umum.\x0a\x0a<b>3. Had Tanggungjawab</b>\x0a3.1 Anda mengakui dan b" +
+	"ersetuju bahawa penggunaan Perkhidmatan adalah atas risiko anda sendiri." +
+	"\x0a3.2 Dalam apa jua keadaan, pemilik, pembangun, atau pemberi lesen Pe" +
+	"rkhidmatan tidak akan bertanggungjawab atas sebarang kerosakan langsung," +
+	" tidak langsung, sampingan, khas, atau berbangkit yang timbul daripada a" +
+	"tau berkaitan dengan akses atau penggunaan Perkhidmatan anda.\x0a3.3 And" +
+	"a memahami bahawa keputusan mengenai penjagaan haiwan peliharaan anda da" +
+	"n sebarang hasil yang terhasil adalah tanggungjawab anda sendiri. Jika a" +
+	"nda mempunyai sebarang keraguan tentang kesejahteraan atau kesihatan hai" +
+	"wan peliharaan anda, anda harus segera berunding dengan doktor haiwan be" +
+	"rlesen.\x0a\x0a<b>4. Tiada Jaminan</b>\x0a4.1 Perkhidmatan disediakan at" +
+	"as dasar “seadanya” dan “sebagaimana tersedia” tanpa sebarang jaminan, s" +
+	"ama ada nyata atau tersirat.\x0a4.2 Kami tidak menjamin bahawa Perkhidma" +
+	"tan akan tidak terganggu, bebas ralat, selamat, atau bebas daripada viru" +
+	"s.\x0a\x0a<b>5. Tanggungjawab Pengguna</b>\x0a5.1 Anda bertanggungjawab " +
+	"untuk memberikan maklumat yang tepat dan lengkap mengenai haiwan pelihar" +
+	"aan anda semasa mendapatkan nasihat.\x0a5.2 Anda mesti memastikan bahawa" +
+	" semua soalan, penerangan, dan data yang anda berikan tidak melanggar se" +
+	"barang hak pihak ketiga atau undang-undang tempatan.\x0a\x0a<b>6. Penggu" +
+	"naan Antarabangsa</b>\x0a6.1 Perkhidmatan ini bertujuan untuk penggunaan" +
+	" global. Anda bertanggungjawab untuk mematuhi semua undang-undang dan pe" +
+	"raturan tempatan yang berkenaan di bidang kuasa anda.\x0a6.2 Kami tidak " +
+	"menjamin bahawa Perkhidmatan atau mana-mana kandungannya sesuai atau dib" +
+	"enarkan di mana-mana negara atau wilayah tertentu.\x0a\x0a<b>7. Pengubah" +
+	"suaian</b>\x0a7.1 Kami berhak untuk mengubah atau menggantikan Terma ini" +
+	" pada bila-bila masa.\x0a7.2 Jika kami membuat perubahan material, kami " +
+	"akan menyiarkan Terma yang dikemas kini dan menunjukkan tarikh semakan t" +
+	"erkini di bahagian atas dokumen ini.\x0a\x0a<b>8. Undang-undang dan Peny" +
+	"elesaian Pertikaian</b>\x0a8.1 Terma ini akan ditadbir oleh dan ditafsir" +
+	"kan mengikut undang-undang yang terpakai di bidang kuasa tempat perniaga" +
+	"an utama penyedia Perkhidmatan, tanpa mengambil kira prinsip konflik und" +
+	"ang-undang.\x0a8.2 Sebarang pertikaian yang timbul daripada atau berkait" +
+	"an dengan Terma ini akan diselesaikan melalui rundingan secara baik dan," +
+	" jika perlu, melalui timbang tara atau litigasi yang mengikat di mahkama" +
+	"h yang berkenaan.\x0a\x0a<b>9. Penerimaan Terma</b>\x0a9.1 Dengan terus " +
+	"mengakses atau menggunakan Perkhidmatan, anda mengakui bahawa anda telah" +
+	" membaca, memahami, dan bersetuju untuk terikat dengan Terma ini.\x0a9.2" +
+	" Jika anda tidak bersetuju, anda mesti berhenti menggunakan Perkhidmatan" +
+	" dengan segera.\x0a\x0aJika anda mempunyai sebarang soalan atau kebimban" +
+	"gan mengenai Terma ini, atau jika anda memerlukan penjelasan lanjut, sil" +
+	"a hubungi di <i>k.sysoev@me.com</i>.\x02<b>Perintah Help My Pet Bot</b>:" +
+	"\x0a/start - Mula perbualan dengan bot\x0a/terms - Lihat Terma dan Syara" +
+	"t perkhidmatan\x0a/editprofile - Kemaskini maklumat profil haiwan peliha" +
+	"raan anda, seperti nama, umur, bangsa, dan lain-lain. Maklumat ini memba" +
+	"ntu bot memberikan nasihat yang lebih tepat.\x0a/addpet - Tambah profil " +
+	"haiwan peliharaan lain, jika anda mempunyai lebih daripada satu\x0a/pets" +
+	" - Senaraikan haiwan peliharaan anda dan lihat yang sedang dipilih\x0a/s" +
+	"witchpet - Pilih haiwan peliharaan untuk soalan anda yang seterusnya\x0a" +
+	"/removepet - Padam profil haiwan peliharaan\x0a/weight - Rekod berat sem" +
+	"asa haiwan peliharaan anda, cth. /weight 12.4kg\x0a/weightchart - Lihat " +
+	"carta berat haiwan peliharaan anda dari semasa ke semasa\x0a/vaccines - " +
+	"Senaraikan vaksinasi dan rawatan pencegahan yang tertunggak bagi haiwan " +
+	"peliharaan anda\x0a/addvaccine - Tambah rekod vaksinasi atau rawatan pen" +
+	"cegahan haiwan peliharaan anda\x0a/remind - Tetapkan peringatan berulang" +
+	", cth. /remind give Rimadyl every 12h for 7 days\x0a/reminders - Senarai" +
+	"kan peringatan anda dan padamkan yang tidak diperlukan\x0a/cancel - Bata" +
+	"l soal selidik semasa, jika ada dalam proses (contohnya, apabila anda in" +
+	"gin memulakan semula atau menukar soalan anda)\x0a/help - Lihat mesej ba" +
+	"ntuan ini\x02Jawapan ini tidak boleh dinilai lagi.\x02Terima kasih atas " +
+	"maklum balas anda!\x02Maaf kerana jawapan itu tidak membantu. Apakah mas" +
+	"alahnya? Balas mesej ini dengan komen ringkas, atau abaikan sahaja.\x02A" +
+	"pakah masalahnya?\x02Terima kasih, maklum balas anda membantu kami menam" +
+	"bah baik jawapan.\x02Maaf, saya tidak dapat memproses video, audio, atau" +
+	" dokumen. Sila hantar soalan anda sebagai teks sahaja.\x02Haiwan pelihar" +
+	"aan anda:\x02Gunakan /switchpet untuk memilih haiwan peliharaan yang and" +
+	"a tanyakan.\x02Haiwan peliharaan mana yang ingin anda tanyakan?\x02Saya " +
+	"tidak menemui haiwan peliharaan bernama %[1]s. Gunakan /pets untuk melih" +
+	"at haiwan peliharaan anda.\x02Soalan anda kini mengenai %[1]s.\x02Profil" +
+	" haiwan peliharaan mana yang ingin anda padamkan?\x02Profil %[1]s telah " +
+	"dipadamkan.\x02Anda belum mempunyai profil haiwan peliharaan. Gunakan /e" +
+	"ditprofile atau /addpet untuk menciptanya.\x02Sila berikan soalan anda d" +
+	"alam format teks bersama dengan gambar\x02Sila berikan sekurang-kurangny" +
+	"a satu gambar\x02Sila berikan tidak lebih daripada %[1]d gambar\x02Anda " +
+	"mempunyai terlalu banyak peringatan. Gunakan /reminders untuk memadamkan" +
+	" yang tidak diperlukan.\x02Peringatan tidak tersedia buat masa ini.\x02P" +
+	"eringatan ditetapkan: %[1]s, %[2]s.\x0aPeringatan seterusnya: %[3]s\x02P" +
+	"eringatan: %[1]s\x02Selesai\x02Tunda 1 jam\x02Peringatan ini tidak wujud" +
+	" lagi.\x02Ditandakan sebagai selesai\x02Saya akan mengingatkan anda lagi" +
+	" dalam masa sejam\x02Peringatan dipadamkan\x02Anda tiada sebarang pering" +
+	"atan. Gunakan /remind untuk menciptanya, cth. /remind give Rimadyl every" +
+	" 12h for 7 days\x02Peringatan anda:\x02Seterusnya: %[1]s\x02Beritahu say" +
+	"a perkara yang perlu diingatkan dan kekerapannya, contohnya:\x0a/remind " +
+	"give Rimadyl every 12h for 7 days\x0a/remind flea treatment monthly\x0a/" +
+	"remind brush teeth twice a day\x02🚨 KECEMASAN: haiwan peliharaan anda mu" +
+	"ngkin memerlukan rawatan veterinar segera. Hubungi doktor haiwan anda at" +
+	"au klinik kecemasan terdekat sekarang.\x02⚠️ Kami mengesyorkan anda berj" +
+	"umpa doktor haiwan dalam masa sehari dua.\x02🏥 Cari doktor haiwan kecema" +
+	"san berdekatan\x02%[1]s sepatutnya pada %[2]s\x02Tiada vaksinasi atau ra" +
+	"watan pencegahan yang tertunggak. Gunakan /addvaccine untuk menambah rek" +
+	"od baharu.\x02Vaksinasi dan rawatan pencegahan yang tertunggak:\x02Sila " +
+	"hubungi doktor haiwan anda untuk menjadualkannya, kemudian gunakan /addv" +
+	"accine untuk merekodkannya.\x02Berat %[1]s direkodkan: %[2]s.\x02Gunakan" +
+	" /weightchart untuk melihat perubahannya dari semasa ke semasa.\x02Belum" +
+	" ada rekod berat untuk %[1]s. Gunakan /weight untuk menambahnya, cth. /w" +
+	"eight 12.4kg\x02Sejarah berat %[1]s\x02Sila hantar berat bersama unitnya" +
+	", cth. /weight 12.4kg atau /weight 9 lbs\x02Profil haiwan peliharaan ber" +
+	"jaya disimpan\x02Tarikh yang diberikan tidak boleh di masa hadapan. Sila" +
+	" berikan tarikh yang sah.\x02Sila berikan tarikh dalam format yang sah Y" +
+	"YYY-MM-DD (contohnya, 2023-12-31)\x02Menambah rekod vaksinasi atau rawat" +
+	"an pencegahan untuk %[1]s.\x02%[1]s tiada lagi dalam senarai haiwan peli" +
+	"haraan anda, jadi rekod tidak disimpan.\x02Rekod %[1]s disimpan untuk %[" +
+	"2]s\x02Apakah nama haiwan peliharaan anda?\x02Jenis haiwan peliharaan ap" +
+	"a yang anda miliki?\x02anjing\x02kucing\x02Apakah bangsa haiwan pelihara" +
+	"an anda?\x02Bila haiwan peliharaan anda dilahirkan? Sila masukkan tarikh" +
+	" dalam format YYYY-MM-DD (contohnya, 2010-12-31).\x02Apakah jantina haiw" +
+	"an peliharaan anda?\x02lelaki\x02perempuan\x02Berapakah berat haiwan pel" +
+	"iharaan anda? Sila nyatakan berat diikuti dengan unit, contohnya, 5 kg" +
+	"\x02Adakah haiwan peliharaan anda telah dimandulkan?\x02ya\x02tidak\x02B" +
+	"agaimana anda akan menggambarkan tahap aktiviti haiwan peliharaan anda?" +
+	"\x02rendah\x02sederhana\x02tinggi\x02Adakah haiwan peliharaan anda mempu" +
+	"nyai sebarang penyakit kronik?\x02Apakah pilihan makanan haiwan pelihara" +
+	"an anda atau sekatan diet?\x02langkau\x02Vaksin atau rawatan pencegahan " +
+	"apakah yang diberikan (cth. rabies, nyahcacing, rawatan kutu)?\x02Bilaka" +
+	"h ia diberikan? Sila masukkan tarikh dalam format YYYY-MM-DD (cth. 2024-" +
+	"05-31).\x02Bilakah dos seterusnya? Sila masukkan tarikh dalam format YYY" +
+	"Y-MM-DD, atau langkau jika anda tidak tahu.\x02Klinik manakah yang membe" +
+	"rikannya?"
 
-var nl_NLIndex = []uint32{ // 36 elements
+var nl_NLIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x0000001b, 0x0000002d, 0x00000311,
-	0x00001242, 0x00001405, 0x0000146d, 0x000014da,
-	0x0000152c, 0x0000158e, 0x000015cb, 0x000015f0,
-	0x0000161e, 0x0000167f, 0x000016cd, 0x00001714,
-	0x00001739, 0x00001759, 0x00001779, 0x0000177e,
-	0x00001782, 0x0000179b, 0x000017fa, 0x0000181f,
-	0x00001829, 0x00001834, 0x00001898, 0x000018c6,
-	0x000018c9, 0x000018cd, 0x0000190b, 0x00001910,
+	0x00000000, 0x0000001b, 0x0000004c, 0x000000b9,
+	0x0000010b, 0x00000151, 0x000001b3, 0x00000217,
+	0x00000278, 0x0000028a, 0x0000056e, 0x0000149f,
+	0x00001968, 0x00001996, 0x000019b0, 0x00001a2e,
+	0x00001a3e, 0x00001a7a, 0x00001ae2, 0x00001af1,
+	0x00001b38, 0x00001b5f, 0x00001bb6, 0x00001bd4,
+	0x00001bfd, 0x00001c22, 0x00001c7a, 0x00001cb7,
+	0x00001cdc, 0x00001d0a, 0x00001d77, 0x00001d9f,
 	// Entry 20 - 3F
-	0x0000191a, 0x0000191f, 0x00001945, 0x00001988,
-} // Size: 168 bytes
+	0x00001de0, 0x00001df3, 0x00001df9, 0x00001e0a,
+	0x00001e2e, 0x00001e43, 0x00001e6b, 0x00001e82,
+	0x00001ef2, 0x00001f04, 0x00001f14, 0x00001fc0,
+	0x00002054, 0x000020aa, 0x000020d4, 0x000020ef,
+	0x00002172, 0x000021ab, 0x00002215, 0x0000223d,
+	0x00002287, 0x000022f7, 0x00002316, 0x0000235e,
+	0x00002383, 0x000023d1, 0x00002418, 0x00002458,
+	0x000024ab, 0x000024d7, 0x000024f7, 0x00002517,
+	// Entry 40 - 5F
+	0x0000251c, 0x00002520, 0x00002539, 0x00002598,
+	0x000025bd, 0x000025c7, 0x000025d2, 0x00002636,
+	0x00002664, 0x00002667, 0x0000266b, 0x000026a9,
+	0x000026ae, 0x000026b8, 0x000026bd, 0x000026e3,
+	0x00002726, 0x00002730, 0x00002798, 0x000027ef,
+	0x00002863, 0x00002884,
+} // Size: 368 bytes
 
-const nl_NLData string = "" + // Size: 6536 bytes
-	"\x02Vragenlijst is geannuleerd\x02Onbekend commando\x02Welkom bij Help M" +
-	"y Pet Bot! 🐾\x0a\x0aIk ben je persoonlijke assistent voor huisdierenverz" +
-	"orging, klaar om begeleiding te bieden voor je harige vrienden. Ik kan h" +
-	"elpen met:\x0a\x0a- Gezondheidsproblemen en symptoombeoordeling\x0a- Ged" +
-	"ragsvragen en trainingsmethoden\x0a- Dieet- en voedingsaanbevelingen\x0a" +
-	"- Algemene adviezen over huisdierenverzorging en welzijn\x0a\x0aTyp gewo" +
-	"on je vraag of zorg over je huisdier. Je kunt ook foto's toevoegen om me" +
-	" te helpen je situatie beter te begrijpen.\x0a\x0aOnthoud dat ik nuttige" +
-	" begeleiding bied op basis van betrouwbare veterinaire kennis, maar ik b" +
-	"en geen vervanging voor professionele diergeneeskundige zorg. Raadpleeg " +
-	"altijd een dierenarts bij ernstige medische zorgen.\x0a\x0aMet welke hui" +
-	"sdierenvraag kan ik je vandaag helpen?\x02<b>Algemene voorwaarden</b>" +
-	"\x0a<i>Laatst bijgewerkt: 30.01.2025</i>\x0a\x0aBedankt voor het gebruik" +
-	" van onze chatbot voor veterinaire adviezen (“de Service”). Door toegang" +
-	" te krijgen tot of gebruik te maken van deze Service, gaat u akkoord met" +
-	" de volgende algemene voorwaarden (“Voorwaarden”). Als u niet akkoord ga" +
-	"at met deze Voorwaarden, stop dan onmiddellijk met het gebruik.\x0a\x0a<" +
-	"b>1. Aard van de Service</b>\x0a1.1 De Service biedt algemene informatie" +
-	", begeleiding en suggesties voor huisdierverzorging, inclusief (maar nie" +
-	"t beperkt tot) dieet, gedrag en training.\x0a1.2 De Service is geen verv" +
-	"anging voor professionele veterinaire diagnose, behandeling of zorg. Raa" +
-	"dpleeg altijd een erkende dierenarts voor vragen over de gezondheid van " +
-	"uw huisdier.\x0a\x0a<b>2. Geen Veterinair-Client-Patiënt Relatie</b>\x0a" +
-	"2.1 Het gebruik van de Service of interactie met onze AI-assistent creëe" +
-	"rt geen dierenarts-client-patiënt relatie.\x0a2.2 Advies of begeleiding " +
-	"verstrekt door de Service is gebaseerd op beperkte informatie en moet al" +
-	"leen als algemene informatie worden beschouwd.\x0a\x0a<b>3. Beperking va" +
-	"n Aansprakelijkheid</b>\x0a3.1 U erkent en gaat ermee akkoord dat het ge" +
-	"bruik van de Service op eigen risico is.\x0a3.2 Onder geen enkele omstan" +
-	"digheid zullen de eigenaren, ontwikkelaars of licentiehouders van de Ser" +
-	"vice aansprakelijk zijn voor enige directe, indirecte, incidentele, spec" +
-	"iale of gevolgschade die voortvloeit uit of verband houdt met uw toegang" +
-	" tot of gebruik van de Service.\x0a3.3 U begrijpt dat beslissingen met b" +
-	"etrekking tot de zorg voor uw huisdier en de daaruit voortvloeiende resu" +
-	"ltaten uw eigen verantwoordelijkheid zijn. Als u twijfelt over het welzi" +
-	"jn of de gezondheid van uw huisdier, raadpleeg dan onmiddellijk een erke" +
-	"nde dierenarts.\x0a\x0a<b>4. Geen Garantie</b>\x0a4.1 De Service wordt g" +
-	"eleverd op een “as is” en “as available” basis zonder enige vorm van gar" +
-	"antie, expliciet of impliciet.\x0a4.2 Wij garanderen niet dat de Service" +
-	" ononderbroken, foutloos, veilig of vrij van virussen zal zijn.\x0a\x0a<" +
-	"b>5. Verantwoordelijkheden van de Gebruiker</b>\x0a5.1 U bent verantwoor" +
-	"delijk voor het verstrekken van nauwkeurige en volledige informatie over" +
-	" uw huisdier bij het zoeken naar advies.\x0a5.2 U moet ervoor zorgen dat" +
-	" alle vragen, beschrijvingen en gegevens die u verstrekt geen inbreuk ma" +
-	"ken op de rechten van derden of lokale wetten.\x0a\x0a<b>6. Internationa" +
-	"al Gebruik</b>\x0a6.1 De Service is bedoeld voor wereldwijd gebruik. U b" +
-	"ent verantwoordelijk voor naleving van alle toepasselijke lokale wetten " +
-	"en voorschriften in uw rechtsgebied.\x0a6.2 Wij garanderen niet dat de S" +
-	"ervice of enige inhoud ervan geschikt of toegestaan is in een specifiek " +
-	"land of regio.\x0a\x0a<b>7. Wijzigingen</b>\x0a7.1 Wij behouden ons het " +
-	"recht voor om deze Voorwaarden op elk moment te wijzigen of te vervangen" +
-	".\x0a7.2 Als we materiële wijzigingen aanbrengen, zullen we de bijgewerk" +
-	"te Voorwaarden plaatsen en de datum van de laatste herziening bovenaan d" +
-	"it document aangeven.\x0a\x0a<b>8. Toepasselijk Recht en Geschillenbesle" +
-	"chting</b>\x0a8.1 Deze Voorwaarden worden beheerst door en geïnterpretee" +
-	"rd in overeenstemming met de wetten die van toepassing zijn in het recht" +
-	"sgebied van de hoofdvestiging van de Serviceprovider, zonder rekening te" +
-	" houden met conflicterende rechtsprincipes.\x0a8.2 Elk geschil dat voort" +
-	"vloeit uit of verband houdt met deze Voorwaarden zal worden opgelost doo" +
-	"r middel van minnelijke onderhandelingen en, indien nodig, door bindende" +
-	" arbitrage of rechtszaken in de toepasselijke rechtbanken.\x0a\x0a<b>9. " +
-	"Aanvaarding van Voorwaarden</b>\x0a9.1 Door toegang te blijven krijgen t" +
-	"ot of gebruik te maken van de Service, erkent u dat u deze Voorwaarden h" +
-	"ebt gelezen, begrepen en ermee akkoord gaat.\x0a9.2 Als u niet akkoord g" +
-	"aat, moet u onmiddellijk stoppen met het gebruik van de Service.\x0a\x0a" +
-	"Als u vragen of opmerkingen heeft over deze Voorwaarden, of als u verder" +
-	"e verduidelijking nodig heeft, neem dan contact op via <i>k.sysoev@me.co" +
-	"m</i>.\x02<b>Help My Pet Bot Commands</b>:\x0a/start - Start het gesprek" +
-	" met de bot\x0a/terms - Bekijk de Algemene Voorwaarden van de service" +
-	"\x0a/editprofile - Werk de profielinformatie van uw huisdier bij, zoals " +
-	"naam, leeftijd, ras, enz. Deze informatie helpt de bot om nauwkeuriger a" +
-	"dvies te geven.\x0a/cancel - Annuleer de huidige vragenlijst, indien dez" +
-	"e in uitvoering is (bijv. wanneer u opnieuw wilt beginnen of uw vraag wi" +
-	"lt wijzigen)\x0a/help - Bekijk dit helpbericht\x02Sorry, ik kan geen vid" +
-	"eo's, audio of documenten verwerken. Stuur alstublieft alleen uw vraag a" +
-	"ls tekst.\x02Het spijt me, maar uw bericht is te lang voor mij om te ver" +
-	"werken. Probeer het korter en beknopter te maken.\x02U heeft het maximal" +
-	"e aantal verzoeken per uur bereikt. Probeer het later opnieuw.\x02We heb" +
-	"ben ons dagelijkse verzoeklimiet bereikt. Kom morgen terug wanneer ons b" +
-	"udget is vernieuwd.\x02Geef alstublieft uw vraag in tekstformaat samen m" +
-	"et foto('s)\x02Geef alstublieft minstens één foto\x02Geef alstublieft ni" +
-	"et meer dan %[1]d foto('s)\x02Sorry, ik heb een fout aangetroffen bij he" +
-	"t verwerken van uw verzoek. Probeer het later opnieuw.\x02De opgegeven d" +
-	"atum kan niet in de toekomst liggen. Geef een geldige datum op.\x02Geef " +
-	"een datum op in het geldige formaat JJJJ-MM-DD (bijv. 2023-12-31)\x02Hui" +
-	"sdierprofiel succesvol opgeslagen\x02Wat is de naam van je huisdier?\x02" +
-	"Wat voor soort huisdier heb je?\x02hond\x02kat\x02Welk ras is je huisdie" +
-	"r?\x02Wanneer is je huisdier geboren? Voer de datum in het formaat JJJJ-" +
-	"MM-DD in (bijv. 2010-12-31).\x02Wat is het geslacht van je huisdier?\x02" +
-	"mannelijk\x02vrouwelijk\x02Wat is het gewicht van je huisdier? Geef het " +
-	"gewicht op, gevolgd door de eenheid, bijvoorbeeld 5 kg\x02Is je huisdier" +
-	" gesteriliseerd of gecastreerd?\x02ja\x02nee\x02Hoe zou je het activitei" +
-	"tsniveau van je huisdier beschrijven?\x02laag\x02gemiddeld\x02hoog\x02He" +
-	"eft je huisdier chronische ziekten?\x02Wat zijn de voedselvoorkeuren of " +
-	"dieetbeperkingen van je huisdier?"
+const nl_NLData string = "" + // Size: 10372 bytes
+	"\x02Vragenlijst is geannuleerd\x02Je gesprek en huisdierprofielen zijn v" +
+	"erwijderd.\x02Het spijt me, maar uw bericht is te lang voor mij om te ve" +
+	"rwerken. Probeer het korter en beknopter te maken.\x02U heeft het maxima" +
+	"le aantal verzoeken per uur bereikt. Probeer het later opnieuw.\x02Je he" +
+	"bt je vragenlimiet voorlopig bereikt. Probeer het later opnieuw.\x02We h" +
+	"ebben ons dagelijkse verzoeklimiet bereikt. Kom morgen terug wanneer ons" +
+	" budget is vernieuwd.\x02Je gesprek is door een ander bericht gewijzigd " +
+	"terwijl ik dit bericht verwerkte. Stuur het opnieuw.\x02Sorry, ik heb ee" +
+	"n fout aangetroffen bij het verwerken van uw verzoek. Probeer het later " +
+	"opnieuw.\x02Onbekend commando\x02Welkom bij Help My Pet Bot! 🐾\x0a\x0aIk" +
+	" ben je persoonlijke assistent voor huisdierenverzorging, klaar om begel" +
+	"eiding te bieden voor je harige vrienden. Ik kan helpen met:\x0a\x0a- Ge" +
+	"zondheidsproblemen en symptoombeoordeling\x0a- Gedragsvragen en training" +
+	"smethoden\x0a- Dieet- en voedingsaanbevelingen\x0a- Algemene adviezen ov" +
+	"er huisdierenverzorging en welzijn\x0a\x0aTyp gewoon je vraag of zorg ov" +
+	"er je huisdier. Je kunt ook foto's toevoegen om me te helpen je situatie" +
+	" beter te begrijpen.\x0a\x0aOnthoud dat ik nuttige begeleiding bied op b" +
+	"asis van betrouwbare veterinaire kennis, maar ik ben geen vervanging voo" +
+	"r professionele diergeneeskundige zorg. Raadpleeg altijd een dierenarts " +
+	"bij ernstige medische zorgen.\x0a\x0aMet welke huisdierenvraag kan ik je" +
+	" vandaag helpen?\x02<b>Algemene voorwaarden</b>\x0a<i>Laatst bijgewerkt:" +
+	" 30.01.2025</i>\x0a\x0aBedankt voor het gebruik van onze chatbot voor ve" +
+	"terinaire adviezen (“de Service”). Door toegang te krijgen tot of gebrui" +
+	"k te maken van deze Service, gaat u akkoord met de volgende algemene voo" +
+	"rwaarden (“Voorwaarden”). Als u niet akkoord gaat met deze Voorwaarden, " +
+	"stop dan onmiddellijk met het gebruik.\x0a\x0a<b>1. Aard van de Service<" +
+	"/b>\x0a1.1 De Service biedt algemene informatie, begeleiding en suggesti" +
+	"es voor huisdierverzorging, inclusief (maar niet beperkt tot) dieet, ged" +
+	"rag en training.\x0a1.2 De Service is geen vervanging voor professionele" +
+	" veterinaire diagnose, behandeling of zorg. Raadpleeg altijd een erkende" +
+	" dierenarts voor vragen over de gezondheid van uw huisdier.\x0a\x0a<b>2." +
+	" Geen Veterinair-Client-Patiënt Relatie</b>\x0a2.1 Het gebruik van de Se" +
+	"rvice of interactie met onze AI-assistent creëert geen dierenarts-client" +
+	"-patiënt relatie.\x0a2.2 Advies of begeleiding verstrekt door de Service" +
+	" is gebaseerd op beperkte informatie en moet alleen als algemene informa" +
+	"tie worden beschouwd.\x0a\x0a<b>3. Beperking van Aansprakelijkheid</b>" +
+	"\x0a3.1 U erkent en gaat ermee akkoord dat het gebruik van de Service op" +
+	" eigen risico is.\x0a3.2 Onder geen enkele omstandigheid zullen de eigen" +
+	"aren, ontwikkelaars of licentiehouders van de Service aansprakelijk zijn" +
+	" voor enige directe, indirecte, incidentele, speciale of gevolgschade di" +
+	"e voortvloeit uit of verband houdt met uw toegang tot of gebruik van de " +
+	"Service.\x0a3.3 U begrijpt dat beslissingen met betrekking tot de zorg v" +
+	"oor uw huisdier en de daaruit voortvloeiende resultaten uw eigen verantw" +
+	"oordelijkheid zijn. Als u twijfelt over het welzijn of de gezondheid van" +
+	" uw huisdier, raadpleeg dan onmiddellijk een erkende dierenarts.\x0a\x0a" +
+	"<b>4. Geen Garantie</b>\x0a4.1 De Service wordt geleverd op een “as is” " +
+	"en “as available” basis zonder enige vorm van garantie, expliciet of imp" +
+	"liciet.\x0a4.2 Wij garanderen niet dat de Service ononderbroken, foutloo" +
+	"s, veilig of vrij van virussen zal zijn.\x0a\x0a<b>5. Verantwoordelijkhe" +
+	"den van de Gebruiker</b>\x0a5.1 U bent verantwoordelijk voor het verstre" +
+	"kken van nauwkeurige en volledige informatie over uw huisdier bij het zo" +
+	"eken naar advies.\x0a5.2 U moet ervoor zorgen dat alle vragen, beschrijv" +
+	"ingen en gegevens die u verstrekt geen inbreuk maken op de rechten van d" +
+	"erden of lokale wetten.\x0a\x0a<b>6. Internationaal Gebruik</b>\x0a6.1 D" +
+	"e Service is bedoeld voor wereldwijd gebruik. U bent verantwoordelijk vo" +
+	"or naleving van alle toepasselijke lokale wetten en voorschriften in uw " +
+	"rechtsgebied.\x0a6.2 Wij garanderen niet dat de Service of enige inhoud " +
+	"ervan geschikt of toegestaan is in een specifiek land of regio.\x0a\x0a<" +
+	"b>7. Wijzigingen</b>\x0a7.1 Wij behouden ons het recht voor om deze Voor" +
+	"waarden op elk moment te wijzigen of te vervangen.\x0a7.2 Als we materië" +
+	"le wijzigingen aanbrengen, zullen we de bijgewerkte Voorwaarden plaatsen" +
+	" en de datum van de laatste herziening bovenaan dit document aangeven." +
+	"\x0a\x0a<b>8. Toepasselijk Recht en Geschillenbeslechting</b>\x0a8.1 Dez" +
+	"e Voorwaarden worden beheerst door en geïnterpreteerd in overeenstemming" +
+	" met de wetten die van toepassing zijn in het rechtsgebied van de hoofdv" +
+	"estiging van de Serviceprovider, zonder rekening te houden met conflicte" +
+	"rende rechtsprincipes.\x0a8.2 Elk geschil dat voortvloeit uit of verband" +
+	" houdt met deze Voorwaarden zal worden opgelost door middel van minnelij" +
+	"ke onderhandelingen en, indien nodig, door bindende arbitrage of rechtsz" +
+	"aken in de toepasselijke rechtbanken.\x0a\x0a<b>9. Aanvaarding van Voorw" +
+	"aarden</b>\x0a9.1 Door toegang te blijven krijgen tot of gebruik te make" +
+	"n van de Service, erkent u dat u deze Voorwaarden hebt gelezen, begrepen" +
+	" en ermee akkoord gaat.\x0a9.2 Als u niet akkoord gaat, moet u onmiddell" +
+	"ijk stoppen met het gebruik van de Service.\x0a\x0aAls u vragen of opmer" +
+	"kingen heeft over deze Voorwaarden, of als u verdere verduidelijking nod" +
+	"ig heeft, neem dan contact op via <i>k.sysoev@me.com</i>.\x02<b>Help My " +
+	"Pet Bot Commands</b>:\x0a/start - Start het gesprek met de bot\x0a/terms" +
+	" - Bekijk de Algemene Voorwaarden van de service\x0a/editprofile - Werk " +
+	"de profielinformatie van uw huisdier bij, zoals naam, leeftijd, ras, enz" +
+	". Deze informatie helpt de bot om nauwkeuriger advies te geven.\x0a/addp" +
+	"et - Voeg het profiel van een ander huisdier toe, als u er meer dan één " +
+	"hebt\x0a/pets - Bekijk uw huisdieren en welk huisdier nu geselecteerd is" +
+	"\x0a/switchpet - Kies het huisdier waar uw volgende vragen over gaan\x0a" +
+	"/removepet - Verwijder een huisdierprofiel\x0a/weight - Registreer het h" +
+	"uidige gewicht van uw huisdier, bijv. /weight 12.4kg\x0a/weightchart - B" +
+	"ekijk een grafiek van het gewicht van uw huisdier door de tijd\x0a/vacci" +
+	"nes - Bekijk achterstallige vaccinaties en preventieve behandelingen van" +
+	" uw huisdieren\x0a/addvaccine - Voeg een vaccinatie of preventieve behan" +
+	"deling van uw huisdier toe\x0a/remind - Stel een terugkerende herinnerin" +
+	"g in, bijv. /remind give Rimadyl every 12h for 7 days\x0a/reminders - Be" +
+	"kijk uw herinneringen en verwijder de herinneringen die u niet nodig heb" +
+	"t\x0a/cancel - Annuleer de huidige vragenlijst, indien deze in uitvoerin" +
+	"g is (bijv. wanneer u opnieuw wilt beginnen of uw vraag wilt wijzigen)" +
+	"\x0a/help - Bekijk dit helpbericht\x02Dit antwoord kan niet meer beoorde" +
+	"eld worden.\x02Bedankt voor je feedback!\x02Jammer dat het antwoord niet" +
+	" hielp. Wat was er mis mee? Beantwoord dit bericht met een korte opmerki" +
+	"ng, of negeer het gewoon.\x02Wat was er mis?\x02Bedankt, je feedback hel" +
+	"pt ons de antwoorden te verbeteren.\x02Sorry, ik kan geen video's, audio" +
+	" of documenten verwerken. Stuur alstublieft alleen uw vraag als tekst." +
+	"\x02Je huisdieren:\x02Gebruik /switchpet om het huisdier te kiezen waar " +
+	"je vragen over gaan.\x02Over welk huisdier wil je iets vragen?\x02Ik kon" +
+	" geen huisdier met de naam %[1]s vinden. Gebruik /pets om je huisdieren " +
+	"te zien.\x02Je vragen gaan nu over %[1]s.\x02Welk huisdierprofiel wil je" +
+	" verwijderen?\x02Het profiel van %[1]s is verwijderd.\x02Je hebt nog gee" +
+	"n huisdierprofielen. Gebruik /editprofile of /addpet om er een te maken." +
+	"\x02Geef alstublieft uw vraag in tekstformaat samen met foto('s)\x02Geef" +
+	" alstublieft minstens één foto\x02Geef alstublieft niet meer dan %[1]d f" +
+	"oto('s)\x02Je hebt te veel herinneringen. Gebruik /reminders om de herin" +
+	"neringen die je niet nodig hebt te verwijderen.\x02Herinneringen zijn nu" +
+	" niet beschikbaar.\x02Herinnering ingesteld: %[1]s, %[2]s.\x0aVolgende h" +
+	"erinnering: %[3]s\x02Herinnering: %[1]s\x02Klaar\x021 uur uitstellen\x02" +
+	"Deze herinnering bestaat niet meer.\x02Gemarkeerd als klaar\x02Ik herinn" +
+	"er je er over een uur weer aan\x02Herinnering verwijderd\x02Je hebt geen" +
+	" herinneringen. Gebruik /remind om er een te maken, bijv. /remind give R" +
+	"imadyl every 12h for 7 days\x02Je herinneringen:\x02Volgende: %[1]s\x02V" +
+	"ertel me waaraan en hoe vaak ik je moet herinneren, bijvoorbeeld:\x0a/re" +
+	"mind give Rimadyl every 12h for 7 days\x0a/remind flea treatment monthly" +
+	"\x0a/remind brush teeth twice a day\x02🚨 NOODGEVAL: je huisdier heeft mo" +
+	"gelijk direct veterinaire zorg nodig. Neem nu contact op met je dierenar" +
+	"ts of de dichtstbijzijnde spoedkliniek.\x02⚠️ We raden een bezoek aan je" +
+	" dierenarts aan binnen de komende een à twee dagen.\x02🏥 Zoek een spoedd" +
+	"ierenarts in de buurt\x02%[1]s was gepland op %[2]s\x02Er zijn geen acht" +
+	"erstallige vaccinaties of preventieve behandelingen. Gebruik /addvaccine" +
+	" om een nieuwe registratie toe te voegen.\x02Achterstallige vaccinaties " +
+	"en preventieve behandelingen:\x02Neem contact op met je dierenarts om ze" +
+	" in te plannen en gebruik daarna /addvaccine om ze te registreren.\x02Ge" +
+	"wicht van %[1]s geregistreerd: %[2]s.\x02Gebruik /weightchart om te zien" +
+	" hoe het in de loop van de tijd verandert.\x02Er zijn nog geen gewichtsr" +
+	"egistraties voor %[1]s. Gebruik /weight om er een toe te voegen, bijv. /" +
+	"weight 12.4kg\x02Gewichtsgeschiedenis van %[1]s\x02Stuur het gewicht met" +
+	" de eenheid, bijv. /weight 12.4kg of /weight 9 lbs\x02Huisdierprofiel su" +
+	"ccesvol opgeslagen\x02De opgegeven datum kan niet in de toekomst liggen." +
+	" Geef een geldige datum op.\x02Geef een datum op in het geldige formaat " +
+	"JJJJ-MM-DD (bijv. 2023-12-31)\x02Een vaccinatie of preventieve behandeli" +
+	"ng voor %[1]s toevoegen.\x02%[1]s staat niet meer tussen je huisdieren, " +
+	"dus de registratie is niet opgeslagen.\x02Registratie van %[1]s opgeslag" +
+	"en voor %[2]s\x02Wat is de naam van je huisdier?\x02Wat voor soort huisd" +
+	"ier heb je?\x02hond\x02kat\x02Welk ras is je huisdier?\x02Wanneer is je " +
+	"huisdier geboren? Voer de datum in het formaat JJJJ-MM-DD in (bijv. 2010" +
+	"-12-31).\x02Wat is het geslacht van je huisdier?\x02mannelijk\x02vrouwel" +
+	"ijk\x02Wat is het gewicht van je huisdier? Geef het gewicht op, gevolgd " +
+	"door de eenheid, bijvoorbeeld 5 kg\x02Is je huisdier gesteriliseerd of g" +
+	"ecastreerd?\x02ja\x02nee\x02Hoe zou je het activiteitsniveau van je huis" +
+	"dier beschrijven?\x02laag\x02gemiddeld\x02hoog\x02Heeft je huisdier chro" +
+	"nische ziekten?\x02Wat zijn de voedselvoorkeuren of dieetbeperkingen van" +
+	" je huisdier?\x02overslaan\x02Welke vaccinatie of preventieve behandelin" +
+	"g is gegeven (bijv. rabiës, ontworming, vlooienbehandeling)?\x02Wanneer " +
+	"is het gegeven? Voer de datum in het formaat JJJJ-MM-DD in (bijv. 2024-0" +
+	"5-31).\x02Wanneer is de volgende dosis gepland? Voer de datum in het for" +
+	"maat JJJJ-MM-DD in, of sla over als je het niet weet.\x02Welke kliniek h" +
+	"eeft het gegeven?"
 
-var pl_PLIndex = []uint32{ // 36 elements
+var pl_PLIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000021, 0x00000034, 0x000003a9,
-	0x000012a2, 0x00001462, 0x000014d1, 0x0000154d,
-	0x000015a3, 0x00001606, 0x0000164f, 0x0000167a,
-	0x000016ad, 0x00001713, 0x0000175e, 0x0000179e,
-	0x000017cd, 0x000017f0, 0x00001817, 0x0000181c,
-	0x00001820, 0x00001844, 0x000018a0, 0x000018c6,
-	0x000018cd, 0x000018d4, 0x00001927, 0x0000195d,
-	0x00001961, 0x00001965, 0x0000199d, 0x000019a3,
+	0x00000000, 0x00000021, 0x00000057, 0x000000d3,
+	0x00000129, 0x0000016d, 0x000001d0, 0x00000240,
+	0x000002a6, 0x000002b9, 0x0000062e, 0x00001527,
+	0x00001992, 0x000019ba, 0x000019d2, 0x00001a64,
+	0x00001a76, 0x00001ab1, 0x00001b20, 0x00001b32,
+	0x00001b7b, 0x00001b9e, 0x00001bf7, 0x00001c27,
+	0x00001c52, 0x00001c7e, 0x00001ce1, 0x00001d2a,
+	0x00001d55, 0x00001d88, 0x00001de4, 0x00001e0a,
 	// Entry 20 - 3F
-	0x000019ab, 0x000019b2, 0x000019e8, 0x00001a3c,
-} // Size: 168 bytes
+	0x00001e50, 0x00001e65, 0x00001e6e, 0x00001e81,
+	0x00001ea5, 0x00001ebd, 0x00001edd, 0x00001ef5,
+	0x00001f64, 0x00001f79, 0x00001f8a, 0x00002033,
+	0x000020e5, 0x0000213a, 0x0000216a, 0x00002186,
+	0x000021e8, 0x00002217, 0x0000227c, 0x000022a5,
+	0x000022e3, 0x00002349, 0x00002369, 0x000023ad,
+	0x000023dc, 0x00002427, 0x00002467, 0x000024b8,
+	0x0000250a, 0x00002534, 0x00002557, 0x0000257e,
+	// Entry 40 - 5F
+	0x00002583, 0x00002587, 0x000025ab, 0x00002607,
+	0x0000262d, 0x00002634, 0x0000263b, 0x0000268e,
+	0x000026c4, 0x000026c8, 0x000026cc, 0x00002704,
+	0x0000270a, 0x00002712, 0x00002719, 0x0000274f,
+	0x000027a3, 0x000027aa, 0x0000282a, 0x00002877,
+	0x000028d7, 0x000028fc,
+} // Size: 368 bytes
 
-const pl_PLData string = "" + // Size: 6716 bytes
-	"\x02Kwestionariusz został anulowany\x02Nieznane polecenie\x02Witaj w Hel" +
-	"p My Pet Bot! 🐾\x0a\x0aJestem twoim osobistym asystentem do opieki nad z" +
-	"wierzętami, gotowym do udzielenia wskazówek dotyczących twoich futerkowy" +
-	"ch przyjaciół. Mogę pomóc w:\x0a\x0a- Zdrowotnych obawach i ocenie objaw" +
-	"ów\x0a- Pytaniach dotyczących zachowania i technik szkoleniowych\x0a- Z" +
-	"aleceniach dotyczących diety i żywienia\x0a- Ogólnych poradach dotyczący" +
-	"ch opieki nad zwierzętami i ich dobrostanu\x0a\x0aPo prostu wpisz swoje " +
-	"pytanie lub obawę dotyczącą twojego zwierzaka. Możesz również dołączyć z" +
-	"djęcia, aby pomóc mi lepiej zrozumieć twoją sytuację.\x0a\x0aPamiętaj, ż" +
-	"e chociaż oferuję pomocne wskazówki oparte na wiarygodnej wiedzy weteryn" +
-	"aryjnej, nie zastępuję profesjonalnej opieki weterynaryjnej. Zawsze skon" +
-	"sultuj się z weterynarzem w przypadku poważnych problemów zdrowotnych." +
-	"\x0a\x0aJakie pytanie dotyczące zwierząt mogę dzisiaj dla ciebie odpowie" +
-	"dzieć?\x02<b>Warunki korzystania</b>\x0a<i>Ostatnia aktualizacja: 30.01." +
-	"2025</i>\x0a\x0aDziękujemy za korzystanie z naszego chatbota doradztwa w" +
-	"eterynaryjnego („Usługa”). Korzystając z tej Usługi, zgadzasz się na prz" +
-	"estrzeganie następujących warunków („Warunki”). Jeśli nie zgadzasz się z" +
-	" tymi Warunkami, natychmiast przerwij korzystanie.\x0a\x0a<b>1. Charakte" +
-	"r Usługi</b>\x0a1.1 Usługa dostarcza ogólnych informacji, wskazówek i su" +
-	"gestii dotyczących opieki nad zwierzętami, w tym (ale nie tylko) diety, " +
-	"zachowania i szkolenia.\x0a1.2 Usługa nie zastępuje profesjonalnej diagn" +
-	"ozy, leczenia ani opieki weterynaryjnej. Zawsze zasięgaj porady licencjo" +
-	"nowanego weterynarza w przypadku pytań dotyczących zdrowia Twojego zwier" +
-	"zaka.\x0a\x0a<b>2. Brak relacji weterynarz-klient-pacjent</b>\x0a2.1 Kor" +
-	"zystanie z Usługi lub interakcja z naszym asystentem AI nie tworzy relac" +
-	"ji weterynarz-klient-pacjent.\x0a2.2 Wszelkie porady lub wskazówki udzie" +
-	"lane przez Usługę są oparte na ograniczonych informacjach i powinny być " +
-	"traktowane wyłącznie jako ogólne informacje.\x0a\x0a<b>3. Ograniczenie o" +
-	"dpowiedzialności</b>\x0a3.1 Uznajesz i zgadzasz się, że korzystanie z Us" +
-	"ługi odbywa się na własne ryzyko.\x0a3.2 W żadnym wypadku właściciele, " +
-	"deweloperzy ani licencjodawcy Usługi nie ponoszą odpowiedzialności za ja" +
-	"kiekolwiek bezpośrednie, pośrednie, przypadkowe, specjalne lub wynikowe " +
-	"szkody wynikające z dostępu do Usługi lub korzystania z niej.\x0a3.3 Roz" +
-	"umiesz, że decyzje dotyczące opieki nad Twoim zwierzakiem i wszelkie wyn" +
-	"ikające z tego konsekwencje są wyłącznie Twoją odpowiedzialnością. Jeśli" +
-	" masz jakiekolwiek wątpliwości co do zdrowia lub samopoczucia Twojego zw" +
-	"ierzaka, natychmiast skonsultuj się z licencjonowanym weterynarzem.\x0a" +
-	"\x0a<b>4. Brak gwarancji</b>\x0a4.1 Usługa jest dostarczana „tak jak jes" +
-	"t” i „w miarę dostępności” bez jakichkolwiek gwarancji, wyraźnych ani do" +
-	"rozumianych.\x0a4.2 Nie gwarantujemy, że Usługa będzie nieprzerwana, wol" +
-	"na od błędów, bezpieczna ani wolna od wirusów.\x0a\x0a<b>5. Obowiązki uż" +
-	"ytkownika</b>\x0a5.1 Jesteś odpowiedzialny za dostarczanie dokładnych i " +
-	"kompletnych informacji o swoim zwierzaku podczas korzystania z porad." +
-	"\x0a5.2 Musisz upewnić się, że wszystkie pytania, opisy i dane, które po" +
-	"dajesz, nie naruszają praw osób trzecich ani lokalnych przepisów.\x0a" +
-	"\x0a<b>6. Użycie międzynarodowe</b>\x0a6.1 Usługa jest przeznaczona do u" +
-	"żytku globalnego. Jesteś odpowiedzialny za przestrzeganie wszystkich ob" +
-	"owiązujących lokalnych przepisów i regulacji w Twojej jurysdykcji.\x0a6." +
-	"2 Nie gwarantujemy, że Usługa lub jakakolwiek jej zawartość jest odpowie" +
-	"dnia lub dozwolona w jakimkolwiek konkretnym kraju lub regionie.\x0a\x0a" +
-	"<b>7. Modyfikacje</b>\x0a7.1 Zastrzegamy sobie prawo do modyfikowania lu" +
-	"b zastępowania tych Warunków w dowolnym czasie.\x0a7.2 Jeśli wprowadzimy" +
-	" istotne zmiany, opublikujemy zaktualizowane Warunki i wskażemy datę naj" +
-	"nowszej rewizji na górze tego dokumentu.\x0a\x0a<b>8. Prawo właściwe i r" +
-	"ozstrzyganie sporów</b>\x0a8.1 Niniejsze Warunki podlegają i są interpre" +
-	"towane zgodnie z prawem obowiązującym w jurysdykcji głównego miejsca pro" +
-	"wadzenia działalności dostawcy Usługi, bez względu na zasady kolizji pra" +
-	"w.\x0a8.2 Wszelkie spory wynikające z tych Warunków lub z nimi związane " +
-	"będą rozstrzygane poprzez polubowne negocjacje, a w razie potrzeby przez" +
-	" wiążący arbitraż lub postępowanie sądowe w odpowiednich sądach.\x0a\x0a" +
-	"<b>9. Akceptacja Warunków</b>\x0a9.1 Kontynuując dostęp do Usługi lub ko" +
-	"rzystanie z niej, potwierdzasz, że przeczytałeś, zrozumiałeś i zgadzasz " +
-	"się na przestrzeganie tych Warunków.\x0a9.2 Jeśli się nie zgadzasz, musi" +
-	"sz natychmiast przerwać korzystanie z Usługi.\x0a\x0aJeśli masz jakiekol" +
-	"wiek pytania lub wątpliwości dotyczące tych Warunków lub potrzebujesz da" +
-	"lszych wyjaśnień, skontaktuj się pod adresem <i>k.sysoev@me.com</i>.\x02" +
-	"<b>Polecenia Help My Pet Bot</b>:\x0a/start - Rozpocznij rozmowę z botem" +
-	"\x0a/terms - Wyświetl Warunki korzystania z usługi\x0a/editprofile - Zak" +
-	"tualizuj informacje o profilu swojego zwierzaka, takie jak imię, wiek, r" +
-	"asa itp. Te informacje pomagają botowi udzielać bardziej precyzyjnych po" +
-	"rad.\x0a/cancel - Anuluj bieżący kwestionariusz, jeśli jest w toku (np. " +
-	"gdy chcesz zacząć od nowa lub zmienić pytanie)\x0a/help - Wyświetl tę wi" +
-	"adomość pomocy\x02Przepraszam, nie mogę przetwarzać wideo, audio ani dok" +
-	"umentów. Wyślij swoje pytanie tylko w formie tekstu.\x02Przepraszam, ale" +
-	" Twoja wiadomość jest dla mnie zbyt długa do przetworzenia. Spróbuj ją s" +
-	"krócić i bardziej zwięźle.\x02Osiągnąłeś maksymalną liczbę żądań na godz" +
-	"inę. Spróbuj ponownie później.\x02Osiągnęliśmy nasz dzienny limit żądań." +
-	" Wróć jutro, gdy nasz budżet zostanie odświeżony.\x02Proszę, podaj swoje" +
-	" pytanie w formacie tekstowym wraz z zdjęciem(-ami)\x02Proszę, podaj prz" +
-	"ynajmniej jedno zdjęcie\x02Proszę, podaj nie więcej niż %[1]d zdjęcie(-a" +
-	")\x02Przepraszam, napotkałem błąd podczas przetwarzania Twojego żądania." +
-	" Spróbuj ponownie później.\x02Podana data nie może być w przyszłości. Pr" +
-	"oszę podaj poprawną datę.\x02Podaj datę w prawidłowym formacie RRRR-MM-D" +
-	"D (np. 2023-12-31)\x02Profil zwierzątka został pomyślnie zapisany\x02Jak" +
-	" ma na imię Twoje zwierzątko?\x02Jakiego rodzaju zwierzątko posiadasz?" +
+const pl_PLData string = "" + // Size: 10492 bytes
+	"\x02Kwestionariusz został anulowany\x02Twoja rozmowa i profile zwierząt " +
+	"zostały usunięte.\x02Przepraszam, ale Twoja wiadomość jest dla mnie zbyt" +
+	" długa do przetworzenia. Spróbuj ją skrócić i bardziej zwięźle.\x02Osiąg" +
+	"nąłeś maksymalną liczbę żądań na godzinę. Spróbuj ponownie później.\x02N" +
+	"a razie wykorzystałeś limit pytań. Spróbuj ponownie później.\x02Osiągnęl" +
+	"iśmy nasz dzienny limit żądań. Wróć jutro, gdy nasz budżet zostanie odśw" +
+	"ieżony.\x02Podczas przetwarzania tej wiadomości rozmowa została zmienion" +
+	"a przez inną wiadomość. Wyślij ją ponownie.\x02Przepraszam, napotkałem b" +
+	"łąd podczas przetwarzania Twojego żądania. Spróbuj ponownie później." +
+	"\x02Nieznane polecenie\x02Witaj w Help My Pet Bot! 🐾\x0a\x0aJestem twoim" +
+	" osobistym asystentem do opieki nad zwierzętami, gotowym do udzielenia w" +
+	"skazówek dotyczących twoich futerkowych przyjaciół. Mogę pomóc w:\x0a" +
+	"\x0a- Zdrowotnych obawach i ocenie objawów\x0a- Pytaniach dotyczących za" +
+	"chowania i technik szkoleniowych\x0a- Zaleceniach dotyczących diety i ży" +
+	"wienia\x0a- Ogólnych poradach dotyczących opieki nad zwierzętami i ich d" +
+	"obrostanu\x0a\x0aPo prostu wpisz swoje pytanie lub obawę dotyczącą twoje" +
+	"go zwierzaka. Możesz również dołączyć zdjęcia, aby pomóc mi lepiej zrozu" +
+	"mieć twoją sytuację.\x0a\x0aPamiętaj, że chociaż oferuję pomocne wskazów" +
+	"ki oparte na wiarygodnej wiedzy weterynaryjnej, nie zastępuję profesjona" +
+	"lnej opieki weterynaryjnej. Zawsze skonsultuj się z weterynarzem w przyp" +
+	"adku poważnych problemów zdrowotnych.\x0a\x0aJakie pytanie dotyczące zwi" +
+	"erząt mogę dzisiaj dla ciebie odpowiedzieć?\x02<b>Warunki korzystania</b" +
+	">\x0a<i>Ostatnia aktualizacja: 30.01.2025</i>\x0a\x0aDziękujemy za korzy" +
+	"stanie z naszego chatbota doradztwa weterynaryjnego („Usługa”). Korzysta" +
+	"jąc z tej Usługi, zgadzasz się na przestrzeganie następujących warunków " +
+	"(„Warunki”). Jeśli nie zgadzasz się z tymi Warunkami, natychmiast przerw" +
+	"ij korzystanie.\x0a\x0a<b>1. Charakter Usługi</b>\x0a1.1 Usługa dostarcz" +
+	"a ogólnych informacji, wskazówek i sugestii dotyczących opieki nad zwier" +
+	"zętami, w tym (ale nie tylko) diety, zachowania i szkolenia.\x0a1.2 Usłu" +
+	"ga nie zastępuje profesjonalnej diagnozy, leczenia ani opieki weterynary" +
+	"jnej. Zawsze zasięgaj porady licencjonowanego weterynarza w przypadku py" +
+	"tań dotyczących zdrowia Twojego zwierzaka.\x0a\x0a<b>2. Brak relacji wet" +
+	"erynarz-klient-pacjent</b>\x0a2.1 Korzystanie z Usługi lub interakcja z " +
+	"naszym asystentem AI nie tworzy relacji weterynarz-klient-pacjent.\x0a2." +
+	"2 Wszelkie porady lub wskazówki udzielane przez Usługę są oparte na ogra" +
+	"niczonych informacjach i powinny być traktowane wyłącznie jako ogólne in" +
+	"formacje.\x0a\x0a<b>3. Ograniczenie odpowiedzialności</b>\x0a3.1 Uznajes" +
+	"z i zgadzasz się, że korzystanie z Usługi odbywa się na własne ryzyko." +
+	"\x0a3.2 W żadnym wypadku właściciele, deweloperzy ani licencjodawcy Usłu" +
+	"gi nie ponoszą odpowiedzialności za jakiekolwiek bezpośrednie, pośrednie" +
+	", przypadkowe, specjalne lub wynikowe szkody wynikające z dostępu do Usł" +
+	"ugi lub korzystania z niej.\x0a3.3 Rozumiesz, że decyzje dotyczące opiek" +
+	"i nad Twoim zwierzakiem i wszelkie wynikające z tego konsekwencje są wył" +
+	"ącznie Twoją odpowiedzialnością. Jeśli masz jakiekolwiek wątpliwości co" +
+	" do zdrowia lub samopoczucia Twojego zwierzaka, natychmiast skonsultuj s" +
+	"ię z licencjonowanym weterynarzem.\x0a\x0a<b>4. Brak gwarancji</b>\x0a4." +
+	"1 Usługa jest dostarczana „tak jak jest” i „w miarę dostępności” bez jak" +
+	"ichkolwiek gwarancji, wyraźnych ani dorozumianych.\x0a4.2 Nie gwarantuje" +
+	"my, że Usługa będzie nieprzerwana, wolna od błędów, bezpieczna ani wolna" +
+	" od wirusów.\x0a\x0a<b>5. Obowiązki użytkownika</b>\x0a5.1 Jesteś odpowi" +
+	"edzialny za dostarczanie dokładnych i kompletnych informacji o swoim zwi" +
+	"erzaku podczas korzystania z porad.\x0a5.2 Musisz upewnić się, że wszyst" +
+	"kie pytania, opisy i dane, które podajesz, nie naruszają praw osób trzec" +
+	"ich ani lokalnych przepisów.\x0a\x0a<b>6. Użycie międzynarodowe</b>\x0a6" +
+	".1 Usługa jest przeznaczona do użytku globalnego. Jesteś odpowiedzialny " +
+	"za przestrzeganie wszystkich obowiązujących lokalnych przepisów i regula" +
+	"cji w Twojej jurysdykcji.\x0a6.2 Nie gwarantujemy, że Usługa lub jakakol" +
+	"wiek jej zawartość jest odpowiednia lub dozwolona w jakimkolwiek konkret" +
+	"nym kraju lub regionie.\x0a\x0a<b>7. Modyfikacje</b>\x0a7.1 Zastrzegamy " +
+	"sobie prawo do modyfikowania lub zastępowania tych Warunków w dowolnym c" +
+	"zasie.\x0a7.2 Jeśli wprowadzimy istotne zmiany, opublikujemy zaktualizow" +
+	"ane Warunki i wskażemy datę najnowszej rewizji na górze tego dokumentu." +
+	"\x0a\x0a<b>8. Prawo właściwe i rozstrzyganie sporów</b>\x0a8.1 Niniejsze" +
+	" Warunki podlegają i są interpretowane zgodnie z prawem obowiązującym w " +
+	"jurysdykcji głównego miejsca prowadzenia działalności dostawcy Usługi, b" +
+	"ez względu na zasady kolizji praw.\x0a8.2 Wszelkie spory wynikające z ty" +
+	"ch Warunków lub z nimi związane będą rozstrzygane poprzez polubowne nego" +
+	"cjacje, a w razie potrzeby przez wiążący arbitraż lub postępowanie sądow" +
+	"e w odpowiednich sądach.\x0a\x0a<b>9. Akceptacja Warunków</b>\x0a9.1 Kon" +
+	"tynuując dostęp do Usługi lub korzystanie z niej, potwierdzasz, że przec" +
+	"zytałeś, zrozumiałeś i zgadzasz się na przestrzeganie tych Warunków.\x0a" +
+	"9.2 Jeśli się nie zgadzasz, musisz natychmiast przerwać korzystanie z Us" +
+	"ługi.\x0a\x0aJeśli masz jakiekolwiek pytania lub wątpliwości dotyczące " +
+	"tych Warunków lub potrzebujesz dalszych wyjaśnień, skontaktuj się pod ad" +
+	"resem <i>k.sysoev@me.com</i>.\x02<b>Polecenia Help My Pet Bot</b>:\x0a/s" +
+	"tart - Rozpocznij rozmowę z botem\x0a/terms - Wyświetl Warunki korzystan" +
+	"ia z usługi\x0a/editprofile - Zaktualizuj informacje o profilu swojego z" +
+	"wierzaka, takie jak imię, wiek, rasa itp. Te informacje pomagają botowi " +
+	"udzielać bardziej precyzyjnych porad.\x0a/addpet - Dodaj profil kolejneg" +
+	"o zwierzaka, jeśli masz ich więcej\x0a/pets - Wyświetl swoje zwierzęta i" +
+	" to, które jest obecnie wybrane\x0a/switchpet - Wybierz zwierzę, którego" +
+	" będą dotyczyć kolejne pytania\x0a/removepet - Usuń profil zwierzęcia" +
+	"\x0a/weight - Zapisz aktualną wagę zwierzęcia, np. /weight 12.4kg\x0a/we" +
+	"ightchart - Zobacz wykres wagi zwierzęcia w czasie\x0a/vaccines - Wyświe" +
+	"tl zaległe szczepienia i zabiegi profilaktyczne swoich zwierząt\x0a/addv" +
+	"accine - Dodaj wpis o szczepieniu lub zabiegu profilaktycznym zwierzęcia" +
+	"\x0a/remind - Ustaw cykliczne przypomnienie, np. /remind give Rimadyl ev" +
+	"ery 12h for 7 days\x0a/reminders - Wyświetl swoje przypomnienia i usuń n" +
+	"iepotrzebne\x0a/cancel - Anuluj bieżący kwestionariusz, jeśli jest w tok" +
+	"u (np. gdy chcesz zacząć od nowa lub zmienić pytanie)\x0a/help - Wyświet" +
+	"l tę wiadomość pomocy\x02Tej odpowiedzi nie można już ocenić.\x02Dziękuj" +
+	"emy za opinię!\x02Przykro nam, że odpowiedź nie pomogła. Co było z nią n" +
+	"ie tak? Odpowiedz na tę wiadomość krótkim komentarzem albo po prostu ją " +
+	"zignoruj.\x02Co było nie tak?\x02Dziękujemy, Twoja opinia pomaga nam ule" +
+	"pszać odpowiedzi.\x02Przepraszam, nie mogę przetwarzać wideo, audio ani " +
+	"dokumentów. Wyślij swoje pytanie tylko w formie tekstu.\x02Twoje zwierzę" +
+	"ta:\x02Użyj /switchpet, aby wybrać zwierzę, którego dotyczą Twoje pytani" +
+	"a.\x02O które zwierzę chcesz zapytać?\x02Nie znalazłem zwierzęcia o imie" +
+	"niu %[1]s. Użyj /pets, aby zobaczyć swoje zwierzęta.\x02Twoje pytania do" +
+	"tyczą teraz zwierzęcia %[1]s.\x02Który profil zwierzęcia chcesz usunąć?" +
+	"\x02Profil zwierzęcia %[1]s został usunięty.\x02Nie masz jeszcze żadnych" +
+	" profili zwierząt. Użyj /editprofile lub /addpet, aby utworzyć profil." +
+	"\x02Proszę, podaj swoje pytanie w formacie tekstowym wraz z zdjęciem(-am" +
+	"i)\x02Proszę, podaj przynajmniej jedno zdjęcie\x02Proszę, podaj nie więc" +
+	"ej niż %[1]d zdjęcie(-a)\x02Masz zbyt wiele przypomnień. Użyj /reminders" +
+	", aby usunąć te, których nie potrzebujesz.\x02Przypomnienia są teraz nie" +
+	"dostępne.\x02Ustawiono przypomnienie: %[1]s, %[2]s.\x0aNastępne przypomn" +
+	"ienie: %[3]s\x02Przypomnienie: %[1]s\x02Zrobione\x02Odłóż o 1 godz.\x02T" +
+	"o przypomnienie już nie istnieje.\x02Oznaczono jako zrobione\x02Przypomn" +
+	"ę ponownie za godzinę\x02Przypomnienie usunięte\x02Nie masz żadnych prz" +
+	"ypomnień. Użyj /remind, aby je utworzyć, np. /remind give Rimadyl every " +
+	"12h for 7 days\x02Twoje przypomnienia:\x02Następne: %[1]s\x02Napisz, o c" +
+	"zym i jak często mam Ci przypominać, na przykład:\x0a/remind give Rimady" +
+	"l every 12h for 7 days\x0a/remind flea treatment monthly\x0a/remind brus" +
+	"h teeth twice a day\x02🚨 NAGŁY WYPADEK: Twoje zwierzę może potrzebować n" +
+	"atychmiastowej pomocy weterynaryjnej. Skontaktuj się teraz ze swoim wete" +
+	"rynarzem lub najbliższą całodobową kliniką.\x02⚠️ Zalecamy wizytę u wete" +
+	"rynarza w ciągu najbliższych jednego lub dwóch dni.\x02🏥 Znajdź pobliski" +
+	"ego weterynarza dyżurnego\x02%[1]s: termin minął %[2]s\x02Brak zaległych" +
+	" szczepień i zabiegów profilaktycznych. Użyj /addvaccine, aby dodać nowy" +
+	" wpis.\x02Zaległe szczepienia i zabiegi profilaktyczne:\x02Skontaktuj si" +
+	"ę z weterynarzem, aby je zaplanować, a następnie użyj /addvaccine, aby " +
+	"je zapisać.\x02Zapisano wagę zwierzęcia %[1]s: %[2]s.\x02Użyj /weightcha" +
+	"rt, aby zobaczyć, jak zmienia się w czasie.\x02Nie ma jeszcze wpisów wag" +
+	"i dla zwierzęcia %[1]s. Użyj /weight, aby dodać wpis, np. /weight 12.4kg" +
+	"\x02Historia wagi zwierzęcia %[1]s\x02Podaj wagę wraz z jednostką, np. /" +
+	"weight 12.4kg lub /weight 9 lbs\x02Profil zwierzątka został pomyślnie za" +
+	"pisany\x02Podana data nie może być w przyszłości. Proszę podaj poprawną " +
+	"datę.\x02Podaj datę w prawidłowym formacie RRRR-MM-DD (np. 2023-12-31)" +
+	"\x02Dodawanie wpisu o szczepieniu lub zabiegu profilaktycznym dla zwierz" +
+	"ęcia %[1]s.\x02%[1]s nie jest już na liście Twoich zwierząt, więc wpis " +
+	"nie został zapisany.\x02Zapisano wpis %[1]s dla zwierzęcia %[2]s\x02Jak " +
+	"ma na imię Twoje zwierzątko?\x02Jakiego rodzaju zwierzątko posiadasz?" +
 	"\x02pies\x02kot\x02Jaka jest rasa Twojego zwierzątka?\x02Kiedy urodziło " +
 	"się Twoje zwierzątko? Podaj datę w formacie RRRR-MM-DD (np. 2010-12-31)." +
 	"\x02Jaka jest płeć Twojego zwierzątka?\x02samiec\x02samica\x02Jaka jest " +
@@ -1265,109 +2043,176 @@ const pl_PLData string = "" + // Size: 6716 bytes
 	"Czy Twoje zwierzątko jest sterylizowane lub kastrat?\x02tak\x02nie\x02Ja" +
 	"k opisałbyś poziom aktywności Twojego zwierzątka?\x02niski\x02średni\x02" +
 	"wysoki\x02Czy Twoje zwierzątko ma jakieś przewlekłe choroby?\x02Jakie są" +
-	" preferencje żywieniowe Twojego zwierzątka lub ograniczenia dietetyczne?"
+	" preferencje żywieniowe Twojego zwierzątka lub ograniczenia dietetyczne?" +
+	"\x02pomiń\x02Jakie szczepienie lub zabieg profilaktyczny wykonano (np. p" +
+	"rzeciw wściekliźnie, odrobaczanie, zabezpieczenie przed pchłami)?\x02Kie" +
+	"dy zostało wykonane? Podaj datę w formacie RRRR-MM-DD (np. 2024-05-31)." +
+	"\x02Kiedy przypada następna dawka? Podaj datę w formacie RRRR-MM-DD lub " +
+	"pomiń, jeśli nie wiesz.\x02W której klinice zostało wykonane?"
 
-var pt_PTIndex = []uint32{ // 36 elements
+var pt_PTIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000018, 0x0000002d, 0x0000038d,
-	0x0000125e, 0x0000143a, 0x000014ad, 0x00001523,
-	0x00001586, 0x000015f6, 0x00001644, 0x0000166c,
-	0x00001699, 0x000016f7, 0x00001749, 0x0000179e,
-	0x000017d0, 0x000017fd, 0x0000182a, 0x0000182f,
-	0x00001834, 0x00001862, 0x000018d7, 0x00001907,
-	0x0000190d, 0x00001914, 0x00001985, 0x000019c1,
-	0x000019c5, 0x000019ca, 0x00001a0f, 0x00001a15,
+	0x00000000, 0x00000018, 0x00000055, 0x000000cb,
+	0x0000012e, 0x00000177, 0x000001e7, 0x00000246,
+	0x000002a4, 0x000002b9, 0x00000619, 0x000014ea,
+	0x0000197e, 0x000019a8, 0x000019c4, 0x00001a54,
+	0x00001a69, 0x00001aa5, 0x00001b18, 0x00001b29,
+	0x00001b77, 0x00001b9f, 0x00001bf3, 0x00001c1d,
+	0x00001c47, 0x00001c67, 0x00001cb8, 0x00001d06,
+	0x00001d2e, 0x00001d5b, 0x00001dab, 0x00001de0,
 	// Entry 20 - 3F
-	0x00001a1c, 0x00001a21, 0x00001a5a, 0x00001abc,
-} // Size: 168 bytes
+	0x00001e18, 0x00001e28, 0x00001e2e, 0x00001e38,
+	0x00001e57, 0x00001e6a, 0x00001e90, 0x00001ea3,
+	0x00001f07, 0x00001f1a, 0x00001f2a, 0x00001fd2,
+	0x00002070, 0x000020c7, 0x00002104, 0x00002125,
+	0x00002191, 0x000021be, 0x00002219, 0x00002239,
+	0x00002275, 0x000022d8, 0x000022f4, 0x00002341,
+	0x00002373, 0x000023c5, 0x0000241a, 0x00002460,
+	0x000024b2, 0x000024d7, 0x00002504, 0x00002531,
+	// Entry 40 - 5F
+	0x00002536, 0x0000253b, 0x00002569, 0x000025de,
+	0x0000260e, 0x00002614, 0x0000261b, 0x0000268c,
+	0x000026c8, 0x000026cc, 0x000026d1, 0x00002716,
+	0x0000271c, 0x00002723, 0x00002728, 0x00002761,
+	0x000027c3, 0x000027ca, 0x00002839, 0x0000288f,
+	0x000028eb, 0x00002907,
+} // Size: 368 bytes
 
-const pt_PTData string = "" + // Size: 6844 bytes
-	"\x02Questionário cancelado\x02Comando desconhecido\x02Bem-vindo ao Help " +
-	"My Pet Bot! 🐾\x0a\x0aSou o seu assistente pessoal de cuidados com animai" +
-	"s de estimação, pronto para fornecer orientação para os seus amigos pelu" +
-	"dos. Posso ajudar com:\x0a\x0a- Preocupações de saúde e avaliação de sin" +
-	"tomas\x0a- Questões de comportamento e técnicas de treino\x0a- Recomenda" +
-	"ções de dieta e nutrição\x0a- Conselhos gerais de cuidados e bem-estar " +
-	"para animais de estimação\x0a\x0aBasta digitar a sua pergunta ou preocup" +
-	"ação sobre o seu animal de estimação. Também pode incluir fotos para me " +
-	"ajudar a entender melhor a sua situação.\x0a\x0aLembre-se, embora eu ofe" +
-	"reça orientações úteis com base em conhecimentos veterinários confiáveis" +
-	", não substituo os cuidados veterinários profissionais. Consulte sempre " +
-	"um veterinário em caso de preocupações médicas graves.\x0a\x0aCom que pe" +
-	"rgunta sobre animais de estimação posso ajudar hoje?\x02<b>Termos e Cond" +
-	"ições</b>\x0a<i>Última atualização: 30.01.2025</i>\x0a\x0aObrigado por u" +
-	"sar nosso chatbot de aconselhamento veterinário (“o Serviço”). Ao acessa" +
-	"r ou usar este Serviço, você concorda em estar vinculado pelos seguintes" +
-	" termos e condições (“Termos”). Se você não concordar com estes Termos, " +
-	"interrompa o uso imediatamente.\x0a\x0a<b>1. Natureza do Serviço</b>\x0a" +
-	"1.1 O Serviço fornece informações gerais, orientações e sugestões para c" +
-	"uidados com animais de estimação, incluindo (mas não se limitando a) die" +
-	"ta, comportamento e treinamento.\x0a1.2 O Serviço não substitui o diagnó" +
-	"stico, tratamento ou cuidado veterinário profissional. Sempre procure o " +
-	"conselho de um veterinário licenciado para quaisquer perguntas sobre a s" +
-	"aúde do seu animal de estimação.\x0a\x0a<b>2. Nenhuma Relação Veterinári" +
-	"o-Cliente-Paciente</b>\x0a2.1 Usar o Serviço ou interagir com nosso assi" +
-	"stente de IA não cria uma relação veterinário-cliente-paciente.\x0a2.2 Q" +
-	"ualquer conselho ou orientação fornecida pelo Serviço é baseada em infor" +
-	"mações limitadas e deve ser considerada apenas como informação geral." +
-	"\x0a\x0a<b>3. Limitação de Responsabilidade</b>\x0a3.1 Você reconhece e " +
-	"concorda que o uso do Serviço é por sua conta e risco.\x0a3.2 Em nenhuma" +
-	" circunstância os proprietários, desenvolvedores ou licenciadores do Ser" +
-	"viço serão responsáveis por quaisquer danos diretos, indiretos, incident" +
-	"ais, especiais ou consequenciais decorrentes ou relacionados ao seu aces" +
-	"so ou uso do Serviço.\x0a3.3 Você entende que as decisões sobre o cuidad" +
-	"o do seu animal de estimação e quaisquer resultados decorrentes são de s" +
-	"ua exclusiva responsabilidade. Se você tiver alguma dúvida sobre o bem-e" +
-	"star ou a saúde do seu animal de estimação, deve consultar imediatamente" +
-	" um veterinário licenciado.\x0a\x0a<b>4. Nenhuma Garantia</b>\x0a4.1 O S" +
-	"erviço é fornecido “como está” e “conforme disponível” sem garantias de " +
-	"qualquer tipo, expressas ou implícitas.\x0a4.2 Não garantimos que o Serv" +
-	"iço será ininterrupto, livre de erros, seguro ou livre de vírus.\x0a\x0a" +
-	"<b>5. Responsabilidades do Usuário</b>\x0a5.1 Você é responsável por for" +
-	"necer informações precisas e completas sobre seu animal de estimação ao " +
-	"buscar aconselhamento.\x0a5.2 Você deve garantir que todas as perguntas," +
-	" descrições e dados fornecidos não violem direitos de terceiros ou leis " +
-	"locais.\x0a\x0a<b>6. Uso Internacional</b>\x0a6.1 O Serviço é destinado " +
-	"ao uso global. Você é responsável pelo cumprimento de todas as leis e re" +
-	"gulamentos locais aplicáveis em sua jurisdição.\x0a6.2 Não garantimos qu" +
-	"e o Serviço ou qualquer um de seus conteúdos seja apropriado ou permitid" +
-	"o em qualquer país ou região específica.\x0a\x0a<b>7. Modificações</b>" +
-	"\x0a7.1 Reservamo-nos o direito de modificar ou substituir estes Termos " +
-	"a qualquer momento.\x0a7.2 Se fizermos alterações materiais, publicaremo" +
-	"s os Termos atualizados e indicaremos a data da última revisão no topo d" +
-	"este documento.\x0a\x0a<b>8. Lei Aplicável e Resolução de Disputas</b>" +
-	"\x0a8.1 Estes Termos serão regidos e interpretados de acordo com as leis" +
-	" aplicáveis na jurisdição do principal local de negócios do provedor do " +
-	"Serviço, sem considerar os princípios de conflito de leis.\x0a8.2 Qualqu" +
-	"er disputa decorrente ou relacionada a estes Termos será resolvida por m" +
-	"eio de negociação amigável e, se necessário, por arbitragem vinculativa " +
-	"ou litígio nos tribunais aplicáveis.\x0a\x0a<b>9. Aceitação dos Termos</" +
-	"b>\x0a9.1 Ao continuar a acessar ou usar o Serviço, você reconhece que l" +
-	"eu, entendeu e concorda em estar vinculado por estes Termos.\x0a9.2 Se v" +
-	"ocê não concordar, deve cessar o uso do Serviço imediatamente.\x0a\x0aSe" +
-	" você tiver alguma dúvida ou preocupação em relação a estes Termos, ou s" +
-	"e precisar de mais esclarecimentos, entre em contato pelo <i>k.sysoev@me" +
-	".com</i>.\x02<b>Comandos do Help My Pet Bot</b>:\x0a/start - Iniciar a c" +
-	"onversa com o bot\x0a/terms - Ver os Termos e Condições do serviço\x0a/e" +
-	"ditprofile - Atualizar as informações do perfil do seu animal de estimaç" +
-	"ão, como nome, idade, raça, etc. Essas informações ajudam o bot a forne" +
-	"cer conselhos mais precisos.\x0a/cancel - Cancelar o questionário atual," +
-	" se houver algum em andamento (por exemplo, quando deseja recomeçar ou a" +
-	"lterar a sua pergunta)\x0a/help - Ver esta mensagem de ajuda\x02Desculpe" +
-	", não consigo processar vídeos, áudio ou documentos. Por favor, envie a " +
-	"sua pergunta apenas como texto.\x02Peço desculpa, mas a sua mensagem é m" +
-	"uito longa para eu processar. Por favor, tente torná-la mais curta e con" +
-	"cisa.\x02Você atingiu o número máximo de solicitações por hora. Por favo" +
-	"r, tente novamente mais tarde.\x02Atingimos o nosso limite diário de ped" +
-	"idos. Por favor, volte amanhã quando o nosso orçamento for atualizado." +
-	"\x02Por favor, forneça a sua pergunta em formato de texto juntamente com" +
-	" foto(s)\x02Por favor, forneça pelo menos uma foto\x02Por favor, forneça" +
-	" no máximo %[1]d foto(s)\x02Desculpe, encontrei um erro ao processar o s" +
-	"eu pedido. Por favor, tente novamente mais tarde.\x02A data fornecida nã" +
-	"o pode estar no futuro. Por favor, forneça uma data válida.\x02Por favor" +
-	", forneça uma data no formato válido AAAA-MM-DD (por exemplo, 2023-12-31" +
-	")\x02Perfil do animal de estimação salvo com sucesso\x02Qual é o nome do" +
-	" seu animal de estimação?\x02Que tipo de animal de estimação você tem?" +
+const pt_PTData string = "" + // Size: 10503 bytes
+	"\x02Questionário cancelado\x02A sua conversa e os perfis dos seus animai" +
+	"s foram removidos.\x02Peço desculpa, mas a sua mensagem é muito longa pa" +
+	"ra eu processar. Por favor, tente torná-la mais curta e concisa.\x02Você" +
+	" atingiu o número máximo de solicitações por hora. Por favor, tente nova" +
+	"mente mais tarde.\x02Esgotou o seu limite de perguntas por agora. Tente " +
+	"novamente mais tarde.\x02Atingimos o nosso limite diário de pedidos. Por" +
+	" favor, volte amanhã quando o nosso orçamento for atualizado.\x02A sua c" +
+	"onversa foi alterada por outra mensagem enquanto eu processava esta. Env" +
+	"ie-a novamente.\x02Desculpe, encontrei um erro ao processar o seu pedido" +
+	". Por favor, tente novamente mais tarde.\x02Comando desconhecido\x02Bem-" +
+	"vindo ao Help My Pet Bot! 🐾\x0a\x0aSou o seu assistente pessoal de cuida" +
+	"dos com animais de estimação, pronto para fornecer orientação para os se" +
+	"us amigos peludos. Posso ajudar com:\x0a\x0a- Preocupações de saúde e av" +
+	"aliação de sintomas\x0a- Questões de comportamento e técnicas de treino" +
+	"\x0a- Recomendações de dieta e nutrição\x0a- Conselhos gerais de cuidado" +
+	"s e bem-estar para animais de estimação\x0a\x0aBasta digitar a sua pergu" +
+	"nta ou preocupação sobre o seu animal de estimação. Também pode incluir " +
+	"fotos para me ajudar a entender melhor a sua situação.\x0a\x0aLembre-se," +
+	" embora eu ofereça orientações úteis com base em conhecimentos veterinár" +
+	"ios confiáveis, não substituo os cuidados veterinários profissionais. Co" +
+	"nsulte sempre um veterinário em caso de preocupações médicas graves.\x0a" +
+	"\x0aCom que pergunta sobre animais de estimação posso ajudar hoje?\x02<b" +
+	">Termos e Condições</b>\x0a<i>Última atualização: 30.01.2025</i>\x0a\x0a" +
+	"Obrigado por usar nosso chatbot de aconselhamento veterinário (“o Serviç" +
+	"o”). Ao acessar ou usar este Serviço, você concorda em estar vinculado p" +
+	"elos seguintes termos e condições (“Termos”). Se você não concordar com " +
+	"estes Termos, interrompa o uso imediatamente.\x0a\x0a<b>1. Natureza do S" +
+	"erviço</b>\x0a1.1 O Serviço fornece informações gerais, orientações e su" +
+	"gestões para cuidados com animais de estimação, incluindo (mas não se li" +
+	"mitando a) dieta, comportamento e treinamento.\x0a1.2 O Serviço não subs" +
+	"titui o diagnóstico, tratamento ou cuidado veterinário profissional. Sem" +
+	"pre procure o conselho de um veterinário licenciado para quaisquer pergu" +
+	"ntas sobre a saúde do seu animal de estimação.\x0a\x0a<b>2. Nenhuma Rela" +
+	"ção Veterinário-Cliente-Paciente</b>\x0a2.1 Usar o Serviço ou interagir" +
+	" com nosso assistente de IA não cria uma relação veterinário-cliente-pac" +
+	"iente.\x0a2.2 Qualquer conselho ou orientação fornecida pelo Serviço é b" +
+	"aseada em informações limitadas e deve ser considerada apenas como infor" +
+	"mação geral.\x0a\x0a<b>3. Limitação de Responsabilidade</b>\x0a3.1 Você " +
+	"reconhece e concorda que o uso do Serviço é por sua conta e risco.\x0a3." +
+	"2 Em nenhuma circunstância os proprietários, desenvolvedores ou licencia" +
+	"dores do Serviço serão responsáveis por quaisquer danos diretos, indiret" +
+	"os, incidentais, especiais ou consequenciais decorrentes ou relacionados" +
+	" ao seu acesso ou uso do Serviço.\x0a3.3 Você entende que as decisões so" +
+	"bre o cuidado do seu animal de estimação e quaisquer resultados decorren" +
+	"tes são de sua exclusiva responsabilidade. Se você tiver alguma dúvida s" +
+	"obre o bem-estar ou a saúde do seu animal de estimação, deve consultar i" +
+	"mediatamente um veterinário licenciado.\x0a\x0a<b>4. Nenhuma Garantia</b" +
+	">\x0a4.1 O Serviço é fornecido “como está” e “conforme disponível” sem g" +
+	"arantias de qualquer tipo, expressas ou implícitas.\x0a4.2 Não garantimo" +
+	"s que o Serviço será ininterrupto, livre de erros, seguro ou livre de ví" +
+	"rus.\x0a\x0a<b>5. Responsabilidades do Usuário</b>\x0a5.1 Você é respons" +
+	"ável por fornecer informações precisas e completas sobre seu animal de " +
+	"estimação ao buscar aconselhamento.\x0a5.2 Você deve garantir que todas " +
+	"as perguntas, descrições e dados fornecidos não violem direitos de terce" +
+	"iros ou leis locais.\x0a\x0a<b>6. Uso Internacional</b>\x0a6.1 O Serviço" +
+	" é destinado ao uso global. Você é responsável pelo cumprimento de todas" +
+	" as leis e regulamentos locais aplicáveis em sua jurisdição.\x0a6.2 Não " +
+	"garantimos que o Serviço ou qualquer um de seus conteúdos seja apropriad" +
+	"o ou permitido em qualquer país ou região específica.\x0a\x0a<b>7. Modif" +
+	"icações</b>\x0a7.1 Reservamo-nos o direito de modificar ou substituir es" +
+	"tes Termos a qualquer momento.\x0a7.2 Se fizermos alterações materiais, " +
+	"publicaremos os Termos atualizados e indicaremos a data da última revisã" +
+	"o no topo deste documento.\x0a\x0a<b>8. Lei Aplicável e Resolução de Dis" +
+	"putas</b>\x0a8.1 Estes Termos serão regidos e interpretados de acordo co" +
+	"m as leis aplicáveis na jurisdição do principal local de negócios do pro" +
+	"vedor do Serviço, sem considerar os princípios de conflito de leis.\x0a8" +
+	".2 Qualquer disputa decorrente ou relacionada a estes Termos será resolv" +
+	"ida por meio de negociação amigável e, se necessário, por arbitragem vin" +
+	"culativa ou litígio nos tribunais aplicáveis.\x0a\x0a<b>9. Aceitação dos" +
+	" Termos</b>\x0a9.1 Ao continuar a acessar ou usar o Serviço, você reconh" +
+	"ece que leu, entendeu e concorda em estar vinculado por estes Termos." +
+	"\x0a9.2 Se você não concordar, deve cessar o uso do Serviço imediatament" +
+	"e.\x0a\x0aSe você tiver alguma dúvida ou preocupação em relação a estes " +
+	"Termos, ou se precisar de mais esclarecimentos, entre em contato pelo <i" +
+	">k.sysoev@me.com</i>.\x02<b>Comandos do Help My Pet Bot</b>:\x0a/start -" +
+	" Iniciar a conversa com o bot\x0a/terms - Ver os Termos e Condições do s" +
+	"erviço\x0a/editprofile - Atualizar as informações do perfil do seu anima" +
+	"l de estimação, como nome, idade, raça, etc. Essas informações ajudam o " +
+	"bot a fornecer conselhos mais precisos.\x0a/addpet - Adicionar o perfil " +
+	"de outro animal, se tiver mais do que um\x0a/pets - Ver os seus animais " +
+	"e qual está selecionado\x0a/switchpet - Escolher o animal a que se refer" +
+	"em as próximas perguntas\x0a/removepet - Remover o perfil de um animal" +
+	"\x0a/weight - Registar o peso atual do seu animal, p. ex. /weight 12.4kg" +
+	"\x0a/weightchart - Ver um gráfico do peso do seu animal ao longo do temp" +
+	"o\x0a/vaccines - Ver as vacinas e tratamentos preventivos em atraso dos " +
+	"seus animais\x0a/addvaccine - Adicionar um registo de vacina ou tratamen" +
+	"to preventivo do seu animal\x0a/remind - Criar um lembrete periódico, p." +
+	" ex. /remind give Rimadyl every 12h for 7 days\x0a/reminders - Ver os se" +
+	"us lembretes e eliminar os que não precisa\x0a/cancel - Cancelar o quest" +
+	"ionário atual, se houver algum em andamento (por exemplo, quando deseja " +
+	"recomeçar ou alterar a sua pergunta)\x0a/help - Ver esta mensagem de aju" +
+	"da\x02Esta resposta já não pode ser avaliada.\x02Obrigado pela sua opini" +
+	"ão!\x02Lamentamos que a resposta não tenha ajudado. O que estava errado" +
+	"? Responda a esta mensagem com um breve comentário, ou simplesmente igno" +
+	"re-a.\x02O que estava errado?\x02Obrigado, a sua opinião ajuda-nos a mel" +
+	"horar as respostas.\x02Desculpe, não consigo processar vídeos, áudio ou " +
+	"documentos. Por favor, envie a sua pergunta apenas como texto.\x02Os seu" +
+	"s animais:\x02Utilize /switchpet para escolher o animal a que se referem" +
+	" as suas perguntas.\x02Sobre que animal gostaria de perguntar?\x02Não en" +
+	"contrei nenhum animal chamado %[1]s. Utilize /pets para ver os seus anim" +
+	"ais.\x02As suas perguntas são agora sobre %[1]s.\x02Que perfil de animal" +
+	" gostaria de remover?\x02O perfil de %[1]s foi removido.\x02Ainda não te" +
+	"m perfis de animais. Utilize /editprofile ou /addpet para criar um.\x02P" +
+	"or favor, forneça a sua pergunta em formato de texto juntamente com foto" +
+	"(s)\x02Por favor, forneça pelo menos uma foto\x02Por favor, forneça no m" +
+	"áximo %[1]d foto(s)\x02Tem demasiados lembretes. Utilize /reminders par" +
+	"a eliminar os que não precisa.\x02Os lembretes não estão disponíveis nes" +
+	"te momento.\x02Lembrete criado: %[1]s, %[2]s.\x0aPróximo lembrete: %[3]s" +
+	"\x02Lembrete: %[1]s\x02Feito\x02Adiar 1 h\x02Este lembrete já não existe" +
+	".\x02Marcado como feito\x02Volto a lembrá-lo dentro de uma hora\x02Lembr" +
+	"ete eliminado\x02Não tem lembretes. Utilize /remind para criar um, p. ex" +
+	". /remind give Rimadyl every 12h for 7 days\x02Os seus lembretes:\x02Pró" +
+	"ximo: %[1]s\x02Diga-me o que devo lembrar e com que frequência, por exem" +
+	"plo:\x0a/remind give Rimadyl every 12h for 7 days\x0a/remind flea treatm" +
+	"ent monthly\x0a/remind brush teeth twice a day\x02🚨 EMERGÊNCIA: o seu an" +
+	"imal pode precisar de cuidados veterinários imediatos. Contacte agora o " +
+	"seu veterinário ou a clínica de urgência mais próxima.\x02⚠️ Recomendamo" +
+	"s uma consulta com o seu veterinário nos próximos um ou dois dias.\x02🏥 " +
+	"Encontrar um veterinário de urgência nas proximidades\x02%[1]s estava pr" +
+	"evisto para %[2]s\x02Não há vacinas nem tratamentos preventivos em atras" +
+	"o. Utilize /addvaccine para adicionar um novo registo.\x02Vacinas e trat" +
+	"amentos preventivos em atraso:\x02Contacte o seu veterinário para os age" +
+	"ndar e depois utilize /addvaccine para os registar.\x02Peso de %[1]s reg" +
+	"istado: %[2]s.\x02Utilize /weightchart para ver como varia ao longo do t" +
+	"empo.\x02Ainda não há registos de peso de %[1]s. Utilize /weight para ad" +
+	"icionar um, p. ex. /weight 12.4kg\x02Histórico de peso de %[1]s\x02Envie" +
+	" o peso com a respetiva unidade, p. ex. /weight 12.4kg ou /weight 9 lbs" +
+	"\x02Perfil do animal de estimação salvo com sucesso\x02A data fornecida " +
+	"não pode estar no futuro. Por favor, forneça uma data válida.\x02Por fav" +
+	"or, forneça uma data no formato válido AAAA-MM-DD (por exemplo, 2023-12-" +
+	"31)\x02A adicionar um registo de vacina ou tratamento preventivo para %[" +
+	"1]s.\x02%[1]s já não está entre os seus animais, por isso o registo não " +
+	"foi guardado.\x02Registo de %[1]s guardado para %[2]s\x02Qual é o nome d" +
+	"o seu animal de estimação?\x02Que tipo de animal de estimação você tem?" +
 	"\x02cão\x02gato\x02Qual é a raça do seu animal de estimação?\x02Quando n" +
 	"asceu o seu animal de estimação? Por favor, insira a data no formato AAA" +
 	"A-MM-DD (por exemplo, 2010-12-31).\x02Qual é o género do seu animal de e" +
@@ -1377,329 +2222,534 @@ const pt_PTData string = "" + // Size: 6844 bytes
 	"\x02Como descreveria o nível de atividade do seu animal de estimação?" +
 	"\x02baixo\x02médio\x02alto\x02O seu animal de estimação tem alguma doenç" +
 	"a crónica?\x02Quais são as preferências alimentares ou restrições dietét" +
-	"icas do seu animal de estimação?"
+	"icas do seu animal de estimação?\x02saltar\x02Que vacina ou tratamento p" +
+	"reventivo foi administrado (p. ex., raiva, desparasitação, tratamento an" +
+	"tipulgas)?\x02Quando foi administrado? Introduza a data no formato AAAA-" +
+	"MM-DD (p. ex., 2024-05-31).\x02Quando é a próxima dose? Introduza a data" +
+	" no formato AAAA-MM-DD, ou salte se não souber.\x02Que clínica o adminis" +
+	"trou?"
 
-var ru_RUIndex = []uint32{ // 36 elements
+var ru_RUIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000020, 0x00000046, 0x00000574,
-	0x00001f7c, 0x00002261, 0x00002341, 0x00002403,
-	0x0000249c, 0x00002568, 0x000025fb, 0x00002656,
-	0x000026b4, 0x0000275e, 0x000027f2, 0x00002879,
-	0x000028b7, 0x000028e6, 0x0000291e, 0x0000292b,
-	0x00002936, 0x0000296e, 0x00002a12, 0x00002a44,
-	0x00002a53, 0x00002a62, 0x00002b0a, 0x00002b58,
-	0x00002b5d, 0x00002b64, 0x00002bc5, 0x00002bd2,
+	0x00000000, 0x00000020, 0x00000067, 0x00000129,
+	0x000001c2, 0x00000241, 0x0000030d, 0x000003d0,
+	0x0000047a, 0x000004a0, 0x000009ce, 0x000023d6,
+	0x00002ae0, 0x00002b1e, 0x00002b45, 0x00002c2c,
+	0x00002c49, 0x00002ca2, 0x00002d82, 0x00002d9b,
+	0x00002e13, 0x00002e54, 0x00002ee2, 0x00002f20,
+	0x00002f6d, 0x00002f9f, 0x0000303a, 0x000030cd,
+	0x00003128, 0x00003186, 0x00003211, 0x0000324b,
 	// Entry 20 - 3F
-	0x00002be1, 0x00002bf0, 0x00002c47, 0x00002cd2,
-} // Size: 168 bytes
+	0x000032b1, 0x000032cf, 0x000032dc, 0x000032f7,
+	0x0000332e, 0x0000335d, 0x0000338c, 0x000033b2,
+	0x00003469, 0x0000348a, 0x000034a4, 0x0000356d,
+	0x0000368e, 0x000036f9, 0x0000374b, 0x00003768,
+	0x0000382a, 0x0000388c, 0x0000392b, 0x0000395e,
+	0x000039d2, 0x00003a81, 0x00003aae, 0x00003b25,
+	0x00003b63, 0x00003bf7, 0x00003c7e, 0x00003d0a,
+	0x00003d90, 0x00003dd6, 0x00003e05, 0x00003e3d,
+	// Entry 40 - 5F
+	0x00003e4a, 0x00003e55, 0x00003e8d, 0x00003f31,
+	0x00003f63, 0x00003f72, 0x00003f81, 0x00004029,
+	0x00004077, 0x0000407c, 0x00004083, 0x000040e4,
+	0x000040f1, 0x00004100, 0x0000410f, 0x00004166,
+	0x000041f1, 0x00004206, 0x000042c6, 0x0000434e,
+	0x000043ec, 0x00004420,
+} // Size: 368 bytes
 
-const ru_RUData string = "" + // Size: 11474 bytes
-	"\x02Опросник отменен\x02Неизвестная команда\x02Добро пожаловать в Help M" +
-	"y Pet Bot! 🐾\x0a\x0aЯ ваш личный помощник по уходу за питомцем, готовый " +
-	"предоставить рекомендации для ваших пушистых друзей. Я могу помочь с:" +
-	"\x0a\x0a- Здоровьем и оценкой симптомов\x0a- Вопросами поведения и метод" +
-	"иками дрессировки\x0a- Рекомендациями по диете и питанию\x0a- Общими со" +
-	"ветами по уходу за питомцем и его благополучием\x0a\x0aПросто введите в" +
-	"аш вопрос о вашем питомце. Вы также можете прикрепить фотографии, чтобы" +
-	" помочь мне лучше понять вашу ситуацию.\x0a\x0aПомните, что хотя я предл" +
-	"агаю полезные рекомендации на основе надежных ветеринарных знаний, я не" +
-	" заменяю профессиональный ветеринарный уход. Всегда консультируйтесь с в" +
-	"етеринаром по серьезным медицинским вопросам.\x0a\x0aС чем я могу помоч" +
-	"ь вам сегодня по вопросам питомца?\x02<b>Условия и положения</b>\x0a<i>" +
-	"Последнее обновление: 30.01.2025</i>\x0a\x0aСпасибо за использование на" +
-	"шего чат-бота для ветеринарных консультаций («Сервис»). Получая доступ " +
-	"или используя этот Сервис, вы соглашаетесь соблюдать следующие условия " +
-	"и положения («Условия»). Если вы не согласны с этими Условиями, пожалуй" +
-	"ста, немедленно прекратите использование.\x0a\x0a<b>1. Характер Сервиса" +
-	"</b>\x0a1.1 Сервис предоставляет общую информацию, рекомендации и предло" +
-	"жения по уходу за питомцами, включая (но не ограничиваясь) диету, повед" +
-	"ение и дрессировку.\x0a1.2 Сервис не является заменой профессиональной " +
-	"ветеринарной диагностики, лечения или ухода. Всегда обращайтесь за сове" +
-	"том к лицензированному ветеринару по любым вопросам, касающимся здоровь" +
-	"я вашего питомца.\x0a\x0a<b>2. Отсутствие ветеринарно-клиентских отноше" +
-	"ний</b>\x0a2.1 Использование Сервиса или взаимодействие с нашим AI-помо" +
-	"щником не создает ветеринарно-клиентских отношений.\x0a2.2 Любые советы" +
-	" или рекомендации, предоставляемые Сервисом, основаны на ограниченной ин" +
-	"формации и должны рассматриваться только как общая информация.\x0a\x0a<" +
-	"b>3. Ограничение ответственности</b>\x0a3.1 Вы признаете и соглашаетесь," +
-	" что использование Сервиса осуществляется на ваш собственный риск.\x0a3." +
-	"2 Ни при каких обстоятельствах владельцы, разработчики или лицензиары Се" +
-	"рвиса не несут ответственности за любые прямые, косвенные, случайные, о" +
-	"собые или косвенные убытки, возникающие в связи с вашим доступом к Серв" +
-	"ису или его использованием.\x0a3.3 Вы понимаете, что решения, касающиес" +
-	"я ухода за вашим питомцем и любые возникающие последствия, являются ваш" +
-	"ей личной ответственностью. Если у вас есть сомнения относительно благо" +
-	"получия или здоровья вашего питомца, немедленно обратитесь к лицензиров" +
-	"анному ветеринару.\x0a\x0a<b>4. Отсутствие гарантии</b>\x0a4.1 Сервис п" +
-	"редоставляется на условиях «как есть» и «по мере доступности» без каких" +
-	"-либо гарантий, явных или подразумеваемых.\x0a4.2 Мы не гарантируем, что" +
-	" Сервис будет непрерывным, безошибочным, безопасным или свободным от вир" +
-	"усов.\x0a\x0a<b>5. Обязанности пользователя</b>\x0a5.1 Вы несете ответс" +
-	"твенность за предоставление точной и полной информации о вашем питомце " +
-	"при обращении за советом.\x0a5.2 Вы должны убедиться, что все вопросы, " +
-	"описания и данные, которые вы предоставляете, не нарушают права третьих" +
-	" лиц или местные законы.\x0a\x0a<b>6. Международное использование</b>" +
-	"\x0a6.1 Сервис предназначен для глобального использования. Вы несете отв" +
-	"етственность за соблюдение всех применимых местных законов и нормативны" +
-	"х актов в вашей юрисдикции.\x0a6.2 Мы не гарантируем, что Сервис или лю" +
-	"бой его контент является подходящим или допустимым в какой-либо конкрет" +
-	"ной стране или регионе.\x0a\x0a<b>7. Изменения</b>\x0a7.1 Мы оставляем " +
-	"за собой право изменять или заменять эти Условия в любое время.\x0a7.2 " +
-	"Если мы внесем существенные изменения, мы опубликуем обновленные Услови" +
-	"я и укажем дату последней редакции в верхней части этого документа.\x0a" +
-	"\x0a<b>8. Применимое право и разрешение споров</b>\x0a8.1 Эти Условия ре" +
-	"гулируются и толкуются в соответствии с законами, применимыми в юрисдик" +
-	"ции основного места ведения бизнеса поставщика Сервиса, без учета принц" +
-	"ипов коллизионного права.\x0a8.2 Любой спор, возникающий из этих Услови" +
-	"й или в связи с ними, должен разрешаться путем дружественных переговоро" +
-	"в и, при необходимости, путем обязательного арбитража или судебного раз" +
-	"бирательства в соответствующих судах.\x0a\x0a<b>9. Принятие условий</b>" +
-	"\x0a9.1 Продолжая доступ или использование Сервиса, вы подтверждаете, чт" +
-	"о прочитали, поняли и согласны соблюдать эти Условия.\x0a9.2 Если вы не" +
-	" согласны, вы должны немедленно прекратить использование Сервиса.\x0a" +
-	"\x0aЕсли у вас есть вопросы или сомнения по поводу этих Условий, или есл" +
-	"и вам нужна дополнительная информация, пожалуйста, свяжитесь с нами по " +
-	"адресу <i>k.sysoev@me.com</i>.\x02<b>Команды Help My Pet Bot</b>:\x0a/s" +
-	"tart - Начать разговор с ботом\x0a/terms - Просмотреть Условия и положен" +
-	"ия сервиса\x0a/editprofile - Обновить информацию профиля вашего питомца" +
-	", такую как имя, возраст, порода и т. д. Эта информация помогает боту пр" +
-	"едоставлять более точные советы.\x0a/cancel - Отменить текущий опрос, е" +
-	"сли он в процессе (например, когда вы хотите начать сначала или изменит" +
-	"ь свой вопрос)\x0a/help - Просмотреть это сообщение справки\x02Извините" +
-	", я не могу обрабатывать видео, аудио или документы. Пожалуйста, отправь" +
-	"те свой вопрос только в текстовом формате.\x02Извините, но ваше сообщен" +
-	"ие слишком длинное для обработки. Попробуйте сделать его более кратким " +
-	"и сжатым.\x02Вы достигли максимального количества запросов в час. Пожал" +
-	"уйста, попробуйте позже.\x02Мы достигли нашего ежедневного лимита запро" +
-	"сов. Пожалуйста, вернитесь завтра, когда наш бюджет будет обновлен.\x02" +
-	"Пожалуйста, предоставьте свой вопрос в текстовом формате вместе с фотог" +
-	"рафиями\x02Пожалуйста, предоставьте хотя бы одну фотографию\x02Пожалуйс" +
-	"та, предоставьте не более %[1]d фотографии(й)\x02Извините, я столкнулся" +
-	" с ошибкой при обработке вашего запроса. Пожалуйста, попробуйте позже." +
-	"\x02Указанная дата не может быть в будущем. Пожалуйста, укажите действит" +
-	"ельную дату.\x02Пожалуйста, укажите дату в допустимом формате ГГГГ-ММ-Д" +
-	"Д (например, 2023-12-31)\x02Профиль питомца успешно сохранен\x02Как зов" +
-	"ут вашего питомца?\x02Какое у вас домашнее животное?\x02собака\x02кошка" +
-	"\x02Какая порода у вашего питомца?\x02Когда родился ваш питомец? Пожалуй" +
-	"ста, введите дату в формате ГГГГ-ММ-ДД (например, 2010-12-31).\x02Какой" +
-	" пол у вашего питомца?\x02мужской\x02женский\x02Какой вес у вашего питом" +
-	"ца? Укажите вес, за которым следует единица измерения, например, 5 кг" +
-	"\x02Ваш питомец стерилизован или кастрирован?\x02да\x02нет\x02Как вы бы " +
-	"описали уровень активности вашего питомца?\x02низкий\x02средний\x02высо" +
-	"кий\x02У вашего питомца есть хронические заболевания?\x02Какие у вашего" +
-	" питомца предпочтения в питании или диетические ограничения?"
+const ru_RUData string = "" + // Size: 17440 bytes
+	"\x02Опросник отменен\x02Ваш диалог и профили питомцев удалены.\x02Извини" +
+	"те, но ваше сообщение слишком длинное для обработки. Попробуйте сделать" +
+	" его более кратким и сжатым.\x02Вы достигли максимального количества зап" +
+	"росов в час. Пожалуйста, попробуйте позже.\x02Вы исчерпали доступный ли" +
+	"мит вопросов. Пожалуйста, попробуйте позже.\x02Мы достигли нашего ежедн" +
+	"евного лимита запросов. Пожалуйста, вернитесь завтра, когда наш бюджет " +
+	"будет обновлен.\x02Пока я обрабатывал это сообщение, диалог был изменён" +
+	" другим сообщением. Пожалуйста, отправьте его ещё раз.\x02Извините, я ст" +
+	"олкнулся с ошибкой при обработке вашего запроса. Пожалуйста, попробуйте" +
+	" позже.\x02Неизвестная команда\x02Добро пожаловать в Help My Pet Bot! 🐾" +
+	"\x0a\x0aЯ ваш личный помощник по уходу за питомцем, готовый предоставить" +
+	" рекомендации для ваших пушистых друзей. Я могу помочь с:\x0a\x0a- Здоро" +
+	"вьем и оценкой симптомов\x0a- Вопросами поведения и методиками дрессиро" +
+	"вки\x0a- Рекомендациями по диете и питанию\x0a- Общими советами по уход" +
+	"у за питомцем и его благополучием\x0a\x0aПросто введите ваш вопрос о ва" +
+	"шем питомце. Вы также можете прикрепить фотографии, чтобы помочь мне лу" +
+	"чше понять вашу ситуацию.\x0a\x0aПомните, что хотя я предлагаю полезные" +
+	" рекомендации на основе надежных ветеринарных знаний, я не заменяю профе" +
+	"ссиональный ветеринарный уход. Всегда консультируйтесь с ветеринаром по" +
+	" серьезным медицинским вопросам.\x0a\x0aС чем я могу помочь вам сегодня " +
+	"по вопросам питомца?\x02<b>Условия и положения</b>\x0a<i>Последнее обно" +
+	"вление: 30.01.2025</i>\x0a\x0aСпасибо за использование нашего чат-бота " +
+	"для ветеринарных консультаций («Сервис»). Получая доступ или используя " +
+	"этот Сервис, вы соглашаетесь соблюдать следующие условия и положения («" +
+	"Условия»). Если вы не согласны с этими Условиями, пожалуйста, немедленн" +
+	"о прекратите использование.\x0a\x0a<b>1. Характер Сервиса</b>\x0a1.1 Се" +
+	"рвис предоставляет общую информацию, рекомендации и предложения по уход" +
+	"у за питомцами, включая (но не ограничиваясь) диету, поведение и дресси" +
+	"ровку.\x0a1.2 Сервис не является заменой профессиональной ветеринарной " +
+	"диагностики, лечения или ухода. Всегда обращайтесь за советом к лицензи" +
+	"рованному ветеринару по любым вопросам, касающимся здоровья вашего пито" +
+	"мца.\x0a\x0a<b>2. Отсутствие ветеринарно-клиентских отношений</b>\x0a2." +
+	"1 Использование Сервиса или взаимодействие с нашим AI-помощником не созд" +
+	"ает ветеринарно-клиентских отношений.\x0a2.2 Любые советы или рекоменда" +
+	"ции, предоставляемые Сервисом, основаны на ограниченной информации и до" +
+	"лжны рассматриваться только как общая информация.\x0a\x0a<b>3. Ограниче" +
+	"ние ответственности</b>\x0a3.1 Вы признаете и соглашаетесь, что использ" +
+	"ование Сервиса осуществляется на ваш собственный риск.\x0a3.2 Ни при ка" +
+	"ких обстоятельствах владельцы, разработчики или лицензиары Сервиса не н" +
+	"есут ответственности за любые прямые, косвенные, случайные, особые или " +
+	"косвенные убытки, возникающие в связи с вашим доступом к Сервису или ег" +
+	"о использованием.\x0a3.3 Вы понимаете, что решения, касающиеся ухода за" +
+	" вашим питомцем и любые возникающие последствия, являются вашей личной о" +
+	"тветственностью. Если у вас есть сомнения относительно благополучия или" +
+	" здоровья вашего питомца, немедленно обратитесь к лицензированному ветер" +
+	"инару.\x0a\x0a<b>4. Отсутствие гарантии</b>\x0a4.1 Сервис предоставляет" +
+	"ся на условиях «как есть» и «по мере доступности» без каких-либо гарант" +
+	"ий, явных или подразумеваемых.\x0a4.2 Мы не гарантируем, что Сервис буд" +
+	"ет непрерывным, безошибочным, безопасным или свободным от вирусов.\x0a" +
+	"\x0a<b>5. Обязанности пользователя</b>\x0a5.1 Вы несете ответственность " +
+	"за предоставление точной и полной информации о вашем питомце при обраще" +
+	"нии за советом.\x0a5.2 Вы должны убедиться, что все вопросы, описания и" +
+	" данные, которые вы предоставляете, не нарушают права третьих лиц или ме" +
+	"стные законы.\x0a\x0a<b>6. Международное использование</b>\x0a6.1 Серви" +
+	"с предназначен для глобального использования. Вы несете ответственность" +
+	" за соблюдение всех применимых местных законов и нормативных актов в ваш" +
+	"ей юрисдикции.\x0a6.2 Мы не гарантируем, что Сервис или любой его конте" +
+	"нт является подходящим или допустимым в какой-либо конкретной стране ил" +
+	"и регионе.\x0a\x0a<b>7. Изменения</b>\x0a7.1 Мы оставляем за собой прав" +
+	"о изменять или заменять эти Условия в любое время.\x0a7.2 Если мы внесе" +
+	"м существенные изменения, мы опубликуем обновленные Условия и укажем да" +
+	"ту последней редакции в верхней части этого документа.\x0a\x0a<b>8. При" +
+	"менимое право и разрешение споров</b>\x0a8.1 Эти Условия регулируются и" +
+	" толкуются в соответствии с законами, применимыми в юрисдикции основного" +
+	" места ведения бизнеса поставщика Сервиса, без учета принципов коллизион" +
+	"ного права.\x0a8.2 Любой спор, возникающий из этих Условий или в связи " +
+	"с ними, должен разрешаться путем дружественных переговоров и, при необх" +
+	"одимости, путем обязательного арбитража или судебного разбирательства в" +
+	" соответствующих судах.\x0a\x0a<b>9. Принятие условий</b>\x0a9.1 Продолж" +
+	"ая доступ или использование Сервиса, вы подтверждаете, что прочитали, п" +
+	"оняли и согласны соблюдать эти Условия.\x0a9.2 Если вы не согласны, вы " +
+	"должны немедленно прекратить использование Сервиса.\x0a\x0aЕсли у вас е" +
+	"сть вопросы или сомнения по поводу этих Условий, или если вам нужна доп" +
+	"олнительная информация, пожалуйста, свяжитесь с нами по адресу <i>k.sys" +
+	"oev@me.com</i>.\x02<b>Команды Help My Pet Bot</b>:\x0a/start - Начать ра" +
+	"зговор с ботом\x0a/terms - Просмотреть Условия и положения сервиса\x0a/" +
+	"editprofile - Обновить информацию профиля вашего питомца, такую как имя," +
+	" возраст, порода и т. д. Эта информация помогает боту предоставлять боле" +
+	"е точные советы.\x0a/addpet - Добавить профиль ещё одного питомца, если" +
+	" у вас их несколько\x0a/pets - Показать ваших питомцев и выбранного сейч" +
+	"ас\x0a/switchpet - Выбрать питомца, о котором будут следующие вопросы" +
+	"\x0a/removepet - Удалить профиль питомца\x0a/weight - Записать текущий в" +
+	"ес питомца, например /weight 12.4kg\x0a/weightchart - Посмотреть график" +
+	" веса питомца\x0a/vaccines - Показать просроченные прививки и профилакти" +
+	"ческие обработки ваших питомцев\x0a/addvaccine - Добавить запись о прив" +
+	"ивке или профилактической обработке питомца\x0a/remind - Создать повтор" +
+	"яющееся напоминание, например /remind give Rimadyl every 12h for 7 days" +
+	"\x0a/reminders - Показать напоминания и удалить ненужные\x0a/cancel - От" +
+	"менить текущий опрос, если он в процессе (например, когда вы хотите нач" +
+	"ать сначала или изменить свой вопрос)\x0a/help - Просмотреть это сообще" +
+	"ние справки\x02Этот ответ больше нельзя оценить.\x02Спасибо за ваш отзы" +
+	"в!\x02Жаль, что ответ не помог. Что с ним было не так? Ответьте на это " +
+	"сообщение коротким комментарием или просто проигнорируйте его.\x02Что б" +
+	"ыло не так?\x02Спасибо, ваш отзыв помогает нам улучшать ответы.\x02Изви" +
+	"ните, я не могу обрабатывать видео, аудио или документы. Пожалуйста, от" +
+	"правьте свой вопрос только в текстовом формате.\x02Ваши питомцы:\x02Исп" +
+	"ользуйте /switchpet, чтобы выбрать питомца, о котором ваши вопросы.\x02" +
+	"О каком питомце вы хотите спросить?\x02Я не нашёл питомца по имени %[1]" +
+	"s. Используйте /pets, чтобы увидеть своих питомцев.\x02Теперь ваши вопро" +
+	"сы о питомце %[1]s.\x02Профиль какого питомца вы хотите удалить?\x02Про" +
+	"филь питомца %[1]s удалён.\x02У вас пока нет профилей питомцев. Использ" +
+	"уйте /editprofile или /addpet, чтобы создать профиль.\x02Пожалуйста, пр" +
+	"едоставьте свой вопрос в текстовом формате вместе с фотографиями\x02Пож" +
+	"алуйста, предоставьте хотя бы одну фотографию\x02Пожалуйста, предоставь" +
+	"те не более %[1]d фотографии(й)\x02У вас слишком много напоминаний. Исп" +
+	"ользуйте /reminders, чтобы удалить ненужные.\x02Напоминания сейчас недо" +
+	"ступны.\x02Напоминание создано: %[1]s, %[2]s.\x0aСледующее напоминание:" +
+	" %[3]s\x02Напоминание: %[1]s\x02Готово\x02Отложить на 1 ч\x02Этого напом" +
+	"инания больше нет.\x02Отмечено как выполненное\x02Я напомню снова через" +
+	" час\x02Напоминание удалено\x02У вас нет напоминаний. Используйте /remin" +
+	"d, чтобы создать напоминание, например: /remind give Rimadyl every 12h f" +
+	"or 7 days\x02Ваши напоминания:\x02Следующее: %[1]s\x02Напишите, о чём и " +
+	"как часто вам напоминать, например:\x0a/remind give Rimadyl every 12h f" +
+	"or 7 days\x0a/remind flea treatment monthly\x0a/remind brush teeth twice" +
+	" a day\x02🚨 СРОЧНО: вашему питомцу может потребоваться немедленная ветер" +
+	"инарная помощь. Свяжитесь с ветеринаром или ближайшей круглосуточной кл" +
+	"иникой прямо сейчас.\x02⚠️ Рекомендуем посетить ветеринара в ближайшие " +
+	"день-два.\x02🏥 Найти ветклинику неотложной помощи рядом\x02%[1]s: срок " +
+	"был %[2]s\x02Просроченных прививок и профилактических обработок нет. Ис" +
+	"пользуйте /addvaccine, чтобы добавить новую запись.\x02Просроченные при" +
+	"вивки и профилактические обработки:\x02Свяжитесь с ветеринаром, чтобы з" +
+	"аписаться, а затем используйте /addvaccine, чтобы внести их.\x02Вес пит" +
+	"омца %[1]s записан: %[2]s.\x02Используйте /weightchart, чтобы увидеть, " +
+	"как он меняется со временем.\x02Для питомца %[1]s пока нет записей веса" +
+	". Используйте /weight, чтобы добавить запись, например /weight 12.4kg" +
+	"\x02История веса питомца %[1]s\x02Отправьте вес с единицей измерения, на" +
+	"пример /weight 12.4kg или /weight 9 lbs\x02Профиль питомца успешно сохр" +
+	"анен\x02Указанная дата не может быть в будущем. Пожалуйста, укажите дей" +
+	"ствительную дату.\x02Пожалуйста, укажите дату в допустимом формате ГГГГ" +
+	"-ММ-ДД (например, 2023-12-31)\x02Добавляем запись о прививке или профила" +
+	"ктической обработке для питомца %[1]s.\x02Питомца %[1]s больше нет сред" +
+	"и ваших питомцев, поэтому запись не сохранена.\x02Запись «%[1]s» сохран" +
+	"ена для питомца %[2]s\x02Как зовут вашего питомца?\x02Какое у вас домаш" +
+	"нее животное?\x02собака\x02кошка\x02Какая порода у вашего питомца?\x02К" +
+	"огда родился ваш питомец? Пожалуйста, введите дату в формате ГГГГ-ММ-ДД" +
+	" (например, 2010-12-31).\x02Какой пол у вашего питомца?\x02мужской\x02же" +
+	"нский\x02Какой вес у вашего питомца? Укажите вес, за которым следует ед" +
+	"иница измерения, например, 5 кг\x02Ваш питомец стерилизован или кастрир" +
+	"ован?\x02да\x02нет\x02Как вы бы описали уровень активности вашего питом" +
+	"ца?\x02низкий\x02средний\x02высокий\x02У вашего питомца есть хронически" +
+	"е заболевания?\x02Какие у вашего питомца предпочтения в питании или дие" +
+	"тические ограничения?\x02пропустить\x02Какая прививка или профилактичес" +
+	"кая обработка была сделана (например, от бешенства, от глистов, от блох" +
+	")?\x02Когда это было сделано? Введите дату в формате ГГГГ-ММ-ДД (наприме" +
+	"р, 2024-05-31).\x02Когда следующая доза? Введите дату в формате ГГГГ-ММ" +
+	"-ДД или пропустите, если не знаете.\x02В какой клинике это сделали?"
 
-var tr_TRIndex = []uint32{ // 36 elements
+var tr_TRIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000013, 0x00000024, 0x00000331,
-	0x00001215, 0x000013f1, 0x0000145f, 0x000014cb,
-	0x0000151e, 0x0000157a, 0x000015be, 0x000015e5,
-	0x00001611, 0x00001674, 0x000016b8, 0x00001714,
-	0x00001740, 0x00001762, 0x00001787, 0x0000178e,
-	0x00001793, 0x000017b6, 0x0000181e, 0x00001845,
-	0x0000184b, 0x00001851, 0x000018bd, 0x000018eb,
-	0x000018f0, 0x000018f7, 0x0000193a, 0x00001943,
+	0x00000000, 0x00000013, 0x0000004b, 0x000000b7,
+	0x0000010a, 0x00000156, 0x000001b2, 0x0000020a,
+	0x0000026d, 0x0000027e, 0x0000058b, 0x0000146f,
+	0x00001973, 0x00001998, 0x000019bf, 0x00001a3f,
+	0x00001a4c, 0x00001a9d, 0x00001b0b, 0x00001b22,
+	0x00001b7d, 0x00001bb8, 0x00001c1a, 0x00001c40,
+	0x00001c74, 0x00001c91, 0x00001cf1, 0x00001d35,
+	0x00001d5c, 0x00001d88, 0x00001dea, 0x00001e17,
 	// Entry 20 - 3F
-	0x00001948, 0x00001950, 0x00001990, 0x000019df,
-} // Size: 168 bytes
+	0x00001e5b, 0x00001e6e, 0x00001e7a, 0x00001e88,
+	0x00001eb0, 0x00001ed0, 0x00001efd, 0x00001f14,
+	0x00001f85, 0x00001f9e, 0x00001fad, 0x00002061,
+	0x000020fd, 0x00002152, 0x00002176, 0x00002196,
+	0x000021f5, 0x0000221f, 0x00002287, 0x000022ab,
+	0x000022f5, 0x0000234e, 0x00002363, 0x000023b7,
+	0x000023e3, 0x00002427, 0x00002483, 0x000024bc,
+	0x00002511, 0x00002535, 0x00002557, 0x0000257c,
+	// Entry 40 - 5F
+	0x00002583, 0x00002588, 0x000025ab, 0x00002613,
+	0x0000263a, 0x00002640, 0x00002646, 0x000026b2,
+	0x000026e0, 0x000026e5, 0x000026ec, 0x0000272f,
+	0x00002738, 0x0000273d, 0x00002745, 0x00002785,
+	0x000027d4, 0x000027d9, 0x0000282f, 0x00002882,
+	0x000028e1, 0x000028f9,
+} // Size: 368 bytes
 
-const tr_TRData string = "" + // Size: 6623 bytes
-	"\x02Anket iptal edildi\x02Bilinmeyen komut\x02Help My Pet Bot'a hoş geld" +
-	"iniz! 🐾\x0a\x0aTüylü dostlarınız için rehberlik sağlamaya hazır kişisel " +
-	"evcil hayvan bakım asistanınızım. Aşağıdaki konularda yardımcı olabiliri" +
-	"m:\x0a\x0a- Sağlık endişeleri ve semptom değerlendirmesi\x0a- Davranış s" +
-	"oruları ve eğitim teknikleri\x0a- Diyet ve beslenme önerileri\x0a- Genel" +
-	" evcil hayvan bakımı ve sağlık tavsiyeleri\x0a\x0aSadece evcil hayvanını" +
-	"zla ilgili sorununuzu veya endişenizi yazın. Durumunuzu daha iyi anlamam" +
-	"ı sağlamak için fotoğraflar da ekleyebilirsiniz.\x0a\x0aGüvenilir veter" +
-	"iner bilgilerine dayalı yararlı rehberlik sunarken, profesyonel veterine" +
-	"r bakımının yerine geçmediğimi unutmayın. Ciddi tıbbi endişeleriniz için" +
-	" her zaman bir veteriner hekime danışın.\x0a\x0aBugün size nasıl yardımc" +
-	"ı olabilirim?\x02<b>Şartlar ve Koşullar</b>\x0a<i>Son güncelleme: 30.01" +
-	".2025</i>\x0a\x0aVeterinerlik tavsiye chatbotumuzu (“Hizmet”) kullandığı" +
-	"nız için teşekkür ederiz. Bu Hizmete erişerek veya kullanarak, aşağıdaki" +
-	" şartlar ve koşullara (“Şartlar”) bağlı kalmayı kabul etmiş olursunuz. B" +
-	"u Şartları kabul etmiyorsanız, lütfen kullanımı hemen durdurun.\x0a\x0a<" +
-	"b>1. Hizmetin Niteliği</b>\x0a1.1 Hizmet, evcil hayvan bakımı için genel" +
-	" bilgi, rehberlik ve öneriler sağlar, bunlar (ancak bunlarla sınırlı olm" +
-	"amak üzere) diyet, davranış ve eğitim konularını içerir.\x0a1.2 Hizmet, " +
-	"profesyonel veteriner teşhisi, tedavisi veya bakımı yerine geçmez. Evcil" +
-	" hayvanınızın sağlığı ile ilgili herhangi bir sorunuz olduğunda her zama" +
-	"n lisanslı bir veterinerin tavsiyesini alın.\x0a\x0a<b>2. Veteriner-Müşt" +
-	"eri-Hasta İlişkisi Yok</b>\x0a2.1 Hizmeti kullanmak veya AI asistanımızl" +
-	"a etkileşime geçmek, bir veteriner-müşteri-hasta ilişkisi oluşturmaz." +
-	"\x0a2.2 Hizmet tarafından sağlanan herhangi bir tavsiye veya rehberlik, " +
-	"sınırlı bilgilere dayanmaktadır ve yalnızca genel bilgi olarak değerlend" +
-	"irilmelidir.\x0a\x0a<b>3. Sorumluluğun Sınırlandırılması</b>\x0a3.1 Hizm" +
-	"eti kullanmanın kendi sorumluluğunuzda olduğunu kabul ve beyan edersiniz" +
-	".\x0a3.2 Hizmetin sahipleri, geliştiricileri veya lisans verenleri, Hizm" +
-	"ete erişiminizden veya Hizmeti kullanımınızdan kaynaklanan doğrudan, dol" +
-	"aylı, tesadüfi, özel veya sonuç olarak ortaya çıkan zararlardan hiçbir k" +
-	"oşulda sorumlu tutulamaz.\x0a3.3 Evcil hayvanınızın bakımı ile ilgili ka" +
-	"rarlar ve ortaya çıkan sonuçlar tamamen sizin sorumluluğunuzdadır. Evcil" +
-	" hayvanınızın sağlığı veya refahı konusunda herhangi bir şüpheniz varsa," +
-	" derhal lisanslı bir veterinerle danışmalısınız.\x0a\x0a<b>4. Garanti Yo" +
-	"k</b>\x0a4.1 Hizmet, açık veya zımni herhangi bir garanti olmaksızın “ol" +
-	"duğu gibi” ve “mevcut olduğu gibi” sağlanmaktadır.\x0a4.2 Hizmetin kesin" +
-	"tisiz, hatasız, güvenli veya virüslerden arınmış olacağını garanti etmiy" +
-	"oruz.\x0a\x0a<b>5. Kullanıcı Sorumlulukları</b>\x0a5.1 Tavsiye alırken e" +
-	"vcil hayvanınız hakkında doğru ve eksiksiz bilgi sağlamak sizin sorumlul" +
-	"uğunuzdadır.\x0a5.2 Sağladığınız tüm soruların, açıklamaların ve veriler" +
-	"in üçüncü taraf haklarını veya yerel yasaları ihlal etmediğinden emin ol" +
-	"malısınız.\x0a\x0a<b>6. Uluslararası Kullanım</b>\x0a6.1 Hizmet, küresel" +
-	" kullanım için tasarlanmıştır. Bulunduğunuz yargı alanındaki tüm geçerli" +
-	" yerel yasa ve düzenlemelere uymaktan siz sorumlusunuz.\x0a6.2 Hizmetin " +
-	"veya içeriğinin herhangi bir belirli ülkede veya bölgede uygun veya izin" +
-	" verilebilir olduğunu garanti etmiyoruz.\x0a\x0a<b>7. Değişiklikler</b>" +
-	"\x0a7.1 Bu Şartları herhangi bir zamanda değiştirme veya yerine yenisini" +
-	" koyma hakkını saklı tutarız.\x0a7.2 Önemli değişiklikler yaparsak, günc" +
-	"ellenmiş Şartları yayınlayacak ve bu belgenin en üstünde en son revizyon" +
-	" tarihini belirteceğiz.\x0a\x0a<b>8. Geçerli Hukuk ve Uyuşmazlık Çözümü<" +
-	"/b>\x0a8.1 Bu Şartlar, Hizmet sağlayıcısının ana iş yerinin bulunduğu ya" +
-	"rgı alanında geçerli olan yasalara göre yönetilecek ve yorumlanacaktır, " +
-	"çatışma hukuku ilkelerine bakılmaksızın.\x0a8.2 Bu Şartlardan kaynaklan" +
-	"an veya bunlarla ilgili herhangi bir uyuşmazlık, dostane müzakere yoluyl" +
-	"a ve gerekirse bağlayıcı tahkim veya ilgili mahkemelerde dava yoluyla çö" +
-	"zülecektir.\x0a\x0a<b>9. Şartların Kabulü</b>\x0a9.1 Hizmete erişmeye ve" +
-	"ya kullanmaya devam ederek, bu Şartları okuduğunuzu, anladığınızı ve bağ" +
-	"lı kalmayı kabul ettiğinizi kabul edersiniz.\x0a9.2 Kabul etmiyorsanız, " +
-	"Hizmeti kullanmayı derhal bırakmalısınız.\x0a\x0aBu Şartlarla ilgili her" +
-	"hangi bir sorunuz veya endişeniz varsa veya daha fazla açıklama gerekiyo" +
-	"rsa, lütfen <i>k.sysoev@me.com</i> adresinden iletişime geçin.\x02<b>Hel" +
-	"p My Pet Bot Komutları</b>:\x0a/start - Bot ile sohbeti başlat\x0a/terms" +
-	" - Hizmetin Şartlarını ve Koşullarını görüntüle\x0a/editprofile - Evcil " +
-	"hayvanınızın adı, yaşı, cinsi gibi profil bilgilerini güncelle. Bu bilgi" +
-	"ler, botun daha doğru tavsiyeler sunmasına yardımcı olur.\x0a/cancel - E" +
-	"ğer devam eden bir anket varsa (örneğin, baştan başlamak veya sorunuzu " +
-	"değiştirmek istediğinizde) mevcut anketi iptal et\x0a/help - Bu yardım m" +
-	"esajını görüntüle\x02Üzgünüm, videoları, sesleri veya belgeleri işleyeme" +
-	"m. Lütfen sorunuzu yalnızca metin olarak gönderin.\x02Özür dilerim, anca" +
-	"k mesajınızı işlemem için çok uzun. Lütfen daha kısa ve öz olmasını dene" +
-	"yin.\x02Saatlik maksimum istek sayısına ulaştınız. Lütfen daha sonra tek" +
-	"rar deneyin.\x02Günlük istek limitimize ulaştık. Lütfen yarın geri gelin" +
-	", bütçemiz yenilendiğinde.\x02Lütfen sorunuzu metin formatında ve fotoğr" +
-	"aflarla birlikte verin\x02Lütfen en az bir fotoğraf sağlayın\x02Lütfen e" +
-	"n fazla %[1]d fotoğraf sağlayın\x02Üzgünüm, isteğinizi işlerken bir hata" +
-	" ile karşılaştım. Lütfen daha sonra tekrar deneyin.\x02Sağlanan tarih ge" +
-	"lecekte olamaz. Lütfen geçerli bir tarih girin.\x02Lütfen geçerli bir bi" +
-	"çimde YYYY-AA-GG (örneğin, 2023-12-31) biçiminde bir tarih girin\x02Evc" +
-	"il hayvan profili başarıyla kaydedildi\x02Evcil hayvanınızın adı nedir?" +
-	"\x02Hangi türde evcil hayvanınız var?\x02köpek\x02kedi\x02Evcil hayvanın" +
-	"ızın cinsi nedir?\x02Evcil hayvanınız ne zaman doğdu? Lütfen tarihi YYY" +
-	"Y-AA-GG (örneğin, 2010-12-31) biçiminde girin.\x02Evcil hayvanınızın cin" +
-	"siyeti nedir?\x02erkek\x02dişi\x02Evcil hayvanınızın ağırlığı nedir? Lüt" +
-	"fen birimle birlikte ağırlığı belirtin, örneğin, 5 kg\x02Evcil hayvanını" +
-	"z kısırlaştırıldı mı?\x02evet\x02hayır\x02Evcil hayvanınızın aktivite se" +
-	"viyesini nasıl tanımlarsınız?\x02düşük\x02orta\x02yüksek\x02Evcil hayvan" +
-	"ınızın herhangi bir kronik hastalığı var mı?\x02Evcil hayvanınızın yiye" +
-	"cek tercihleri veya diyet kısıtlamaları nelerdir?"
+const tr_TRData string = "" + // Size: 10489 bytes
+	"\x02Anket iptal edildi\x02Sohbetiniz ve evcil hayvan profilleriniz kaldı" +
+	"rıldı.\x02Özür dilerim, ancak mesajınızı işlemem için çok uzun. Lütfen d" +
+	"aha kısa ve öz olmasını deneyin.\x02Saatlik maksimum istek sayısına ulaş" +
+	"tınız. Lütfen daha sonra tekrar deneyin.\x02Şimdilik soru hakkınızı dold" +
+	"urdunuz. Lütfen daha sonra tekrar deneyin.\x02Günlük istek limitimize ul" +
+	"aştık. Lütfen yarın geri gelin, bütçemiz yenilendiğinde.\x02Bu mesajı iş" +
+	"lerken sohbetiniz başka bir mesajla değişti. Lütfen tekrar gönderin.\x02" +
+	"Üzgünüm, isteğinizi işlerken bir hata ile karşılaştım. Lütfen daha sonr" +
+	"a tekrar deneyin.\x02Bilinmeyen komut\x02Help My Pet Bot'a hoş geldiniz!" +
+	" 🐾\x0a\x0aTüylü dostlarınız için rehberlik sağlamaya hazır kişisel evcil" +
+	" hayvan bakım asistanınızım. Aşağıdaki konularda yardımcı olabilirim:" +
+	"\x0a\x0a- Sağlık endişeleri ve semptom değerlendirmesi\x0a- Davranış sor" +
+	"uları ve eğitim teknikleri\x0a- Diyet ve beslenme önerileri\x0a- Genel e" +
+	"vcil hayvan bakımı ve sağlık tavsiyeleri\x0a\x0aSadece evcil hayvanınızl" +
+	"a ilgili sorununuzu veya endişenizi yazın. Durumunuzu daha iyi anlamamı " +
+	"sağlamak için fotoğraflar da ekleyebilirsiniz.\x0a\x0aGüvenilir veterine" +
+	"r bilgilerine dayalı yararlı rehberlik sunarken, profesyonel veteriner b" +
+	"akımının yerine geçmediğimi unutmayın. Ciddi tıbbi endişeleriniz için he" +
+	"r zaman bir veteriner hekime danışın.\x0a\x0aBugün size nasıl yardımcı o" +
+	"labilirim?\x02<b>Şartlar ve Koşullar</b>\x0a<i>Son güncelleme: 30.01.202" +
+	"5</i>\x0a\x0aVeterinerlik tavsiye chatbotumuzu (“Hizmet”) kullandığınız " +
+	"için teşekkür ederiz. Bu Hizmete erişerek veya kullanarak, aşağıdaki şar" +
+	"tlar ve koşullara (“Şartlar”) bağlı kalmayı kabul etmiş olursunuz. Bu Şa" +
+	"rtları kabul etmiyorsanız, lütfen kullanımı hemen durdurun.\x0a\x0a<b>1." +
+	" Hizmetin Niteliği</b>\x0a1.1 Hizmet, evcil hayvan bakımı için genel bil" +
+	"gi, rehberlik ve öneriler sağlar, bunlar (ancak bunlarla sınırlı olmamak" +
+	" üzere) diyet, davranış ve eğitim konularını içerir.\x0a1.2 Hizmet, prof" +
+	"esyonel veteriner teşhisi, tedavisi veya bakımı yerine geçmez. Evcil hay" +
+	"vanınızın sağlığı ile ilgili herhangi bir sorunuz olduğunda her zaman li" +
+	"sanslı bir veterinerin tavsiyesini alın.\x0a\x0a<b>2. Veteriner-Müşteri-" +
+	"Hasta İlişkisi Yok</b>\x0a2.1 Hizmeti kullanmak veya AI asistanımızla et" +
+	"kileşime geçmek, bir veteriner-müşteri-hasta ilişkisi oluşturmaz.\x0a2.2" +
+	" Hizmet tarafından sağlanan herhangi bir tavsiye veya rehberlik, sınırlı" +
+	" bilgilere dayanmaktadır ve yalnızca genel bilgi olarak değerlendirilmel" +
+	"idir.\x0a\x0a<b>3. Sorumluluğun Sınırlandırılması</b>\x0a3.1 Hizmeti kul" +
+	"lanmanın kendi sorumluluğunuzda olduğunu kabul ve beyan edersiniz.\x0a3." +
+	"2 Hizmetin sahipleri, geliştiricileri veya lisans verenleri, Hizmete eri" +
+	"şiminizden veya Hizmeti kullanımınızdan kaynaklanan doğrudan, dolaylı, " +
+	"tesadüfi, özel veya sonuç olarak ortaya çıkan zararlardan hiçbir koşulda" +
+	" sorumlu tutulamaz.\x0a3.3 Evcil hayvanınızın bakımı ile ilgili kararlar" +
+	" ve ortaya çıkan sonuçlar tamamen sizin sorumluluğunuzdadır. Evcil hayva" +
+	"nınızın sağlığı veya refahı konusunda herhangi bir şüpheniz varsa, derha" +
+	"l lisanslı bir veterinerle danışmalısınız.\x0a\x0a<b>4. Garanti Yok</b>" +
+	"\x0a4.1 Hizmet, açık veya zımni herhangi bir garanti olmaksızın “olduğu " +
+	"gibi” ve “mevcut olduğu gibi” sağlanmaktadır.\x0a4.2 Hizmetin kesintisiz" +
+	", hatasız, güvenli veya virüslerden arınmış olacağını garanti etmiyoruz." +
+	"\x0a\x0a<b>5. Kullanıcı Sorumlulukları</b>\x0a5.1 Tavsiye alırken evcil " +
+	"hayvanınız hakkında doğru ve eksiksiz bilgi sağlamak sizin sorumluluğunu" +
+	"zdadır.\x0a5.2 Sağladığınız tüm soruların, açıklamaların ve verilerin üç" +
+	"üncü taraf haklarını veya yerel yasaları ihlal etmediğinden emin olmalı" +
+	"sınız.\x0a\x0a<b>6. Uluslararası Kullanım</b>\x0a6.1 Hizmet, küresel kul" +
+	"lanım için tasarlanmıştır. Bulunduğunuz yargı alanındaki tüm geçerli yer" +
+	"el yasa ve düzenlemelere uymaktan siz sorumlusunuz.\x0a6.2 Hizmetin veya" +
+	" içeriğinin herhangi bir belirli ülkede veya bölgede uygun veya izin ver" +
+	"ilebilir olduğunu garanti etmiyoruz.\x0a\x0a<b>7. Değişiklikler</b>\x0a7" +
+	".1 Bu Şartları herhangi bir zamanda değiştirme veya yerine yenisini koym" +
+	"a hakkını saklı tutarız.\x0a7.2 Önemli değişiklikler yaparsak, güncellen" +
+	"miş Şartları yayınlayacak ve bu belgenin en üstünde en son revizyon tari" +
+	"hini belirteceğiz.\x0a\x0a<b>8. Geçerli Hukuk ve Uyuşmazlık Çözümü</b>" +
+	"\x0a8.1 Bu Şartlar, Hizmet sağlayıcısının ana iş yerinin bulunduğu yargı" +
+	" alanında geçerli olan yasalara göre yönetilecek ve yorumlanacaktır, çat" +
+	"ışma hukuku ilkelerine bakılmaksızın.\x0a8.2 Bu Şartlardan kaynaklanan " +
+	"veya bunlarla ilgili herhangi bir uyuşmazlık, dostane müzakere yoluyla v" +
+	"e gerekirse bağlayıcı tahkim veya ilgili mahkemelerde dava yoluyla çözül" +
+	"ecektir.\x0a\x0a<b>9. Şartların Kabulü</b>\x0a9.1 Hizmete erişmeye veya " +
+	"kullanmaya devam ederek, bu Şartları okuduğunuzu, anladığınızı ve bağlı " +
+	"kalmayı kabul ettiğinizi kabul edersiniz.\x0a9.2 Kabul etmiyorsanız, Hiz" +
+	"meti kullanmayı derhal bırakmalısınız.\x0a\x0aBu Şartlarla ilgili herhan" +
+	"gi bir sorunuz veya endişeniz varsa veya daha fazla açıklama gerekiyorsa" +
+	", lütfen <i>k.sysoev@me.com</i> adresinden iletişime geçin.\x02<b>Help M" +
+	"y Pet Bot Komutları</b>:\x0a/start - Bot ile sohbeti başlat\x0a/terms - " +
+	"Hizmetin Şartlarını ve Koşullarını görüntüle\x0a/editprofile - Evcil hay" +
+	"vanınızın adı, yaşı, cinsi gibi profil bilgilerini güncelle. Bu bilgiler" +
+	", botun daha doğru tavsiyeler sunmasına yardımcı olur.\x0a/addpet - Bird" +
+	"en fazla evcil hayvanınız varsa başka bir evcil hayvanın profilini ekle" +
+	"\x0a/pets - Evcil hayvanlarınızı ve şu anda hangisinin seçili olduğunu g" +
+	"örüntüle\x0a/switchpet - Sonraki sorularınızın hangi evcil hayvanla ilg" +
+	"ili olacağını seç\x0a/removepet - Bir evcil hayvan profilini kaldır\x0a/" +
+	"weight - Evcil hayvanınızın güncel kilosunu kaydet, ör. /weight 12.4kg" +
+	"\x0a/weightchart - Evcil hayvanınızın zaman içindeki kilo grafiğini gör" +
+	"\x0a/vaccines - Evcil hayvanlarınızın gecikmiş aşılarını ve koruyucu ted" +
+	"avilerini listele\x0a/addvaccine - Evcil hayvanınız için aşı veya koruyu" +
+	"cu tedavi kaydı ekle\x0a/remind - Tekrarlayan bir hatırlatıcı ayarla, ör" +
+	". /remind give Rimadyl every 12h for 7 days\x0a/reminders - Hatırlatıcıl" +
+	"arınızı listele ve ihtiyacınız olmayanları sil\x0a/cancel - Eğer devam e" +
+	"den bir anket varsa (örneğin, baştan başlamak veya sorunuzu değiştirmek " +
+	"istediğinizde) mevcut anketi iptal et\x0a/help - Bu yardım mesajını görü" +
+	"ntüle\x02Bu yanıt artık değerlendirilemez.\x02Geri bildiriminiz için teş" +
+	"ekkürler!\x02Yanıtın yardımcı olmadığı için üzgünüz. Sorun neydi? Bu mes" +
+	"aja kısa bir yorumla yanıt verin veya görmezden gelin.\x02Sorun neydi?" +
+	"\x02Teşekkürler, geri bildiriminiz yanıtları iyileştirmemize yardımcı ol" +
+	"uyor.\x02Üzgünüm, videoları, sesleri veya belgeleri işleyemem. Lütfen so" +
+	"runuzu yalnızca metin olarak gönderin.\x02Evcil hayvanlarınız:\x02Sorula" +
+	"rınızın hangi evcil hayvanla ilgili olduğunu seçmek için /switchpet kull" +
+	"anın.\x02Hangi evcil hayvanınız hakkında soru sormak istersiniz?\x02%[1]" +
+	"s adında bir evcil hayvan bulamadım. Evcil hayvanlarınızı görmek için /p" +
+	"ets kullanın.\x02Sorularınız artık %[1]s hakkında.\x02Hangi evcil hayvan" +
+	" profilini kaldırmak istersiniz?\x02%[1]s profili kaldırıldı.\x02Henüz h" +
+	"iç evcil hayvan profiliniz yok. Oluşturmak için /editprofile veya /addpe" +
+	"t kullanın.\x02Lütfen sorunuzu metin formatında ve fotoğraflarla birlikt" +
+	"e verin\x02Lütfen en az bir fotoğraf sağlayın\x02Lütfen en fazla %[1]d f" +
+	"otoğraf sağlayın\x02Çok fazla hatırlatıcınız var. İhtiyacınız olmayanlar" +
+	"ı silmek için /reminders kullanın.\x02Hatırlatıcılar şu anda kullanılam" +
+	"ıyor.\x02Hatırlatıcı ayarlandı: %[1]s, %[2]s.\x0aSonraki hatırlatma: %[" +
+	"3]s\x02Hatırlatma: %[1]s\x02Tamamlandı\x021 saat ertele\x02Bu hatırlatıc" +
+	"ı artık mevcut değil.\x02Tamamlandı olarak işaretlendi\x02Bir saat sonr" +
+	"a size tekrar hatırlatacağım\x02Hatırlatıcı silindi\x02Hiç hatırlatıcını" +
+	"z yok. Oluşturmak için /remind kullanın, ör. /remind give Rimadyl every " +
+	"12h for 7 days\x02Hatırlatıcılarınız:\x02Sonraki: %[1]s\x02Size neyi ve " +
+	"ne sıklıkla hatırlatmam gerektiğini söyleyin, örneğin:\x0a/remind give R" +
+	"imadyl every 12h for 7 days\x0a/remind flea treatment monthly\x0a/remind" +
+	" brush teeth twice a day\x02🚨 ACİL DURUM: evcil hayvanınızın acil veteri" +
+	"ner bakımına ihtiyacı olabilir. Hemen veterinerinizle veya en yakın acil" +
+	" klinikle iletişime geçin.\x02⚠️ Önümüzdeki bir iki gün içinde veteriner" +
+	"inizi ziyaret etmenizi öneririz.\x02🏥 Yakındaki acil veterineri bul\x02%" +
+	"[1]s için son tarih %[2]s idi\x02Gecikmiş aşı veya koruyucu tedavi yok. " +
+	"Yeni bir kayıt eklemek için /addvaccine kullanın.\x02Gecikmiş aşılar ve " +
+	"koruyucu tedaviler:\x02Randevu almak için veterinerinizle iletişime geçi" +
+	"n, ardından kaydetmek için /addvaccine kullanın.\x02%[1]s için kilo kayd" +
+	"edildi: %[2]s.\x02Zaman içinde nasıl değiştiğini görmek için /weightchar" +
+	"t kullanın.\x02%[1]s için henüz kilo kaydı yok. Eklemek için /weight kul" +
+	"lanın, ör. /weight 12.4kg\x02%[1]s kilo geçmişi\x02Lütfen kiloyu birimiy" +
+	"le birlikte gönderin, ör. /weight 12.4kg veya /weight 9 lbs\x02Evcil hay" +
+	"van profili başarıyla kaydedildi\x02Sağlanan tarih gelecekte olamaz. Lüt" +
+	"fen geçerli bir tarih girin.\x02Lütfen geçerli bir biçimde YYYY-AA-GG (ö" +
+	"rneğin, 2023-12-31) biçiminde bir tarih girin\x02%[1]s için aşı veya kor" +
+	"uyucu tedavi kaydı ekleniyor.\x02%[1]s artık evcil hayvanlarınız arasınd" +
+	"a değil, bu yüzden kayıt kaydedilmedi.\x02%[2]s için %[1]s kaydı kaydedi" +
+	"ldi\x02Evcil hayvanınızın adı nedir?\x02Hangi türde evcil hayvanınız var" +
+	"?\x02köpek\x02kedi\x02Evcil hayvanınızın cinsi nedir?\x02Evcil hayvanını" +
+	"z ne zaman doğdu? Lütfen tarihi YYYY-AA-GG (örneğin, 2010-12-31) biçimin" +
+	"de girin.\x02Evcil hayvanınızın cinsiyeti nedir?\x02erkek\x02dişi\x02Evc" +
+	"il hayvanınızın ağırlığı nedir? Lütfen birimle birlikte ağırlığı belirti" +
+	"n, örneğin, 5 kg\x02Evcil hayvanınız kısırlaştırıldı mı?\x02evet\x02hayı" +
+	"r\x02Evcil hayvanınızın aktivite seviyesini nasıl tanımlarsınız?\x02düşü" +
+	"k\x02orta\x02yüksek\x02Evcil hayvanınızın herhangi bir kronik hastalığı " +
+	"var mı?\x02Evcil hayvanınızın yiyecek tercihleri veya diyet kısıtlamalar" +
+	"ı nelerdir?\x02atla\x02Hangi aşı veya koruyucu tedavi uygulandı (ör. ku" +
+	"duz, iç parazit, pire tedavisi)?\x02Ne zaman uygulandı? Lütfen tarihi YY" +
+	"YY-AA-GG biçiminde girin (ör. 2024-05-31).\x02Sonraki doz ne zaman? Lütf" +
+	"en tarihi YYYY-AA-GG biçiminde girin veya bilmiyorsanız atlayın.\x02Hang" +
+	"i klinik uyguladı?"
 
-var uk_UAIndex = []uint32{ // 36 elements
+var uk_UAIndex = []uint32{ // 86 elements
 	// Entry 0 - 1F
-	0x00000000, 0x00000028, 0x00000048, 0x000005a7,
-	0x00001dea, 0x000020db, 0x000021b2, 0x0000229e,
-	0x00002344, 0x00002402, 0x00002490, 0x000024e5,
-	0x0000253a, 0x000025f0, 0x00002675, 0x000026ff,
-	0x00002743, 0x00002774, 0x000027bc, 0x000027c9,
-	0x000027d0, 0x00002805, 0x000028b2, 0x000028e5,
-	0x000028f6, 0x00002903, 0x0000299e, 0x000029df,
-	0x000029e6, 0x000029eb, 0x00002a49, 0x00002a58,
+	0x00000000, 0x00000028, 0x0000007b, 0x00000167,
+	0x0000020d, 0x0000028d, 0x0000034b, 0x00000413,
+	0x000004c9, 0x000004e9, 0x00000a48, 0x0000228b,
+	0x000029af, 0x000029f4, 0x00002a1d, 0x00002b1d,
+	0x00002b38, 0x00002ba1, 0x00002c78, 0x00002c95,
+	0x00002d17, 0x00002d60, 0x00002dfb, 0x00002e43,
+	0x00002e94, 0x00002ece, 0x00002f71, 0x00002fff,
+	0x00003054, 0x000030a9, 0x00003131, 0x00003169,
 	// Entry 20 - 3F
-	0x00002a69, 0x00002a78, 0x00002adf, 0x00002b5d,
-} // Size: 168 bytes
+	0x000031cf, 0x000031ed, 0x000031fa, 0x0000321b,
+	0x00003256, 0x0000327f, 0x000032b4, 0x000032dc,
+	0x0000339b, 0x000033bc, 0x000033d4, 0x0000349f,
+	0x000035bb, 0x00003649, 0x000036a5, 0x000036c6,
+	0x0000377e, 0x000037d6, 0x00003874, 0x000038af,
+	0x00003925, 0x000039d6, 0x00003a07, 0x00003a7c,
+	0x00003ac0, 0x00003b45, 0x00003bcf, 0x00003c53,
+	0x00003cdd, 0x00003d25, 0x00003d56, 0x00003d9e,
+	// Entry 40 - 5F
+	0x00003dab, 0x00003db2, 0x00003de7, 0x00003e94,
+	0x00003ec7, 0x00003ed8, 0x00003ee5, 0x00003f80,
+	0x00003fc1, 0x00003fc8, 0x00003fcd, 0x0000402b,
+	0x0000403a, 0x0000404b, 0x0000405a, 0x000040c1,
+	0x0000413f, 0x00004154, 0x00004208, 0x00004290,
+	0x0000432a, 0x0000435a,
+} // Size: 368 bytes
 
-const uk_UAData string = "" + // Size: 11101 bytes
-	"\x02Опитування скасовано\x02Невідома команда\x02Ласкаво просимо до Help " +
-	"My Pet Bot! 🐾\x0a\x0aЯ ваш асістэнт па дагляду за домашнімі жывёламі, га" +
-	"товы дапамагчы вашым пухнатым сябрам. Я магу дапамагчы з:\x0a\x0a- Праб" +
-	"лемамі здароўя і ацэнкай сімптомаў\x0a- Пытаннямі паводзін і тэхнікай д" +
-	"рэсіравкі\x0a- Рэкамендацыямі па харчаванню і харчаванню\x0a- Агульнымі" +
-	" парадамі па дагляду за домашнімі жывёламі і дабрабыту\x0a\x0aПроста ўвя" +
-	"дзіце ваша пытанне або праблему з вашым пухнатым сябрам. Вы таксама мож" +
-	"аце дадаць фотаздымкі, каб я лепей разумеў ваша сітуацыю.\x0a\x0aПамята" +
-	"йце, што, хаця я прапаную карысныя парады на аснове надзейнай ветэрынар" +
-	"най ведамасці, я не замяню ветэрынарную дапамогу. За серыёзнымі медычны" +
-	"мі праблемамі заўсёды звяртайцеся да ветэрынара.\x0a\x0aЯкім пытаннем а" +
-	"бо праблемай з домашнімі жывёламі я магу вам дапамагчы сёння?\x02<b>Умо" +
-	"ви та положення</b>\x0a<i>Останнє оновлення: 30.01.2025</i>\x0a\x0aДяку" +
-	"ємо за використання нашого чат-бота для ветеринарних порад (“Сервіс”). " +
-	"Використовуючи цей Сервіс, ви погоджуєтеся з наступними умовами та поло" +
-	"женнями (“Умови”). Якщо ви не погоджуєтеся з цими Умовами, будь ласка, " +
-	"негайно припиніть використання.\x0a\x0a<b>1. Природа Сервісу</b>\x0a1.1" +
-	" Сервіс надає загальну інформацію, рекомендації та поради щодо догляду з" +
-	"а тваринами, включаючи (але не обмежуючись) дієту, поведінку та тренува" +
-	"ння.\x0a1.2 Сервіс не є заміною професійної ветеринарної діагностики, л" +
-	"ікування або догляду. Завжди звертайтеся до ліцензованого ветеринара з " +
-	"будь-якими питаннями щодо здоров'я вашого улюбленця.\x0a\x0a<b>2. Відсу" +
-	"тність відносин ветеринар-клієнт-пацієнт</b>\x0a2.1 Використання Сервіс" +
-	"у або взаємодія з нашим AI-помічником не створює відносин ветеринар-клі" +
-	"єнт-пацієнт.\x0a2.2 Будь-які поради або рекомендації, надані Сервісом, " +
-	"базуються на обмеженій інформації і повинні розглядатися лише як загаль" +
-	"на інформація.\x0a\x0a<b>3. Обмеження відповідальності</b>\x0a3.1 Ви ви" +
-	"знаєте і погоджуєтеся, що використання Сервісу здійснюється на ваш влас" +
-	"ний ризик.\x0a3.2 За жодних обставин власники, розробники або ліцензіар" +
-	"и Сервісу не несуть відповідальності за будь-які прямі, непрямі, випадк" +
-	"ові, спеціальні або наслідкові збитки, що виникають у зв'язку з вашим д" +
-	"оступом до або використанням Сервісу.\x0a3.3 Ви розумієте, що рішення щ" +
-	"одо догляду за вашим улюбленцем і будь-які наслідки цих рішень є вашою " +
-	"особистою відповідальністю. Якщо у вас є сумніви щодо здоров'я вашого у" +
-	"любленця, негайно зверніться до ліцензованого ветеринара.\x0a\x0a<b>4. " +
-	"Відсутність гарантій</b>\x0a4.1 Сервіс надається на умовах “як є” і “як" +
-	" доступно” без будь-яких гарантій, явних або неявних.\x0a4.2 Ми не гаран" +
-	"туємо, що Сервіс буде безперервним, безпомилковим, безпечним або без ві" +
-	"русів.\x0a\x0a<b>5. Обов'язки користувача</b>\x0a5.1 Ви несете відповід" +
-	"альність за надання точної та повної інформації про вашого улюбленця пр" +
-	"и зверненні за порадою.\x0a5.2 Ви повинні переконатися, що всі питання," +
-	" описи та дані, які ви надаєте, не порушують права третіх осіб або місце" +
-	"ві закони.\x0a\x0a<b>6. Міжнародне використання</b>\x0a6.1 Сервіс призн" +
-	"ачений для глобального використання. Ви несете відповідальність за дотр" +
-	"имання всіх застосовних місцевих законів та нормативних актів у вашій ю" +
-	"рисдикції.\x0a6.2 Ми не гарантуємо, що Сервіс або будь-який його вміст " +
-	"є відповідним або дозволеним у будь-якій конкретній країні або регіоні." +
-	"\x0a\x0a<b>7. Зміни</b>\x0a7.1 Ми залишаємо за собою право змінювати або" +
-	" замінювати ці Умови в будь-який час.\x0a7.2 Якщо ми внесемо суттєві змі" +
-	"ни, ми опублікуємо оновлені Умови та вкажемо дату останньої редакції на" +
-	" початку цього документа.\x0a\x0a<b>8. Законодавство та вирішення спорів" +
-	"</b>\x0a8.1 Ці Умови регулюються та тлумачаться відповідно до законів, щ" +
-	"о застосовуються в юрисдикції основного місця ведення бізнесу постачаль" +
-	"ника Сервісу, без урахування принципів колізійного права.\x0a8.2 Будь-я" +
-	"кий спір, що виникає з цих Умов або у зв'язку з ними, вирішується шляхо" +
-	"м дружніх переговорів і, за необхідності, шляхом обов'язкового арбітраж" +
-	"у або судового розгляду в компетентних судах.\x0a\x0a<b>9. Прийняття ум" +
-	"ов</b>\x0a9.1 Продовжуючи доступ до або використання Сервісу, ви підтве" +
-	"рджуєте, що прочитали, зрозуміли і погоджуєтеся дотримуватися цих Умов." +
-	"\x0a9.2 Якщо ви не погоджуєтеся, ви повинні негайно припинити використан" +
-	"ня Сервісу.\x0a\x0aЯкщо у вас є будь-які питання або занепокоєння щодо " +
-	"цих Умов, або якщо вам потрібні додаткові роз'яснення, будь ласка, зв'я" +
-	"жіться за адресою <i>k.sysoev@me.com</i>.\x02<b>Команди Help My Pet Bot" +
-	"</b>:\x0a/start - Почати розмову з ботом\x0a/terms - Переглянути Умови т" +
-	"а положення сервісу\x0a/editprofile - Оновити інформацію про профіль ва" +
-	"шого улюбленця, таку як ім'я, вік, порода тощо. Ця інформація допомагає" +
-	" боту надавати більш точні поради.\x0a/cancel - Скасувати поточне опитув" +
-	"ання, якщо воно вже в процесі (наприклад, коли ви хочете почати спочатк" +
-	"у або змінити своє питання)\x0a/help - Переглянути це довідкове повідом" +
-	"лення\x02Вибачте, я не можу обробляти відео, аудіо або документи. Будь " +
-	"ласка, надішліть своє питання лише у текстовому форматі.\x02Вибачте, ал" +
-	"е ваше повідомлення занадто довге для мене, щоб обробити. Будь ласка, с" +
-	"пробуйте зробити його коротшим і більш стислим.\x02Ви досягли максималь" +
-	"ної кількості запитів за годину. Будь ласка, спробуйте ще раз пізніше." +
-	"\x02Ми досягли нашого щоденного ліміту запитів. Будь ласка, повертайтеся" +
-	" завтра, коли оновиться наш бюджет.\x02Будь ласка, надайте своє питання " +
-	"у текстовому форматі разом з фотографією(ми)\x02Будь ласка, надайте при" +
-	"наймні одну фотографію\x02Будь ласка, надайте не більше %[1]d фотографі" +
-	"ї(й)\x02Вибачте, я стикнувся з помилкою під час обробки вашого запиту. " +
-	"Будь ласка, спробуйте ще раз пізніше.\x02Наданий дата не може бути у ма" +
-	"йбутньому. Будь ласка, вкажіть дійсну дату.\x02Будь ласка, вкажіть дату" +
-	" у правильному форматі РРРР-ММ-ДД (наприклад, 2023-12-31)\x02Профіль улю" +
-	"бленця успішно збережено\x02Як звати вашого улюбленця?\x02Якого типу у " +
-	"вас є домашній улюбленець?\x02собака\x02кіт\x02Яка порода вашого улюбле" +
-	"нця?\x02Коли народився ваш улюбленець? Будь ласка, введіть дату у форма" +
-	"ті РРРР-ММ-ДД (наприклад, 2010-12-31).\x02Яка стать вашого улюбленця?" +
-	"\x02чоловіча\x02жіноча\x02Яка вага вашого улюбленця? Будь ласка, вкажіть" +
-	" вагу, вказавши одиницю, наприклад, 5 кг\x02Чи стерилізовано вашого улюб" +
-	"ленця?\x02так\x02ні\x02Як ви оцінюєте рівень активності вашого улюбленц" +
-	"я?\x02низький\x02середній\x02високий\x02Чи має ваш улюбленець які-небуд" +
-	"ь хронічні захворювання?\x02Які у вашого улюбленця є вподобання щодо їж" +
-	"і або дієтичні обмеження?"
+const uk_UAData string = "" + // Size: 17242 bytes
+	"\x02Опитування скасовано\x02Вашу розмову та профілі улюбленців видалено." +
+	"\x02Вибачте, але ваше повідомлення занадто довге для мене, щоб обробити." +
+	" Будь ласка, спробуйте зробити його коротшим і більш стислим.\x02Ви дося" +
+	"гли максимальної кількості запитів за годину. Будь ласка, спробуйте ще " +
+	"раз пізніше.\x02Ви вичерпали доступний ліміт запитань. Будь ласка, спро" +
+	"буйте пізніше.\x02Ми досягли нашого щоденного ліміту запитів. Будь ласк" +
+	"а, повертайтеся завтра, коли оновиться наш бюджет.\x02Поки я обробляв ц" +
+	"е повідомлення, розмову було змінено іншим повідомленням. Будь ласка, н" +
+	"адішліть його ще раз.\x02Вибачте, я стикнувся з помилкою під час обробк" +
+	"и вашого запиту. Будь ласка, спробуйте ще раз пізніше.\x02Невідома кома" +
+	"нда\x02Ласкаво просимо до Help My Pet Bot! 🐾\x0a\x0aЯ ваш асістэнт па д" +
+	"агляду за домашнімі жывёламі, гатовы дапамагчы вашым пухнатым сябрам. Я" +
+	" магу дапамагчы з:\x0a\x0a- Праблемамі здароўя і ацэнкай сімптомаў\x0a- " +
+	"Пытаннямі паводзін і тэхнікай дрэсіравкі\x0a- Рэкамендацыямі па харчава" +
+	"нню і харчаванню\x0a- Агульнымі парадамі па дагляду за домашнімі жывёла" +
+	"мі і дабрабыту\x0a\x0aПроста ўвядзіце ваша пытанне або праблему з вашым" +
+	" пухнатым сябрам. Вы таксама можаце дадаць фотаздымкі, каб я лепей разум" +
+	"еў ваша сітуацыю.\x0a\x0aПамятайце, што, хаця я прапаную карысныя парад" +
+	"ы на аснове надзейнай ветэрынарнай ведамасці, я не замяню ветэрынарную " +
+	"дапамогу. За серыёзнымі медычнымі праблемамі заўсёды звяртайцеся да вет" +
+	"эрынара.\x0a\x0aЯкім пытаннем або праблемай з домашнімі жывёламі я магу" +
+	" вам дапамагчы сёння?\x02<b>Умови та положення</b>\x0a<i>Останнє оновлен" +
+	"ня: 30.01.2025</i>\x0a\x0aДякуємо за використання нашого чат-бота для в" +
+	"етеринарних порад (“Сервіс”). Використовуючи цей Сервіс, ви погоджуєтес" +
+	"я з наступними умовами та положеннями (“Умови”). Якщо ви не погоджуєтес" +
+	"я з цими Умовами, будь ласка, негайно припиніть використання.\x0a\x0a<b" +
+	">1. Природа Сервісу</b>\x0a1.1 Сервіс надає загальну інформацію, рекомен" +
+	"дації та поради щодо догляду за тваринами, включаючи (але не обмежуючис" +
+	"ь) дієту, поведінку та тренування.\x0a1.2 Сервіс не є заміною професійн" +
+	"ої ветеринарної діагностики, лікування або догляду. Завжди звертайтеся " +
+	"до ліцензованого ветеринара з будь-якими питаннями щодо здоров'я вашого" +
+	" улюбленця.\x0a\x0a<b>2. Відсутність відносин ветеринар-клієнт-пацієнт</" +
+	"b>\x0a2.1 Використання Сервісу або взаємодія з нашим AI-помічником не ст" +
+	"ворює відносин ветеринар-клієнт-пацієнт.\x0a2.2 Будь-які поради або рек" +
+	"омендації, надані Сервісом, базуються на обмеженій інформації і повинні" +
+	" розглядатися лише як загальна інформація.\x0a\x0a<b>3. Обмеження відпов" +
+	"ідальності</b>\x0a3.1 Ви визнаєте і погоджуєтеся, що використання Серві" +
+	"су здійснюється на ваш власний ризик.\x0a3.2 За жодних обставин власник" +
+	"и, розробники або ліцензіари Сервісу не несуть відповідальності за будь" +
+	"-які прямі, непрямі, випадкові, спеціальні або наслідкові збитки, що вин" +
+	"икають у зв'язку з вашим доступом до або використанням Сервісу.\x0a3.3 " +
+	"Ви розумієте, що рішення щодо догляду за вашим улюбленцем і будь-які на" +
+	"слідки цих рішень є вашою особистою відповідальністю. Якщо у вас є сумн" +
+	"іви щодо здоров'я вашого улюбленця, негайно зверніться до ліцензованого" +
+	" ветеринара.\x0a\x0a<b>4. Відсутність гарантій</b>\x0a4.1 Сервіс надаєть" +
+	"ся на умовах “як є” і “як доступно” без будь-яких гарантій, явних або н" +
+	"еявних.\x0a4.2 Ми не гарантуємо, що Сервіс буде безперервним, безпомилк" +
+	"овим, безпечним або без вірусів.\x0a\x0a<b>5. Обов'язки користувача</b>" +
+	"\x0a5.1 Ви несете відповідальність за надання точної та повної інформаці" +
+	"ї про вашого улюбленця при зверненні за порадою.\x0a5.2 Ви повинні пере" +
+	"конатися, що всі питання, описи та дані, які ви надаєте, не порушують п" +
+	"рава третіх осіб або місцеві закони.\x0a\x0a<b>6. Міжнародне використан" +
+	"ня</b>\x0a6.1 Сервіс призначений для глобального використання. Ви несет" +
+	"е відповідальність за дотримання всіх застосовних місцевих законів та н" +
+	"ормативних актів у вашій юрисдикції.\x0a6.2 Ми не гарантуємо, що Сервіс" +
+	" або будь-який його вміст є відповідним або дозволеним у будь-якій конкр" +
+	"етній країні або регіоні.\x0a\x0a<b>7. Зміни</b>\x0a7.1 Ми залишаємо за" +
+	" собою право змінювати або замінювати ці Умови в будь-який час.\x0a7.2 Я" +
+	"кщо ми внесемо суттєві зміни, ми опублікуємо оновлені Умови та вкажемо " +
+	"дату останньої редакції на початку цього документа.\x0a\x0a<b>8. Законо" +
+	"давство та вирішення спорів</b>\x0a8.1 Ці Умови регулюються та тлумачат" +
+	"ься відповідно до законів, що застосовуються в юрисдикції основного міс" +
+	"ця ведення бізнесу постачальника Сервісу, без урахування принципів колі" +
+	"зійного права.\x0a8.2 Будь-який спір, що виникає з цих Умов або у зв'яз" +
+	"ку з ними, вирішується шляхом дружніх переговорів і, за необхідності, ш" +
+	"ляхом обов'язкового арбітражу або судового розгляду в компетентних суда" +
+	"х.\x0a\x0a<b>9. Прийняття умов</b>\x0a9.1 Продовжуючи доступ до або вик" +
+	"ористання Сервісу, ви підтверджуєте, що прочитали, зрозуміли і погоджує" +
+	"теся дотримуватися цих Умов.\x0a9.2 Якщо ви не погоджуєтеся, ви повинні" +
+	" негайно припинити використання Сервісу.\x0a\x0aЯкщо у вас є будь-які пи" +
+	"тання або занепокоєння щодо цих Умов, або якщо вам потрібні додаткові р" +
+	"оз'яснення, будь ласка, зв'яжіться за адресою <i>k.sysoev@me.com</i>." +
+	"\x02<b>Команди Help My Pet Bot</b>:\x0a/start - Почати розмову з ботом" +
+	"\x0a/terms - Переглянути Умови та положення сервісу\x0a/editprofile - Он" +
+	"овити інформацію про профіль вашого улюбленця, таку як ім'я, вік, пород" +
+	"а тощо. Ця інформація допомагає боту надавати більш точні поради.\x0a/a" +
+	"ddpet - Додати профіль ще одного улюбленця, якщо у вас їх кілька\x0a/pet" +
+	"s - Показати ваших улюбленців і вибраного зараз\x0a/switchpet - Вибрати " +
+	"улюбленця, про якого будуть наступні запитання\x0a/removepet - Видалити" +
+	" профіль улюбленця\x0a/weight - Записати поточну вагу улюбленця, наприкл" +
+	"ад /weight 12.4kg\x0a/weightchart - Переглянути графік ваги улюбленця" +
+	"\x0a/vaccines - Показати прострочені щеплення та профілактичні обробки в" +
+	"аших улюбленців\x0a/addvaccine - Додати запис про щеплення або профілак" +
+	"тичну обробку улюбленця\x0a/remind - Створити повторюване нагадування, " +
+	"наприклад /remind give Rimadyl every 12h for 7 days\x0a/reminders - Пок" +
+	"азати нагадування та видалити непотрібні\x0a/cancel - Скасувати поточне" +
+	" опитування, якщо воно вже в процесі (наприклад, коли ви хочете почати с" +
+	"початку або змінити своє питання)\x0a/help - Переглянути це довідкове п" +
+	"овідомлення\x02Цю відповідь більше не можна оцінити.\x02Дякуємо за ваш " +
+	"відгук!\x02Шкода, що відповідь не допомогла. Що з нею було не так? Дайт" +
+	"е відповідь на це повідомлення коротким коментарем або просто проігнору" +
+	"йте його.\x02Що було не так?\x02Дякуємо, ваш відгук допомагає нам покра" +
+	"щувати відповіді.\x02Вибачте, я не можу обробляти відео, аудіо або доку" +
+	"менти. Будь ласка, надішліть своє питання лише у текстовому форматі." +
+	"\x02Ваші улюбленці:\x02Використовуйте /switchpet, щоб вибрати улюбленця," +
+	" про якого ваші запитання.\x02Про якого улюбленця ви хочете запитати?" +
+	"\x02Я не знайшов улюбленця на ім'я %[1]s. Використовуйте /pets, щоб поба" +
+	"чити своїх улюбленців.\x02Тепер ваші запитання про улюбленця %[1]s.\x02" +
+	"Профіль якого улюбленця ви хочете видалити?\x02Профіль улюбленця %[1]s " +
+	"видалено.\x02У вас ще немає профілів улюбленців. Використовуйте /editpr" +
+	"ofile або /addpet, щоб створити профіль.\x02Будь ласка, надайте своє пит" +
+	"ання у текстовому форматі разом з фотографією(ми)\x02Будь ласка, надайт" +
+	"е принаймні одну фотографію\x02Будь ласка, надайте не більше %[1]d фото" +
+	"графії(й)\x02У вас забагато нагадувань. Використовуйте /reminders, щоб " +
+	"видалити непотрібні.\x02Нагадування зараз недоступні.\x02Нагадування ст" +
+	"ворено: %[1]s, %[2]s.\x0aНаступне нагадування: %[3]s\x02Нагадування: %[" +
+	"1]s\x02Готово\x02Відкласти на 1 год\x02Цього нагадування більше немає." +
+	"\x02Позначено як виконане\x02Я нагадаю знову через годину\x02Нагадування" +
+	" видалено\x02У вас немає нагадувань. Використовуйте /remind, щоб створит" +
+	"и нагадування, наприклад: /remind give Rimadyl every 12h for 7 days\x02" +
+	"Ваші нагадування:\x02Наступне: %[1]s\x02Напишіть, про що і як часто вам" +
+	" нагадувати, наприклад:\x0a/remind give Rimadyl every 12h for 7 days\x0a" +
+	"/remind flea treatment monthly\x0a/remind brush teeth twice a day\x02🚨 Т" +
+	"ЕРМІНОВО: вашому улюбленцю може знадобитися негайна ветеринарна допомог" +
+	"а. Зв'яжіться з ветеринаром або найближчою цілодобовою клінікою просто " +
+	"зараз.\x02⚠️ Рекомендуємо відвідати ветеринара протягом найближчих одно" +
+	"го-двох днів.\x02🏥 Знайти ветклініку невідкладної допомоги поруч\x02%[1" +
+	"]s: термін був %[2]s\x02Прострочених щеплень і профілактичних обробок не" +
+	"має. Використовуйте /addvaccine, щоб додати новий запис.\x02Прострочені" +
+	" щеплення та профілактичні обробки:\x02Зв'яжіться з ветеринаром, щоб зап" +
+	"исатися, а потім використовуйте /addvaccine, щоб внести їх.\x02Вагу улю" +
+	"бленця %[1]s записано: %[2]s.\x02Використовуйте /weightchart, щоб побач" +
+	"ити, як вона змінюється з часом.\x02Для улюбленця %[1]s ще немає записі" +
+	"в ваги. Використовуйте /weight, щоб додати запис, наприклад /weight 12." +
+	"4kg\x02Історія ваги улюбленця %[1]s\x02Надішліть вагу з одиницею виміру," +
+	" наприклад /weight 12.4kg або /weight 9 lbs\x02Профіль улюбленця успішно" +
+	" збережено\x02Наданий дата не може бути у майбутньому. Будь ласка, вкажі" +
+	"ть дійсну дату.\x02Будь ласка, вкажіть дату у правильному форматі РРРР-" +
+	"ММ-ДД (наприклад, 2023-12-31)\x02Додаємо запис про щеплення або профіла" +
+	"ктичну обробку для улюбленця %[1]s.\x02Улюбленця %[1]s більше немає сер" +
+	"ед ваших улюбленців, тому запис не збережено.\x02Запис «%[1]s» збережен" +
+	"о для улюбленця %[2]s\x02Як звати вашого улюбленця?\x02Якого типу у вас" +
+	" є домашній улюбленець?\x02собака\x02кіт\x02Яка порода вашого улюбленця?" +
+	"\x02Коли народився ваш улюбленець? Будь ласка, введіть дату у форматі РР" +
+	"РР-ММ-ДД (наприклад, 2010-12-31).\x02Яка стать вашого улюбленця?\x02чол" +
+	"овіча\x02жіноча\x02Яка вага вашого улюбленця? Будь ласка, вкажіть вагу," +
+	" вказавши одиницю, наприклад, 5 кг\x02Чи стерилізовано вашого улюбленця?" +
+	"\x02так\x02ні\x02Як ви оцінюєте рівень активності вашого улюбленця?\x02н" +
+	"изький\x02середній\x02високий\x02Чи має ваш улюбленець які-небудь хроні" +
+	"чні захворювання?\x02Які у вашого улюбленця є вподобання щодо їжі або д" +
+	"ієтичні обмеження?\x02пропустити\x02Яке щеплення або профілактичну обро" +
+	"бку було зроблено (наприклад, від сказу, від глистів, від бліх)?\x02Кол" +
+	"и це було зроблено? Введіть дату у форматі РРРР-ММ-ДД (наприклад, 2024-" +
+	"05-31).\x02Коли наступна доза? Введіть дату у форматі РРРР-ММ-ДД або про" +
+	"пустіть, якщо не знаєте.\x02У якій клініці це зробили?"
 
-	// Total table size 117075 bytes (114KiB); checksum: 73B2F13C
+	// Total table size 184199 bytes (179KiB); checksum: E2A36996
@@ -1,4 +1,4 @@
-//go:generate gotext -srclang=en-GB update -out=catalog.go -lang=en-GB,ru-RU,es-ES,fr-FR,de-DE,ko-KR,tr-TR,it-IT,pl-PL,uk-UA,be-BY,nl-NL,ms-MY,pt-PT,ca-ES github.com/ksysoev/help-my-pet/pkg/...
+//go:generate gotext -srclang=en-GB update -out=catalog.go -lang=en-GB,ru-RU,es-ES,fr-FR,de-DE,ko-KR,tr-TR,it-IT,pl-PL,uk-UA,be-BY,nl-NL,ms-MY,pt-PT,ca-ES github.com/ksysoev/help-my-pet/pkg/api github.com/ksysoev/help-my-pet/pkg/bot github.com/ksysoev/help-my-pet/pkg/bot/middleware github.com/ksysoev/help-my-pet/pkg/cmd github.com/ksysoev/help-my-pet/pkg/core github.com/ksysoev/help-my-pet/pkg/core/conversation
 
 package i18n
 
@@ -0,0 +1,193 @@
+// Package metrics defines Prometheus metrics collected by the bot and exposes them over HTTP.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const namespace = "help_my_pet"
+
+const (
+	// RateLimitUser marks rejections caused by per user hourly or daily limits
+	RateLimitUser = "user"
+	// RateLimitGlobal marks rejections caused by the global daily limit
+	RateLimitGlobal = "global"
+	// RateLimitUserBudget marks rejections caused by per user spend ceilings
+	RateLimitUserBudget = "user_budget"
+
+	// TokensInput marks tokens sent to the model
+	TokensInput = "input"
+	// TokensOutput marks tokens generated by the model
+	TokensOutput = "output"
+
+	// RepairSucceeded marks repair attempts that produced a valid response
+	RepairSucceeded = "succeeded"
+	// RepairFailed marks repair attempts that produced an invalid response again
+	RepairFailed = "failed"
+
+	// BroadcastSent marks broadcast messages delivered to a chat
+	BroadcastSent = "sent"
+	// BroadcastFailed marks broadcast messages that couldn't be delivered
+	BroadcastFailed = "failed"
+)
+
+var (
+	// HandlerDuration tracks message handling latency by message type: command name, text or photo
+	HandlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "handler_duration_seconds",
+		Help:      "Time spent handling incoming messages.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 40, 60, 120},
+	}, []string{"type"})
+
+	// HandlerErrors counts messages whose handling ended with an error, by message type
+	HandlerErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "handler_errors_total",
+		Help:      "Number of messages whose handling failed.",
+	}, []string{"type"})
+
+	// RateLimitRejections counts new questions rejected by the rate limiter, by limit scope: user or global
+	RateLimitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_rejections_total",
+		Help:      "Number of questions rejected by rate limits.",
+	}, []string{"scope"})
+
+	// ThrottlerQueueDepth reports the number of messages waiting for a free processing slot
+	ThrottlerQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "throttler_queue_depth",
+		Help:      "Number of messages waiting for a free processing slot.",
+	})
+
+	// ReducerCancellations counts requests cancelled because a newer message arrived in the same chat
+	ReducerCancellations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reducer_cancellations_total",
+		Help:      "Number of requests cancelled in favour of a newer message from the same chat.",
+	})
+
+	// TriageLevels counts answers by the urgency level assessed by the LLM
+	TriageLevels = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "triage_levels_total",
+		Help:      "Number of answers by assessed urgency level.",
+	}, []string{"level"})
+
+	// LLMTokens counts tokens consumed by LLM calls, by model and direction: input or output
+	LLMTokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "llm_tokens_total",
+		Help:      "Number of tokens consumed by LLM calls.",
+	}, []string{"model", "direction"})
+
+	// LLMRepairAttempts counts re-prompts of the LLM after an invalid response, by outcome: succeeded or failed
+	LLMRepairAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "llm_repair_attempts_total",
+		Help:      "Number of LLM re-prompts after an unparseable or invalid response.",
+	}, []string{"outcome"})
+
+	// LLMRetries counts retries of failed LLM requests by model
+	LLMRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "llm_retries_total",
+		Help:      "Number of LLM requests retried after a transient error.",
+	}, []string{"model"})
+
+	// BroadcastMessages counts messages of broadcasts by delivery status: sent or failed
+	BroadcastMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "broadcast_messages_total",
+		Help:      "Number of broadcast messages by delivery status.",
+	}, []string{"status"})
+)
+
+// Config holds the configuration for the metrics endpoint
+// Listen is the address of the HTTP server exposing /metrics, the endpoint is disabled when empty.
+type Config struct {
+	Listen string `mapstructure:"listen"`
+}
+
+// Total returns the sum of all series of the counter vector since the process start, e.g. errors of all message types.
+func Total(counter *prometheus.CounterVec) float64 {
+	ch := make(chan prometheus.Metric)
+
+	go func() {
+		counter.Collect(ch)
+		close(ch)
+	}()
+
+	var total float64
+
+	for metric := range ch {
+		var m dto.Metric
+		if err := metric.Write(&m); err == nil && m.GetCounter() != nil {
+			total += m.GetCounter().GetValue()
+		}
+	}
+
+	return total
+}
+
+// Handler returns an HTTP handler exposing all registered metrics in Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve starts an HTTP server exposing metrics on /metrics at the configured address and blocks until ctx is cancelled.
+// It does nothing and returns immediately if no listen address is configured.
+// Returns an error if the server fails to listen or stops unexpectedly.
+func Serve(ctx context.Context, cfg Config) error {
+	if cfg.Listen == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.Listen, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	slog.InfoContext(ctx, "Metrics server started", slog.String("listen", listener.Addr().String()))
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("metrics server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to shutdown metrics server: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	LLMTokens.WithLabelValues("test-model", TokensInput).Add(10)
+	RateLimitRejections.WithLabelValues(RateLimitGlobal).Inc()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `help_my_pet_llm_tokens_total{direction="input",model="test-model"}`)
+	assert.Contains(t, rec.Body.String(), `help_my_pet_rate_limit_rejections_total{scope="global"}`)
+}
+
+func TestTotal(t *testing.T) {
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_total"}, []string{"type"})
+
+	assert.Zero(t, Total(counter))
+
+	counter.WithLabelValues("text").Add(2)
+	counter.WithLabelValues("photo").Inc()
+
+	assert.Equal(t, 3.0, Total(counter))
+}
+
+func TestServe(t *testing.T) {
+	t.Run("disabled without listen address", func(t *testing.T) {
+		assert.NoError(t, Serve(context.Background(), Config{}))
+	})
+
+	t.Run("invalid listen address", func(t *testing.T) {
+		assert.Error(t, Serve(context.Background(), Config{Listen: "invalid:address:1"}))
+	})
+
+	t.Run("stops on context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, Serve(ctx, Config{Listen: "127.0.0.1:0"}))
+	})
+}
@@ -62,6 +62,7 @@ Response length:
 const analyzeOutput = `Return your response in JSON format with this structure:
 {
    "reasoning": "Explain your thought process step by step and reasoning behind your decisions. This will help to understand your approach and make sure that you are following the guidelines.",
+   "urgency": "Triage level of the situation, one of: emergency, see_vet_soon, monitor, informational.",
    "text": "Use this section to provide textual response to the user's query. Include a clear, concise summary of the situation, key observations, and initial concerns. Address any immediate risks or critical symptoms. If additional information is needed, specify the gaps in the data and request relevant details.",
    "questions": [
     {
@@ -89,12 +90,20 @@ Notes for Implementation:
   - Provide a detailed explanation of your thought process and decision-making
   - Justify your response based on the information provided
   - Include any assumptions or uncertainties in your analysis
-4. Important, You should return ether text or questions, not both. If you have enough information to provide response, you should return text response. If you need more information, you should return questions.
+4. Urgency:
+  - Always fill this field, even when you return questions
+  - emergency: life-threatening signs that need immediate veterinary care, such as seizures, difficulty breathing, collapse, heavy bleeding, suspected poisoning, bloated abdomen with unproductive retching, inability to urinate, or major trauma
+  - see_vet_soon: signs that should be examined by a veterinarian within 24-48 hours, but are not immediately life-threatening
+  - monitor: mild signs that can be watched at home, advise what changes should lead to a vet visit
+  - informational: general questions about nutrition, training, behavior or care without health concerns
+  - When in doubt between two levels, choose the more urgent one
+5. Important, You should return ether text or questions, not both. If you have enough information to provide response, you should return text response. If you need more information, you should return questions.
 
 
 Example 1 - Acute Injury Case:
 {
   "reasoning": "The photos provide detailed visual information about the injury, including size, location, and surrounding tissue condition. This helps in assessing the severity and potential complications of the wound.",
+  "urgency": "see_vet_soon",
   "questions": [
     {
       "reason": "To assess the severity of the injury and determine the urgency of veterinary care.",
@@ -112,6 +121,7 @@ Example 1 - Acute Injury Case:
 Example 2 - Skin Condition Case:
 {
   "reasoning": "The photos provide detailed visual information about the skin condition, including lesion distribution, size, and texture. This helps in identifying the type of skin issue and potential triggers.",
+  "urgency": "monitor",
   "questions": [
     {
       "reason": "To assess the progression and severity of the skin condition.",
@@ -134,12 +144,14 @@ Example 2 - Skin Condition Case:
 Example 4 - Enough Information Provided: Training Advice
 {
   "reasoning": "The user has provided detailed information about the dog's behavior and the context in which the issue occurs. This allows for a targeted response focusing on separation anxiety management.",
+  "urgency": "informational",
   "text": "Based on the information provided, it seems that your dog is experiencing a behavioral issue related to separation anxiety. This is a common problem in dogs and can be managed with proper training and environmental enrichment. To help your dog cope with being alone, you can try the following strategies: 1. Gradual desensitization: Start by leaving your dog alone for short periods and gradually increase the time. 2. Enrichment activities: Provide interactive toys and puzzles to keep your dog mentally stimulated. 3. Calming aids: Consider using calming pheromones or music to help relax your dog when alone. If the problem persists or worsens, it's recommended to consult with a professional dog trainer or behaviorist for personalized guidance."
 }
 
 Example 5 - Enough Information Provided with photo: Nutrition Advice
 {
   "reasoning": "The photo of the dog food label provides essential information about the dog's current diet, allowing for a targeted response focusing on nutritional recommendations.",
+  "urgency": "informational",
   "text": "Based on the provided photo of the dog food label, it's important to ensure that your dog's diet meets their nutritional needs. Look for a high-quality dog food that lists a protein source as the first ingredient, avoids fillers like corn or by-products, and provides a balanced mix of nutrients. You can also consider consulting with a veterinarian or pet nutritionist to create a customized diet plan for your dog based on their specific needs and health conditions."
 }
 
@@ -7,15 +7,39 @@ import (
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 )
 
-// Model defines the interface for LLM interactions
+// Model defines the interface for LLM interactions.
+// turns are the conversation messages sent to the model, the last of them is the current request.
 type Model interface {
-	Call(ctx context.Context, systemPrompts, request string, imgs []*message.Image) (string, error)
+	Call(ctx context.Context, systemPrompts string, turns []message.Turn) (string, error)
 }
 
-const coreGuidelines = `Core Guidelines strictly:
+// StreamModel defines the interface for models able to stream the response text as it is generated.
+// onDelta is called with every new chunk of the response text.
+type StreamModel interface {
+	Model
+	Stream(ctx context.Context, systemPrompts string, turns []message.Turn, onDelta func(string)) (string, error)
+}
+
+// ToolModel defines the interface for models able to return the response as the input of a forced tool call.
+// The returned string is the JSON input of the tool call; StreamTool calls onDelta with every new chunk of it.
+type ToolModel interface {
+	Model
+	CallTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool) (string, error)
+	StreamTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool, onDelta func(string)) (string, error)
+}
+
+// omittedHistory opens the conversation when its history starts with an assistant turn,
+// as the conversation sent to the model must start with a user message.
+const omittedHistory = "Earlier messages of this conversation are omitted."
+
+// CoreGuidelines defines the base system instructions shared by all models answering pet owners' questions.
+// Models of other providers should send them as the first system instruction to keep behaviour consistent.
+const CoreGuidelines = `Core Guidelines strictly:
 1. Language Detection and Response:
   - ALWAYS analyze the language of the user's input first
   - MUST respond in the EXACT SAME language as the user's question
@@ -41,14 +65,14 @@ const coreGuidelines = `Core Guidelines strictly:
   - Be empathetic, supportive, and non-judgmental in your responses
 5. Request structure:
   - System Information: This section contains system-specific information that may help provide accurate advice or context.
-  - Pet Profile: This section contains the user's pet profile information, you can use this information to provide more accurate advice
-  - Previous conversation - this section contains previous messages of current conversation, this section may contain 3 types of message:
-    - user: user's message or question
-	- assistant: assistant's response
-	- questionnaire: user's responses to the assistant's questions
-    - media_description: detailed description of media content provided by user
-  - Follow-up information - this section contains the assistant's follow-up questions and user's responses. You should analyze last user's question and last assistant's response from the previous conversation section and based on follow-up information section, you provide final response. You SHOULD NOT ask additional question at this point. 
-  - Current question - this section contains the user's current question. You should analyze this question and if information is not enough, you can ask additional questions to get more details for you diagnosis. You can use previous conversation section to get more context.
+  - Pet Profile: This section contains the user's pet profile information, you can use this information to provide more accurate advice. It may include a Weight Trend line with the recent weight change; unexplained weight loss or gain can be a sign of a health issue and should be taken into account
+  - Vaccinations and Preventive Treatments: This section, when present, lists the pet's latest vaccinations and preventive treatments. Take into account what the pet is protected against and remind the user about treatments marked as OVERDUE when relevant
+  - Previous conversation - previous messages of current conversation are sent as separate user and assistant messages before the current request:
+    - Summary of earlier conversation: the first user message may contain the summary of older messages that are no longer included
+    - user: user's message or question, it may contain the Media content section with detailed description of media content provided by user
+    - assistant: assistant's response
+  - Follow-up information - this section contains the assistant's follow-up questions and user's responses. You should analyze last user's question and last assistant's response from the previous conversation and based on follow-up information section, you provide final response. You SHOULD NOT ask additional question at this point. 
+  - Current question - this section contains the user's current question. You should analyze this question and if information is not enough, you can ask additional questions to get more details for you diagnosis. You can use previous conversation to get more context.
   - Media content - this section contains textual description of media content provided by user. You should analyze this content and use it to provide more accurate advice.
 `
 
@@ -71,7 +95,8 @@ type anthropicModel struct {
 // when and how much to use extended reasoning based on request complexity.
 // Returns a pointer to an anthropicModel instance for interacting with Anthropic API and an error if client initialization fails.
 func newAnthropicModel(apiKey string, modelID string, maxTokens int, thinking bool) (*anthropicModel, error) {
-	client := anthropic.NewClient(option.WithAPIKey(apiKey))
+	// Retries are handled by resilientModel, which falls back to other models once they are exhausted
+	client := anthropic.NewClient(option.WithAPIKey(apiKey), option.WithMaxRetries(0))
 
 	return &anthropicModel{
 		client:    client,
@@ -81,27 +106,116 @@ func newAnthropicModel(apiKey string, modelID string, maxTokens int, thinking bo
 	}, nil
 }
 
-// Call sends a request to the Anthropic API with system prompts, user inputs, and optional images.
-// It constructs the message payload using the provided request string, images, and context system settings.
+// Call sends a request to the Anthropic API with system prompts and conversation turns.
+// It constructs the message payload mapping the turns onto user and assistant messages.
 // ctx is the execution context for the request, systemPrompts is the contextual system instruction,
-// request is the user's input, and imgs is a slice of images to include in the request.
+// turns are the conversation messages ending with the user's request, optionally with images.
 // Returns the response text from the API and an error if the request fails, the response is truncated,
 // or the API response is invalid.
-func (m *anthropicModel) Call(ctx context.Context, systemPrompts, request string, imgs []*message.Image) (string, error) {
-	blocks := []anthropic.ContentBlockParamUnion{anthropic.NewTextBlock(request)}
+func (m *anthropicModel) Call(ctx context.Context, systemPrompts string, turns []message.Turn) (string, error) {
+	msg, err := m.client.Messages.New(ctx, m.newParams(systemPrompts, turns))
+	if err != nil {
+		return "", fmt.Errorf("failed to call Anthropic API: %w", err)
+	}
+
+	return m.responseText(ctx, msg)
+}
+
+// Stream sends a request to the Anthropic API using the Messages streaming API.
+// It calls onDelta with every chunk of response text as soon as it arrives, and accumulates the full response.
+// Returns the complete response text and an error if the stream fails, the response is truncated,
+// or the API response is invalid.
+func (m *anthropicModel) Stream(ctx context.Context, systemPrompts string, turns []message.Turn, onDelta func(string)) (string, error) {
+	msg, err := m.stream(ctx, m.newParams(systemPrompts, turns), onDelta)
+	if err != nil {
+		return "", err
+	}
+
+	return m.responseText(ctx, msg)
+}
+
+// CallTool sends a request to the Anthropic API forcing the model to call the given tool.
+// Returns the JSON input of the tool call and an error if the request fails, the response is truncated,
+// or the API response contains no call of the tool.
+func (m *anthropicModel) CallTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool) (string, error) {
+	msg, err := m.client.Messages.New(ctx, m.newToolParams(systemPrompts, turns, tool))
+	if err != nil {
+		return "", fmt.Errorf("failed to call Anthropic API: %w", err)
+	}
+
+	return m.toolInput(ctx, msg, tool.Name)
+}
+
+// StreamTool works like CallTool, but uses the Messages streaming API and calls onDelta with every chunk
+// of the tool input JSON as soon as it arrives.
+// Returns the complete JSON input of the tool call and an error if the stream fails, the response is truncated,
+// or the API response contains no call of the tool.
+func (m *anthropicModel) StreamTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool, onDelta func(string)) (string, error) {
+	msg, err := m.stream(ctx, m.newToolParams(systemPrompts, turns, tool), onDelta)
+	if err != nil {
+		return "", err
+	}
+
+	return m.toolInput(ctx, msg, tool.Name)
+}
+
+// stream sends the request using the Messages streaming API, calling onDelta with every chunk of
+// the response text or the tool input JSON, and accumulates the full response message.
+// If the stream fails after it started, the token usage accumulated so far is recorded, as it is billed anyway.
+// Returns the accumulated message or an error if the stream fails.
+func (m *anthropicModel) stream(ctx context.Context, params anthropic.MessageNewParams, onDelta func(string)) (*anthropic.Message, error) {
+	stream := m.client.Messages.NewStreaming(ctx, params)
+
+	defer func() { _ = stream.Close() }()
+
+	var msg anthropic.Message
+
+	for stream.Next() {
+		event := stream.Current()
+
+		if err := msg.Accumulate(event); err != nil {
+			if msg.ID != "" {
+				m.recordUsage(ctx, &msg)
+			}
+
+			return nil, fmt.Errorf("failed to accumulate Anthropic stream event: %w", err)
+		}
+
+		if event.Type != "content_block_delta" {
+			continue
+		}
+
+		switch {
+		case event.Delta.Type == "text_delta" && event.Delta.Text != "":
+			onDelta(event.Delta.Text)
+		case event.Delta.Type == "input_json_delta" && event.Delta.PartialJSON != "":
+			onDelta(event.Delta.PartialJSON)
+		}
+	}
 
-	for _, img := range imgs {
-		blocks = append(blocks, anthropic.NewImageBlockBase64(img.MIME, img.Data))
+	if err := stream.Err(); err != nil {
+		if msg.ID != "" {
+			m.recordUsage(ctx, &msg)
+		}
+
+		return nil, fmt.Errorf("failed to stream Anthropic API response: %w", err)
 	}
 
+	return &msg, nil
+}
+
+// newParams builds the Messages API request with the core guidelines and system prompts and the conversation turns.
+// The system prompts and the conversation preceding the current request are marked for prompt caching,
+// so following requests of the same conversation reuse them.
+func (m *anthropicModel) newParams(systemPrompts string, turns []message.Turn) anthropic.MessageNewParams {
 	params := anthropic.MessageNewParams{
 		Model:     anthropic.Model(m.modelID),
 		MaxTokens: int64(m.maxTokens),
 		System: []anthropic.TextBlockParam{
-			{Text: coreGuidelines},
-			{Text: systemPrompts},
+			{Text: CoreGuidelines},
+			{Text: systemPrompts, CacheControl: anthropic.NewCacheControlEphemeralParam()},
 		},
-		Messages: []anthropic.MessageParam{anthropic.NewUserMessage(blocks...)},
+		Messages: newMessageParams(turns),
 	}
 
 	if m.thinking {
@@ -109,25 +223,81 @@ func (m *anthropicModel) Call(ctx context.Context, systemPrompts, request string
 		params.Thinking = anthropic.ThinkingConfigParamUnion{OfAdaptive: &adaptive}
 	}
 
-	msg, err := m.client.Messages.New(ctx, params)
-	if err != nil {
-		return "", fmt.Errorf("failed to call Anthropic API: %w", err)
+	return params
+}
+
+// newToolParams builds the Messages API request like newParams, forcing the model to respond with a call of the tool.
+// Thinking is disabled, as it can't be combined with forced tool use.
+func (m *anthropicModel) newToolParams(systemPrompts string, turns []message.Turn, tool *Tool) anthropic.MessageNewParams {
+	params := m.newParams(systemPrompts, turns)
+
+	toolParam := anthropic.ToolUnionParamOfTool(anthropic.ToolInputSchemaParam{
+		Properties: tool.Schema.Properties,
+		Required:   tool.Schema.Required,
+	}, tool.Name)
+	toolParam.OfTool.Description = anthropic.String(tool.Description)
+
+	params.Tools = []anthropic.ToolUnionParam{toolParam}
+	params.ToolChoice = anthropic.ToolChoiceParamOfTool(tool.Name)
+	params.Thinking = anthropic.ThinkingConfigParamUnion{}
+
+	return params
+}
+
+// newMessageParams maps the conversation turns onto user and assistant messages of the Messages API.
+// Consecutive turns of the same role are merged into one message, and the conversation is opened with a user message
+// when it starts with an assistant turn. The last block before the current request is marked as a cache breakpoint.
+func newMessageParams(turns []message.Turn) []anthropic.MessageParam {
+	messages := make([]anthropic.MessageParam, 0, len(turns)+1)
+
+	if len(turns) > 0 && turns[0].Role != message.RoleUser {
+		messages = append(messages, anthropic.NewUserMessage(anthropic.NewTextBlock(omittedHistory)))
 	}
 
-	if len(msg.Content) == 0 {
-		return "", fmt.Errorf("empty response from Anthropic API")
+	for _, turn := range turns {
+		blocks := make([]anthropic.ContentBlockParamUnion, 0, len(turn.Images)+1)
+
+		if turn.Content != "" {
+			blocks = append(blocks, anthropic.NewTextBlock(turn.Content))
+		}
+
+		for _, img := range turn.Images {
+			blocks = append(blocks, anthropic.NewImageBlockBase64(img.MIME, img.Data))
+		}
+
+		if len(blocks) == 0 {
+			continue
+		}
+
+		role := anthropic.MessageParamRoleUser
+		if turn.Role == message.RoleAssistant {
+			role = anthropic.MessageParamRoleAssistant
+		}
+
+		if n := len(messages); n > 0 && messages[n-1].Role == role {
+			messages[n-1].Content = append(messages[n-1].Content, blocks...)
+			continue
+		}
+
+		messages = append(messages, anthropic.MessageParam{Role: role, Content: blocks})
 	}
 
-	if msg.StopReason == anthropic.StopReasonMaxTokens {
-		return "", fmt.Errorf("response truncated: max_tokens limit reached, consider increasing max_tokens in config")
+	if n := len(messages); n > 1 {
+		history := messages[n-2].Content
+		if text := history[len(history)-1].OfText; text != nil {
+			text.CacheControl = anthropic.NewCacheControlEphemeralParam()
+		}
 	}
 
-	slog.InfoContext(
-		ctx, "Model Request",
-		slog.String("model", m.modelID),
-		slog.Int64("input", msg.Usage.InputTokens),
-		slog.Int64("output", msg.Usage.OutputTokens),
-	)
+	return messages
+}
+
+// responseText validates the API response, records token usage and extracts the response text.
+// Returns the text of the first text block and an error if the response is empty or truncated.
+func (m *anthropicModel) responseText(ctx context.Context, msg *anthropic.Message) (string, error) {
+	if err := m.checkResponse(ctx, msg); err != nil {
+		return "", err
+	}
 
 	// When thinking is enabled the response contains thinking blocks before the text block.
 	// Iterate to find the first text-type content block rather than assuming index 0.
@@ -139,3 +309,59 @@ func (m *anthropicModel) Call(ctx context.Context, systemPrompts, request string
 
 	return "", fmt.Errorf("no text block in Anthropic API response")
 }
+
+// toolInput validates the API response, records token usage and extracts the input of the named tool call.
+// Returns the JSON input of the tool call and an error if the response is empty, truncated or has no such call.
+func (m *anthropicModel) toolInput(ctx context.Context, msg *anthropic.Message, name string) (string, error) {
+	if err := m.checkResponse(ctx, msg); err != nil {
+		return "", err
+	}
+
+	for _, block := range msg.Content {
+		if block.Type == "tool_use" && block.Name == name {
+			return string(block.Input), nil
+		}
+	}
+
+	return "", fmt.Errorf("no %s tool call in Anthropic API response", name)
+}
+
+// checkResponse records token usage and validates the API response.
+// Usage is recorded before validation, as tokens of empty or truncated responses are billed as well.
+// Returns an error if the response is empty or truncated.
+func (m *anthropicModel) checkResponse(ctx context.Context, msg *anthropic.Message) error {
+	m.recordUsage(ctx, msg)
+
+	if len(msg.Content) == 0 {
+		return fmt.Errorf("empty response from Anthropic API")
+	}
+
+	if msg.StopReason == anthropic.StopReasonMaxTokens {
+		return fmt.Errorf("response truncated: max_tokens limit reached, consider increasing max_tokens in config")
+	}
+
+	return nil
+}
+
+// recordUsage logs the token usage of the response and adds it to the metrics and to the spend of the request.
+func (m *anthropicModel) recordUsage(ctx context.Context, msg *anthropic.Message) {
+	slog.InfoContext(
+		ctx, "Model Request",
+		slog.String("model", m.modelID),
+		slog.Int64("input", msg.Usage.InputTokens),
+		slog.Int64("output", msg.Usage.OutputTokens),
+		slog.Int64("cache_write", msg.Usage.CacheCreationInputTokens),
+		slog.Int64("cache_read", msg.Usage.CacheReadInputTokens),
+	)
+
+	metrics.LLMTokens.WithLabelValues(m.modelID, metrics.TokensInput).Add(float64(msg.Usage.InputTokens))
+	metrics.LLMTokens.WithLabelValues(m.modelID, metrics.TokensOutput).Add(float64(msg.Usage.OutputTokens))
+
+	budget.Record(ctx, budget.Usage{
+		Model:            m.modelID,
+		InputTokens:      msg.Usage.InputTokens,
+		OutputTokens:     msg.Usage.OutputTokens,
+		CacheWriteTokens: msg.Usage.CacheCreationInputTokens,
+		CacheReadTokens:  msg.Usage.CacheReadInputTokens,
+	})
+}
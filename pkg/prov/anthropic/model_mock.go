@@ -24,9 +24,9 @@ func (_m *MockModel) EXPECT() *MockModel_Expecter {
 	return &MockModel_Expecter{mock: &_m.Mock}
 }
 
-// Call provides a mock function with given fields: ctx, systemPrompts, request, imgs
-func (_m *MockModel) Call(ctx context.Context, systemPrompts string, request string, imgs []*message.Image) (string, error) {
-	ret := _m.Called(ctx, systemPrompts, request, imgs)
+// Call provides a mock function with given fields: ctx, systemPrompts, turns
+func (_m *MockModel) Call(ctx context.Context, systemPrompts string, turns []message.Turn) (string, error) {
+	ret := _m.Called(ctx, systemPrompts, turns)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Call")
@@ -34,17 +34,17 @@ func (_m *MockModel) Call(ctx context.Context, systemPrompts string, request str
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*message.Image) (string, error)); ok {
-		return rf(ctx, systemPrompts, request, imgs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []message.Turn) (string, error)); ok {
+		return rf(ctx, systemPrompts, turns)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*message.Image) string); ok {
-		r0 = rf(ctx, systemPrompts, request, imgs)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []message.Turn) string); ok {
+		r0 = rf(ctx, systemPrompts, turns)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, []*message.Image) error); ok {
-		r1 = rf(ctx, systemPrompts, request, imgs)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []message.Turn) error); ok {
+		r1 = rf(ctx, systemPrompts, turns)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,15 +60,14 @@ type MockModel_Call_Call struct {
 // Call is a helper method to define mock.On call
 //   - ctx context.Context
 //   - systemPrompts string
-//   - request string
-//   - imgs []*message.Image
-func (_e *MockModel_Expecter) Call(ctx interface{}, systemPrompts interface{}, request interface{}, imgs interface{}) *MockModel_Call_Call {
-	return &MockModel_Call_Call{Call: _e.mock.On("Call", ctx, systemPrompts, request, imgs)}
+//   - turns []message.Turn
+func (_e *MockModel_Expecter) Call(ctx interface{}, systemPrompts interface{}, turns interface{}) *MockModel_Call_Call {
+	return &MockModel_Call_Call{Call: _e.mock.On("Call", ctx, systemPrompts, turns)}
 }
 
-func (_c *MockModel_Call_Call) Run(run func(ctx context.Context, systemPrompts string, request string, imgs []*message.Image)) *MockModel_Call_Call {
+func (_c *MockModel_Call_Call) Run(run func(ctx context.Context, systemPrompts string, turns []message.Turn)) *MockModel_Call_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]*message.Image))
+		run(args[0].(context.Context), args[1].(string), args[2].([]message.Turn))
 	})
 	return _c
 }
@@ -78,7 +77,7 @@ func (_c *MockModel_Call_Call) Return(_a0 string, _a1 error) *MockModel_Call_Cal
 	return _c
 }
 
-func (_c *MockModel_Call_Call) RunAndReturn(run func(context.Context, string, string, []*message.Image) (string, error)) *MockModel_Call_Call {
+func (_c *MockModel_Call_Call) RunAndReturn(run func(context.Context, string, []message.Turn) (string, error)) *MockModel_Call_Call {
 	_c.Call.Return(run)
 	return _c
 }
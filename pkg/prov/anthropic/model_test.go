@@ -9,6 +9,8 @@ import (
 
 	anthropicsdk "github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -108,8 +110,177 @@ func TestAnthropicModel_CallMaxTokensError(t *testing.T) {
 		thinking:  false,
 	}
 
-	_, err := model.Call(context.Background(), "system prompt", "user question", nil)
+	_, err := model.Call(context.Background(), "system prompt", []message.Turn{message.NewUserTurn("user question", nil)})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "max_tokens")
 	assert.Contains(t, err.Error(), "response truncated")
 }
+
+func TestAnthropicModel_Stream(t *testing.T) {
+	events := []string{
+		`event: message_start
+data: {"type":"message_start","message":{"id":"msg_test","type":"message","role":"assistant","model":"claude-sonnet-4-6","content":[],"stop_reason":null,"usage":{"input_tokens":10,"output_tokens":0}}}`,
+		`event: content_block_start
+data: {"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+		`event: content_block_delta
+data: {"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hello"}}`,
+		`event: content_block_delta
+data: {"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":", world"}}`,
+		`event: content_block_stop
+data: {"type":"content_block_stop","index":0}`,
+		`event: message_delta
+data: {"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":5}}`,
+		`event: message_stop
+data: {"type":"message_stop"}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		for _, event := range events {
+			_, _ = w.Write([]byte(event + "\n\n"))
+		}
+	}))
+	defer srv.Close()
+
+	model := &anthropicModel{
+		client:    anthropicsdk.NewClient(option.WithAPIKey("test-key"), option.WithBaseURL(srv.URL)),
+		modelID:   "claude-sonnet-4-6",
+		maxTokens: 100,
+	}
+
+	var deltas []string
+
+	resp, err := model.Stream(context.Background(), "system prompt", []message.Turn{message.NewUserTurn("user question", nil)}, func(delta string) {
+		deltas = append(deltas, delta)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, world", resp)
+	assert.Equal(t, []string{"Hello", ", world"}, deltas)
+}
+
+func TestAnthropicModel_StreamErrorRecordsUsage(t *testing.T) {
+	events := []string{
+		`event: message_start
+data: {"type":"message_start","message":{"id":"msg_test","type":"message","role":"assistant","model":"claude-sonnet-4-6","content":[],"stop_reason":null,"usage":{"input_tokens":10,"output_tokens":1}}}`,
+		`event: content_block_start
+data: {"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+		`event: content_block_delta
+data: {"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hello"}}`,
+		`event: error
+data: {"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		for _, event := range events {
+			_, _ = w.Write([]byte(event + "\n\n"))
+		}
+	}))
+	defer srv.Close()
+
+	model := &anthropicModel{
+		client:    anthropicsdk.NewClient(option.WithAPIKey("test-key"), option.WithBaseURL(srv.URL), option.WithMaxRetries(0)),
+		modelID:   "claude-sonnet-4-6",
+		maxTokens: 100,
+	}
+
+	ctx, rec := budget.WithRecorder(context.Background())
+
+	_, err := model.Stream(ctx, "system prompt", []message.Turn{message.NewUserTurn("user question", nil)}, func(string) {})
+
+	require.ErrorContains(t, err, "failed to stream Anthropic API response")
+	assert.Equal(t, []budget.Usage{{Model: "claude-sonnet-4-6", InputTokens: 10, OutputTokens: 1}}, rec.Usage(),
+		"tokens consumed before the stream failed are billed")
+}
+
+func TestNewMessageParams(t *testing.T) {
+	tests := []struct {
+		name      string
+		turns     []message.Turn
+		wantRoles []anthropicsdk.MessageParamRole
+		wantTexts [][]string
+		wantCache int
+	}{
+		{
+			name:      "single request",
+			turns:     []message.Turn{message.NewUserTurn("question", nil)},
+			wantRoles: []anthropicsdk.MessageParamRole{anthropicsdk.MessageParamRoleUser},
+			wantTexts: [][]string{{"question"}},
+			wantCache: -1,
+		},
+		{
+			name: "conversation with history",
+			turns: []message.Turn{
+				message.NewUserTurn("Is chocolate bad for dogs?", nil),
+				message.NewUserTurn("Media content:\nA chocolate bar", nil),
+				message.NewAssistantTurn("Yes, it is toxic."),
+				message.NewUserTurn("How much is dangerous?", nil),
+			},
+			wantRoles: []anthropicsdk.MessageParamRole{
+				anthropicsdk.MessageParamRoleUser,
+				anthropicsdk.MessageParamRoleAssistant,
+				anthropicsdk.MessageParamRoleUser,
+			},
+			wantTexts: [][]string{
+				{"Is chocolate bad for dogs?", "Media content:\nA chocolate bar"},
+				{"Yes, it is toxic."},
+				{"How much is dangerous?"},
+			},
+			wantCache: 1,
+		},
+		{
+			name: "history starting with assistant turn",
+			turns: []message.Turn{
+				message.NewAssistantTurn("Yes, it is toxic."),
+				message.NewUserTurn("How much is dangerous?", nil),
+			},
+			wantRoles: []anthropicsdk.MessageParamRole{
+				anthropicsdk.MessageParamRoleUser,
+				anthropicsdk.MessageParamRoleAssistant,
+				anthropicsdk.MessageParamRoleUser,
+			},
+			wantTexts: [][]string{
+				{omittedHistory},
+				{"Yes, it is toxic."},
+				{"How much is dangerous?"},
+			},
+			wantCache: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := newMessageParams(tt.turns)
+
+			require.Len(t, messages, len(tt.wantRoles))
+
+			for i, msg := range messages {
+				assert.Equal(t, tt.wantRoles[i], msg.Role)
+
+				texts := make([]string, 0, len(msg.Content))
+				for _, block := range msg.Content {
+					texts = append(texts, block.OfText.Text)
+				}
+
+				assert.Equal(t, tt.wantTexts[i], texts)
+
+				last := msg.Content[len(msg.Content)-1].OfText
+				assert.Equal(t, i == tt.wantCache, last.CacheControl != anthropicsdk.CacheControlEphemeralParam{})
+			}
+		})
+	}
+}
+
+func TestNewMessageParams_Images(t *testing.T) {
+	messages := newMessageParams([]message.Turn{
+		message.NewUserTurn("What is this?", []*message.Image{{MIME: "image/png", Data: "aW1n"}}),
+	})
+
+	require.Len(t, messages, 1)
+	require.Len(t, messages[0].Content, 2)
+	assert.Equal(t, "What is this?", messages[0].Content[0].OfText.Text)
+	require.NotNil(t, messages[0].Content[1].OfImage)
+}
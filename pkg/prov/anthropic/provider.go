@@ -2,11 +2,15 @@ package anthropic
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
 )
 
 // Config defines the configuration settings required for interacting with the language model API.
@@ -15,11 +19,18 @@ import (
 // Model identifies the specific language model to interact with, such as "claude-2".
 // MediaModel specifies the model used for media analysis, such as "haiku".
 // MaxTokens sets the maximum number of tokens allowed per request, controlling output length and cost.
+// StructuredOutput makes the model return responses as the input of a forced tool call validated against
+// the response schema, instead of JSON parsed from the response text.
+// FallbackModels is the ordered list of models used when Model keeps failing with retryable errors.
+// Retry defines the backoff of failed requests.
 type Config struct {
-	APIKey     string `mapstructure:"api_key"`
-	Model      string `mapstructure:"model"`
-	MediaModel string `mapstructure:"media_model"`
-	MaxTokens  int    `mapstructure:"max_tokens"`
+	APIKey           string      `mapstructure:"api_key"`
+	Model            string      `mapstructure:"model"`
+	MediaModel       string      `mapstructure:"media_model"`
+	FallbackModels   []string    `mapstructure:"fallback_models"`
+	Retry            RetryConfig `mapstructure:"retry"`
+	MaxTokens        int         `mapstructure:"max_tokens"`
+	StructuredOutput bool        `mapstructure:"structured_output"`
 }
 
 // Provider encapsulates the LLM model, response parser, and configuration for handling language model interactions.
@@ -41,60 +52,112 @@ func New(cfg Config) (*Provider, error) {
 		return nil, fmt.Errorf("API key is required")
 	}
 
-	llm, err := newAnthropicModel(cfg.APIKey, cfg.Model, cfg.MaxTokens, false)
+	// Circuit breakers are shared by model, as the same model may serve both chains
+	breakers := make(map[string]*circuitBreaker)
+
+	newChain := func(modelIDs ...string) (*resilientModel, error) {
+		chain := make([]chainedModel, 0, len(modelIDs))
+
+		for _, modelID := range modelIDs {
+			model, err := newAnthropicModel(cfg.APIKey, modelID, cfg.MaxTokens, false)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := breakers[modelID]; !ok {
+				breakers[modelID] = newCircuitBreaker()
+			}
+
+			chain = append(chain, chainedModel{model: model, breaker: breakers[modelID], id: modelID})
+		}
+
+		return newResilientModel(cfg.Retry, chain...), nil
+	}
+
+	llm, err := newChain(append([]string{cfg.Model}, cfg.FallbackModels...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Anthropic model: %w", err)
 	}
 
-	mediaModel, err := newAnthropicModel(cfg.APIKey, cfg.MediaModel, cfg.MaxTokens, false)
+	mediaModel, err := newChain(append([]string{cfg.MediaModel}, cfg.FallbackModels...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Anthropic media model: %w", err)
 	}
 
+	provider := NewProvider(llm, mediaModel)
+	provider.config = cfg
+
+	return provider, nil
+}
+
+// NewProvider creates a Provider on top of the given models, so prompts and response parsing can be reused
+// with models of other LLM backends.
+// llm is the model used to answer questions; mediaModel is the model used to describe attached images.
+// Returns a Provider instance for LLM interactions.
+func NewProvider(llm, mediaModel Model) *Provider {
 	return &Provider{
 		llm:        llm,
 		mediaModel: mediaModel,
-		config:     cfg,
-	}, nil
+	}
 }
 
-// Analyze processes a request and associated images using the LLM, returning a formatted result or an error.
-// It sends the request combined with system prompts to the LLM, parses the response, and handles errors if the API call or parsing fails.
-// ctx is the context for managing request lifecycle; request is the input query; imgs represents associated images to be analyzed.
+// PromptVersion returns a short hash identifying the prompts answers are generated with.
+// It changes with any change of the prompts or output formats, so ratings of answers can be compared between prompt changes.
+func (p *Provider) PromptVersion() string {
+	hash := sha256.New()
+
+	for _, prompt := range []string{analyzePrompt, analyzeOutput, reportPrompt, reportOutput, responseToolInstructions, repairPrompt} {
+		hash.Write([]byte(prompt))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:12]
+}
+
+// Analyze processes the conversation turns using the LLM, returning a formatted result or an error.
+// The last turn is the user's request, images attached to it are described by the media model.
+// It sends the turns combined with system prompts to the LLM, parses the response, and handles errors if the API call or parsing fails.
+// ctx is the context for managing request lifecycle; turns are the conversation history followed by the user's request.
 // Returns a structured LLMResult containing the analysis or an error if the LLM call or response parsing fails.
-func (p *Provider) Analyze(ctx context.Context, request string, imgs []*message.Image) (*message.LLMResult, error) {
-	slog.DebugContext(ctx, "Anthropic LLM call", slog.String("question", request))
+func (p *Provider) Analyze(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	return p.analyze(ctx, turns, nil)
+}
 
-	mediaDesc := ""
-	if len(imgs) > 0 {
-		var err error
+// AnalyzeStream works like Analyze, but streams the answer text to onText while the response is generated.
+// onText receives the full answer text generated so far; if the model does not support streaming it is not called.
+// Returns a structured LLMResult containing the analysis or an error if the LLM call or response parsing fails.
+func (p *Provider) AnalyzeStream(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error) {
+	return p.analyze(ctx, turns, onText)
+}
+
+// analyze describes attached media, requests the analysis from the LLM and parses the response.
+// The answer text is streamed to onText when it is provided.
+func (p *Provider) analyze(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error) {
+	history, request, err := splitTurns(turns)
+	if err != nil {
+		return nil, err
+	}
 
-		mediaDesc, err = p.mediaModel.Call(ctx, mediaExtractionPrompt, mediaOutputFormat, imgs)
+	slog.DebugContext(ctx, "LLM call", slog.String("question", request.Content), slog.Int("history", len(history)))
+
+	content := request.Content
+
+	mediaDesc := ""
+	if len(request.Images) > 0 {
+		mediaDesc, err = p.mediaModel.Call(ctx, mediaExtractionPrompt, []message.Turn{message.NewUserTurn(mediaOutputFormat, request.Images)})
 		if err != nil {
-			return nil, fmt.Errorf("failed to call Anthropic media model: %w", err)
+			return nil, fmt.Errorf("failed to call media model: %w", err)
 		}
 
-		slog.Debug("Anthropic media model response", slog.String("response", mediaDesc))
+		slog.Debug("Media model response", slog.String("response", mediaDesc))
 
 		if mediaDesc != "" {
-			request += "\n\nMedia content:\n" + mediaDesc
+			content += "\n\nMedia content:\n" + mediaDesc
 		}
 	}
 
-	parser := newAssistantResponseParser(analyzeOutput)
-
-	systemPrompt := analyzePrompt + parser.FormatInstructions()
-
-	response, err := p.llm.Call(ctx, systemPrompt, p.systemInfo()+request, nil)
+	result, err := p.respond(ctx, analyzePrompt, analyzeOutput, p.withRequest(history, content), onText)
 	if err != nil {
-		return nil, fmt.Errorf("failed to call Anthropic API: %w", err)
-	}
-
-	slog.Debug("Anthropic LLM response", slog.Any("response", response))
-
-	result, err := parser.Parse(response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+		return nil, err
 	}
 
 	if mediaDesc != "" {
@@ -104,32 +167,140 @@ func (p *Provider) Analyze(ctx context.Context, request string, imgs []*message.
 	return result, nil
 }
 
-// Report generates a formatted analysis based on the provided request using the LLM and parses the response into a structured result.
-// It sends a system prompt combined with the user's input to the LLM and handles errors during the API call or parsing process.
-// ctx is the context for managing the request lifecycle; request is the input query to be analyzed.
+// Report generates a formatted analysis based on the conversation turns using the LLM and parses the response into a structured result.
+// It sends a system prompt combined with the turns to the LLM and handles errors during the API call or parsing process.
+// ctx is the context for managing the request lifecycle; turns are the conversation history followed by the user's request.
 // Returns a structured LLMResult containing the analysis or an error if the LLM call or response parsing fails.
-func (p *Provider) Report(ctx context.Context, request string) (*message.LLMResult, error) {
-	slog.DebugContext(ctx, "Anthropic LLM call", slog.String("question", request))
-
-	parser := newAssistantResponseParser(reportOutput)
+func (p *Provider) Report(ctx context.Context, turns []message.Turn) (*message.LLMResult, error) {
+	return p.report(ctx, turns, nil)
+}
 
-	systemPrompt := reportPrompt + parser.FormatInstructions()
+// ReportStream works like Report, but streams the answer text to onText while the response is generated.
+// onText receives the full answer text generated so far; if the model does not support streaming it is not called.
+// Returns a structured LLMResult containing the analysis or an error if the LLM call or response parsing fails.
+func (p *Provider) ReportStream(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error) {
+	return p.report(ctx, turns, onText)
+}
 
-	response, err := p.llm.Call(ctx, systemPrompt, p.systemInfo()+request, nil)
+// report requests the final report from the LLM and parses the response.
+// The answer text is streamed to onText when it is provided.
+func (p *Provider) report(ctx context.Context, turns []message.Turn, onText func(string)) (*message.LLMResult, error) {
+	history, request, err := splitTurns(turns)
 	if err != nil {
-		return nil, fmt.Errorf("failed to call Anthropic API: %w", err)
+		return nil, err
 	}
 
-	slog.Debug("Anthropic LLM response", slog.Any("response", response))
+	slog.DebugContext(ctx, "LLM call", slog.String("question", request.Content), slog.Int("history", len(history)))
+
+	return p.respond(ctx, reportPrompt, reportOutput, p.withRequest(history, request.Content), onText)
+}
+
+// respond requests the response from the LLM with the prompt and output format instructions and decodes it.
+// When structured output is enabled and the model supports tools, the response arrives as the input of
+// the forced response tool call; otherwise JSON is parsed from the response text.
+// If the response can't be decoded or is invalid, the LLM is re-prompted with the error and its previous output
+// up to maxRepairAttempts times; repairs are not streamed.
+// Returns the decoded result or an error if the LLM call fails or no valid response is received.
+func (p *Provider) respond(ctx context.Context, prompt, output string, turns []message.Turn, onText func(string)) (*message.LLMResult, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRepairAttempts; attempt++ {
+		response, err := p.request(ctx, prompt, output, turns, onText)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call LLM: %w", err)
+		}
+
+		slog.Debug("LLM response", slog.String("response", response))
+
+		result, err := p.decode(response)
+
+		if attempt > 0 {
+			outcome := metrics.RepairSucceeded
+			if err != nil {
+				outcome = metrics.RepairFailed
+			}
+
+			metrics.LLMRepairAttempts.WithLabelValues(outcome).Inc()
+		}
+
+		if err == nil {
+			return result, nil
+		}
+
+		slog.WarnContext(ctx, "Invalid LLM response", slog.Int("attempt", attempt), slog.Any("error", err))
+
+		lastErr = err
+		turns = repairTurns(turns, response, err)
+		onText = nil
+	}
+
+	return nil, fmt.Errorf("failed to parse LLM response: %w", lastErr)
+}
+
+// request sends the turns to the LLM and returns the raw response: the input of the response tool call
+// in the structured output mode, or the response text otherwise.
+func (p *Provider) request(ctx context.Context, prompt, output string, turns []message.Turn, onText func(string)) (string, error) {
+	if toolModel, ok := p.toolModel(); ok {
+		return p.callTool(ctx, toolModel, prompt+output+responseToolInstructions, turns, onText)
+	}
+
+	return p.call(ctx, prompt+newAssistantResponseParser(output).FormatInstructions(), turns, onText)
+}
+
+// decode converts the raw LLM response into LLMResult and validates it.
+// Returns the result or an error if the response can't be decoded or is invalid.
+func (p *Provider) decode(response string) (*message.LLMResult, error) {
+	var (
+		result *message.LLMResult
+		err    error
+	)
+
+	if _, ok := p.toolModel(); ok {
+		result, err = decodeToolInput(response)
+	} else {
+		result, err = newAssistantResponseParser("").Parse(response)
+	}
 
-	result, err := parser.Parse(response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+		return nil, err
+	}
+
+	if err := validateResult(result); err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
+// toolModel returns the LLM as ToolModel when structured output is enabled and the model supports tools.
+func (p *Provider) toolModel() (ToolModel, bool) {
+	if !p.config.StructuredOutput {
+		return nil, false
+	}
+
+	toolModel, ok := p.llm.(ToolModel)
+
+	return toolModel, ok
+}
+
+// splitTurns separates the conversation history from the user's request, which is expected to be the last turn.
+// Returns the history, the request and an error if there are no turns or the last turn is not a user turn.
+func splitTurns(turns []message.Turn) ([]message.Turn, message.Turn, error) {
+	if len(turns) == 0 || turns[len(turns)-1].Role != message.RoleUser {
+		return nil, message.Turn{}, fmt.Errorf("last turn must be the user's request")
+	}
+
+	return turns[:len(turns)-1], turns[len(turns)-1], nil
+}
+
+// withRequest appends the user's request with the system information to the conversation history.
+// The system information is added to the request rather than to the first turn, so earlier turns stay
+// unchanged between requests and can be cached. Images are not sent to the LLM, they are described by the media model.
+// Returns a new slice of turns, history is not modified.
+func (p *Provider) withRequest(history []message.Turn, content string) []message.Turn {
+	return append(slices.Clone(history), message.NewUserTurn(p.systemInfo()+content, nil))
+}
+
 // systemInfo retrieves and formats basic system information, including the current date in YYYY-MM-DD format.
 // It generates a string containing the system details to be included in LLM calls.
 // Returns a string representing the system information.
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNew(t *testing.T) {
@@ -85,7 +86,7 @@ func TestProvider_Anylyze(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, p.systemInfo()+"test prompt", []*message.Image(nil)).
+				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"test prompt", nil)}).
 					Return(`{"text": "test response", "questions": [{"text": "follow up?"}]}`, nil)
 
 				return p
@@ -104,8 +105,10 @@ func TestProvider_Anylyze(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, p.systemInfo()+"test prompt", []*message.Image(nil)).
-					Return("test response", nil)
+				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"test prompt", nil)}).
+					Return("test response", nil).Once()
+				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, mock.Anything).
+					Return("test response", nil).Times(maxRepairAttempts)
 
 				return p
 			},
@@ -123,7 +126,7 @@ func TestProvider_Anylyze(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, p.systemInfo()+"test prompt", []*message.Image(nil)).
+				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"test prompt", nil)}).
 					Return("", assert.AnError)
 
 				return p
@@ -147,14 +150,14 @@ func TestProvider_Anylyze(t *testing.T) {
 					config:     config,
 				}
 
-				mockMediaModel.EXPECT().Call(ctx, mediaExtractionPrompt, mediaOutputFormat, []*message.Image{
+				mockMediaModel.EXPECT().Call(ctx, mediaExtractionPrompt, []message.Turn{message.NewUserTurn(mediaOutputFormat, []*message.Image{
 					{
 						MIME: "image/jpeg",
 						Data: "base64-encoded-image",
 					},
-				}).Return("media description", nil)
+				})}).Return("media description", nil)
 
-				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, p.systemInfo()+"test prompt\n\nMedia content:\nmedia description", []*message.Image(nil)).
+				mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"test prompt\n\nMedia content:\nmedia description", nil)}).
 					Return(`{"text": "test response"}`, nil)
 
 				return p
@@ -181,12 +184,12 @@ func TestProvider_Anylyze(t *testing.T) {
 					config:     config,
 				}
 
-				mockMediaModel.EXPECT().Call(ctx, mediaExtractionPrompt, mediaOutputFormat, []*message.Image{
+				mockMediaModel.EXPECT().Call(ctx, mediaExtractionPrompt, []message.Turn{message.NewUserTurn(mediaOutputFormat, []*message.Image{
 					{
 						MIME: "image/jpeg",
 						Data: "base64-encoded-image",
 					},
-				}).Return("", assert.AnError)
+				})}).Return("", assert.AnError)
 
 				return p
 			},
@@ -202,7 +205,7 @@ func TestProvider_Anylyze(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			provider := tt.setupMock(t)
-			result, err := provider.Analyze(ctx, tt.prompt, tt.images)
+			result, err := provider.Analyze(ctx, []message.Turn{message.NewUserTurn(tt.prompt, tt.images)})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -250,7 +253,7 @@ func TestProvider_Report(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, p.systemInfo()+"report request", []*message.Image(nil)).
+				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"report request", nil)}).
 					Return(`{"text": "test report response", "questions": [{"text": "additional details?"}]}`, nil)
 
 				return p
@@ -269,8 +272,10 @@ func TestProvider_Report(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, p.systemInfo()+"report request", []*message.Image(nil)).
-					Return("invalid response", nil)
+				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"report request", nil)}).
+					Return("invalid response", nil).Once()
+				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, mock.Anything).
+					Return("invalid response", nil).Times(maxRepairAttempts)
 
 				return p
 			},
@@ -288,7 +293,7 @@ func TestProvider_Report(t *testing.T) {
 					config: config,
 				}
 
-				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, p.systemInfo()+"report request", []*message.Image(nil)).
+				mockModel.EXPECT().Call(ctx, reportPrompt+reportOutput, []message.Turn{message.NewUserTurn(p.systemInfo()+"report request", nil)}).
 					Return("", assert.AnError)
 
 				return p
@@ -299,7 +304,7 @@ func TestProvider_Report(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			provider := tt.setupMock(t)
-			result, err := provider.Report(ctx, tt.request)
+			result, err := provider.Report(ctx, []message.Turn{message.NewUserTurn(tt.request, nil)})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -311,3 +316,47 @@ func TestProvider_Report(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_AnalyzeWithHistory(t *testing.T) {
+	ctx := context.Background()
+	mockModel := NewMockModel(t)
+	p := NewProvider(mockModel, NewMockModel(t))
+
+	history := []message.Turn{
+		message.NewUserTurn("Is chocolate bad for dogs?", nil),
+		message.NewAssistantTurn("Yes, it is toxic."),
+	}
+
+	mockModel.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{
+		history[0],
+		history[1],
+		message.NewUserTurn(p.systemInfo()+"How much is dangerous?", nil),
+	}).Return(`{"text": "Even small amounts"}`, nil)
+
+	turns := append(history, message.NewUserTurn("How much is dangerous?", nil))
+
+	result, err := p.Analyze(ctx, turns)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Even small amounts", result.Text)
+	assert.Equal(t, "How much is dangerous?", turns[2].Content)
+}
+
+func TestProvider_InvalidTurns(t *testing.T) {
+	p := NewProvider(NewMockModel(t), NewMockModel(t))
+
+	_, err := p.Analyze(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, err = p.Report(context.Background(), []message.Turn{message.NewAssistantTurn("answer")})
+	assert.Error(t, err)
+}
+
+func TestProvider_PromptVersion(t *testing.T) {
+	provider := NewProvider(NewMockModel(t), NewMockModel(t))
+
+	version := provider.PromptVersion()
+
+	assert.Len(t, version, 12)
+	assert.Equal(t, version, NewProvider(NewMockModel(t), NewMockModel(t)).PromptVersion())
+}
@@ -0,0 +1,86 @@
+package anthropic
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+const (
+	// maxRepairAttempts limits how many times the LLM is re-prompted after an invalid response
+	maxRepairAttempts = 2
+	// maxQuestions limits the number of follow-up questions asked at once
+	maxQuestions = 6
+	// maxAnswerOptionLength limits the length of a predefined answer, so it fits a keyboard button
+	maxAnswerOptionLength = 100
+)
+
+// repairPrompt asks the LLM to fix its previous response, it is formatted with the processing error.
+const repairPrompt = `Your previous response could not be processed: %v.
+Respond to the same request again, strictly following the required response format.`
+
+// repairTurns appends the invalid LLM response and the request to fix it to the conversation turns.
+// Returns a new slice of turns, turns are not modified.
+func repairTurns(turns []message.Turn, response string, err error) []message.Turn {
+	repaired := slices.Clone(turns)
+
+	if strings.TrimSpace(response) != "" {
+		repaired = append(repaired, message.NewAssistantTurn(response))
+	}
+
+	return append(repaired, message.NewUserTurn(fmt.Sprintf(repairPrompt, err), nil))
+}
+
+// validateResult checks the required fields of the LLM result and caps the follow-up questions.
+// Questions without text and empty answers are dropped, questions beyond maxQuestions are removed
+// and answers longer than maxAnswerOptionLength are truncated.
+// Returns ErrEmptyText if the result has neither text nor questions.
+func validateResult(result *message.LLMResult) error {
+	questions := make([]message.Question, 0, len(result.Questions))
+
+	for _, q := range result.Questions {
+		if strings.TrimSpace(q.Text) == "" {
+			continue
+		}
+
+		if len(q.Answers) > 0 {
+			answers := make([]string, 0, len(q.Answers))
+
+			for _, answer := range q.Answers {
+				if answer = strings.TrimSpace(answer); answer != "" {
+					answers = append(answers, truncate(answer, maxAnswerOptionLength))
+				}
+			}
+
+			q.Answers = answers
+		}
+
+		questions = append(questions, q)
+	}
+
+	if len(questions) > maxQuestions {
+		questions = questions[:maxQuestions]
+	}
+
+	if strings.TrimSpace(result.Text) == "" && len(questions) == 0 {
+		return fmt.Errorf("%w: text is required when there are no questions", ErrEmptyText)
+	}
+
+	if len(result.Questions) > 0 {
+		result.Questions = questions
+	}
+
+	return nil
+}
+
+// truncate shortens s to at most limit characters, marking the cut with an ellipsis.
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
@@ -0,0 +1,106 @@
+package anthropic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResult(t *testing.T) {
+	longAnswer := strings.Repeat("a", maxAnswerOptionLength+10)
+
+	tests := []struct {
+		result  *message.LLMResult
+		want    *message.LLMResult
+		wantErr error
+		name    string
+	}{
+		{
+			name:   "text response",
+			result: &message.LLMResult{Text: "Keep calm"},
+			want:   &message.LLMResult{Text: "Keep calm"},
+		},
+		{
+			name:    "empty text without questions",
+			result:  &message.LLMResult{Text: " ", Questions: []message.Question{}},
+			wantErr: ErrEmptyText,
+		},
+		{
+			name:    "questions without text",
+			result:  &message.LLMResult{Questions: []message.Question{{Text: " "}}},
+			wantErr: ErrEmptyText,
+		},
+		{
+			name: "questions are capped",
+			result: &message.LLMResult{Questions: []message.Question{
+				{Text: "Q1", Answers: []string{"Yes", " ", longAnswer}},
+				{Text: ""},
+				{Text: "Q2"}, {Text: "Q3"}, {Text: "Q4"}, {Text: "Q5"}, {Text: "Q6"}, {Text: "Q7"},
+			}},
+			want: &message.LLMResult{Questions: []message.Question{
+				{Text: "Q1", Answers: []string{"Yes", strings.Repeat("a", maxAnswerOptionLength-1) + "…"}},
+				{Text: "Q2"}, {Text: "Q3"}, {Text: "Q4"}, {Text: "Q5"}, {Text: "Q6"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateResult(tt.result)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tt.result)
+		})
+	}
+}
+
+func TestProvider_RepairInvalidResponse(t *testing.T) {
+	ctx := context.Background()
+	model := NewMockModel(t)
+	p := NewProvider(model, NewMockModel(t))
+
+	request := message.NewUserTurn(p.systemInfo()+"question", nil)
+	repaired := testutil.ToFloat64(metrics.LLMRepairAttempts.WithLabelValues(metrics.RepairSucceeded))
+
+	model.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, []message.Turn{request}).
+		Return(`Sure! {"text": "unterminated`, nil).Once()
+	model.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, mock.MatchedBy(func(turns []message.Turn) bool {
+		return len(turns) == 3 &&
+			turns[0].Content == request.Content &&
+			turns[1].Role == message.RoleAssistant && turns[1].Content == `Sure! {"text": "unterminated` &&
+			turns[2].Role == message.RoleUser && strings.Contains(turns[2].Content, "unterminated string")
+	})).Return(`{"text": "Keep calm", "urgency": "monitor"}`, nil).Once()
+
+	var updates []string
+
+	result, err := p.AnalyzeStream(ctx, []message.Turn{message.NewUserTurn("question", nil)}, func(text string) {
+		updates = append(updates, text)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &message.LLMResult{Text: "Keep calm", Urgency: message.UrgencyMonitor}, result)
+	assert.Empty(t, updates)
+	assert.Equal(t, repaired+1, testutil.ToFloat64(metrics.LLMRepairAttempts.WithLabelValues(metrics.RepairSucceeded)))
+}
+
+func TestRepairTurns(t *testing.T) {
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	repaired := repairTurns(turns, " ", ErrEmptyText)
+
+	require.Len(t, repaired, 2)
+	assert.Equal(t, message.RoleUser, repaired[1].Role)
+	assert.Contains(t, repaired[1].Content, ErrEmptyText.Error())
+	assert.Len(t, turns, 1)
+}
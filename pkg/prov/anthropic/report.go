@@ -61,6 +61,7 @@ Response length:
 const reportOutput = `Return your response in JSON format with this structure:
 {
   "reasoning": "Explain your thought process step by step and reasoning behind your decisions. This will help to understand your approach and make sure that you are following the guidelines.",
+  "urgency": "Triage level of the situation, one of: emergency, see_vet_soon, monitor, informational.",
   "text": "Use this section to provide textual response to the user's query. Include a clear, concise summary of the situation, key observations, and initial concerns. Address any immediate risks or critical symptoms. If additional information is needed, specify the gaps in the data and request relevant details.",
  }
 
@@ -74,33 +75,45 @@ Notes for Implementation:
   - Provide a detailed explanation of your thought process and decision-making
   - Justify your response based on the information provided
   - Include any assumptions or uncertainties in your analysis
+3. Urgency:
+  - Always fill this field
+  - emergency: life-threatening signs that need immediate veterinary care, such as seizures, difficulty breathing, collapse, heavy bleeding, suspected poisoning, bloated abdomen with unproductive retching, inability to urinate, or major trauma
+  - see_vet_soon: signs that should be examined by a veterinarian within 24-48 hours, but are not immediately life-threatening
+  - monitor: mild signs that can be watched at home, advise what changes should lead to a vet visit
+  - informational: general questions about nutrition, training, behavior or care without health concerns
+  - When in doubt between two levels, choose the more urgent one
 
 Example 1 - Acute Injury Case:
 {
   "reasoning": "The dog has sustained an injury to the paw pad, resulting in a laceration and swelling. Immediate care is recommended to prevent infection and manage pain.",
+  "urgency": "see_vet_soon",
   "text": "Based on the information provided, it seems that your dog has sustained an injury to the paw pad, resulting in a laceration and swelling. Immediate care is recommended to prevent infection and manage pain. Please clean the wound gently with a mild antiseptic solution and apply a sterile bandage. It's important to monitor for signs of infection such as increased swelling, redness, or discharge. If the dog shows signs of pain or discomfort, consult with a veterinarian for further evaluation and treatment."
 }
 
 Example 2 - Dietary Advice:
 {
   "reasoning": "The dog is exhibiting symptoms of food allergies or sensitivities, such as itching, skin irritation, and gastrointestinal upset. Dietary changes can help alleviate these symptoms and improve the dog's overall health.",
+  "urgency": "monitor",
   "text": "Based on the information provided, it appears that your dog may be experiencing food allergies or sensitivities. To address this issue, consider switching to a limited ingredient diet or a hypoallergenic dog food. Look for options that contain novel protein sources and avoid common allergens like wheat, soy, and dairy. It's recommended to consult with a veterinarian to determine the best diet plan for your dog and to rule out any underlying health conditions."
 }
 
 Example 3 - Behavioral Training:
 {
   "reasoning": "The dog is displaying signs of separation anxiety, such as destructive behavior, excessive barking, and restlessness when left alone. Behavioral training and environmental enrichment can help address these issues and improve the dog's well-being.",
+  "urgency": "informational",
   "text": "Based on the information provided, it seems that your dog is exhibiting signs of separation anxiety. This is a common behavioral issue that can be addressed through training and behavior modification. To help your dog cope with being alone, consider implementing a gradual desensitization program, providing interactive toys for mental stimulation, and creating a safe and comfortable environment. If the problem persists, consult with a professional dog trainer or behaviorist for personalized guidance."
 }
 
 Example 4 - General Care Guidance:
 {
+  "urgency": "monitor",
   "text": "Based on the information provided, it's important to monitor your pet's symptoms closely and observe for any changes in behavior or appetite. If the condition worsens or if you have any concerns, it's recommended to seek veterinary care for a thorough evaluation. In the meantime, ensure that your pet has access to fresh water, a comfortable resting area, and a balanced diet. Regular exercise and mental stimulation can also help maintain your pet's overall well-being."
 }
 
 Example 5 - Out of Scope Response:
 {
   "reasoning": "The issue falls outside the scope of veterinary assistance and requires specialized training or behavior modification. Referring the user to a professional behaviorist or trainer is the best course of action to address the dog's specific needs effectively.",
+  "urgency": "informational",
   "text": "Based on the information provided, it seems that the issue falls outside the scope of veterinary assistance. It's recommended to consult with a professional behaviorist or trainer for guidance on addressing your dog's specific needs. They can provide tailored advice and training programs to help manage your dog's behavior effectively."
 }
 `
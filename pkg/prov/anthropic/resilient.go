@@ -0,0 +1,335 @@
+package anthropic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+)
+
+// ErrCircuitOpen is returned when all models of the chain are skipped because their circuit breakers are open.
+var ErrCircuitOpen = errors.New("LLM provider is unavailable, circuit breaker is open")
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 500 * time.Millisecond
+	defaultMaxDelay    = 8 * time.Second
+
+	// breakerThreshold is the number of consecutive retryable failures opening the circuit breaker
+	breakerThreshold = 5
+	// breakerCooldown is the time the circuit breaker stays open before a trial request is let through
+	breakerCooldown = 30 * time.Second
+)
+
+// RetryConfig defines how failed LLM requests are retried.
+// MaxAttempts is the number of attempts per model including the first one, it defaults to 3.
+// BaseDelay and MaxDelay bound the jittered exponential backoff between attempts, they default to 500ms and 8s.
+type RetryConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseDelay   time.Duration `mapstructure:"base_delay"`
+	MaxDelay    time.Duration `mapstructure:"max_delay"`
+}
+
+// withDefaults returns the retry configuration with zero values replaced by defaults.
+func (c RetryConfig) withDefaults() RetryConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultBaseDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultMaxDelay
+	}
+
+	return c
+}
+
+// chainedModel is a model of the fallback chain guarded by its circuit breaker.
+type chainedModel struct {
+	model   Model
+	breaker *circuitBreaker
+	id      string
+}
+
+// resilientModel wraps an ordered chain of models: a request is retried with jittered exponential backoff
+// on retryable errors and falls back to the next model when the retries are exhausted.
+// Models whose circuit breaker is open are skipped, so requests fail fast while the provider is down.
+type resilientModel struct {
+	sleep  func(ctx context.Context, d time.Duration) error
+	chain  []chainedModel
+	config RetryConfig
+}
+
+// newResilientModel creates a model wrapping the chain of models, the first one is the primary model.
+func newResilientModel(config RetryConfig, chain ...chainedModel) *resilientModel {
+	return &resilientModel{
+		chain:  chain,
+		config: config.withDefaults(),
+		sleep:  sleepContext,
+	}
+}
+
+// Call sends the request to the first available model of the chain, retrying and falling back on retryable errors.
+// Returns the response text or the last error if all models fail.
+func (m *resilientModel) Call(ctx context.Context, systemPrompts string, turns []message.Turn) (string, error) {
+	return m.do(ctx, func(model Model, _ func(string)) (string, error) {
+		return model.Call(ctx, systemPrompts, turns)
+	})
+}
+
+// Stream works like Call, but streams the response with models supporting streaming.
+// A request is not retried once a part of the response was streamed, the error is returned instead.
+func (m *resilientModel) Stream(ctx context.Context, systemPrompts string, turns []message.Turn, onDelta func(string)) (string, error) {
+	return m.doStream(ctx, onDelta, func(model Model, onDelta func(string)) (string, error) {
+		if streamModel, ok := model.(StreamModel); ok {
+			return streamModel.Stream(ctx, systemPrompts, turns, onDelta)
+		}
+
+		return model.Call(ctx, systemPrompts, turns)
+	})
+}
+
+// CallTool works like Call, forcing the models to respond with a call of the tool.
+func (m *resilientModel) CallTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool) (string, error) {
+	return m.do(ctx, func(model Model, _ func(string)) (string, error) {
+		toolModel, ok := model.(ToolModel)
+		if !ok {
+			return "", fmt.Errorf("model does not support tools")
+		}
+
+		return toolModel.CallTool(ctx, systemPrompts, turns, tool)
+	})
+}
+
+// StreamTool works like Stream, forcing the models to respond with a call of the tool.
+func (m *resilientModel) StreamTool(ctx context.Context, systemPrompts string, turns []message.Turn, tool *Tool, onDelta func(string)) (string, error) {
+	return m.doStream(ctx, onDelta, func(model Model, onDelta func(string)) (string, error) {
+		toolModel, ok := model.(ToolModel)
+		if !ok {
+			return "", fmt.Errorf("model does not support tools")
+		}
+
+		return toolModel.StreamTool(ctx, systemPrompts, turns, tool, onDelta)
+	})
+}
+
+// doStream runs the streaming request through the chain, stopping retries once a delta was delivered,
+// as the consumer can't take back the streamed part of a failed response.
+func (m *resilientModel) doStream(ctx context.Context, onDelta func(string), call func(Model, func(string)) (string, error)) (string, error) {
+	streamed := false
+
+	return m.do(ctx, func(model Model, _ func(string)) (string, error) {
+		response, err := call(model, func(delta string) {
+			streamed = true
+			onDelta(delta)
+		})
+		if err != nil && streamed {
+			return "", &fatalError{err: err}
+		}
+
+		return response, err
+	})
+}
+
+// do runs the request through the chain of models.
+// Fatal errors are returned immediately; retryable ones are retried and then passed to the next model.
+// Returns the response of the first successful model, ErrCircuitOpen if all models are skipped,
+// or the last error if all models fail.
+func (m *resilientModel) do(ctx context.Context, call func(Model, func(string)) (string, error)) (string, error) {
+	lastErr := ErrCircuitOpen
+
+	for i, target := range m.chain {
+		if !target.breaker.Allow() {
+			slog.WarnContext(ctx, "Skipping model with open circuit breaker", slog.String("model", target.id))
+			continue
+		}
+
+		response, err := m.retry(ctx, target, call)
+		if err == nil {
+			return response, nil
+		}
+
+		var fatal *fatalError
+		if errors.As(err, &fatal) {
+			return "", fatal.err
+		}
+
+		if !isRetryable(err) || ctx.Err() != nil {
+			return "", err
+		}
+
+		lastErr = err
+
+		if i < len(m.chain)-1 {
+			slog.WarnContext(ctx, "Falling back to the next model",
+				slog.String("model", target.id),
+				slog.String("fallback", m.chain[i+1].id),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return "", lastErr
+}
+
+// retry calls the model until it succeeds, fails with a fatal error, the attempts are exhausted,
+// its circuit breaker opens or the next backoff delay doesn't fit into the context deadline.
+// Returns the response or the last error.
+func (m *resilientModel) retry(ctx context.Context, target chainedModel, call func(Model, func(string)) (string, error)) (string, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := call(target.model, nil)
+		if err == nil {
+			target.breaker.Success()
+			return response, nil
+		}
+
+		if !isRetryable(err) {
+			return "", err
+		}
+
+		target.breaker.Failure()
+
+		if attempt >= m.config.MaxAttempts || !target.breaker.Allow() {
+			return "", err
+		}
+
+		delay := m.backoff(attempt)
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return "", err
+		}
+
+		slog.WarnContext(ctx, "Retrying LLM request",
+			slog.String("model", target.id),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.Any("error", err),
+		)
+
+		metrics.LLMRetries.WithLabelValues(target.id).Inc()
+
+		if err := m.sleep(ctx, delay); err != nil {
+			return "", err
+		}
+	}
+}
+
+// backoff returns the jittered exponential delay before the next attempt, between half and full
+// of the base delay doubled for every previous attempt, capped by the maximum delay.
+func (m *resilientModel) backoff(attempt int) time.Duration {
+	delay := m.config.MaxDelay
+	if shift := attempt - 1; shift < 30 {
+		delay = min(m.config.BaseDelay<<shift, m.config.MaxDelay)
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// sleepContext waits for the duration or until the context is done.
+// Returns the context error if it is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// fatalError marks errors that must not be retried or passed to the fallback models.
+type fatalError struct {
+	err error
+}
+
+func (e *fatalError) Error() string { return e.err.Error() }
+
+func (e *fatalError) Unwrap() error { return e.err }
+
+// isRetryable reports whether the error is transient: rate limits, overloaded or unavailable API,
+// server errors and network failures. Cancelled requests and client errors are fatal.
+func isRetryable(err error) bool {
+	var fatal *fatalError
+	if errors.As(err, &fatal) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *anthropic.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests, 529:
+			return true
+		default:
+			return apiErr.StatusCode >= http.StatusInternalServerError
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	// Errors received in the middle of a stream are not typed, they carry the error type of the API
+	msg := err.Error()
+
+	return strings.Contains(msg, "overloaded_error") || strings.Contains(msg, "rate_limit_error") || strings.Contains(msg, "api_error")
+}
+
+// circuitBreaker opens after breakerThreshold consecutive failures and rejects requests for breakerCooldown,
+// after which requests are let through again until the next failure reopens it.
+type circuitBreaker struct {
+	openedAt time.Time
+	now      func() time.Time
+	failures int
+	mu       sync.Mutex
+}
+
+// newCircuitBreaker creates a closed circuit breaker.
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{now: time.Now}
+}
+
+// Allow reports whether a request may be sent: the breaker is closed or its cooldown has passed.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.failures < breakerThreshold || b.now().Sub(b.openedAt) >= breakerCooldown
+}
+
+// Success closes the breaker and resets the failure count.
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+}
+
+// Failure records a failed request, opening the breaker when the threshold is reached
+// or reopening it when a trial request after the cooldown fails.
+func (b *circuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+
+	if b.failures >= breakerThreshold {
+		b.openedAt = b.now()
+	}
+}
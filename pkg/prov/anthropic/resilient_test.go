@@ -0,0 +1,275 @@
+package anthropic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func apiError(status int) error {
+	req, _ := http.NewRequest(http.MethodPost, "https://api.anthropic.com/v1/messages", http.NoBody)
+
+	return fmt.Errorf("failed to call Anthropic API: %w", &anthropic.Error{
+		StatusCode: status,
+		Request:    req,
+		Response:   &http.Response{StatusCode: status},
+	})
+}
+
+func noSleep(_ context.Context, _ time.Duration) error { return nil }
+
+func newTestChain(t *testing.T, config RetryConfig, models ...Model) *resilientModel {
+	t.Helper()
+
+	chain := make([]chainedModel, 0, len(models))
+	for i, model := range models {
+		chain = append(chain, chainedModel{model: model, breaker: newCircuitBreaker(), id: fmt.Sprintf("model-%d", i)})
+	}
+
+	m := newResilientModel(config, chain...)
+	m.sleep = noSleep
+
+	return m
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		name string
+		want bool
+	}{
+		{name: "rate limited", err: apiError(http.StatusTooManyRequests), want: true},
+		{name: "overloaded", err: apiError(529), want: true},
+		{name: "server error", err: apiError(http.StatusInternalServerError), want: true},
+		{name: "service unavailable", err: apiError(http.StatusServiceUnavailable), want: true},
+		{name: "request timeout", err: apiError(http.StatusRequestTimeout), want: true},
+		{name: "bad request", err: apiError(http.StatusBadRequest), want: false},
+		{name: "unauthorized", err: apiError(http.StatusUnauthorized), want: false},
+		{name: "unexpected EOF", err: fmt.Errorf("failed to stream: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "stream overloaded", err: errors.New(`received error while streaming: {"type":"error","error":{"type":"overloaded_error"}}`), want: true},
+		{name: "canceled", err: fmt.Errorf("failed to call Anthropic API: %w", context.Canceled), want: false},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, want: false},
+		{name: "truncated response", err: errors.New("response truncated: max_tokens limit reached"), want: false},
+		{name: "fatal", err: &fatalError{err: apiError(529)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryable(tt.err))
+		})
+	}
+}
+
+func TestResilientModel_Call(t *testing.T) {
+	ctx := context.Background()
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	tests := []struct {
+		setup   func(t *testing.T) *resilientModel
+		wantErr error
+		name    string
+		want    string
+	}{
+		{
+			name: "retries transient errors",
+			setup: func(t *testing.T) *resilientModel {
+				primary := NewMockModel(t)
+				primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(529)).Once()
+				primary.EXPECT().Call(ctx, "system", turns).Return("answer", nil).Once()
+
+				return newTestChain(t, RetryConfig{}, primary)
+			},
+			want: "answer",
+		},
+		{
+			name: "falls back to the next model",
+			setup: func(t *testing.T) *resilientModel {
+				primary := NewMockModel(t)
+				primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(http.StatusServiceUnavailable)).Times(2)
+
+				fallback := NewMockModel(t)
+				fallback.EXPECT().Call(ctx, "system", turns).Return("fallback answer", nil).Once()
+
+				return newTestChain(t, RetryConfig{MaxAttempts: 2}, primary, fallback)
+			},
+			want: "fallback answer",
+		},
+		{
+			name: "fatal error is not retried",
+			setup: func(t *testing.T) *resilientModel {
+				primary := NewMockModel(t)
+				primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(http.StatusBadRequest)).Once()
+
+				return newTestChain(t, RetryConfig{}, primary, NewMockModel(t))
+			},
+			wantErr: &anthropic.Error{},
+		},
+		{
+			name: "all models fail",
+			setup: func(t *testing.T) *resilientModel {
+				primary := NewMockModel(t)
+				primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(529)).Once()
+
+				fallback := NewMockModel(t)
+				fallback.EXPECT().Call(ctx, "system", turns).Return("", io.ErrUnexpectedEOF).Once()
+
+				return newTestChain(t, RetryConfig{MaxAttempts: 1}, primary, fallback)
+			},
+			wantErr: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.setup(t).Call(ctx, "system", turns)
+
+			if tt.wantErr != nil {
+				var apiErr *anthropic.Error
+				if errors.As(tt.wantErr, &apiErr) {
+					assert.ErrorAs(t, err, &apiErr)
+				} else {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResilientModel_DeadlineBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	primary := NewMockModel(t)
+	primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(529)).Once()
+
+	fallback := NewMockModel(t)
+	fallback.EXPECT().Call(ctx, "system", turns).Return("fallback answer", nil).Once()
+
+	m := newTestChain(t, RetryConfig{BaseDelay: time.Second}, primary, fallback)
+	m.sleep = func(context.Context, time.Duration) error {
+		t.Fatal("backoff must not exceed the context deadline")
+		return nil
+	}
+
+	got, err := m.Call(ctx, "system", turns)
+
+	require.NoError(t, err)
+	assert.Equal(t, "fallback answer", got)
+}
+
+func TestResilientModel_CircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+	now := time.Now()
+
+	primary := NewMockModel(t)
+	primary.EXPECT().Call(ctx, "system", turns).Return("", apiError(529)).Times(breakerThreshold)
+
+	fallback := NewMockModel(t)
+	fallback.EXPECT().Call(ctx, "system", turns).Return("fallback answer", nil).Times(2)
+
+	m := newTestChain(t, RetryConfig{MaxAttempts: breakerThreshold + 1}, primary, fallback)
+	m.chain[0].breaker.now = func() time.Time { return now }
+
+	// The breaker opens after the threshold and the request falls back without using all attempts
+	got, err := m.Call(ctx, "system", turns)
+	require.NoError(t, err)
+	assert.Equal(t, "fallback answer", got)
+
+	// The open breaker skips the primary model
+	got, err = m.Call(ctx, "system", turns)
+	require.NoError(t, err)
+	assert.Equal(t, "fallback answer", got)
+
+	// After the cooldown a trial request is let through and closes the breaker
+	now = now.Add(breakerCooldown)
+
+	primary.EXPECT().Call(ctx, "system", turns).Return("answer", nil).Once()
+
+	got, err = m.Call(ctx, "system", turns)
+	require.NoError(t, err)
+	assert.Equal(t, "answer", got)
+	assert.True(t, m.chain[0].breaker.Allow())
+}
+
+func TestResilientModel_CircuitOpen(t *testing.T) {
+	ctx := context.Background()
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	m := newTestChain(t, RetryConfig{}, NewMockModel(t))
+	for range breakerThreshold {
+		m.chain[0].breaker.Failure()
+	}
+
+	_, err := m.Call(ctx, "system", turns)
+
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestResilientModel_Stream(t *testing.T) {
+	ctx := context.Background()
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	t.Run("retries before the first delta", func(t *testing.T) {
+		primary := NewMockStreamModel(t)
+		primary.EXPECT().Stream(ctx, "system", turns, mock.Anything).Return("", apiError(529)).Once()
+		primary.EXPECT().Stream(ctx, "system", turns, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, _ []message.Turn, onDelta func(string)) (string, error) {
+				onDelta("answer")
+				return "answer", nil
+			}).Once()
+
+		var deltas []string
+
+		got, err := newTestChain(t, RetryConfig{}, primary).Stream(ctx, "system", turns, func(delta string) {
+			deltas = append(deltas, delta)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "answer", got)
+		assert.Equal(t, []string{"answer"}, deltas)
+	})
+
+	t.Run("does not retry after a delta", func(t *testing.T) {
+		streamErr := errors.New(`received error while streaming: {"type":"overloaded_error"}`)
+
+		primary := NewMockStreamModel(t)
+		primary.EXPECT().Stream(ctx, "system", turns, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, _ []message.Turn, onDelta func(string)) (string, error) {
+				onDelta("partial")
+				return "", streamErr
+			}).Once()
+
+		_, err := newTestChain(t, RetryConfig{}, primary, NewMockStreamModel(t)).Stream(ctx, "system", turns, func(string) {})
+
+		assert.ErrorIs(t, err, streamErr)
+	})
+}
+
+func TestResilientModel_Backoff(t *testing.T) {
+	m := newResilientModel(RetryConfig{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second, 100: time.Second} {
+		delay := m.backoff(attempt)
+
+		assert.GreaterOrEqual(t, delay, want/2)
+		assert.LessOrEqual(t, delay, want)
+	}
+}
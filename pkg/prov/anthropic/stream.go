@@ -0,0 +1,203 @@
+package anthropic
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+// streamText extracts the value of the top level "text" field from a possibly incomplete JSON response,
+// so the answer can be shown to the user while the rest of the response is still being generated.
+// Returns the decoded part of the field value received so far, or an empty string if the field has not started yet.
+func streamText(partial string) string {
+	depth := 0
+
+	for i := 0; i < len(partial); i++ {
+		switch partial[i] {
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		case '"':
+			key, next, complete := scanJSONString(partial, i+1)
+			if !complete {
+				return ""
+			}
+
+			i = next - 1
+
+			if depth != 1 || key != "text" {
+				continue
+			}
+
+			j := skipJSONSpace(partial, next)
+			if j >= len(partial) || partial[j] != ':' {
+				continue
+			}
+
+			j = skipJSONSpace(partial, j+1)
+			if j >= len(partial) || partial[j] != '"' {
+				return ""
+			}
+
+			value, _, _ := scanJSONString(partial, j+1)
+
+			return value
+		}
+	}
+
+	return ""
+}
+
+// scanJSONString decodes a JSON string literal starting right after its opening quote.
+// An incomplete escape sequence at the end of the input is ignored.
+// Returns the decoded value, the index right after the closing quote and whether the literal is complete.
+func scanJSONString(s string, start int) (string, int, bool) {
+	var b strings.Builder
+
+	for i := start; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '"':
+			return b.String(), i + 1, true
+		case c != '\\':
+			b.WriteByte(c)
+			continue
+		case i+1 >= len(s):
+			return b.String(), len(s), false
+		}
+
+		i++
+
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			r, size, ok := decodeUnicodeEscape(s, i+1)
+			if !ok {
+				return b.String(), len(s), false
+			}
+
+			b.WriteRune(r)
+
+			i += size
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String(), len(s), false
+}
+
+// decodeUnicodeEscape decodes the hex digits of a \u escape sequence starting at start, including surrogate pairs.
+// Returns the decoded rune, the number of consumed bytes and false if the sequence is incomplete.
+func decodeUnicodeEscape(s string, start int) (rune, int, bool) {
+	if start+4 > len(s) {
+		return 0, 0, false
+	}
+
+	code, err := strconv.ParseUint(s[start:start+4], 16, 16)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	r := rune(code)
+	if !utf16.IsSurrogate(r) {
+		return r, 4, true
+	}
+
+	if start+10 > len(s) || s[start+4:start+6] != `\u` {
+		return 0, 0, false
+	}
+
+	low, err := strconv.ParseUint(s[start+6:start+10], 16, 16)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return utf16.DecodeRune(r, rune(low)), 10, true
+}
+
+// skipJSONSpace returns the index of the first non-whitespace character starting from i.
+func skipJSONSpace(s string, i int) int {
+	for i < len(s) && strings.ContainsRune(" \t\r\n", rune(s[i])) {
+		i++
+	}
+
+	return i
+}
+
+// call sends the system prompt and conversation turns to the LLM and returns the raw response.
+// When onText is provided and the LLM supports streaming, the answer text is streamed to onText as it grows;
+// if streaming fails before the context is done, it falls back to a regular call.
+// Returns the response text or an error if the LLM call fails.
+func (p *Provider) call(ctx context.Context, systemPrompt string, turns []message.Turn, onText func(string)) (string, error) {
+	callModel := func() (string, error) { return p.llm.Call(ctx, systemPrompt, turns) }
+
+	streamModel, ok := p.llm.(StreamModel)
+	if onText == nil || !ok {
+		return callModel()
+	}
+
+	return streamWithFallback(ctx, onText, func(onDelta func(string)) (string, error) {
+		return streamModel.Stream(ctx, systemPrompt, turns, onDelta)
+	}, callModel)
+}
+
+// callTool sends the system prompt and conversation turns to the tool model forcing the response tool call,
+// and returns the JSON input of the call. The answer text is streamed to onText like in call.
+// Returns the tool input or an error if the LLM call fails.
+func (p *Provider) callTool(ctx context.Context, model ToolModel, systemPrompt string, turns []message.Turn, onText func(string)) (string, error) {
+	callModel := func() (string, error) { return model.CallTool(ctx, systemPrompt, turns, responseTool) }
+
+	if onText == nil {
+		return callModel()
+	}
+
+	return streamWithFallback(ctx, onText, func(onDelta func(string)) (string, error) {
+		return model.StreamTool(ctx, systemPrompt, turns, responseTool, onDelta)
+	}, callModel)
+}
+
+// streamWithFallback streams the JSON response, passing the value of its "text" field to onText every time it grows.
+// If streaming fails before the context is done, it falls back to callModel.
+// Returns the complete response or an error if both streaming and the fallback call fail.
+func streamWithFallback(ctx context.Context, onText func(string), stream func(onDelta func(string)) (string, error), callModel func() (string, error)) (string, error) {
+	var (
+		buf      strings.Builder
+		lastText string
+	)
+
+	response, err := stream(func(delta string) {
+		buf.WriteString(delta)
+
+		if text := streamText(buf.String()); text != "" && text != lastText {
+			lastText = text
+			onText(text)
+		}
+	})
+	if err == nil {
+		return response, nil
+	}
+
+	if ctx.Err() != nil {
+		return "", err
+	}
+
+	slog.WarnContext(ctx, "LLM streaming failed, falling back to regular call", slog.Any("error", err))
+
+	return callModel()
+}
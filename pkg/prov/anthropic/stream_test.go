@@ -0,0 +1,102 @@
+package anthropic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamText(t *testing.T) {
+	tests := []struct {
+		name    string
+		partial string
+		want    string
+	}{
+		{name: "empty", partial: "", want: ""},
+		{name: "field not started", partial: `{"te`, want: ""},
+		{name: "value not started", partial: `{"text": `, want: ""},
+		{name: "partial value", partial: `{"text": "Your dog`, want: "Your dog"},
+		{name: "complete value", partial: `{"text": "Your dog is fine", "questions": [`, want: "Your dog is fine"},
+		{name: "escapes", partial: `{"text": "Line\n\"quoted\" é🐶`, want: "Line\n\"quoted\" é🐶"},
+		{name: "incomplete escape", partial: `{"text": "Line\`, want: "Line"},
+		{name: "incomplete unicode escape", partial: `{"text": "Caf\u00`, want: "Caf"},
+		{name: "markdown code block", partial: "```json\n{\"text\": \"Hi", want: "Hi"},
+		{name: "nested text is ignored", partial: `{"questions": [{"text": "Age?"}], "text": "Answer`, want: "Answer"},
+		{name: "text as value is ignored", partial: `{"type": "text", "text": "Answer`, want: "Answer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, streamText(tt.partial))
+		})
+	}
+}
+
+func TestProvider_AnalyzeStream(t *testing.T) {
+	model := NewMockStreamModel(t)
+	provider := NewProvider(model, model)
+
+	model.EXPECT().Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, _ []message.Turn, onDelta func(string)) (string, error) {
+			for _, delta := range []string{`{"te`, `xt": "Keep`, ` calm`, `", "questions": []}`} {
+				onDelta(delta)
+			}
+
+			return `{"text": "Keep calm", "questions": []}`, nil
+		})
+
+	var updates []string
+
+	result, err := provider.AnalyzeStream(context.Background(), []message.Turn{message.NewUserTurn("question", nil)}, func(text string) {
+		updates = append(updates, text)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Keep calm", result.Text)
+	assert.Equal(t, []string{"Keep", "Keep calm"}, updates)
+}
+
+func TestProvider_ReportStreamFallback(t *testing.T) {
+	model := NewMockStreamModel(t)
+	provider := NewProvider(model, model)
+
+	model.EXPECT().Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", assert.AnError)
+	model.EXPECT().Call(mock.Anything, mock.Anything, mock.Anything).Return(`{"text": "Report"}`, nil)
+
+	result, err := provider.ReportStream(context.Background(), []message.Turn{message.NewUserTurn("question", nil)}, func(string) {})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Report", result.Text)
+}
+
+func TestProvider_ReportStreamCancelled(t *testing.T) {
+	model := NewMockStreamModel(t)
+	provider := NewProvider(model, model)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	model.EXPECT().Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", context.Canceled)
+
+	_, err := provider.ReportStream(ctx, []message.Turn{message.NewUserTurn("question", nil)}, func(string) {})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestProvider_AnalyzeStreamWithoutStreamingModel(t *testing.T) {
+	model := NewMockModel(t)
+	provider := NewProvider(model, model)
+
+	model.EXPECT().Call(mock.Anything, mock.Anything, mock.Anything).Return(`{"text": "Answer"}`, nil)
+
+	result, err := provider.AnalyzeStream(context.Background(), []message.Turn{message.NewUserTurn("question", nil)}, func(string) {
+		t.Fatal("text must not be streamed by models without streaming support")
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Answer", result.Text)
+}
@@ -0,0 +1,42 @@
+package anthropic
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+const summaryPrompt = `You maintain a running summary of a conversation between a pet owner and a veterinary assistant.
+You will receive the current summary, which may be empty, and older messages that are being removed from the conversation history.
+Fold the messages into the summary following these rules:
+  - Keep facts that matter for the pet's care: pets involved, symptoms and when they started, how they changed over time, medications and treatments, advice given, vet visits and their outcomes, and questions still open
+  - Keep dates and durations when they are mentioned
+  - Drop greetings, repetitions and anything unrelated to the pet's care
+  - Write in short factual sentences, no longer than 150 words
+  - Respond with the updated summary text only, without any introduction or formatting
+`
+
+// Summarize folds older conversation messages into the running conversation summary.
+// It uses the media model, which is expected to be a cheaper and faster model than the one answering questions.
+// summary is the current summary, possibly empty; messages are the evicted messages formatted one per line.
+// Returns the updated summary or an error if the model call fails or returns an empty summary.
+func (p *Provider) Summarize(ctx context.Context, summary, messages string) (string, error) {
+	request := fmt.Sprintf("Current summary:\n%s\n\nMessages to fold into the summary:\n%s", summary, messages)
+
+	response, err := p.mediaModel.Call(ctx, summaryPrompt, []message.Turn{message.NewUserTurn(request, nil)})
+	if err != nil {
+		return "", fmt.Errorf("failed to call summary model: %w", err)
+	}
+
+	response = strings.TrimSpace(response)
+	if response == "" {
+		return "", fmt.Errorf("empty summary returned by model")
+	}
+
+	slog.DebugContext(ctx, "Conversation summary updated", slog.String("summary", response))
+
+	return response, nil
+}
@@ -0,0 +1,57 @@
+package anthropic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Summarize(t *testing.T) {
+	ctx := context.Background()
+	request := "Current summary:\nMax is limping.\n\nMessages to fold into the summary:\nuser: It is worse today\n"
+
+	tests := []struct {
+		modelErr   error
+		name       string
+		response   string
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name:       "successful summary",
+			response:   "  Max is limping, worse on the second day.\n",
+			wantResult: "Max is limping, worse on the second day.",
+		},
+		{
+			name:     "empty summary",
+			response: " \n",
+			wantErr:  true,
+		},
+		{
+			name:     "model error",
+			modelErr: assert.AnError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mediaModel := NewMockModel(t)
+			mediaModel.EXPECT().Call(ctx, summaryPrompt, []message.Turn{message.NewUserTurn(request, nil)}).Return(tt.response, tt.modelErr)
+
+			p := NewProvider(NewMockModel(t), mediaModel)
+
+			result, err := p.Summarize(ctx, "Max is limping.", "user: It is worse today\n")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
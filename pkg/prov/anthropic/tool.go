@@ -0,0 +1,57 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/invopop/jsonschema"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+)
+
+// responseToolInstructions asks the model to submit the response with the tool call instead of the text reply.
+const responseToolInstructions = `
+Submit your response by calling the ` + responseToolName + ` tool, its input follows the JSON structure described above.
+`
+
+const responseToolName = "submit_response"
+
+// responseTool is the tool the model is forced to call in the structured output mode,
+// its input schema is generated from message.LLMResult.
+var responseTool = newTool[message.LLMResult](responseToolName, "Submit the response to the pet owner's request.")
+
+// Tool describes a tool the model is forced to call, so its response arrives as structured tool input
+// matching the JSON schema instead of free-form text.
+type Tool struct {
+	Schema      *jsonschema.Schema
+	Name        string
+	Description string
+}
+
+// newTool creates a tool with the input schema generated from the fields of T.
+// Only fields tagged with jsonschema "required" are required, additional properties are not allowed.
+func newTool[T any](name, description string) *Tool {
+	reflector := jsonschema.Reflector{
+		AllowAdditionalProperties:  false,
+		DoNotReference:             true,
+		RequiredFromJSONSchemaTags: true,
+	}
+
+	var v T
+
+	return &Tool{
+		Name:        name,
+		Description: description,
+		Schema:      reflector.Reflect(v),
+	}
+}
+
+// decodeToolInput decodes the input of the response tool call into LLMResult.
+// Returns the decoded result or ErrInvalidJSON if the input doesn't match the result structure.
+func decodeToolInput(input string) (*message.LLMResult, error) {
+	var result message.LLMResult
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,215 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	anthropicsdk "github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseToolSchema(t *testing.T) {
+	schema := responseTool.Schema
+
+	assert.Equal(t, []string{"urgency"}, schema.Required)
+
+	for _, field := range []string{"text", "questions", "reasoning", "urgency"} {
+		_, ok := schema.Properties.Get(field)
+		assert.True(t, ok, field)
+	}
+
+	_, ok := schema.Properties.Get("media")
+	assert.False(t, ok)
+
+	urgency, _ := schema.Properties.Get("urgency")
+	assert.Equal(t, []any{"emergency", "see_vet_soon", "monitor", "informational"}, urgency.Enum)
+}
+
+func TestDecodeToolInput(t *testing.T) {
+	tests := []struct {
+		want    *message.LLMResult
+		wantErr error
+		name    string
+		input   string
+	}{
+		{
+			name:  "text response",
+			input: `{"text": "Keep him warm", "urgency": "monitor"}`,
+			want:  &message.LLMResult{Text: "Keep him warm", Urgency: message.UrgencyMonitor},
+		},
+		{
+			name:  "questions",
+			input: `{"questions": [{"text": "How old is he?", "answers": ["Puppy", "Adult"]}], "urgency": "see_vet_soon"}`,
+			want: &message.LLMResult{
+				Questions: []message.Question{{Text: "How old is he?", Answers: []string{"Puppy", "Adult"}}},
+				Urgency:   message.UrgencySeeVetSoon,
+			},
+		},
+		{
+			name:    "invalid structure",
+			input:   `{"text": ["not", "a", "string"]}`,
+			wantErr: ErrInvalidJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := decodeToolInput(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestProvider_StructuredOutput(t *testing.T) {
+	ctx := context.Background()
+	turns := []message.Turn{message.NewUserTurn("question", nil)}
+
+	t.Run("analyze uses forced tool call", func(t *testing.T) {
+		model := NewMockToolModel(t)
+		p := &Provider{llm: model, config: Config{StructuredOutput: true}}
+
+		model.EXPECT().CallTool(ctx, analyzePrompt+analyzeOutput+responseToolInstructions, []message.Turn{
+			message.NewUserTurn(p.systemInfo()+"question", nil),
+		}, responseTool).Return(`{"text": "Keep calm", "urgency": "monitor"}`, nil)
+
+		result, err := p.Analyze(ctx, turns)
+
+		require.NoError(t, err)
+		assert.Equal(t, &message.LLMResult{Text: "Keep calm", Urgency: message.UrgencyMonitor}, result)
+	})
+
+	t.Run("report streams tool input", func(t *testing.T) {
+		model := NewMockToolModel(t)
+		p := &Provider{llm: model, config: Config{StructuredOutput: true}}
+
+		model.EXPECT().StreamTool(ctx, reportPrompt+reportOutput+responseToolInstructions, mock.Anything, responseTool, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, _ []message.Turn, _ *Tool, onDelta func(string)) (string, error) {
+				for _, delta := range []string{`{"urgency": "monitor", "te`, `xt": "Keep`, ` calm"}`} {
+					onDelta(delta)
+				}
+
+				return `{"urgency": "monitor", "text": "Keep calm"}`, nil
+			})
+
+		var updates []string
+
+		result, err := p.ReportStream(ctx, turns, func(text string) {
+			updates = append(updates, text)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Keep calm", result.Text)
+		assert.Equal(t, []string{"Keep", "Keep calm"}, updates)
+	})
+
+	t.Run("invalid tool input", func(t *testing.T) {
+		model := NewMockToolModel(t)
+		p := &Provider{llm: model, config: Config{StructuredOutput: true}}
+
+		model.EXPECT().CallTool(ctx, mock.Anything, mock.Anything, responseTool).Return(`{"urgency": "monitor"}`, nil).Times(maxRepairAttempts + 1)
+
+		_, err := p.Report(ctx, turns)
+
+		assert.ErrorIs(t, err, ErrEmptyText)
+	})
+
+	t.Run("text parsing when structured output is disabled", func(t *testing.T) {
+		model := NewMockToolModel(t)
+		p := &Provider{llm: model}
+
+		model.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, mock.Anything).Return(`{"text": "Keep calm"}`, nil)
+
+		result, err := p.Analyze(ctx, turns)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Keep calm", result.Text)
+	})
+
+	t.Run("text parsing when model has no tool support", func(t *testing.T) {
+		model := NewMockModel(t)
+		p := &Provider{llm: model, config: Config{StructuredOutput: true}}
+
+		model.EXPECT().Call(ctx, analyzePrompt+analyzeOutput, mock.Anything).Return(`{"text": "Keep calm"}`, nil)
+
+		result, err := p.Analyze(ctx, turns)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Keep calm", result.Text)
+	})
+}
+
+func TestAnthropicModel_CallTool(t *testing.T) {
+	var req map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "msg_test", "type": "message", "role": "assistant", "model": "claude-sonnet-4-6",
+			"stop_reason": "tool_use",
+			"content": [{"type": "tool_use", "id": "toolu_1", "name": "submit_response", "input": {"text": "Keep calm"}}],
+			"usage": {"input_tokens": 10, "output_tokens": 5}
+		}`))
+	}))
+	defer srv.Close()
+
+	model := &anthropicModel{
+		client:    anthropicsdk.NewClient(option.WithAPIKey("test-key"), option.WithBaseURL(srv.URL)),
+		modelID:   "claude-sonnet-4-6",
+		maxTokens: 100,
+		thinking:  true,
+	}
+
+	input, err := model.CallTool(context.Background(), "system prompt", []message.Turn{message.NewUserTurn("question", nil)}, responseTool)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": "Keep calm"}`, input)
+
+	assert.Equal(t, map[string]any{"type": "tool", "name": "submit_response"}, req["tool_choice"])
+	assert.NotContains(t, req, "thinking")
+
+	tools := req["tools"].([]any)
+	require.Len(t, tools, 1)
+
+	tool := tools[0].(map[string]any)
+	assert.Equal(t, "submit_response", tool["name"])
+	assert.Equal(t, []any{"urgency"}, tool["input_schema"].(map[string]any)["required"])
+}
+
+func TestAnthropicModel_CallToolWithoutToolCall(t *testing.T) {
+	srv := newFakeServer(t, fakeAPIResponse{
+		ID:         "msg_test",
+		Type:       "message",
+		Role:       "assistant",
+		Model:      "claude-sonnet-4-6",
+		StopReason: "end_turn",
+		Content: []interface{}{
+			map[string]string{"type": "text", "text": "plain answer"},
+		},
+	})
+	defer srv.Close()
+
+	model := &anthropicModel{
+		client:    anthropicsdk.NewClient(option.WithAPIKey("test-key"), option.WithBaseURL(srv.URL)),
+		modelID:   "claude-sonnet-4-6",
+		maxTokens: 100,
+	}
+
+	_, err := model.CallTool(context.Background(), "system prompt", []message.Turn{message.NewUserTurn("question", nil)}, responseTool)
+
+	assert.ErrorContains(t, err, "no submit_response tool call")
+}
@@ -0,0 +1,193 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/metrics"
+	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+)
+
+const (
+	finishReasonLength = "length"
+	maxErrorBodySize   = 1024
+)
+
+// chatRequest represents the body of a chat completions request.
+type chatRequest struct {
+	Model     string        `json:"model"`
+	Messages  []chatMessage `json:"messages"`
+	MaxTokens int           `json:"max_tokens,omitempty"`
+}
+
+// chatMessage represents a single message of a chat completions request.
+// Content is either a plain string or a list of content parts for messages with images.
+type chatMessage struct {
+	Content any    `json:"content"`
+	Role    string `json:"role"`
+}
+
+// contentPart represents a text or image part of a multimodal user message.
+type contentPart struct {
+	ImageURL *imageURL `json:"image_url,omitempty"`
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+}
+
+// imageURL holds the data URL of an image attached to a message.
+type imageURL struct {
+	URL string `json:"url"`
+}
+
+// chatResponse represents the relevant part of a chat completions response.
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int64 `json:"prompt_tokens"`
+		CompletionTokens int64 `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// model adapts an OpenAI-compatible chat completions API to the anthropic.Model interface.
+type model struct {
+	client     *http.Client
+	headers    map[string]string
+	url        string
+	apiKey     string
+	authHeader string
+	modelID    string
+	maxTokens  int
+}
+
+// newModel creates a model calling the chat completions endpoint of the configured API with the given model ID.
+// The API version is added to the endpoint as the "api-version" query parameter when configured.
+func newModel(client *http.Client, cfg Config, modelID string) *model {
+	endpoint := strings.TrimSuffix(cfg.BaseURL, "/") + "/chat/completions"
+	if cfg.APIVersion != "" {
+		endpoint += "?" + url.Values{"api-version": {cfg.APIVersion}}.Encode()
+	}
+
+	return &model{
+		client:     client,
+		headers:    cfg.Headers,
+		url:        endpoint,
+		apiKey:     cfg.APIKey,
+		authHeader: cfg.AuthHeader,
+		modelID:    modelID,
+		maxTokens:  cfg.MaxTokens,
+	}
+}
+
+// Call sends a chat completions request with the core guidelines and system prompts as the system message,
+// followed by the conversation turns as user and assistant messages with optional images.
+// Returns the text of the first choice and an error if the request fails, the response is truncated or empty.
+func (m *model) Call(ctx context.Context, systemPrompts string, turns []message.Turn) (string, error) {
+	messages := make([]chatMessage, 0, len(turns)+1)
+	messages = append(messages, chatMessage{Role: "system", Content: anthropic.CoreGuidelines + "\n" + systemPrompts})
+
+	for _, turn := range turns {
+		messages = append(messages, newChatMessage(turn))
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model:     m.modelID,
+		Messages:  messages,
+		MaxTokens: m.maxTokens,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if m.apiKey != "" && m.authHeader != "" {
+		req.Header.Set(m.authHeader, m.apiKey)
+	} else if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	for key, value := range m.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to call OpenAI-compatible API: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return "", fmt.Errorf("unexpected status code %d from OpenAI-compatible API: %s", resp.StatusCode, strings.TrimSpace(string(errBody)))
+	}
+
+	var chatResp chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	slog.InfoContext(
+		ctx, "Model Request",
+		slog.String("model", m.modelID),
+		slog.Int64("input", chatResp.Usage.PromptTokens),
+		slog.Int64("output", chatResp.Usage.CompletionTokens),
+	)
+
+	metrics.LLMTokens.WithLabelValues(m.modelID, metrics.TokensInput).Add(float64(chatResp.Usage.PromptTokens))
+	metrics.LLMTokens.WithLabelValues(m.modelID, metrics.TokensOutput).Add(float64(chatResp.Usage.CompletionTokens))
+
+	budget.Record(ctx, budget.Usage{
+		Model:        m.modelID,
+		InputTokens:  chatResp.Usage.PromptTokens,
+		OutputTokens: chatResp.Usage.CompletionTokens,
+	})
+
+	if len(chatResp.Choices) == 0 || chatResp.Choices[0].Message.Content == "" {
+		return "", fmt.Errorf("empty response from OpenAI-compatible API")
+	}
+
+	if chatResp.Choices[0].FinishReason == finishReasonLength {
+		return "", fmt.Errorf("response truncated: max_tokens limit reached, consider increasing max_tokens in config")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
+
+// newChatMessage converts the conversation turn into a chat completions message.
+// Turns with images are sent as a list of content parts, others as plain text.
+func newChatMessage(turn message.Turn) chatMessage {
+	if len(turn.Images) == 0 {
+		return chatMessage{Role: string(turn.Role), Content: turn.Content}
+	}
+
+	parts := []contentPart{{Type: "text", Text: turn.Content}}
+
+	for _, img := range turn.Images {
+		parts = append(parts, contentPart{
+			Type:     "image_url",
+			ImageURL: &imageURL{URL: "data:" + img.MIME + ";base64," + img.Data},
+		})
+	}
+
+	return chatMessage{Role: string(turn.Role), Content: parts}
+}
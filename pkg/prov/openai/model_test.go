@@ -0,0 +1,164 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStubServer starts a chat completions stub that records the last request and replies with the given response.
+func newStubServer(t *testing.T, status int, response string, lastReq *map[string]any, headers *http.Header) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+
+		if headers != nil {
+			*headers = r.Header.Clone()
+		}
+
+		if lastReq != nil {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(lastReq))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestModel_Call(t *testing.T) {
+	var (
+		req     map[string]any
+		headers http.Header
+	)
+
+	srv := newStubServer(t, http.StatusOK, `{
+		"choices": [{"message": {"role": "assistant", "content": "answer"}, "finish_reason": "stop"}],
+		"usage": {"prompt_tokens": 10, "completion_tokens": 5}
+	}`, &req, &headers)
+
+	m := newModel(srv.Client(), Config{
+		BaseURL:   srv.URL + "/v1/",
+		APIKey:    "test-key",
+		MaxTokens: 100,
+		Headers:   map[string]string{"api-key": "azure-key"},
+	}, "test-model")
+
+	resp, err := m.Call(context.Background(), "system prompt", []message.Turn{
+		message.NewUserTurn("earlier question", nil),
+		message.NewAssistantTurn("earlier answer"),
+		message.NewUserTurn("question", []*message.Image{{MIME: "image/png", Data: "aW1n"}}),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "answer", resp)
+
+	assert.Equal(t, "Bearer test-key", headers.Get("Authorization"))
+	assert.Equal(t, "azure-key", headers.Get("api-key"))
+
+	assert.Equal(t, "test-model", req["model"])
+	assert.EqualValues(t, 100, req["max_tokens"])
+
+	messages := req["messages"].([]any)
+	require.Len(t, messages, 4)
+
+	system := messages[0].(map[string]any)
+	assert.Equal(t, "system", system["role"])
+	assert.Equal(t, anthropic.CoreGuidelines+"\nsystem prompt", system["content"])
+
+	assert.Equal(t, map[string]any{"role": "user", "content": "earlier question"}, messages[1])
+	assert.Equal(t, map[string]any{"role": "assistant", "content": "earlier answer"}, messages[2])
+
+	user := messages[3].(map[string]any)
+	assert.Equal(t, "user", user["role"])
+
+	parts := user["content"].([]any)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "question", parts[0].(map[string]any)["text"])
+	assert.Equal(t, "data:image/png;base64,aW1n", parts[1].(map[string]any)["image_url"].(map[string]any)["url"])
+}
+
+func TestModel_Call_Azure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/openai/deployments/gpt-4o/chat/completions", r.URL.Path)
+		assert.Equal(t, "2024-10-21", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "azure-key", r.Header.Get("api-key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "answer"}, "finish_reason": "stop"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	m := newModel(srv.Client(), Config{
+		BaseURL:    srv.URL + "/openai/deployments/gpt-4o",
+		APIKey:     "azure-key",
+		AuthHeader: "api-key",
+		APIVersion: "2024-10-21",
+	}, "gpt-4o")
+
+	resp, err := m.Call(context.Background(), "system prompt", []message.Turn{message.NewUserTurn("question", nil)})
+
+	require.NoError(t, err)
+	assert.Equal(t, "answer", resp)
+}
+
+func TestModel_CallErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+		status   int
+	}{
+		{
+			name:     "non-200 status",
+			status:   http.StatusUnauthorized,
+			response: `{"error": {"message": "invalid api key"}}`,
+			wantErr:  "unexpected status code 401",
+		},
+		{
+			name:     "invalid json",
+			status:   http.StatusOK,
+			response: `not json`,
+			wantErr:  "failed to decode response",
+		},
+		{
+			name:     "no choices",
+			status:   http.StatusOK,
+			response: `{"choices": []}`,
+			wantErr:  "empty response",
+		},
+		{
+			name:     "truncated response",
+			status:   http.StatusOK,
+			response: `{"choices": [{"message": {"content": "partial"}, "finish_reason": "length"}]}`,
+			wantErr:  "response truncated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req map[string]any
+
+			srv := newStubServer(t, tt.status, tt.response, &req, nil)
+			m := newModel(srv.Client(), Config{BaseURL: srv.URL + "/v1"}, "test-model")
+
+			_, err := m.Call(context.Background(), "system", []message.Turn{message.NewUserTurn("question", nil)})
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Equal(t, "question", req["messages"].([]any)[1].(map[string]any)["content"])
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package openai implements the LLM provider for OpenAI-compatible chat completions APIs,
+// such as OpenAI, Azure OpenAI, vLLM, llama.cpp server or Ollama.
+package openai
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/prov/anthropic"
+)
+
+const defaultTimeout = 2 * time.Minute
+
+// Config defines the configuration settings for an OpenAI-compatible chat completions API.
+// BaseURL is the API root the "/chat/completions" path is appended to, such as "https://api.openai.com/v1"
+// or "http://localhost:11434/v1" for Ollama.
+// APIKey is sent as a bearer token when provided, self-hosted servers usually do not require it.
+// AuthHeader sends APIKey as is in the named header instead, such as "api-key" for Azure OpenAI.
+// APIVersion adds the "api-version" query parameter required by Azure OpenAI, such as "2024-10-21",
+// where BaseURL is the deployment root, e.g. "https://my-resource.openai.azure.com/openai/deployments/gpt-4o".
+// Model identifies the model answering questions; MediaModel the model describing images, it defaults to Model.
+// MaxTokens sets the maximum number of tokens generated per request, zero leaves it up to the server.
+// Headers adds custom headers to every request.
+type Config struct {
+	Headers    map[string]string `mapstructure:"headers"`
+	BaseURL    string            `mapstructure:"base_url"`
+	APIKey     string            `mapstructure:"api_key"`
+	AuthHeader string            `mapstructure:"auth_header"`
+	APIVersion string            `mapstructure:"api_version"`
+	Model      string            `mapstructure:"model"`
+	MediaModel string            `mapstructure:"media_model"`
+	MaxTokens  int               `mapstructure:"max_tokens"`
+}
+
+// New initializes an LLM provider backed by an OpenAI-compatible chat completions API.
+// It reuses the prompts and response parsing of the Anthropic provider, so both backends produce the same results.
+// Returns the provider or an error if the configuration is missing required settings.
+func New(cfg Config) (*anthropic.Provider, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("base URL is required")
+	}
+
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("model is required")
+	}
+
+	if cfg.MediaModel == "" {
+		cfg.MediaModel = cfg.Model
+	}
+
+	client := &http.Client{Timeout: defaultTimeout}
+
+	llm := newModel(client, cfg, cfg.Model)
+	mediaModel := newModel(client, cfg, cfg.MediaModel)
+
+	return anthropic.NewProvider(llm, mediaModel), nil
+}
@@ -0,0 +1,62 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:   "valid configuration",
+			config: Config{BaseURL: "http://localhost:11434/v1", Model: "llama3"},
+		},
+		{
+			name:    "empty base URL",
+			config:  Config{Model: "llama3"},
+			wantErr: true,
+		},
+		{
+			name:    "empty model",
+			config:  Config{BaseURL: "http://localhost:11434/v1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := New(tt.config)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, provider)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, provider)
+			}
+		})
+	}
+}
+
+func TestProvider_Analyze(t *testing.T) {
+	srv := newStubServer(t, http.StatusOK, `{
+		"choices": [{"message": {"content": "{\"text\": \"Keep your pet hydrated.\", \"questions\": []}"}, "finish_reason": "stop"}]
+	}`, nil, nil)
+
+	provider, err := New(Config{BaseURL: srv.URL + "/v1", Model: "llama3"})
+	require.NoError(t, err)
+
+	result, err := provider.Analyze(context.Background(), []message.Turn{message.NewUserTurn("My dog is lethargic", nil)})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Keep your pet hydrated.", result.Text)
+	assert.Empty(t, result.Questions)
+}
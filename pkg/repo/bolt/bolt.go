@@ -0,0 +1,104 @@
+// Package bolt implements repositories keeping data in a single bbolt database file,
+// for deployments without a Redis server. The file can be used by one process at a time.
+package bolt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	bbolt "go.etcd.io/bbolt"
+)
+
+const openTimeout = time.Second
+
+var (
+	conversationsBucket = []byte("conversations")
+	petProfilesBucket   = []byte("pet_profiles")
+	// conversationExpiryBucket indexes conversations by the time they expire, keys are made with timeKey
+	conversationExpiryBucket = []byte("conversation_expiry")
+	remindersBucket          = []byte("reminders")
+	// reminderScheduleBucket indexes reminders by the time they are due, keys are made with timeKey
+	reminderScheduleBucket = []byte("reminder_schedule")
+	// userRemindersBucket holds a nested bucket with reminder IDs for every user
+	userRemindersBucket = []byte("user_reminders")
+	answersBucket       = []byte("answers")
+	// answerExpiryBucket indexes answers by the time they expire, keys are made with timeKey
+	answerExpiryBucket    = []byte("answer_expiry")
+	feedbackBucket        = []byte("feedback")
+	feedbackReasonsBucket = []byte("feedback_reasons")
+	broadcastChatsBucket  = []byte("broadcast_chats")
+	broadcastsBucket      = []byte("broadcasts")
+	// pendingBroadcastsBucket indexes broadcasts which are not completed by the time they were created,
+	// keys are made with timeKey
+	pendingBroadcastsBucket = []byte("pending_broadcasts")
+	// broadcastQueuesBucket holds a nested bucket with queued recipients for every pending broadcast
+	broadcastQueuesBucket = []byte("broadcast_queues")
+
+	buckets = [][]byte{
+		conversationsBucket, petProfilesBucket, conversationExpiryBucket,
+		remindersBucket, reminderScheduleBucket, userRemindersBucket,
+		answersBucket, answerExpiryBucket, feedbackBucket, feedbackReasonsBucket,
+		broadcastChatsBucket, broadcastsBucket, pendingBroadcastsBucket, broadcastQueuesBucket,
+	}
+)
+
+// Open opens the database file at path, creating it and its buckets if they don't exist.
+// Returns the database or an error if the file can't be opened, e.g. when another process holds it.
+func Open(path string) (*bbolt.DB, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		indexed := tx.Bucket(conversationExpiryBucket) != nil
+
+		for _, name := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		if indexed {
+			return nil
+		}
+
+		// Conversations saved before the expiry index was introduced are indexed once
+		return indexConversations(tx)
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create buckets: %w", err)
+	}
+
+	return db, nil
+}
+
+// timeKey returns the key of the record with the ID in an index ordered by time,
+// keys are ordered by the time followed by the record ID.
+func timeKey(t time.Time, id []byte) []byte {
+	key := make([]byte, 8, 8+len(id))
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+
+	return append(key, id...)
+}
+
+// keyTime returns the time encoded in the key of an index made with timeKey.
+func keyTime(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[:8])))
+}
+
+// indexConversations adds all stored conversations to the expiry index.
+func indexConversations(tx *bbolt.Tx) error {
+	index := tx.Bucket(conversationExpiryBucket)
+
+	return tx.Bucket(conversationsBucket).ForEach(func(k, v []byte) error {
+		stored, err := decodeConversation(v)
+		if err != nil {
+			return err
+		}
+
+		return index.Put(timeKey(stored.ExpiresAt, k), nil)
+	})
+}
@@ -0,0 +1,35 @@
+package bolt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "help-my-pet.db")
+
+	db, err := Open(path)
+	require.NoError(t, err)
+
+	_, err = Open(path)
+	assert.ErrorContains(t, err, "failed to open database", "the file is locked by the first connection")
+
+	require.NoError(t, db.Close())
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing", "help-my-pet.db"))
+	assert.ErrorContains(t, err, "failed to open database")
+}
+
+// testDBPath returns the path of a database file in a temporary directory removed when the test ends.
+func testDBPath(t *testing.T) string {
+	t.Helper()
+
+	return filepath.Join(t.TempDir(), "help-my-pet.db")
+}
@@ -0,0 +1,335 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// storedBroadcast is a serialized broadcast with the lease of the worker delivering it
+type storedBroadcast struct {
+	LeaseExpiresAt time.Time       `json:"lease_expires_at,omitempty"`
+	Broadcast      json.RawMessage `json:"broadcast"`
+	LeaseOwner     string          `json:"lease_owner,omitempty"`
+}
+
+// BroadcastRepository implements core.BroadcastRepository using a bbolt database.
+// Known chats map chat IDs to their languages. Broadcasts are stored as JSON, pending ones are indexed
+// by creation time, and every pending broadcast has a nested bucket of queued recipients ordered by a sequence.
+type BroadcastRepository struct {
+	db  *bbolt.DB
+	now func() time.Time
+}
+
+// NewBroadcastRepository creates a new BroadcastRepository storing broadcasts in the database opened with Open.
+func NewBroadcastRepository(db *bbolt.DB) *BroadcastRepository {
+	return &BroadcastRepository{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// SaveChat adds the chat to the recipients of future broadcasts or updates its language.
+// Returns an error if the database update fails.
+func (r *BroadcastRepository) SaveChat(_ context.Context, chat broadcast.Chat) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(broadcastChatsBucket).Put([]byte(chat.ID), []byte(chat.Language))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveChat removes the chat from the recipients of future broadcasts.
+// Broadcasts already queued for the chat are still attempted, the delivery fails for unavailable chats.
+// Returns an error if the database update fails.
+func (r *BroadcastRepository) RemoveChat(_ context.Context, chatID string) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(broadcastChatsBucket).Delete([]byte(chatID))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove chat: %w", err)
+	}
+
+	return nil
+}
+
+// CountChats returns the number of known chats per language.
+// Returns an error if reading the chats fails.
+func (r *BroadcastRepository) CountChats(_ context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(broadcastChatsBucket).ForEach(func(_, language []byte) error {
+			counts[string(language)]++
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chats: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CreateBroadcast stores the broadcast, marks it as pending and queues all known chats as its recipients
+// in a single transaction. The languages of the chats are captured in the queue,
+// so later language changes don't affect the broadcast.
+// Returns an error if serialization or the database update fails.
+func (r *BroadcastRepository) CreateBroadcast(_ context.Context, b *broadcast.Broadcast) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		id := []byte(b.ID)
+
+		queue, err := tx.Bucket(broadcastQueuesBucket).CreateBucketIfNotExists(id)
+		if err != nil {
+			return err
+		}
+
+		b.Total = 0
+
+		err = tx.Bucket(broadcastChatsBucket).ForEach(func(chatID, language []byte) error {
+			data, err := json.Marshal(broadcast.Chat{ID: string(chatID), Language: string(language)})
+			if err != nil {
+				return fmt.Errorf("failed to marshal recipient: %w", err)
+			}
+
+			seq, err := queue.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			b.Total++
+
+			return queue.Put(binary.BigEndian.AppendUint64(nil, seq), data)
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := putBroadcast(tx, b, storedBroadcast{}); err != nil {
+			return err
+		}
+
+		return tx.Bucket(pendingBroadcastsBucket).Put(timeKey(b.CreatedAt, id), nil)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingBroadcast retrieves the oldest broadcast which is not completed.
+// Returns core.ErrBroadcastNotFound if there are no pending broadcasts, or an error if reading or unmarshaling fails.
+func (r *BroadcastRepository) GetPendingBroadcast(_ context.Context) (*broadcast.Broadcast, error) {
+	var b *broadcast.Broadcast
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		k, _ := tx.Bucket(pendingBroadcastsBucket).Cursor().First()
+		if k == nil {
+			return core.ErrBroadcastNotFound
+		}
+
+		stored, err := getBroadcast(tx, k[8:])
+		if err != nil {
+			return err
+		}
+
+		b, err = unmarshalBroadcast(stored.Broadcast)
+
+		return err
+	})
+
+	switch {
+	case errors.Is(err, core.ErrBroadcastNotFound):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("failed to get pending broadcast: %w", err)
+	}
+
+	return b, nil
+}
+
+// LeaseBroadcast acquires or renews the lease of the broadcast for owner for the duration of ttl
+// and retrieves the current state of the broadcast. The lease is checked and set in a single transaction.
+// Returns core.ErrBroadcastLeased if another owner holds an unexpired lease, core.ErrBroadcastNotFound if there is
+// no such broadcast, or an error if the database update or unmarshaling fails.
+func (r *BroadcastRepository) LeaseBroadcast(_ context.Context, id, owner string, ttl time.Duration) (*broadcast.Broadcast, error) {
+	now := r.now()
+
+	var b *broadcast.Broadcast
+
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		stored, err := getBroadcast(tx, []byte(id))
+		if err != nil {
+			return err
+		}
+
+		if stored.LeaseOwner != owner && now.Before(stored.LeaseExpiresAt) {
+			return core.ErrBroadcastLeased
+		}
+
+		if b, err = unmarshalBroadcast(stored.Broadcast); err != nil {
+			return err
+		}
+
+		stored.LeaseOwner = owner
+		stored.LeaseExpiresAt = now.Add(ttl)
+
+		return putBroadcast(tx, b, *stored)
+	})
+
+	switch {
+	case errors.Is(err, core.ErrBroadcastLeased), errors.Is(err, core.ErrBroadcastNotFound):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("failed to lease broadcast: %w", err)
+	}
+
+	return b, nil
+}
+
+// GetRecipients retrieves up to limit recipients from the head of the queue of the broadcast without removing them.
+// Returns the recipients, or an error if reading or unmarshaling fails.
+func (r *BroadcastRepository) GetRecipients(_ context.Context, id string, limit int) ([]broadcast.Chat, error) {
+	var chats []broadcast.Chat
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		queue := tx.Bucket(broadcastQueuesBucket).Bucket([]byte(id))
+		if queue == nil {
+			return nil
+		}
+
+		cursor := queue.Cursor()
+
+		for k, v := cursor.First(); k != nil && len(chats) < limit; k, v = cursor.Next() {
+			var chat broadcast.Chat
+			if err := json.Unmarshal(v, &chat); err != nil {
+				return fmt.Errorf("failed to unmarshal recipient: %w", err)
+			}
+
+			chats = append(chats, chat)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipients: %w", err)
+	}
+
+	return chats, nil
+}
+
+// UpdateBroadcast removes the first processed recipients from the queue of the broadcast and stores its progress
+// if owner holds an unexpired lease, the lease is checked in the same transaction.
+// Completed broadcasts are no longer pending, their queues are removed and their leases released.
+// Returns core.ErrBroadcastLeased if owner doesn't hold the lease, or an error if the database update fails.
+func (r *BroadcastRepository) UpdateBroadcast(_ context.Context, b *broadcast.Broadcast, owner string, processed int) error {
+	now := r.now()
+
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		id := []byte(b.ID)
+
+		stored, err := getBroadcast(tx, id)
+		if errors.Is(err, core.ErrBroadcastNotFound) {
+			return core.ErrBroadcastLeased
+		} else if err != nil {
+			return err
+		}
+
+		if stored.LeaseOwner != owner || !now.Before(stored.LeaseExpiresAt) {
+			return core.ErrBroadcastLeased
+		}
+
+		queues := tx.Bucket(broadcastQueuesBucket)
+
+		if b.Completed() {
+			if err := tx.Bucket(pendingBroadcastsBucket).Delete(timeKey(b.CreatedAt, id)); err != nil {
+				return err
+			}
+
+			if queues.Bucket(id) != nil {
+				if err := queues.DeleteBucket(id); err != nil {
+					return err
+				}
+			}
+
+			return putBroadcast(tx, b, storedBroadcast{})
+		}
+
+		if queue := queues.Bucket(id); queue != nil {
+			cursor := queue.Cursor()
+
+			for k, _ := cursor.First(); k != nil && processed > 0; k, _ = cursor.First() {
+				if err := cursor.Delete(); err != nil {
+					return err
+				}
+
+				processed--
+			}
+		}
+
+		return putBroadcast(tx, b, *stored)
+	})
+
+	switch {
+	case errors.Is(err, core.ErrBroadcastLeased):
+		return err
+	case err != nil:
+		return fmt.Errorf("failed to update broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// getBroadcast unmarshals the stored broadcast with the ID.
+// Returns core.ErrBroadcastNotFound if there is no such broadcast.
+func getBroadcast(tx *bbolt.Tx, id []byte) (*storedBroadcast, error) {
+	value := tx.Bucket(broadcastsBucket).Get(id)
+	if value == nil {
+		return nil, core.ErrBroadcastNotFound
+	}
+
+	var stored storedBroadcast
+	if err := json.Unmarshal(value, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored broadcast: %w", err)
+	}
+
+	return &stored, nil
+}
+
+// putBroadcast stores the broadcast with the lease of stored.
+func putBroadcast(tx *bbolt.Tx, b *broadcast.Broadcast, stored storedBroadcast) error {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	stored.Broadcast = data
+
+	value, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	return tx.Bucket(broadcastsBucket).Put([]byte(b.ID), value)
+}
+
+// unmarshalBroadcast deserializes the broadcast.
+func unmarshalBroadcast(data []byte) (*broadcast.Broadcast, error) {
+	var b broadcast.Broadcast
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal broadcast: %w", err)
+	}
+
+	return &b, nil
+}
@@ -0,0 +1,221 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// storedConversation is a serialized conversation with its version and the time it expires at
+type storedConversation struct {
+	ExpiresAt    time.Time       `json:"expires_at"`
+	Conversation json.RawMessage `json:"conversation"`
+	Version      int64           `json:"version"`
+}
+
+// ConversationRepository implements core.ConversationRepository using a bbolt database.
+// Conversations expire after conversation.TTL since their last save, as in Redis. Expired conversations
+// are not returned and are deleted by RemoveExpired, which finds them in an index ordered by the expiration time.
+type ConversationRepository struct {
+	db  *bbolt.DB
+	now func() time.Time
+}
+
+// NewConversationRepository creates a new ConversationRepository storing conversations in the database opened with Open.
+func NewConversationRepository(db *bbolt.DB) *ConversationRepository {
+	return &ConversationRepository{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// Save serializes the conversation and stores it under its ID with the next version, replacing the previous version
+// and extending its TTL. An expired stored conversation is replaced as if there was none.
+// Returns core.ErrConversationConflict if the stored version differs from the version of the conversation,
+// or an error if serialization or the database update fails.
+func (r *ConversationRepository) Save(_ context.Context, conv core.Conversation) error {
+	version := conv.GetVersion()
+
+	conv.SetVersion(version + 1)
+
+	data, err := json.Marshal(conv)
+	if err != nil {
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+
+	now := r.now()
+
+	value, err := json.Marshal(storedConversation{ExpiresAt: now.Add(conversation.TTL), Conversation: data, Version: version + 1})
+	if err != nil {
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(conversationsBucket)
+		index := tx.Bucket(conversationExpiryBucket)
+		id := []byte(conv.GetID())
+
+		stored, err := decodeConversation(bucket.Get(id))
+		if err != nil {
+			return err
+		}
+
+		var storedVersion int64
+
+		if stored != nil {
+			if now.Before(stored.ExpiresAt) {
+				storedVersion = stored.Version
+			}
+
+			if err := index.Delete(timeKey(stored.ExpiresAt, id)); err != nil {
+				return err
+			}
+		}
+
+		if storedVersion != version {
+			return core.ErrConversationConflict
+		}
+
+		if err := index.Put(timeKey(now.Add(conversation.TTL), id), nil); err != nil {
+			return err
+		}
+
+		return bucket.Put(id, value)
+	})
+
+	switch {
+	case errors.Is(err, core.ErrConversationConflict):
+		conv.SetVersion(version)
+		return err
+	case err != nil:
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to save conversation: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves the conversation with the given ID.
+// Returns core.ErrConversationNotFound if there is no such conversation or it expired,
+// or an error if reading or unmarshaling fails.
+func (r *ConversationRepository) FindByID(_ context.Context, id string) (core.Conversation, error) {
+	var stored *storedConversation
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		stored, err = decodeConversation(tx.Bucket(conversationsBucket).Get([]byte(id)))
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+
+	if stored == nil || !r.now().Before(stored.ExpiresAt) {
+		return nil, core.ErrConversationNotFound
+	}
+
+	conv, err := conversation.Unmarshal(stored.Conversation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal conversation with id %s: %w", id, err)
+	}
+
+	return conv, nil
+}
+
+// FindOrCreate retrieves the conversation with the given ID, or creates a new one if it is not found.
+// Returns an error if the stored conversation can't be read.
+func (r *ConversationRepository) FindOrCreate(ctx context.Context, id string) (core.Conversation, error) {
+	conv, err := r.FindByID(ctx, id)
+	if err == core.ErrConversationNotFound {
+		return conversation.NewConversation(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return conv, nil
+}
+
+// Delete removes the conversation with the given ID, it does nothing if there is no such conversation.
+// Returns an error if the database update fails.
+func (r *ConversationRepository) Delete(_ context.Context, id string) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(conversationsBucket)
+
+		stored, err := decodeConversation(bucket.Get([]byte(id)))
+		if err != nil || stored == nil {
+			return err
+		}
+
+		if err := tx.Bucket(conversationExpiryBucket).Delete(timeKey(stored.ExpiresAt, []byte(id))); err != nil {
+			return err
+		}
+
+		return bucket.Delete([]byte(id))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete conversation: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveExpired deletes the conversations expired at now. Only the expired entries of the expiry index are read,
+// so the cost doesn't depend on the number of stored conversations.
+// Returns an error if the database update fails.
+func (r *ConversationRepository) RemoveExpired(_ context.Context, now time.Time) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		var expired [][]byte
+
+		cursor := tx.Bucket(conversationExpiryBucket).Cursor()
+
+		for k, _ := cursor.First(); k != nil && !now.Before(keyTime(k)); k, _ = cursor.Next() {
+			expired = append(expired, bytes.Clone(k))
+		}
+
+		bucket := tx.Bucket(conversationsBucket)
+		index := tx.Bucket(conversationExpiryBucket)
+
+		for _, k := range expired {
+			if err := bucket.Delete(k[8:]); err != nil {
+				return err
+			}
+
+			if err := index.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove expired conversations: %w", err)
+	}
+
+	return nil
+}
+
+// decodeConversation unmarshals the stored conversation.
+// Returns nil if there is no stored value.
+func decodeConversation(value []byte) (*storedConversation, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var stored storedConversation
+	if err := json.Unmarshal(value, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored conversation: %w", err)
+	}
+
+	return &stored, nil
+}
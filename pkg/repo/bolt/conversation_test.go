@@ -0,0 +1,158 @@
+package bolt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bbolt "go.etcd.io/bbolt"
+)
+
+func TestConversationRepository(t *testing.T) {
+	ctx := context.Background()
+	path := testDBPath(t)
+
+	db, err := Open(path)
+	require.NoError(t, err)
+
+	repo := NewConversationRepository(db)
+
+	conv, err := repo.FindOrCreate(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, conversation.NewConversation("chat1"), conv)
+
+	conv.AddMessage("user", "Hello")
+	require.NoError(t, repo.Save(ctx, conv))
+
+	// Conversations survive restarts
+	require.NoError(t, db.Close())
+
+	db, err = Open(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo = NewConversationRepository(db)
+
+	found, err := repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, "chat1", found.GetID())
+	assert.Len(t, found.(*conversation.Conversation).Messages, 1)
+
+	require.NoError(t, repo.Delete(ctx, "chat1"))
+
+	_, err = repo.FindByID(ctx, "chat1")
+	assert.ErrorIs(t, err, core.ErrConversationNotFound)
+}
+
+func TestConversationRepository_TTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	db, err := Open(testDBPath(t))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewConversationRepository(db)
+	repo.now = func() time.Time { return now }
+
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat1")))
+
+	now = now.Add(conversation.TTL - time.Second)
+
+	_, err = repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+
+	_, err = repo.FindByID(ctx, "chat1")
+	assert.ErrorIs(t, err, core.ErrConversationNotFound)
+
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat1")), "expired conversation is replaced")
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat2")))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat3")))
+
+	now = now.Add(conversation.TTL - time.Minute)
+	require.NoError(t, repo.RemoveExpired(ctx, now))
+
+	assert.Equal(t, []string{"chat3"}, storedKeys(t, db, conversationsBucket), "expired conversations are removed")
+	assert.Len(t, storedKeys(t, db, conversationExpiryBucket), 1)
+}
+
+func TestOpen_IndexesConversations(t *testing.T) {
+	ctx := context.Background()
+	path := testDBPath(t)
+
+	db, err := Open(path)
+	require.NoError(t, err)
+
+	repo := NewConversationRepository(db)
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat1")))
+
+	// Databases created before the expiry index have no index bucket
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket(conversationExpiryBucket)
+	}))
+	require.NoError(t, db.Close())
+
+	db, err = Open(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, NewConversationRepository(db).RemoveExpired(ctx, time.Now().Add(conversation.TTL)))
+	assert.Empty(t, storedKeys(t, db, conversationsBucket))
+}
+
+// storedKeys returns the keys of the bucket.
+func storedKeys(t *testing.T, db *bbolt.DB, bucket []byte) []string {
+	t.Helper()
+
+	var keys []string
+
+	require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(k, _ []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+	}))
+
+	return keys
+}
+
+func TestConversationRepository_Versions(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := Open(testDBPath(t))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewConversationRepository(db)
+
+	first, err := repo.FindOrCreate(ctx, "chat1")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, first))
+	assert.Equal(t, int64(1), first.GetVersion())
+
+	second, err := repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), second.GetVersion())
+
+	require.NoError(t, repo.Save(ctx, second))
+	assert.Equal(t, int64(2), second.GetVersion())
+
+	first.AddMessage("user", "Hello")
+	assert.ErrorIs(t, repo.Save(ctx, first), core.ErrConversationConflict, "conversation saved by another request")
+	assert.Equal(t, int64(1), first.GetVersion())
+
+	require.NoError(t, repo.Delete(ctx, "chat1"))
+	assert.ErrorIs(t, repo.Save(ctx, second), core.ErrConversationConflict, "conversation deleted by another request")
+}
@@ -0,0 +1,295 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// storedAnswer is a serialized answer with the time it can be rated until
+type storedAnswer struct {
+	ExpiresAt time.Time       `json:"expires_at"`
+	Answer    json.RawMessage `json:"answer"`
+}
+
+// pendingReason is the answer the user is asked to give the reason of the rating for
+type pendingReason struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	AnswerID  string    `json:"answer_id"`
+}
+
+// FeedbackRepository implements core.FeedbackRepository using a bbolt database.
+// Answers are stored as JSON, in the same format as in Redis, and can be rated until they expire
+// after feedback.AnswerTTL. Rated answers are kept for quality reviews after that.
+// Expired answers are not returned and are deleted by RemoveExpired, which finds them in an index
+// ordered by the expiration time.
+type FeedbackRepository struct {
+	db  *bbolt.DB
+	now func() time.Time
+}
+
+// NewFeedbackRepository creates a new FeedbackRepository storing answers in the database opened with Open.
+func NewFeedbackRepository(db *bbolt.DB) *FeedbackRepository {
+	return &FeedbackRepository{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// SaveAnswer stores the unrated answer, it can be rated until it expires.
+// Returns an error if serialization or the database update fails.
+func (r *FeedbackRepository) SaveAnswer(_ context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		return putAnswer(tx, []byte(f.ID), data, r.now().Add(feedback.AnswerTTL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save answer: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnswer retrieves the answer with the ID.
+// Returns core.ErrAnswerNotFound if the answer doesn't exist or has expired, or an error if reading or unmarshaling fails.
+func (r *FeedbackRepository) GetAnswer(_ context.Context, id string) (*feedback.Feedback, error) {
+	var stored *storedAnswer
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		stored, err = decodeAnswer(tx.Bucket(answersBucket).Get([]byte(id)))
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get answer: %w", err)
+	}
+
+	if stored == nil || !r.now().Before(stored.ExpiresAt) {
+		return nil, core.ErrAnswerNotFound
+	}
+
+	var f feedback.Feedback
+	if err := json.Unmarshal(stored.Answer, &f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal answer: %w", err)
+	}
+
+	return &f, nil
+}
+
+// SaveFeedback stores the rated answer permanently and updates the answer, keeping its expiration.
+// Returns an error if serialization or the database update fails.
+func (r *FeedbackRepository) SaveFeedback(_ context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal feedback: %w", err)
+	}
+
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(feedbackBucket).Put([]byte(f.ID), data); err != nil {
+			return err
+		}
+
+		return putAnswer(tx, []byte(f.ID), data, f.CreatedAt.Add(feedback.AnswerTTL))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return nil
+}
+
+// SetPendingReason remembers the answer the user is asked to give the reason of the rating for,
+// the reason is awaited for feedback.ReasonTTL.
+// Returns an error if the database update fails.
+func (r *FeedbackRepository) SetPendingReason(_ context.Context, userID, answerID string) error {
+	value, err := json.Marshal(pendingReason{AnswerID: answerID, ExpiresAt: r.now().Add(feedback.ReasonTTL)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending reason: %w", err)
+	}
+
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(feedbackReasonsBucket).Put([]byte(userID), value)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save pending reason: %w", err)
+	}
+
+	return nil
+}
+
+// PopPendingReason retrieves and removes the answer waiting for the reason of the user.
+// Returns core.ErrAnswerNotFound if no reason is awaited from the user or it has expired,
+// or an error if the database update fails.
+func (r *FeedbackRepository) PopPendingReason(_ context.Context, userID string) (string, error) {
+	var reason pendingReason
+
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(feedbackReasonsBucket)
+
+		value := bucket.Get([]byte(userID))
+		if value == nil {
+			return core.ErrAnswerNotFound
+		}
+
+		if err := json.Unmarshal(value, &reason); err != nil {
+			return fmt.Errorf("failed to unmarshal pending reason: %w", err)
+		}
+
+		return bucket.Delete([]byte(userID))
+	})
+
+	switch {
+	case errors.Is(err, core.ErrAnswerNotFound):
+		return "", err
+	case err != nil:
+		return "", fmt.Errorf("failed to get pending reason: %w", err)
+	case !r.now().Before(reason.ExpiresAt):
+		return "", core.ErrAnswerNotFound
+	}
+
+	return reason.AnswerID, nil
+}
+
+// ListFeedback retrieves all rated answers ordered by the time they were rated.
+// Returns the rated answers, or an error if reading or unmarshaling fails.
+func (r *FeedbackRepository) ListFeedback(_ context.Context) ([]*feedback.Feedback, error) {
+	var records []*feedback.Feedback
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(feedbackBucket).ForEach(func(_, v []byte) error {
+			var f feedback.Feedback
+			if err := json.Unmarshal(v, &f); err != nil {
+				return fmt.Errorf("failed to unmarshal feedback: %w", err)
+			}
+
+			records = append(records, &f)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	slices.SortFunc(records, func(a, b *feedback.Feedback) int {
+		return a.RatedAt.Compare(b.RatedAt)
+	})
+
+	return records, nil
+}
+
+// RemoveExpired deletes the answers and awaited reasons expired at now, rated answers are kept.
+// Only the expired entries of the answer expiry index are read, reasons are awaited briefly, so all of them are read.
+// Returns an error if the database update fails.
+func (r *FeedbackRepository) RemoveExpired(_ context.Context, now time.Time) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		var expired [][]byte
+
+		cursor := tx.Bucket(answerExpiryBucket).Cursor()
+
+		for k, _ := cursor.First(); k != nil && !now.Before(keyTime(k)); k, _ = cursor.Next() {
+			expired = append(expired, bytes.Clone(k))
+		}
+
+		answers := tx.Bucket(answersBucket)
+		index := tx.Bucket(answerExpiryBucket)
+
+		for _, k := range expired {
+			if err := answers.Delete(k[8:]); err != nil {
+				return err
+			}
+
+			if err := index.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		var reasons [][]byte
+
+		err := tx.Bucket(feedbackReasonsBucket).ForEach(func(k, v []byte) error {
+			var reason pendingReason
+			if err := json.Unmarshal(v, &reason); err != nil {
+				return fmt.Errorf("failed to unmarshal pending reason: %w", err)
+			}
+
+			if !now.Before(reason.ExpiresAt) {
+				reasons = append(reasons, bytes.Clone(k))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range reasons {
+			if err := tx.Bucket(feedbackReasonsBucket).Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove expired answers: %w", err)
+	}
+
+	return nil
+}
+
+// putAnswer stores the serialized answer with the ID until expiresAt, replacing its previous expiry index entry.
+func putAnswer(tx *bbolt.Tx, id, data []byte, expiresAt time.Time) error {
+	bucket := tx.Bucket(answersBucket)
+	index := tx.Bucket(answerExpiryBucket)
+
+	stored, err := decodeAnswer(bucket.Get(id))
+	if err != nil {
+		return err
+	}
+
+	if stored != nil {
+		if err := index.Delete(timeKey(stored.ExpiresAt, id)); err != nil {
+			return err
+		}
+	}
+
+	value, err := json.Marshal(storedAnswer{ExpiresAt: expiresAt, Answer: data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	if err := index.Put(timeKey(expiresAt, id), nil); err != nil {
+		return err
+	}
+
+	return bucket.Put(id, value)
+}
+
+// decodeAnswer unmarshals the stored answer.
+// Returns nil if there is no stored value.
+func decodeAnswer(value []byte) (*storedAnswer, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var stored storedAnswer
+	if err := json.Unmarshal(value, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored answer: %w", err)
+	}
+
+	return &stored, nil
+}
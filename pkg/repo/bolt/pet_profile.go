@@ -0,0 +1,182 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// PetProfileRepository implements core.PetProfileRepository using a bbolt database.
+// Profiles of a user are stored as a single JSON value, in the same format as in Redis.
+type PetProfileRepository struct {
+	db *bbolt.DB
+}
+
+// NewPetProfileRepository creates a new PetProfileRepository storing profiles in the database opened with Open.
+func NewPetProfileRepository(db *bbolt.DB) *PetProfileRepository {
+	return &PetProfileRepository{
+		db: db,
+	}
+}
+
+// SaveProfile replaces the user's active pet profile, or adds the profile as the first pet if the user has none.
+// Returns an error if serialization or the database update fails.
+func (r *PetProfileRepository) SaveProfile(_ context.Context, userID string, profile *pet.Profile) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		profiles.ReplaceCurrent(*profile)
+		return nil
+	})
+}
+
+// AddProfile adds a new pet profile for the user and makes it the active one.
+// If the user already has a pet with the same name, that pet's profile is replaced keeping its medical history.
+// Returns an error if serialization or the database update fails.
+func (r *PetProfileRepository) AddProfile(_ context.Context, userID string, profile *pet.Profile) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		profiles.Add(*profile)
+		return nil
+	})
+}
+
+// GetProfiles returns all pet profiles of the user together with the active pet index.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if reading or unmarshaling fails.
+func (r *PetProfileRepository) GetProfiles(_ context.Context, userID string) (*pet.Profiles, error) {
+	var profiles *pet.Profiles
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		profiles, err = load(tx, userID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profiles.Profiles) == 0 {
+		return nil, core.ErrProfileNotFound
+	}
+
+	return profiles, nil
+}
+
+// GetCurrentProfile returns the user's active pet profile.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if reading or unmarshaling fails.
+func (r *PetProfileRepository) GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error) {
+	profiles, err := r.GetProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles.Current(), nil
+}
+
+// SetActiveProfile makes the pet with the given name the active one for the user.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) SetActiveProfile(_ context.Context, userID, name string) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Select(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// UpdateProfile applies fn to the profile of the pet with the given name, the active pet is not changed.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) UpdateProfile(_ context.Context, userID, name string, fn func(profile *pet.Profile)) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Update(name, fn) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveProfile removes the pet with the given name from the user's profiles.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) RemoveProfile(_ context.Context, userID, name string) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Remove(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveUserProfiles removes all pet profiles of the user.
+// Returns an error if the database update fails.
+func (r *PetProfileRepository) RemoveUserProfiles(_ context.Context, userID string) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(petProfilesBucket).Delete([]byte(userID))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// update applies fn to the profiles of the user and stores the result in a single transaction,
+// users left without pets are removed.
+// Returns the error of fn, in which case nothing is stored, or an error if the database update fails.
+func (r *PetProfileRepository) update(userID string, fn func(profiles *pet.Profiles) error) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		profiles, err := load(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(profiles); err != nil {
+			return err
+		}
+
+		bucket := tx.Bucket(petProfilesBucket)
+
+		if len(profiles.Profiles) == 0 {
+			return bucket.Delete([]byte(userID))
+		}
+
+		data, err := json.Marshal(profiles)
+		if err != nil {
+			return fmt.Errorf("failed to marshal pet profiles: %w", err)
+		}
+
+		return bucket.Put([]byte(userID), data)
+	})
+
+	switch {
+	case errors.Is(err, core.ErrProfileNotFound):
+		return err
+	case err != nil:
+		return fmt.Errorf("failed to save pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// load unmarshals the profiles of the user stored in the transaction.
+// Returns an empty collection if the user has no stored profiles.
+func load(tx *bbolt.Tx, userID string) (*pet.Profiles, error) {
+	var profiles pet.Profiles
+
+	data := tx.Bucket(petProfilesBucket).Get([]byte(userID))
+	if data == nil {
+		return &profiles, nil
+	}
+
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pet profiles: %w", err)
+	}
+
+	return &profiles, nil
+}
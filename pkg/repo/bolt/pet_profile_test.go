@@ -0,0 +1,70 @@
+package bolt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetProfileRepository(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := Open(testDBPath(t))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := NewPetProfileRepository(db)
+
+	_, err = repo.GetCurrentProfile(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrProfileNotFound)
+
+	require.NoError(t, repo.SaveProfile(ctx, "user1", &pet.Profile{Name: "Rex", Species: "dog"}))
+	require.NoError(t, repo.AddProfile(ctx, "user1", &pet.Profile{Name: "Luna", Species: "cat"}))
+	require.NoError(t, repo.AddProfile(ctx, "user2", &pet.Profile{Name: "Max", Species: "dog"}))
+
+	current, err := repo.GetCurrentProfile(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "Luna", current.Name)
+
+	require.NoError(t, repo.SetActiveProfile(ctx, "user1", "Rex"))
+
+	profiles, err := repo.GetProfiles(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, []pet.Profile{{Name: "Rex", Species: "dog"}, {Name: "Luna", Species: "cat"}}, profiles.Profiles)
+	assert.Equal(t, "Rex", profiles.Current().Name)
+
+	require.NoError(t, repo.SaveProfile(ctx, "user1", &pet.Profile{Name: "Rex", Species: "dog", Weight: "12 kg"}))
+
+	current, err = repo.GetCurrentProfile(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "12 kg", current.Weight)
+
+	require.NoError(t, repo.UpdateProfile(ctx, "user1", "luna", func(profile *pet.Profile) {
+		profile.Weight = "4 kg"
+	}))
+
+	profiles, err = repo.GetProfiles(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "4 kg", profiles.Profiles[1].Weight)
+	assert.Equal(t, "Rex", profiles.Current().Name)
+
+	assert.ErrorIs(t, repo.SetActiveProfile(ctx, "user1", "Max"), core.ErrProfileNotFound)
+	assert.ErrorIs(t, repo.UpdateProfile(ctx, "user1", "Max", func(*pet.Profile) {}), core.ErrProfileNotFound)
+	assert.ErrorIs(t, repo.RemoveProfile(ctx, "user1", "Max"), core.ErrProfileNotFound)
+
+	require.NoError(t, repo.RemoveProfile(ctx, "user1", "Rex"))
+	require.NoError(t, repo.RemoveProfile(ctx, "user1", "Luna"))
+
+	_, err = repo.GetProfiles(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrProfileNotFound)
+
+	require.NoError(t, repo.RemoveUserProfiles(ctx, "user2"))
+
+	_, err = repo.GetProfiles(ctx, "user2")
+	assert.ErrorIs(t, err, core.ErrProfileNotFound)
+}
@@ -0,0 +1,288 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// storedReminder is a serialized reminder with the time it is scheduled for,
+// which differs from the time the reminder is due while it is claimed
+type storedReminder struct {
+	ScheduledAt time.Time       `json:"scheduled_at"`
+	Reminder    json.RawMessage `json:"reminder"`
+}
+
+// ReminderRepository implements core.ReminderRepository using a bbolt database.
+// Reminders are stored as JSON, in the same format as in Redis, scheduled in an index ordered by the time they are due
+// and indexed per user in nested buckets of reminder IDs.
+type ReminderRepository struct {
+	db *bbolt.DB
+}
+
+// NewReminderRepository creates a new ReminderRepository storing reminders in the database opened with Open.
+func NewReminderRepository(db *bbolt.DB) *ReminderRepository {
+	return &ReminderRepository{
+		db: db,
+	}
+}
+
+// SaveReminder creates or updates the reminder and schedules it for the time it is due.
+// The reminder is saved only if the stored version is the one it was loaded with, a missing reminder has version 0.
+// On success the version of the reminder is incremented.
+// Returns core.ErrReminderConflict if the reminder was changed or deleted since it was loaded,
+// or an error if serialization or the database update fails.
+func (r *ReminderRepository) SaveReminder(_ context.Context, rem *reminder.Reminder) error {
+	version := rem.Version
+	rem.Version++
+
+	data, err := json.Marshal(rem)
+	if err != nil {
+		rem.Version = version
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	err = r.db.Update(func(tx *bbolt.Tx) error {
+		id := []byte(rem.ID)
+
+		stored, err := decodeReminder(tx.Bucket(remindersBucket).Get(id))
+		if err != nil {
+			return err
+		}
+
+		var storedVersion int64
+
+		if stored != nil {
+			previous, err := unmarshalReminder(stored.Reminder)
+			if err != nil {
+				return err
+			}
+
+			storedVersion = previous.Version
+		}
+
+		if storedVersion != version {
+			return core.ErrReminderConflict
+		}
+
+		users, err := tx.Bucket(userRemindersBucket).CreateBucketIfNotExists([]byte(rem.UserID))
+		if err != nil {
+			return err
+		}
+
+		if err := users.Put(id, nil); err != nil {
+			return err
+		}
+
+		return schedule(tx, id, stored, rem.DueAt(), data)
+	})
+
+	switch {
+	case errors.Is(err, core.ErrReminderConflict):
+		rem.Version = version
+		return err
+	case err != nil:
+		rem.Version = version
+		return fmt.Errorf("failed to save reminder: %w", err)
+	}
+
+	return nil
+}
+
+// GetReminder retrieves the reminder by its ID.
+// Returns core.ErrReminderNotFound if the reminder doesn't exist, or an error if reading or unmarshaling fails.
+func (r *ReminderRepository) GetReminder(_ context.Context, id string) (*reminder.Reminder, error) {
+	var rem *reminder.Reminder
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		stored, err := decodeReminder(tx.Bucket(remindersBucket).Get([]byte(id)))
+		if err != nil {
+			return err
+		}
+
+		if stored == nil {
+			return core.ErrReminderNotFound
+		}
+
+		rem, err = unmarshalReminder(stored.Reminder)
+
+		return err
+	})
+
+	switch {
+	case errors.Is(err, core.ErrReminderNotFound):
+		return nil, err
+	case err != nil:
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	return rem, nil
+}
+
+// GetUserReminders retrieves all reminders of the user.
+// Returns the reminders, or an error if reading or unmarshaling fails.
+func (r *ReminderRepository) GetUserReminders(_ context.Context, userID string) ([]*reminder.Reminder, error) {
+	var reminders []*reminder.Reminder
+
+	err := r.db.View(func(tx *bbolt.Tx) error {
+		users := tx.Bucket(userRemindersBucket).Bucket([]byte(userID))
+		if users == nil {
+			return nil
+		}
+
+		bucket := tx.Bucket(remindersBucket)
+
+		return users.ForEach(func(id, _ []byte) error {
+			stored, err := decodeReminder(bucket.Get(id))
+			if err != nil || stored == nil {
+				return err
+			}
+
+			rem, err := unmarshalReminder(stored.Reminder)
+			if err != nil {
+				return err
+			}
+
+			reminders = append(reminders, rem)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// ClaimDueReminders retrieves up to limit reminders due at or before now, the earliest first,
+// and postpones them in the schedule by ttl in a single transaction, so they aren't claimed again
+// until they are saved or ttl expires. Saving a claimed reminder schedules it for its next due time.
+// Returns the reminders, or an error if the database update or unmarshaling fails.
+func (r *ReminderRepository) ClaimDueReminders(_ context.Context, now time.Time, ttl time.Duration, limit int) ([]*reminder.Reminder, error) {
+	var reminders []*reminder.Reminder
+
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		var due [][]byte
+
+		cursor := tx.Bucket(reminderScheduleBucket).Cursor()
+
+		for k, _ := cursor.First(); k != nil && len(due) < limit && !now.Before(keyTime(k)); k, _ = cursor.Next() {
+			due = append(due, bytes.Clone(k[8:]))
+		}
+
+		bucket := tx.Bucket(remindersBucket)
+
+		for _, id := range due {
+			stored, err := decodeReminder(bucket.Get(id))
+			if err != nil {
+				return err
+			}
+
+			rem, err := unmarshalReminder(stored.Reminder)
+			if err != nil {
+				return err
+			}
+
+			if err := schedule(tx, id, stored, now.Add(ttl), stored.Reminder); err != nil {
+				return err
+			}
+
+			reminders = append(reminders, rem)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// DeleteReminder removes the reminder together with its schedule and user index entries,
+// it does nothing if there is no such reminder.
+// Returns an error if the database update fails.
+func (r *ReminderRepository) DeleteReminder(_ context.Context, rem *reminder.Reminder) error {
+	err := r.db.Update(func(tx *bbolt.Tx) error {
+		id := []byte(rem.ID)
+		bucket := tx.Bucket(remindersBucket)
+
+		stored, err := decodeReminder(bucket.Get(id))
+		if err != nil || stored == nil {
+			return err
+		}
+
+		if err := tx.Bucket(reminderScheduleBucket).Delete(timeKey(stored.ScheduledAt, id)); err != nil {
+			return err
+		}
+
+		if users := tx.Bucket(userRemindersBucket).Bucket([]byte(rem.UserID)); users != nil {
+			if err := users.Delete(id); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Delete(id)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	return nil
+}
+
+// schedule stores the serialized reminder with the ID for the time it is scheduled at,
+// replacing its previous schedule entry if the reminder is already stored.
+func schedule(tx *bbolt.Tx, id []byte, stored *storedReminder, scheduledAt time.Time, data []byte) error {
+	index := tx.Bucket(reminderScheduleBucket)
+
+	if stored != nil {
+		if err := index.Delete(timeKey(stored.ScheduledAt, id)); err != nil {
+			return err
+		}
+	}
+
+	value, err := json.Marshal(storedReminder{ScheduledAt: scheduledAt, Reminder: data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	if err := index.Put(timeKey(scheduledAt, id), nil); err != nil {
+		return err
+	}
+
+	return tx.Bucket(remindersBucket).Put(id, value)
+}
+
+// decodeReminder unmarshals the stored reminder.
+// Returns nil if there is no stored value.
+func decodeReminder(value []byte) (*storedReminder, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var stored storedReminder
+	if err := json.Unmarshal(value, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored reminder: %w", err)
+	}
+
+	return &stored, nil
+}
+
+// unmarshalReminder deserializes the reminder.
+func unmarshalReminder(data []byte) (*reminder.Reminder, error) {
+	var rem reminder.Reminder
+	if err := json.Unmarshal(data, &rem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reminder: %w", err)
+	}
+
+	return &rem, nil
+}
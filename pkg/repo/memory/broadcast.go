@@ -0,0 +1,195 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+)
+
+// storedBroadcast is a serialized broadcast with its queued recipients and the lease of the worker delivering it
+type storedBroadcast struct {
+	leaseExpiresAt time.Time
+	leaseOwner     string
+	data           []byte
+	queue          []broadcast.Chat
+}
+
+// BroadcastRepository implements core.BroadcastRepository using in-memory storage.
+// Known chats map chat IDs to their languages, broadcasts are stored serialized with their queues of recipients,
+// and pending broadcasts are kept in the order they were created.
+type BroadcastRepository struct {
+	chats      map[string]string
+	broadcasts map[string]*storedBroadcast
+	now        func() time.Time
+	pending    []string
+	mu         sync.Mutex
+}
+
+// NewBroadcastRepository creates a new empty in-memory BroadcastRepository.
+func NewBroadcastRepository() *BroadcastRepository {
+	return &BroadcastRepository{
+		chats:      make(map[string]string),
+		broadcasts: make(map[string]*storedBroadcast),
+		now:        time.Now,
+	}
+}
+
+// SaveChat adds the chat to the recipients of future broadcasts or updates its language.
+func (r *BroadcastRepository) SaveChat(_ context.Context, chat broadcast.Chat) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chats[chat.ID] = chat.Language
+
+	return nil
+}
+
+// RemoveChat removes the chat from the recipients of future broadcasts.
+// Broadcasts already queued for the chat are still attempted, the delivery fails for unavailable chats.
+func (r *BroadcastRepository) RemoveChat(_ context.Context, chatID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.chats, chatID)
+
+	return nil
+}
+
+// CountChats returns the number of known chats per language.
+func (r *BroadcastRepository) CountChats(_ context.Context) (map[string]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int)
+	for _, language := range r.chats {
+		counts[language]++
+	}
+
+	return counts, nil
+}
+
+// CreateBroadcast stores the broadcast, marks it as pending and queues all known chats as its recipients.
+// The languages of the chats are captured in the queue, so later language changes don't affect the broadcast.
+// Returns an error if serialization fails.
+func (r *BroadcastRepository) CreateBroadcast(_ context.Context, b *broadcast.Broadcast) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue := make([]broadcast.Chat, 0, len(r.chats))
+	for id, language := range r.chats {
+		queue = append(queue, broadcast.Chat{ID: id, Language: language})
+	}
+
+	b.Total = len(queue)
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	r.broadcasts[b.ID] = &storedBroadcast{data: data, queue: queue}
+	r.pending = append(r.pending, b.ID)
+
+	return nil
+}
+
+// GetPendingBroadcast returns a copy of the oldest broadcast which is not completed.
+// Returns core.ErrBroadcastNotFound if there are no pending broadcasts, or an error if unmarshaling fails.
+func (r *BroadcastRepository) GetPendingBroadcast(_ context.Context) (*broadcast.Broadcast, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.pending) == 0 {
+		return nil, core.ErrBroadcastNotFound
+	}
+
+	return unmarshalBroadcast(r.broadcasts[r.pending[0]].data)
+}
+
+// LeaseBroadcast acquires or renews the lease of the broadcast for owner for the duration of ttl
+// and returns a copy of the current state of the broadcast.
+// Returns core.ErrBroadcastLeased if another owner holds an unexpired lease, core.ErrBroadcastNotFound if there is
+// no such broadcast, or an error if unmarshaling fails.
+func (r *BroadcastRepository) LeaseBroadcast(_ context.Context, id, owner string, ttl time.Duration) (*broadcast.Broadcast, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.broadcasts[id]
+	if !ok {
+		return nil, core.ErrBroadcastNotFound
+	}
+
+	if stored.leaseOwner != owner && now.Before(stored.leaseExpiresAt) {
+		return nil, core.ErrBroadcastLeased
+	}
+
+	stored.leaseOwner = owner
+	stored.leaseExpiresAt = now.Add(ttl)
+
+	return unmarshalBroadcast(stored.data)
+}
+
+// GetRecipients returns up to limit recipients from the head of the queue of the broadcast without removing them.
+func (r *BroadcastRepository) GetRecipients(_ context.Context, id string, limit int) ([]broadcast.Chat, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.broadcasts[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return slices.Clone(stored.queue[:min(limit, len(stored.queue))]), nil
+}
+
+// UpdateBroadcast removes the first processed recipients from the queue of the broadcast and stores its progress
+// if owner holds an unexpired lease. Completed broadcasts are no longer pending and their lease is released.
+// Returns core.ErrBroadcastLeased if owner doesn't hold the lease, or an error if serialization fails.
+func (r *BroadcastRepository) UpdateBroadcast(_ context.Context, b *broadcast.Broadcast, owner string, processed int) error {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.broadcasts[b.ID]
+	if !ok || stored.leaseOwner != owner || !now.Before(stored.leaseExpiresAt) {
+		return core.ErrBroadcastLeased
+	}
+
+	stored.data = data
+
+	if b.Completed() {
+		stored.queue = nil
+		stored.leaseOwner = ""
+		r.pending = slices.DeleteFunc(r.pending, func(id string) bool { return id == b.ID })
+
+		return nil
+	}
+
+	stored.queue = stored.queue[min(processed, len(stored.queue)):]
+
+	return nil
+}
+
+// unmarshalBroadcast deserializes the broadcast.
+func unmarshalBroadcast(data []byte) (*broadcast.Broadcast, error) {
+	var b broadcast.Broadcast
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal broadcast: %w", err)
+	}
+
+	return &b, nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	repo := NewBroadcastRepository()
+	repo.now = func() time.Time { return now }
+
+	require.NoError(t, repo.SaveChat(ctx, broadcast.Chat{ID: "chat1", Language: "en"}))
+	require.NoError(t, repo.SaveChat(ctx, broadcast.Chat{ID: "chat2", Language: "es"}))
+	require.NoError(t, repo.SaveChat(ctx, broadcast.Chat{ID: "chat3", Language: "es"}))
+	require.NoError(t, repo.RemoveChat(ctx, "chat3"))
+
+	counts, err := repo.CountChats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"en": 1, "es": 1}, counts)
+
+	_, err = repo.GetPendingBroadcast(ctx)
+	assert.ErrorIs(t, err, core.ErrBroadcastNotFound)
+
+	b := &broadcast.Broadcast{ID: "b1", Text: "Hello", CreatedAt: now}
+	require.NoError(t, repo.CreateBroadcast(ctx, b))
+	assert.Equal(t, 2, b.Total)
+
+	pending, err := repo.GetPendingBroadcast(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "b1", pending.ID)
+
+	_, err = repo.LeaseBroadcast(ctx, "missing", "worker1", time.Minute)
+	assert.ErrorIs(t, err, core.ErrBroadcastNotFound)
+
+	leased, err := repo.LeaseBroadcast(ctx, "b1", "worker1", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 2, leased.Total)
+
+	_, err = repo.LeaseBroadcast(ctx, "b1", "worker2", time.Minute)
+	assert.ErrorIs(t, err, core.ErrBroadcastLeased)
+
+	recipients, err := repo.GetRecipients(ctx, "b1", 1)
+	require.NoError(t, err)
+	require.Len(t, recipients, 1)
+
+	leased.Sent = 1
+	require.NoError(t, repo.UpdateBroadcast(ctx, leased, "worker1", 1))
+	assert.ErrorIs(t, repo.UpdateBroadcast(ctx, leased, "worker2", 1), core.ErrBroadcastLeased)
+
+	rest, err := repo.GetRecipients(ctx, "b1", 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.NotEqual(t, recipients[0].ID, rest[0].ID, "processed recipients are removed from the queue")
+
+	now = now.Add(time.Minute)
+
+	assert.ErrorIs(t, repo.UpdateBroadcast(ctx, leased, "worker1", 1), core.ErrBroadcastLeased, "lease expired")
+
+	leased, err = repo.LeaseBroadcast(ctx, "b1", "worker2", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, leased.Sent)
+
+	leased.Sent = 2
+	leased.CompletedAt = now
+	require.NoError(t, repo.UpdateBroadcast(ctx, leased, "worker2", 1))
+
+	_, err = repo.GetPendingBroadcast(ctx)
+	assert.ErrorIs(t, err, core.ErrBroadcastNotFound)
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+)
+
+// spendKey identifies the spend of a user, or of all users when userID is empty, within a day or a month.
+type spendKey struct {
+	userID string
+	period string
+}
+
+// BudgetTracker implements core.BudgetTracker interface using in-memory storage
+type BudgetTracker struct {
+	spend  map[spendKey]float64
+	config *budget.Config
+	now    func() time.Time
+	mu     sync.RWMutex
+}
+
+// NewBudgetTracker creates a new BudgetTracker with the given configuration
+func NewBudgetTracker(cfg *budget.Config) *BudgetTracker {
+	return &BudgetTracker{
+		spend:  make(map[spendKey]float64),
+		config: cfg,
+		now:    time.Now,
+	}
+}
+
+// GetSpend returns the spend of the user and of all users for the current day and month.
+func (b *BudgetTracker) GetSpend(_ context.Context, userID string) (*budget.Spend, error) {
+	now := b.now()
+	day, month := budget.Day(now), budget.Month(now)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	spend := b.config.NewSpend()
+	spend.UserDaily = b.spend[spendKey{userID: userID, period: day}]
+	spend.UserMonthly = b.spend[spendKey{userID: userID, period: month}]
+	spend.GlobalDaily = b.spend[spendKey{period: day}]
+	spend.GlobalMonthly = b.spend[spendKey{period: month}]
+
+	return spend, nil
+}
+
+// RecordUsage prices the token usage and adds it to the spend of the user and of all users.
+// Spend of past days and months is dropped while recording.
+func (b *BudgetTracker) RecordUsage(_ context.Context, userID string, usage []budget.Usage) error {
+	cost := b.config.Cost(usage...)
+	if cost == 0 {
+		return nil
+	}
+
+	now := b.now()
+	day, month := budget.Day(now), budget.Month(now)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for key := range b.spend {
+		if key.period != day && key.period != month {
+			delete(b.spend, key)
+		}
+	}
+
+	b.spend[spendKey{userID: userID, period: day}] += cost
+	b.spend[spendKey{userID: userID, period: month}] += cost
+	b.spend[spendKey{period: day}] += cost
+	b.spend[spendKey{period: month}] += cost
+
+	return nil
+}
+
+// ResetUserSpend clears the spend of the user, the global spend is kept.
+func (b *BudgetTracker) ResetUserSpend(_ context.Context, userID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for key := range b.spend {
+		if key.userID == userID {
+			delete(b.spend, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetTracker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	tracker := NewBudgetTracker(&budget.Config{
+		Prices:         []budget.Price{{Model: "sonnet", Input: 3, Output: 15}},
+		UserDailyLimit: 5,
+	})
+	tracker.now = func() time.Time { return now }
+
+	require.NoError(t, tracker.RecordUsage(ctx, "user1", []budget.Usage{{Model: "sonnet", InputTokens: 1_000_000}}))
+	require.NoError(t, tracker.RecordUsage(ctx, "user2", []budget.Usage{{Model: "sonnet", OutputTokens: 100_000}}))
+	require.NoError(t, tracker.RecordUsage(ctx, "user2", []budget.Usage{{Model: "unknown", OutputTokens: 100_000}}))
+
+	spend, err := tracker.GetSpend(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, &budget.Spend{
+		UserDaily:      3,
+		UserMonthly:    3,
+		GlobalDaily:    4.5,
+		GlobalMonthly:  4.5,
+		UserDailyLimit: 5,
+	}, spend)
+
+	// Daily spend starts over the next day and monthly spend the next month
+	now = now.Add(24 * time.Hour)
+
+	require.NoError(t, tracker.RecordUsage(ctx, "user1", []budget.Usage{{Model: "sonnet", InputTokens: 1_000_000}}))
+
+	spend, err = tracker.GetSpend(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, spend.UserDaily)
+	assert.Equal(t, 3.0, spend.UserMonthly)
+	assert.Equal(t, 3.0, spend.GlobalDaily)
+	assert.Equal(t, 3.0, spend.GlobalMonthly)
+	assert.Len(t, tracker.spend, 4, "spend of past periods is dropped")
+}
+
+func TestBudgetTracker_ResetUserSpend(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewBudgetTracker(&budget.Config{Prices: []budget.Price{{Model: "sonnet", Input: 1}}})
+
+	require.NoError(t, tracker.RecordUsage(ctx, "user1", []budget.Usage{{Model: "sonnet", InputTokens: 1_000_000}}))
+	require.NoError(t, tracker.ResetUserSpend(ctx, "user1"))
+
+	spend, err := tracker.GetSpend(ctx, "user1")
+	require.NoError(t, err)
+	assert.Zero(t, spend.UserDaily)
+	assert.Zero(t, spend.UserMonthly)
+	assert.Equal(t, 1.0, spend.GlobalDaily)
+	assert.Equal(t, 1.0, spend.GlobalMonthly)
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+)
+
+// storedConversation is a serialized conversation with its version and the time it expires at
+type storedConversation struct {
+	expiresAt time.Time
+	data      []byte
+	version   int64
+}
+
+// ConversationRepository implements core.ConversationRepository using in-memory storage.
+// Conversations are stored serialized, so changes are visible only after Save, as with Redis,
+// and expire after conversation.TTL since their last save. Expired conversations are not returned,
+// they are dropped when they are found and by RemoveExpired.
+type ConversationRepository struct {
+	conversations map[string]storedConversation
+	now           func() time.Time
+	mu            sync.Mutex
+}
+
+// NewConversationRepository creates a new empty in-memory ConversationRepository.
+func NewConversationRepository() *ConversationRepository {
+	return &ConversationRepository{
+		conversations: make(map[string]storedConversation),
+		now:           time.Now,
+	}
+}
+
+// Save serializes the conversation and stores it under its ID with the next version, replacing the previous version
+// and extending its TTL. An expired stored conversation is replaced as if there was none.
+// Returns core.ErrConversationConflict if the stored version differs from the version of the conversation,
+// or an error if serialization fails.
+func (r *ConversationRepository) Save(_ context.Context, conv core.Conversation) error {
+	version := conv.GetVersion()
+
+	conv.SetVersion(version + 1)
+
+	data, err := json.Marshal(conv)
+	if err != nil {
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var storedVersion int64
+	if stored, ok := r.conversations[conv.GetID()]; ok && now.Before(stored.expiresAt) {
+		storedVersion = stored.version
+	}
+
+	if storedVersion != version {
+		conv.SetVersion(version)
+		return core.ErrConversationConflict
+	}
+
+	r.conversations[conv.GetID()] = storedConversation{data: data, version: version + 1, expiresAt: now.Add(conversation.TTL)}
+
+	return nil
+}
+
+// FindByID returns a copy of the stored conversation with the given ID.
+// An expired conversation is dropped when it is found.
+// Returns core.ErrConversationNotFound if there is no such conversation or it expired,
+// or an error if unmarshaling fails.
+func (r *ConversationRepository) FindByID(_ context.Context, id string) (core.Conversation, error) {
+	now := r.now()
+
+	r.mu.Lock()
+
+	stored, ok := r.conversations[id]
+	if ok && !now.Before(stored.expiresAt) {
+		delete(r.conversations, id)
+
+		ok = false
+	}
+
+	r.mu.Unlock()
+
+	if !ok {
+		return nil, core.ErrConversationNotFound
+	}
+
+	conv, err := conversation.Unmarshal(stored.data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal conversation with id %s: %w", id, err)
+	}
+
+	return conv, nil
+}
+
+// FindOrCreate returns the conversation with the given ID, or a new conversation if there is none.
+// Returns an error if the stored conversation can't be unmarshaled.
+func (r *ConversationRepository) FindOrCreate(ctx context.Context, id string) (core.Conversation, error) {
+	conv, err := r.FindByID(ctx, id)
+	if err == core.ErrConversationNotFound {
+		return conversation.NewConversation(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return conv, nil
+}
+
+// Delete removes the conversation with the given ID, it does nothing if there is no such conversation.
+func (r *ConversationRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.conversations, id)
+
+	return nil
+}
+
+// RemoveExpired deletes the conversations expired at now, it is called periodically
+// so conversations of users who don't come back don't stay in memory.
+func (r *ConversationRepository) RemoveExpired(_ context.Context, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, stored := range r.conversations {
+		if !now.Before(stored.expiresAt) {
+			delete(r.conversations, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversationRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	repo := NewConversationRepository()
+	repo.now = func() time.Time { return now }
+
+	conv, err := repo.FindOrCreate(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, conversation.NewConversation("chat1"), conv)
+
+	conv.AddMessage("user", "Hello")
+
+	_, err = repo.FindByID(ctx, "chat1")
+	assert.ErrorIs(t, err, core.ErrConversationNotFound, "conversation is stored only on save")
+
+	require.NoError(t, repo.Save(ctx, conv))
+
+	found, err := repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, "chat1", found.GetID())
+	assert.Len(t, found.(*conversation.Conversation).Messages, 1)
+
+	found.AddMessage("assistant", "Hi")
+
+	found, err = repo.FindOrCreate(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Len(t, found.(*conversation.Conversation).Messages, 1, "changes are not visible before save")
+
+	require.NoError(t, repo.Delete(ctx, "chat1"))
+
+	_, err = repo.FindByID(ctx, "chat1")
+	assert.ErrorIs(t, err, core.ErrConversationNotFound)
+}
+
+func TestConversationRepository_TTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	repo := NewConversationRepository()
+	repo.now = func() time.Time { return now }
+
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat1")))
+
+	now = now.Add(conversation.TTL - time.Second)
+
+	_, err := repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+
+	now = now.Add(time.Second)
+
+	_, err = repo.FindByID(ctx, "chat1")
+	assert.ErrorIs(t, err, core.ErrConversationNotFound)
+
+	assert.Empty(t, repo.conversations, "expired conversation is dropped when it is found")
+
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat1")), "expired conversation is replaced as a new one")
+	require.NoError(t, repo.Save(ctx, conversation.NewConversation("chat2")))
+
+	require.NoError(t, repo.RemoveExpired(ctx, now.Add(conversation.TTL-time.Second)))
+	assert.Len(t, repo.conversations, 2)
+
+	require.NoError(t, repo.RemoveExpired(ctx, now.Add(conversation.TTL)))
+	assert.Empty(t, repo.conversations)
+}
+
+func TestConversationRepository_Versions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewConversationRepository()
+
+	first, err := repo.FindOrCreate(ctx, "chat1")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, first))
+	assert.Equal(t, int64(1), first.GetVersion())
+
+	second, err := repo.FindByID(ctx, "chat1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), second.GetVersion())
+
+	require.NoError(t, repo.Save(ctx, second))
+	assert.Equal(t, int64(2), second.GetVersion())
+
+	first.AddMessage("user", "Hello")
+	assert.ErrorIs(t, repo.Save(ctx, first), core.ErrConversationConflict, "conversation saved by another request")
+	assert.Equal(t, int64(1), first.GetVersion())
+
+	require.NoError(t, repo.Delete(ctx, "chat1"))
+	assert.ErrorIs(t, repo.Save(ctx, second), core.ErrConversationConflict, "conversation deleted by another request")
+}
@@ -0,0 +1,168 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+)
+
+// expiringValue is a value with the time it expires at
+type expiringValue struct {
+	expiresAt time.Time
+	data      []byte
+}
+
+// FeedbackRepository implements core.FeedbackRepository using in-memory storage.
+// Answers are stored serialized and can be rated until they expire after feedback.AnswerTTL,
+// rated answers are kept until the process stops. Expired answers and reasons are not returned,
+// they are dropped by RemoveExpired.
+type FeedbackRepository struct {
+	answers map[string]expiringValue
+	rated   map[string][]byte
+	reasons map[string]expiringValue
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+// NewFeedbackRepository creates a new empty in-memory FeedbackRepository.
+func NewFeedbackRepository() *FeedbackRepository {
+	return &FeedbackRepository{
+		answers: make(map[string]expiringValue),
+		rated:   make(map[string][]byte),
+		reasons: make(map[string]expiringValue),
+		now:     time.Now,
+	}
+}
+
+// SaveAnswer stores the unrated answer, it can be rated until it expires.
+// Returns an error if serialization fails.
+func (r *FeedbackRepository) SaveAnswer(_ context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	expiresAt := r.now().Add(feedback.AnswerTTL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.answers[f.ID] = expiringValue{data: data, expiresAt: expiresAt}
+
+	return nil
+}
+
+// GetAnswer returns a copy of the answer with the ID.
+// Returns core.ErrAnswerNotFound if the answer doesn't exist or has expired, or an error if unmarshaling fails.
+func (r *FeedbackRepository) GetAnswer(_ context.Context, id string) (*feedback.Feedback, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	stored, ok := r.answers[id]
+	r.mu.Unlock()
+
+	if !ok || !now.Before(stored.expiresAt) {
+		return nil, core.ErrAnswerNotFound
+	}
+
+	var f feedback.Feedback
+	if err := json.Unmarshal(stored.data, &f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal answer: %w", err)
+	}
+
+	return &f, nil
+}
+
+// SaveFeedback stores the rated answer until the process stops and updates the answer, keeping its expiration.
+// Returns an error if serialization fails.
+func (r *FeedbackRepository) SaveFeedback(_ context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal feedback: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rated[f.ID] = data
+	r.answers[f.ID] = expiringValue{data: data, expiresAt: f.CreatedAt.Add(feedback.AnswerTTL)}
+
+	return nil
+}
+
+// SetPendingReason remembers the answer the user is asked to give the reason of the rating for,
+// the reason is awaited for feedback.ReasonTTL.
+func (r *FeedbackRepository) SetPendingReason(_ context.Context, userID, answerID string) error {
+	expiresAt := r.now().Add(feedback.ReasonTTL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reasons[userID] = expiringValue{data: []byte(answerID), expiresAt: expiresAt}
+
+	return nil
+}
+
+// PopPendingReason returns and removes the answer waiting for the reason of the user.
+// Returns core.ErrAnswerNotFound if no reason is awaited from the user or it has expired.
+func (r *FeedbackRepository) PopPendingReason(_ context.Context, userID string) (string, error) {
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.reasons[userID]
+	delete(r.reasons, userID)
+
+	if !ok || !now.Before(stored.expiresAt) {
+		return "", core.ErrAnswerNotFound
+	}
+
+	return string(stored.data), nil
+}
+
+// ListFeedback returns copies of all rated answers ordered by the time they were rated.
+// Returns an error if unmarshaling fails.
+func (r *FeedbackRepository) ListFeedback(_ context.Context) ([]*feedback.Feedback, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := make([]*feedback.Feedback, 0, len(r.rated))
+
+	for _, data := range r.rated {
+		var f feedback.Feedback
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal feedback: %w", err)
+		}
+
+		records = append(records, &f)
+	}
+
+	slices.SortFunc(records, func(a, b *feedback.Feedback) int {
+		return a.RatedAt.Compare(b.RatedAt)
+	})
+
+	return records, nil
+}
+
+// RemoveExpired deletes the answers and awaited reasons expired at now, rated answers are kept.
+func (r *FeedbackRepository) RemoveExpired(_ context.Context, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, values := range []map[string]expiringValue{r.answers, r.reasons} {
+		for key, stored := range values {
+			if !now.Before(stored.expiresAt) {
+				delete(values, key)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedbackRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	repo := NewFeedbackRepository()
+	repo.now = func() time.Time { return now }
+
+	answer := &feedback.Feedback{ID: "a1", UserID: "user1", Question: "Q", Answer: "A", CreatedAt: now}
+	require.NoError(t, repo.SaveAnswer(ctx, answer))
+	require.NoError(t, repo.SaveAnswer(ctx, &feedback.Feedback{ID: "a2", CreatedAt: now}))
+
+	_, err := repo.GetAnswer(ctx, "missing")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound)
+
+	answer.Rate(feedback.RatingDown, now.Add(time.Minute))
+	require.NoError(t, repo.SaveFeedback(ctx, answer))
+
+	got, err := repo.GetAnswer(ctx, "a1")
+	require.NoError(t, err)
+	assert.Equal(t, feedback.RatingDown, got.Rating)
+
+	require.NoError(t, repo.SetPendingReason(ctx, "user1", "a1"))
+
+	answerID, err := repo.PopPendingReason(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "a1", answerID)
+
+	_, err = repo.PopPendingReason(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound, "reason is awaited once")
+
+	require.NoError(t, repo.SetPendingReason(ctx, "user1", "a1"))
+
+	now = now.Add(feedback.AnswerTTL)
+
+	_, err = repo.PopPendingReason(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound, "reason expired")
+
+	_, err = repo.GetAnswer(ctx, "a1")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound, "answer expired")
+
+	require.NoError(t, repo.SetPendingReason(ctx, "user2", "a2"))
+	require.NoError(t, repo.RemoveExpired(ctx, now.Add(feedback.ReasonTTL)))
+	assert.Empty(t, repo.answers)
+	assert.Empty(t, repo.reasons)
+
+	records, err := repo.ListFeedback(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1, "rated answers are kept")
+	assert.Equal(t, "a1", records[0].ID)
+}
@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+)
+
+// PetProfileRepository implements core.PetProfileRepository using in-memory storage.
+// Profiles are stored serialized, so callers never share them with the repository.
+type PetProfileRepository struct {
+	profiles map[string][]byte
+	mu       sync.Mutex
+}
+
+// NewPetProfileRepository creates a new empty in-memory PetProfileRepository.
+func NewPetProfileRepository() *PetProfileRepository {
+	return &PetProfileRepository{
+		profiles: make(map[string][]byte),
+	}
+}
+
+// SaveProfile replaces the user's active pet profile, or adds the profile as the first pet if the user has none.
+// Returns an error if serialization fails.
+func (r *PetProfileRepository) SaveProfile(_ context.Context, userID string, profile *pet.Profile) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		profiles.ReplaceCurrent(*profile)
+		return nil
+	})
+}
+
+// AddProfile adds a new pet profile for the user and makes it the active one.
+// If the user already has a pet with the same name, that pet's profile is replaced keeping its medical history.
+// Returns an error if serialization fails.
+func (r *PetProfileRepository) AddProfile(_ context.Context, userID string, profile *pet.Profile) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		profiles.Add(*profile)
+		return nil
+	})
+}
+
+// GetProfiles returns all pet profiles of the user together with the active pet index.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if deserialization fails.
+func (r *PetProfileRepository) GetProfiles(_ context.Context, userID string) (*pet.Profiles, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profiles, err := r.load(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profiles.Profiles) == 0 {
+		return nil, core.ErrProfileNotFound
+	}
+
+	return profiles, nil
+}
+
+// GetCurrentProfile returns the user's active pet profile.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if deserialization fails.
+func (r *PetProfileRepository) GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error) {
+	profiles, err := r.GetProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles.Current(), nil
+}
+
+// SetActiveProfile makes the pet with the given name the active one for the user.
+// Returns core.ErrProfileNotFound if the user has no pet with such name.
+func (r *PetProfileRepository) SetActiveProfile(_ context.Context, userID, name string) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Select(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// UpdateProfile applies fn to the profile of the pet with the given name, the active pet is not changed.
+// Returns core.ErrProfileNotFound if the user has no pet with such name.
+func (r *PetProfileRepository) UpdateProfile(_ context.Context, userID, name string, fn func(profile *pet.Profile)) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Update(name, fn) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveProfile removes the pet with the given name from the user's profiles.
+// Returns core.ErrProfileNotFound if the user has no pet with such name.
+func (r *PetProfileRepository) RemoveProfile(_ context.Context, userID, name string) error {
+	return r.update(userID, func(profiles *pet.Profiles) error {
+		if !profiles.Remove(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveUserProfiles removes all pet profiles of the user.
+func (r *PetProfileRepository) RemoveUserProfiles(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.profiles, userID)
+
+	return nil
+}
+
+// update applies fn to the profiles of the user and stores the result, users left without pets are removed.
+// Returns the error of fn, in which case nothing is stored, or an error if serialization fails.
+func (r *PetProfileRepository) update(userID string, fn func(profiles *pet.Profiles) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profiles, err := r.load(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(profiles); err != nil {
+		return err
+	}
+
+	if len(profiles.Profiles) == 0 {
+		delete(r.profiles, userID)
+		return nil
+	}
+
+	data, err := json.Marshal(profiles)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pet profiles: %w", err)
+	}
+
+	r.profiles[userID] = data
+
+	return nil
+}
+
+// load deserializes the profiles of the user, the caller must hold the lock.
+// Returns an empty collection if the user has no stored profiles.
+func (r *PetProfileRepository) load(userID string) (*pet.Profiles, error) {
+	data, ok := r.profiles[userID]
+	if !ok {
+		return &pet.Profiles{}, nil
+	}
+
+	var profiles pet.Profiles
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pet profiles: %w", err)
+	}
+
+	return &profiles, nil
+}
@@ -10,7 +10,10 @@ import (
 )
 
 // RateLimitConfig holds configuration for rate limiting
+// Storage selects where request history is kept: "memory" (default) or "redis" to share limits between instances.
+// GlobalDailyLimit caps the number of questions of all users per day, zero disables it in favour of budget spend ceilings.
 type RateLimitConfig struct {
+	Storage          string  `mapstructure:"storage"`
 	WhitelistIDs     []int64 `mapstructure:"whitelist_ids"`
 	UserHourlyLimit  int     `mapstructure:"user_hourly_limit"`
 	UserDailyLimit   int     `mapstructure:"user_daily_limit"`
@@ -28,6 +31,7 @@ type RateLimiter struct {
 	config    *RateLimitConfig
 	whitelist map[string]struct{}
 	mu        sync.RWMutex
+	allowMu   sync.Mutex
 }
 
 // NewRateLimiter creates a new RateLimiter with the given configuration
@@ -94,12 +98,12 @@ func (r *RateLimiter) AddUserRequest(ctx context.Context, userID string, timesta
 		r.requests[userID] = userReqs
 	}
 
-	// Clean up old timestamps while adding new one
+	// Clean up timestamps outside of the longest window while adding new one
 	newTimestamps := make([]time.Time, 0)
-	hourAgo := time.Now().Add(-time.Hour)
+	dayAgo := time.Now().Add(-24 * time.Hour)
 
 	for _, ts := range userReqs.Timestamps {
-		if ts.After(hourAgo) {
+		if ts.After(dayAgo) {
 			newTimestamps = append(newTimestamps, ts)
 		}
 	}
@@ -136,7 +140,11 @@ func (r *RateLimiter) IsNewQuestionAllowed(ctx context.Context, userID string) (
 		return false, core.ErrRateLimit
 	}
 
-	// Check global daily limit
+	// Check global daily limit, spend ceilings of the budget are used instead when it is disabled
+	if r.config.GlobalDailyLimit <= 0 {
+		return true, nil
+	}
+
 	globalCount, err := r.GetGlobalRequests(ctx, dayStart)
 	if err != nil {
 		return false, fmt.Errorf("failed to get global requests: %w", err)
@@ -153,6 +161,25 @@ func (r *RateLimiter) RecordNewQuestion(ctx context.Context, userID string) erro
 	return r.AddUserRequest(ctx, userID, time.Now())
 }
 
+// AllowNewQuestion checks if a user is allowed to ask a new question and records the question if so.
+// Concurrent calls are serialized, so questions asked at the same time can't exceed the limits.
+// Returns core.ErrRateLimit if user limits are exceeded or core.ErrGlobalLimit if the global limit is exceeded.
+func (r *RateLimiter) AllowNewQuestion(ctx context.Context, userID string) (bool, error) {
+	r.allowMu.Lock()
+	defer r.allowMu.Unlock()
+
+	allowed, err := r.IsNewQuestionAllowed(ctx, userID)
+	if err != nil || !allowed {
+		return allowed, err
+	}
+
+	if err := r.RecordNewQuestion(ctx, userID); err != nil {
+		return false, fmt.Errorf("failed to record request: %w", err)
+	}
+
+	return true, nil
+}
+
 // IsWhitelisted checks if a user is whitelisted
 func (r *RateLimiter) IsWhitelisted(ctx context.Context, userID string) bool {
 	r.mu.RLock()
@@ -161,3 +188,53 @@ func (r *RateLimiter) IsWhitelisted(ctx context.Context, userID string) bool {
 	_, exists := r.whitelist[userID]
 	return exists
 }
+
+// DailyUsage returns the number of distinct users who asked questions today and the number of their questions.
+func (r *RateLimiter) DailyUsage(_ context.Context) (users, questions int, err error) {
+	dayStart := time.Now().Truncate(24 * time.Hour)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, userReqs := range r.requests {
+		count := 0
+
+		for _, ts := range userReqs.Timestamps {
+			if ts.After(dayStart) {
+				count++
+			}
+		}
+
+		if count > 0 {
+			users++
+			questions += count
+		}
+	}
+
+	return users, questions, nil
+}
+
+// ResetQuota removes the question history of the user, so the limits of the user start over.
+func (r *RateLimiter) ResetQuota(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requests, userID)
+
+	return nil
+}
+
+// SetWhitelisted adds the user to the whitelist or removes the user from it.
+// The change is not persisted, the whitelist is restored from the configuration on restart.
+func (r *RateLimiter) SetWhitelisted(_ context.Context, userID string, whitelisted bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if whitelisted {
+		r.whitelist[userID] = struct{}{}
+	} else {
+		delete(r.whitelist, userID)
+	}
+
+	return nil
+}
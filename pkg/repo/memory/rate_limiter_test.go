@@ -2,11 +2,15 @@ package memory_test
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/ksysoev/help-my-pet/pkg/core"
 	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
 )
 
@@ -202,6 +206,21 @@ func TestRateLimiter(t *testing.T) {
 				assert.True(t, allowed)
 			},
 		},
+		{
+			name: "IsNewQuestionAllowed with disabled global daily limit",
+			cfg: &memory.RateLimitConfig{
+				UserHourlyLimit: 20,
+				UserDailyLimit:  20,
+			},
+			testFn: func(t *testing.T, rl *memory.RateLimiter) {
+				err := rl.AddUserRequest(context.Background(), "user1", time.Now())
+				assert.NoError(t, err)
+
+				allowed, err := rl.IsNewQuestionAllowed(context.Background(), "user2")
+				assert.NoError(t, err)
+				assert.True(t, allowed)
+			},
+		},
 		{
 			name: "RecordNewQuestion",
 			cfg: &memory.RateLimitConfig{
@@ -248,3 +267,70 @@ func TestRateLimiter(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimiter_QuotaManagement(t *testing.T) {
+	ctx := context.Background()
+	rl := memory.NewRateLimiter(&memory.RateLimitConfig{UserHourlyLimit: 1, UserDailyLimit: 10, WhitelistIDs: []int64{1}})
+
+	require.NoError(t, rl.RecordNewQuestion(ctx, "user1"))
+	require.NoError(t, rl.RecordNewQuestion(ctx, "user1"))
+	require.NoError(t, rl.RecordNewQuestion(ctx, "user2"))
+
+	users, questions, err := rl.DailyUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, users)
+	assert.Equal(t, 3, questions)
+
+	_, err = rl.IsNewQuestionAllowed(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrRateLimit)
+
+	require.NoError(t, rl.ResetQuota(ctx, "user1"))
+
+	allowed, err := rl.IsNewQuestionAllowed(ctx, "user1")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	require.NoError(t, rl.SetWhitelisted(ctx, "user2", true))
+	require.NoError(t, rl.SetWhitelisted(ctx, "1", false))
+
+	assert.True(t, rl.IsWhitelisted(ctx, "user2"))
+	assert.False(t, rl.IsWhitelisted(ctx, "1"))
+}
+
+func TestRateLimiter_AllowNewQuestion(t *testing.T) {
+	ctx := context.Background()
+	rl := memory.NewRateLimiter(&memory.RateLimitConfig{UserHourlyLimit: 3, UserDailyLimit: 10, WhitelistIDs: []int64{1}})
+
+	var (
+		wg      sync.WaitGroup
+		allowed atomic.Int32
+	)
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if ok, err := rl.AllowNewQuestion(ctx, "user1"); ok && err == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(3), allowed.Load(), "concurrent questions don't exceed the limit")
+
+	ok, err := rl.AllowNewQuestion(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrRateLimit)
+	assert.False(t, ok)
+
+	ok, err = rl.AllowNewQuestion(ctx, "1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, questions, err := rl.DailyUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, questions, "questions of whitelisted users are recorded")
+}
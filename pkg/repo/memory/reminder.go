@@ -0,0 +1,174 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+)
+
+// storedReminder is a serialized reminder with the time it is scheduled for
+type storedReminder struct {
+	dueAt   time.Time
+	data    []byte
+	version int64
+}
+
+// ReminderRepository implements core.ReminderRepository using in-memory storage.
+// Reminders are stored serialized, in the same format as in Redis, and indexed per user.
+type ReminderRepository struct {
+	reminders map[string]storedReminder
+	users     map[string]map[string]struct{}
+	mu        sync.Mutex
+}
+
+// NewReminderRepository creates a new empty in-memory ReminderRepository.
+func NewReminderRepository() *ReminderRepository {
+	return &ReminderRepository{
+		reminders: make(map[string]storedReminder),
+		users:     make(map[string]map[string]struct{}),
+	}
+}
+
+// SaveReminder creates or updates the reminder and schedules it for the time it is due.
+// The reminder is saved only if the stored version is the one it was loaded with, a missing reminder has version 0.
+// On success the version of the reminder is incremented.
+// Returns core.ErrReminderConflict if the reminder was changed or deleted since it was loaded,
+// or an error if serialization fails.
+func (r *ReminderRepository) SaveReminder(_ context.Context, rem *reminder.Reminder) error {
+	version := rem.Version
+	rem.Version++
+
+	data, err := json.Marshal(rem)
+	if err != nil {
+		rem.Version = version
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.reminders[rem.ID].version != version {
+		rem.Version = version
+		return core.ErrReminderConflict
+	}
+
+	r.reminders[rem.ID] = storedReminder{data: data, version: rem.Version, dueAt: rem.DueAt()}
+
+	if r.users[rem.UserID] == nil {
+		r.users[rem.UserID] = make(map[string]struct{})
+	}
+
+	r.users[rem.UserID][rem.ID] = struct{}{}
+
+	return nil
+}
+
+// GetReminder returns a copy of the reminder with the given ID.
+// Returns core.ErrReminderNotFound if there is no such reminder, or an error if unmarshaling fails.
+func (r *ReminderRepository) GetReminder(_ context.Context, id string) (*reminder.Reminder, error) {
+	r.mu.Lock()
+	stored, ok := r.reminders[id]
+	r.mu.Unlock()
+
+	if !ok {
+		return nil, core.ErrReminderNotFound
+	}
+
+	return unmarshalReminder(stored.data)
+}
+
+// GetUserReminders returns copies of all reminders of the user.
+// Returns an error if unmarshaling fails.
+func (r *ReminderRepository) GetUserReminders(_ context.Context, userID string) ([]*reminder.Reminder, error) {
+	r.mu.Lock()
+
+	stored := make([]storedReminder, 0, len(r.users[userID]))
+	for id := range r.users[userID] {
+		stored = append(stored, r.reminders[id])
+	}
+
+	r.mu.Unlock()
+
+	return unmarshalReminders(stored)
+}
+
+// ClaimDueReminders returns up to limit reminders due at or before now, the earliest first,
+// and postpones them by ttl, so they aren't claimed again until they are saved or ttl expires.
+// Returns an error if unmarshaling fails.
+func (r *ReminderRepository) ClaimDueReminders(_ context.Context, now time.Time, ttl time.Duration, limit int) ([]*reminder.Reminder, error) {
+	r.mu.Lock()
+
+	var due []string
+
+	for id, stored := range r.reminders {
+		if !stored.dueAt.After(now) {
+			due = append(due, id)
+		}
+	}
+
+	slices.SortFunc(due, func(a, b string) int {
+		return r.reminders[a].dueAt.Compare(r.reminders[b].dueAt)
+	})
+
+	stored := make([]storedReminder, 0, min(limit, len(due)))
+
+	for _, id := range due[:min(limit, len(due))] {
+		claimed := r.reminders[id]
+		stored = append(stored, claimed)
+
+		claimed.dueAt = now.Add(ttl)
+		r.reminders[id] = claimed
+	}
+
+	r.mu.Unlock()
+
+	return unmarshalReminders(stored)
+}
+
+// DeleteReminder removes the reminder, it does nothing if there is no such reminder.
+func (r *ReminderRepository) DeleteReminder(_ context.Context, rem *reminder.Reminder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.reminders, rem.ID)
+	delete(r.users[rem.UserID], rem.ID)
+
+	if len(r.users[rem.UserID]) == 0 {
+		delete(r.users, rem.UserID)
+	}
+
+	return nil
+}
+
+// unmarshalReminders deserializes the stored reminders.
+// Returns an error if unmarshaling fails.
+func unmarshalReminders(stored []storedReminder) ([]*reminder.Reminder, error) {
+	reminders := make([]*reminder.Reminder, 0, len(stored))
+
+	for _, s := range stored {
+		rem, err := unmarshalReminder(s.data)
+		if err != nil {
+			return nil, err
+		}
+
+		reminders = append(reminders, rem)
+	}
+
+	return reminders, nil
+}
+
+// unmarshalReminder deserializes the reminder.
+func unmarshalReminder(data []byte) (*reminder.Reminder, error) {
+	var rem reminder.Reminder
+	if err := json.Unmarshal(data, &rem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reminder: %w", err)
+	}
+
+	return &rem, nil
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	repo := NewReminderRepository()
+
+	rem := &reminder.Reminder{ID: "rem1", UserID: "user1", Text: "give Rimadyl", NextAt: now}
+	require.NoError(t, repo.SaveReminder(ctx, rem))
+	assert.Equal(t, int64(1), rem.Version)
+	require.NoError(t, repo.SaveReminder(ctx, &reminder.Reminder{ID: "rem2", UserID: "user1", NextAt: now.Add(time.Hour)}))
+
+	_, err := repo.GetReminder(ctx, "missing")
+	assert.ErrorIs(t, err, core.ErrReminderNotFound)
+
+	found, err := repo.GetReminder(ctx, "rem1")
+	require.NoError(t, err)
+	assert.Equal(t, "give Rimadyl", found.Text)
+
+	stale := *found
+	found.Text = "give Rimadyl with food"
+	require.NoError(t, repo.SaveReminder(ctx, found))
+	assert.ErrorIs(t, repo.SaveReminder(ctx, &stale), core.ErrReminderConflict)
+	assert.Equal(t, int64(1), stale.Version)
+
+	reminders, err := repo.GetUserReminders(ctx, "user1")
+	require.NoError(t, err)
+	assert.Len(t, reminders, 2)
+
+	claimed, err := repo.ClaimDueReminders(ctx, now, 5*time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "give Rimadyl with food", claimed[0].Text)
+
+	claimed, err = repo.ClaimDueReminders(ctx, now.Add(time.Minute), 5*time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "claimed reminder is postponed")
+
+	claimed, err = repo.ClaimDueReminders(ctx, now.Add(2*time.Hour), 5*time.Minute, 1)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "rem1", claimed[0].ID, "the earliest reminder is claimed first")
+
+	require.NoError(t, repo.DeleteReminder(ctx, claimed[0]))
+	assert.ErrorIs(t, repo.SaveReminder(ctx, claimed[0]), core.ErrReminderConflict, "deleted reminder isn't recreated")
+
+	reminders, err = repo.GetUserReminders(ctx, "user1")
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "rem2", reminders[0].ID)
+}
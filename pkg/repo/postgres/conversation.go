@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+)
+
+const (
+	insertConversationSQL = `INSERT INTO conversations (id, data, expires_at, version) VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO NOTHING`
+	updateConversationSQL = `UPDATE conversations SET data = $2, expires_at = $3, version = $4 WHERE id = $1 AND version = $5`
+	importConversationSQL = `INSERT INTO conversations (id, data, expires_at, version) VALUES ($1, $2, $3, 1)
+ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, expires_at = EXCLUDED.expires_at, version = conversations.version + 1`
+	findConversationSQL   = `SELECT data, version FROM conversations WHERE id = $1 AND expires_at > $2`
+	deleteConversationSQL = `DELETE FROM conversations WHERE id = $1`
+)
+
+// ConversationRepository implements core.ConversationRepository using a PostgreSQL database.
+// Conversations are stored as JSON and expire after conversation.TTL since their last save, as in Redis.
+type ConversationRepository struct {
+	db  DB
+	now func() time.Time
+}
+
+// NewConversationRepository creates a new ConversationRepository storing conversations in the conversations table.
+func NewConversationRepository(db DB) *ConversationRepository {
+	return &ConversationRepository{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// Save serializes the conversation and stores it under its ID, replacing the previous version and extending its TTL.
+// The conversation is saved only if the stored version is the one it was loaded with, a new conversation only if
+// there is no stored one. On success the version of the conversation is incremented.
+// Returns core.ErrConversationConflict if the conversation was saved or deleted by another request since it was loaded,
+// or an error if serialization or the database update fails.
+func (r *ConversationRepository) Save(ctx context.Context, conv core.Conversation) error {
+	version := conv.GetVersion()
+	conv.SetVersion(version + 1)
+
+	data, err := json.Marshal(conv)
+	if err != nil {
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+
+	var tag pgconn.CommandTag
+
+	expiresAt := r.now().Add(conversation.TTL)
+
+	if version == 0 {
+		tag, err = r.db.Exec(ctx, insertConversationSQL, conv.GetID(), data, expiresAt, version+1)
+	} else {
+		tag, err = r.db.Exec(ctx, updateConversationSQL, conv.GetID(), data, expiresAt, version+1, version)
+	}
+
+	switch {
+	case err != nil:
+		conv.SetVersion(version)
+		return fmt.Errorf("failed to save conversation: %w", err)
+	case tag.RowsAffected() == 0:
+		conv.SetVersion(version)
+		return core.ErrConversationConflict
+	}
+
+	return nil
+}
+
+// Import stores the serialized conversation under the ID with the given expiration time, replacing the existing one
+// and incrementing its version, so requests holding the replaced conversation can't overwrite it.
+// It is used to copy conversations from another storage keeping their remaining TTL.
+// Returns an error if the database update fails, e.g. when data is not valid JSON.
+func (r *ConversationRepository) Import(ctx context.Context, id string, data []byte, expiresAt time.Time) error {
+	if _, err := r.db.Exec(ctx, importConversationSQL, id, data, expiresAt); err != nil {
+		return fmt.Errorf("failed to save conversation: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves the conversation with the given ID.
+// Returns core.ErrConversationNotFound if there is no such conversation or it expired,
+// or an error if reading or unmarshaling fails.
+func (r *ConversationRepository) FindByID(ctx context.Context, id string) (core.Conversation, error) {
+	var (
+		data    []byte
+		version int64
+	)
+
+	err := r.db.QueryRow(ctx, findConversationSQL, id, r.now()).Scan(&data, &version)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, core.ErrConversationNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+
+	conv, err := conversation.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal conversation with id %s: %w", id, err)
+	}
+
+	conv.SetVersion(version)
+
+	return conv, nil
+}
+
+// FindOrCreate retrieves the conversation with the given ID, or creates a new one if it is not found.
+// Returns an error if the stored conversation can't be read.
+func (r *ConversationRepository) FindOrCreate(ctx context.Context, id string) (core.Conversation, error) {
+	conv, err := r.FindByID(ctx, id)
+	if err == core.ErrConversationNotFound {
+		return conversation.NewConversation(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return conv, nil
+}
+
+// Delete removes the conversation with the given ID, it does nothing if there is no such conversation.
+// Returns an error if the database update fails.
+func (r *ConversationRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.Exec(ctx, deleteConversationSQL, id); err != nil {
+		return fmt.Errorf("failed to delete conversation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConversationRepository creates a repository backed by a mocked pool with a fixed clock.
+func newTestConversationRepository(t *testing.T, now time.Time) (*ConversationRepository, pgxmock.PgxPoolIface) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	t.Cleanup(mock.Close)
+
+	repo := NewConversationRepository(mock)
+	repo.now = func() time.Time { return now }
+
+	return repo, mock
+}
+
+func TestConversationRepository_Save(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// marshalVersion serializes the conversation as it is stored after saving it with the given version.
+	marshalVersion := func(conv *conversation.Conversation, version int64) []byte {
+		saved := *conv
+		saved.Version = version
+
+		data, err := json.Marshal(&saved)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	tests := []struct {
+		setup       func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation)
+		name        string
+		wantErr     string
+		version     int64
+		wantVersion int64
+	}{
+		{
+			name: "new conversation",
+			setup: func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation) {
+				mock.ExpectExec(insertConversationSQL).WithArgs("chat1", marshalVersion(conv, 1), now.Add(conversation.TTL), int64(1)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			wantVersion: 1,
+		},
+		{
+			name:    "loaded conversation",
+			version: 3,
+			setup: func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation) {
+				mock.ExpectExec(updateConversationSQL).WithArgs("chat1", marshalVersion(conv, 4), now.Add(conversation.TTL), int64(4), int64(3)).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			wantVersion: 4,
+		},
+		{
+			name: "conversation created by another request",
+			setup: func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation) {
+				mock.ExpectExec(insertConversationSQL).WithArgs("chat1", marshalVersion(conv, 1), now.Add(conversation.TTL), int64(1)).WillReturnResult(pgxmock.NewResult("INSERT", 0))
+			},
+			wantErr: core.ErrConversationConflict.Error(),
+		},
+		{
+			name:    "conversation changed by another request",
+			version: 3,
+			setup: func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation) {
+				mock.ExpectExec(updateConversationSQL).WithArgs("chat1", marshalVersion(conv, 4), now.Add(conversation.TTL), int64(4), int64(3)).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			wantErr:     core.ErrConversationConflict.Error(),
+			wantVersion: 3,
+		},
+		{
+			name: "insert fails",
+			setup: func(mock pgxmock.PgxPoolIface, conv *conversation.Conversation) {
+				mock.ExpectExec(insertConversationSQL).WithArgs("chat1", marshalVersion(conv, 1), now.Add(conversation.TTL), int64(1)).WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to save conversation: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := conversation.NewConversation("chat1")
+			conv.AddMessage("user", "hello")
+			conv.Version = tt.version
+
+			repo, mock := newTestConversationRepository(t, now)
+			tt.setup(mock, conv)
+
+			err := repo.Save(context.Background(), conv)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantVersion, conv.GetVersion())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationRepository_FindByID(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	conv := conversation.NewConversation("chat1")
+	conv.AddMessage("user", "hello")
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow(data, int64(5)))
+
+		found, err := repo.FindByID(context.Background(), "chat1")
+		require.NoError(t, err)
+		assert.Equal(t, "chat1", found.GetID())
+		assert.Equal(t, int64(5), found.GetVersion())
+		assert.Len(t, found.(*conversation.Conversation).Messages, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found or expired", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.FindByID(context.Background(), "chat1")
+		assert.ErrorIs(t, err, core.ErrConversationNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query fails", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnError(assert.AnError)
+
+		_, err := repo.FindByID(context.Background(), "chat1")
+		assert.EqualError(t, err, "failed to get conversation: "+assert.AnError.Error())
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow([]byte("invalid"), int64(1)))
+
+		_, err := repo.FindByID(context.Background(), "chat1")
+		assert.ErrorContains(t, err, "failed to unmarshal conversation with id chat1")
+	})
+}
+
+func TestConversationRepository_FindOrCreate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("creates missing conversation", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnError(pgx.ErrNoRows)
+
+		conv, err := repo.FindOrCreate(context.Background(), "chat1")
+		require.NoError(t, err)
+		assert.Equal(t, "chat1", conv.GetID())
+		assert.Empty(t, conv.(*conversation.Conversation).Messages)
+	})
+
+	t.Run("query fails", func(t *testing.T) {
+		repo, mock := newTestConversationRepository(t, now)
+		mock.ExpectQuery(findConversationSQL).WithArgs("chat1", now).WillReturnError(assert.AnError)
+
+		_, err := repo.FindOrCreate(context.Background(), "chat1")
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestConversationRepository_Delete(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	repo, mock := newTestConversationRepository(t, now)
+	mock.ExpectExec(deleteConversationSQL).WithArgs("chat1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(deleteConversationSQL).WithArgs("chat2").WillReturnError(assert.AnError)
+
+	assert.NoError(t, repo.Delete(context.Background(), "chat1"))
+	assert.EqualError(t, repo.Delete(context.Background(), "chat2"), "failed to delete conversation: "+assert.AnError.Error())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConversationRepository_Import(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	data := []byte(`{"id":"chat1"}`)
+
+	repo, mock := newTestConversationRepository(t, now)
+	mock.ExpectExec(importConversationSQL).WithArgs("chat1", data, expiresAt).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	assert.NoError(t, repo.Import(context.Background(), "chat1", data, expiresAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+)
+
+const (
+	saveAnswerSQL = `INSERT INTO feedback (id, data, expires_at) VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, expires_at = EXCLUDED.expires_at`
+	saveFeedbackSQL = `INSERT INTO feedback (id, data, rated_at, expires_at) VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, rated_at = EXCLUDED.rated_at`
+	importFeedbackSQL = `INSERT INTO feedback (id, data, rated_at, expires_at) VALUES ($1, $2, $3, $4)
+ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, rated_at = EXCLUDED.rated_at, expires_at = EXCLUDED.expires_at`
+	findAnswerSQL       = `SELECT data FROM feedback WHERE id = $1 AND expires_at > $2`
+	listFeedbackSQL     = `SELECT data FROM feedback WHERE rated_at IS NOT NULL ORDER BY rated_at`
+	setPendingReasonSQL = `INSERT INTO feedback_reasons (user_id, answer_id, expires_at) VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET answer_id = EXCLUDED.answer_id, expires_at = EXCLUDED.expires_at`
+	popPendingReasonSQL = `DELETE FROM feedback_reasons WHERE user_id = $1 RETURNING answer_id, expires_at`
+)
+
+// FeedbackRepository implements core.FeedbackRepository using a PostgreSQL database.
+// Answers are stored as JSON, in the same format as in Redis, and can be rated until they expire
+// after feedback.AnswerTTL. Rated answers are kept for quality reviews after that.
+type FeedbackRepository struct {
+	db  DB
+	now func() time.Time
+}
+
+// NewFeedbackRepository creates a new FeedbackRepository storing answers in the feedback table
+// and awaited reasons of ratings in the feedback_reasons table.
+func NewFeedbackRepository(db DB) *FeedbackRepository {
+	return &FeedbackRepository{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// SaveAnswer stores the unrated answer, it can be rated until it expires.
+// Returns an error if serialization or the database update fails.
+func (r *FeedbackRepository) SaveAnswer(ctx context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	if _, err := r.db.Exec(ctx, saveAnswerSQL, f.ID, data, r.now().Add(feedback.AnswerTTL)); err != nil {
+		return fmt.Errorf("failed to save answer: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnswer retrieves the answer with the ID.
+// Returns core.ErrAnswerNotFound if the answer doesn't exist or has expired, or an error if reading or unmarshaling fails.
+func (r *FeedbackRepository) GetAnswer(ctx context.Context, id string) (*feedback.Feedback, error) {
+	var data []byte
+
+	err := r.db.QueryRow(ctx, findAnswerSQL, id, r.now()).Scan(&data)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, core.ErrAnswerNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to get answer: %w", err)
+	}
+
+	var f feedback.Feedback
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal answer: %w", err)
+	}
+
+	return &f, nil
+}
+
+// SaveFeedback stores the rated answer permanently, keeping the expiration of the answer.
+// Returns an error if serialization or the database update fails.
+func (r *FeedbackRepository) SaveFeedback(ctx context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal feedback: %w", err)
+	}
+
+	if _, err := r.db.Exec(ctx, saveFeedbackSQL, f.ID, data, f.RatedAt, f.CreatedAt.Add(feedback.AnswerTTL)); err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return nil
+}
+
+// Import stores the answer with the given expiration time, replacing the existing one with the same ID.
+// It is used to copy answers and feedback from another storage.
+// Returns an error if serialization or the database update fails.
+func (r *FeedbackRepository) Import(ctx context.Context, f *feedback.Feedback, expiresAt time.Time) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal feedback: %w", err)
+	}
+
+	var ratedAt *time.Time
+	if f.Rated() {
+		ratedAt = &f.RatedAt
+	}
+
+	if _, err := r.db.Exec(ctx, importFeedbackSQL, f.ID, data, ratedAt, expiresAt); err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return nil
+}
+
+// SetPendingReason remembers the answer the user is asked to give the reason of the rating for,
+// the reason is awaited for feedback.ReasonTTL.
+// Returns an error if the database update fails.
+func (r *FeedbackRepository) SetPendingReason(ctx context.Context, userID, answerID string) error {
+	if _, err := r.db.Exec(ctx, setPendingReasonSQL, userID, answerID, r.now().Add(feedback.ReasonTTL)); err != nil {
+		return fmt.Errorf("failed to save pending reason: %w", err)
+	}
+
+	return nil
+}
+
+// PopPendingReason retrieves and removes the answer waiting for the reason of the user.
+// Returns core.ErrAnswerNotFound if no reason is awaited from the user or it has expired,
+// or an error if the database update fails.
+func (r *FeedbackRepository) PopPendingReason(ctx context.Context, userID string) (string, error) {
+	var (
+		answerID  string
+		expiresAt time.Time
+	)
+
+	err := r.db.QueryRow(ctx, popPendingReasonSQL, userID).Scan(&answerID, &expiresAt)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return "", core.ErrAnswerNotFound
+	case err != nil:
+		return "", fmt.Errorf("failed to get pending reason: %w", err)
+	case !expiresAt.After(r.now()):
+		return "", core.ErrAnswerNotFound
+	}
+
+	return answerID, nil
+}
+
+// ListFeedback retrieves all rated answers ordered by the time they were rated.
+// Returns the rated answers, or an error if reading or unmarshaling fails.
+func (r *FeedbackRepository) ListFeedback(ctx context.Context) ([]*feedback.Feedback, error) {
+	rows, err := r.db.Query(ctx, listFeedbackSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	defer rows.Close()
+
+	var records []*feedback.Feedback
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to get feedback: %w", err)
+		}
+
+		var f feedback.Feedback
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal feedback: %w", err)
+		}
+
+		records = append(records, &f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFeedbackRepository creates a repository backed by a mocked pool with a fixed clock.
+func newTestFeedbackRepository(t *testing.T, now time.Time) (*FeedbackRepository, pgxmock.PgxPoolIface) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	t.Cleanup(mock.Close)
+
+	repo := NewFeedbackRepository(mock)
+	repo.now = func() time.Time { return now }
+
+	return repo, mock
+}
+
+func testAnswer(now time.Time) *feedback.Feedback {
+	return &feedback.Feedback{ID: "a1", UserID: "user1", ChatID: "chat1", Question: "Q", Answer: "A", CreatedAt: now}
+}
+
+func TestFeedbackRepository_Answers(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo, mock := newTestFeedbackRepository(t, now)
+	ctx := context.Background()
+
+	answer := testAnswer(now)
+	data, _ := json.Marshal(answer)
+
+	mock.ExpectExec(saveAnswerSQL).WithArgs("a1", data, now.Add(feedback.AnswerTTL)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	require.NoError(t, repo.SaveAnswer(ctx, answer))
+
+	mock.ExpectQuery(findAnswerSQL).WithArgs("a1", now).WillReturnRows(pgxmock.NewRows([]string{"data"}).AddRow(data))
+
+	got, err := repo.GetAnswer(ctx, "a1")
+	require.NoError(t, err)
+	assert.Equal(t, "A", got.Answer)
+
+	mock.ExpectQuery(findAnswerSQL).WithArgs("expired", now).WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.GetAnswer(ctx, "expired")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound)
+
+	answer.Rate(feedback.RatingUp, now.Add(time.Minute))
+	rated, _ := json.Marshal(answer)
+
+	mock.ExpectExec(saveFeedbackSQL).WithArgs("a1", rated, answer.RatedAt, now.Add(feedback.AnswerTTL)).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	require.NoError(t, repo.SaveFeedback(ctx, answer))
+
+	mock.ExpectQuery(listFeedbackSQL).WillReturnRows(pgxmock.NewRows([]string{"data"}).AddRow(rated))
+
+	records, err := repo.ListFeedback(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, feedback.RatingUp, records[0].Rating)
+
+	mock.ExpectExec(saveAnswerSQL).WithArgs("a1", data, now.Add(feedback.AnswerTTL)).WillReturnError(assert.AnError)
+	assert.ErrorContains(t, repo.SaveAnswer(ctx, testAnswer(now)), "failed to save answer")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedbackRepository_Import(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo, mock := newTestFeedbackRepository(t, now)
+
+	unrated := testAnswer(now)
+	data, _ := json.Marshal(unrated)
+
+	mock.ExpectExec(importFeedbackSQL).WithArgs("a1", data, (*time.Time)(nil), now.Add(time.Hour)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	require.NoError(t, repo.Import(context.Background(), unrated, now.Add(time.Hour)))
+
+	rated := testAnswer(now)
+	rated.Rate(feedback.RatingDown, now)
+	data, _ = json.Marshal(rated)
+
+	mock.ExpectExec(importFeedbackSQL).WithArgs("a1", data, &rated.RatedAt, now.Add(time.Hour)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	require.NoError(t, repo.Import(context.Background(), rated, now.Add(time.Hour)))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedbackRepository_PendingReason(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		setup   func(mock pgxmock.PgxPoolIface)
+		wantErr error
+		name    string
+		want    string
+	}{
+		{
+			name: "awaited reason",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(popPendingReasonSQL).WithArgs("user1").
+					WillReturnRows(pgxmock.NewRows([]string{"answer_id", "expires_at"}).AddRow("a1", now.Add(time.Minute)))
+			},
+			want: "a1",
+		},
+		{
+			name: "expired reason",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(popPendingReasonSQL).WithArgs("user1").
+					WillReturnRows(pgxmock.NewRows([]string{"answer_id", "expires_at"}).AddRow("a1", now.Add(-time.Minute)))
+			},
+			wantErr: core.ErrAnswerNotFound,
+		},
+		{
+			name: "no reason awaited",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(popPendingReasonSQL).WithArgs("user1").WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: core.ErrAnswerNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestFeedbackRepository(t, now)
+
+			mock.ExpectExec(setPendingReasonSQL).WithArgs("user1", "a1", now.Add(feedback.ReasonTTL)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			require.NoError(t, repo.SetPendingReason(context.Background(), "user1", "a1"))
+
+			tt.setup(mock)
+
+			got, err := repo.PopPendingReason(context.Background(), "user1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
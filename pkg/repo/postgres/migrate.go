@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// migrationLockID is the key of the advisory lock serializing migrations run by several instances at once
+const migrationLockID = 7216354190
+
+const (
+	createMigrationsTableSQL = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    INTEGER PRIMARY KEY,
+    name       TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+	lockMigrationsSQL   = `SELECT pg_advisory_xact_lock($1)`
+	migrationAppliedSQL = `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`
+	recordMigrationSQL  = `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`
+
+	migrationsDir       = "migrations"
+	migrationFileExt    = ".sql"
+	migrationVersionSep = "_"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migration is a versioned change of the database schema, embedded from the migrations directory.
+// Files are named "<version>_<name>.sql" and applied in the order of their versions.
+type Migration struct {
+	Name    string
+	SQL     string
+	Version int
+}
+
+// Migrations returns the embedded migrations ordered by version.
+// Returns an error if a migration file can't be read or its name has no version.
+func Migrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, migrationsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+
+	for _, entry := range entries {
+		base, ok := strings.CutSuffix(entry.Name(), migrationFileExt)
+		if !ok {
+			continue
+		}
+
+		prefix, name, _ := strings.Cut(base, migrationVersionSep)
+
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %s: %w", entry.Name(), err)
+		}
+
+		data, err := migrationFiles.ReadFile(migrationsDir + "/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migrations = append(migrations, Migration{Version: version, Name: name, SQL: string(data)})
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return a.Version - b.Version
+	})
+
+	return migrations, nil
+}
+
+// Migrate applies the embedded migrations missing in the database, each one in its own transaction
+// together with its record in the schema_migrations table. Instances migrating at once wait for each other.
+// Returns the applied migrations, or an error if a migration fails, in which case the earlier ones stay applied.
+func Migrate(ctx context.Context, db DB) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(ctx, createMigrationsTableSQL); err != nil {
+		return nil, fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	var applied []Migration
+
+	for _, m := range migrations {
+		ok, err := apply(ctx, db, m)
+		if err != nil {
+			return applied, fmt.Errorf("failed to apply migration %d %s: %w", m.Version, m.Name, err)
+		}
+
+		if ok {
+			applied = append(applied, m)
+		}
+	}
+
+	return applied, nil
+}
+
+// apply runs the migration unless it is already recorded as applied.
+// Returns true if the migration was applied, or an error if the transaction fails.
+func apply(ctx context.Context, db DB, m Migration) (bool, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, lockMigrationsSQL, int64(migrationLockID)); err != nil {
+		return false, err
+	}
+
+	var done bool
+	if err := tx.QueryRow(ctx, migrationAppliedSQL, m.Version).Scan(&done); err != nil {
+		return false, err
+	}
+
+	if done {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, m.SQL); err != nil {
+		return false, err
+	}
+
+	if _, err := tx.Exec(ctx, recordMigrationSQL, m.Version, m.Name); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations(t *testing.T) {
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	require.Len(t, migrations, 5)
+
+	assert.Equal(t, 1, migrations[0].Version)
+	assert.Equal(t, "create_conversations", migrations[0].Name)
+	assert.Contains(t, migrations[0].SQL, "CREATE TABLE conversations")
+
+	assert.Equal(t, 2, migrations[1].Version)
+	assert.Equal(t, "create_pet_profiles", migrations[1].Name)
+	assert.Contains(t, migrations[1].SQL, "CREATE TABLE pet_profiles")
+
+	assert.Equal(t, 3, migrations[2].Version)
+	assert.Equal(t, "add_conversation_version", migrations[2].Name)
+	assert.Contains(t, migrations[2].SQL, "ALTER TABLE conversations ADD COLUMN version")
+
+	assert.Equal(t, 4, migrations[3].Version)
+	assert.Equal(t, "create_reminders", migrations[3].Name)
+	assert.Contains(t, migrations[3].SQL, "CREATE TABLE reminders")
+
+	assert.Equal(t, 5, migrations[4].Version)
+	assert.Equal(t, "create_feedback", migrations[4].Name)
+	assert.Contains(t, migrations[4].SQL, "CREATE TABLE feedback")
+}
+
+func TestMigrate(t *testing.T) {
+	migrations, err := Migrations()
+	require.NoError(t, err)
+
+	first, second := migrations[0], migrations[1]
+
+	expectApplied := func(mock pgxmock.PgxPoolIface, m Migration, applied bool) {
+		mock.ExpectBegin()
+		mock.ExpectExec(lockMigrationsSQL).WithArgs(int64(migrationLockID)).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		mock.ExpectQuery(migrationAppliedSQL).WithArgs(m.Version).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(applied))
+	}
+
+	tests := []struct {
+		setup       func(mock pgxmock.PgxPoolIface)
+		name        string
+		wantErr     string
+		wantApplied []Migration
+	}{
+		{
+			name: "new database",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(createMigrationsTableSQL).WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+
+				for _, m := range migrations {
+					expectApplied(mock, m, false)
+					mock.ExpectExec(m.SQL).WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+					mock.ExpectExec(recordMigrationSQL).WithArgs(m.Version, m.Name).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+					mock.ExpectCommit()
+				}
+			},
+			wantApplied: migrations,
+		},
+		{
+			name: "up to date database",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(createMigrationsTableSQL).WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+
+				for _, m := range migrations {
+					expectApplied(mock, m, true)
+					mock.ExpectRollback()
+				}
+			},
+		},
+		{
+			name: "migrations table can't be created",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(createMigrationsTableSQL).WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to create migrations table: " + assert.AnError.Error(),
+		},
+		{
+			name: "migration fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(createMigrationsTableSQL).WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+
+				expectApplied(mock, first, false)
+				mock.ExpectExec(first.SQL).WillReturnResult(pgxmock.NewResult("CREATE TABLE", 0))
+				mock.ExpectExec(recordMigrationSQL).WithArgs(first.Version, first.Name).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+
+				expectApplied(mock, second, false)
+				mock.ExpectExec(second.SQL).WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			wantApplied: []Migration{first},
+			wantErr:     "failed to apply migration 2 create_pet_profiles: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			defer mock.Close()
+
+			tt.setup(mock)
+
+			applied, err := Migrate(context.Background(), mock)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantApplied, applied)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConnect(t *testing.T) {
+	_, err := Connect(context.Background(), "")
+	assert.EqualError(t, err, "postgres connection string cannot be empty")
+
+	_, err = Connect(context.Background(), "postgres://localhost:5432/db?pool_max_conns=invalid")
+	assert.ErrorContains(t, err, "failed to create postgres pool")
+}
@@ -0,0 +1,7 @@
+CREATE TABLE conversations (
+    id         TEXT PRIMARY KEY,
+    data       JSONB NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX conversations_expires_at_idx ON conversations (expires_at);
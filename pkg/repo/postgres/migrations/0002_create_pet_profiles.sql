@@ -0,0 +1,5 @@
+CREATE TABLE pet_profiles (
+    user_id    TEXT PRIMARY KEY,
+    profiles   JSONB NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,10 @@
+CREATE TABLE reminders (
+    id      TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    data    JSONB NOT NULL,
+    due_at  TIMESTAMPTZ NOT NULL,
+    version BIGINT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX reminders_user_id_idx ON reminders (user_id);
+CREATE INDEX reminders_due_at_idx ON reminders (due_at);
@@ -0,0 +1,15 @@
+CREATE TABLE feedback (
+    id         TEXT PRIMARY KEY,
+    data       JSONB NOT NULL,
+    rated_at   TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX feedback_rated_at_idx ON feedback (rated_at) WHERE rated_at IS NOT NULL;
+CREATE INDEX feedback_expires_at_idx ON feedback (expires_at);
+
+CREATE TABLE feedback_reasons (
+    user_id    TEXT PRIMARY KEY,
+    answer_id  TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
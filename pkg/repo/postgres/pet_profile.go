@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+)
+
+const (
+	savePetProfilesSQL = `INSERT INTO pet_profiles (user_id, profiles, updated_at) VALUES ($1, $2, now())
+ON CONFLICT (user_id) DO UPDATE SET profiles = EXCLUDED.profiles, updated_at = EXCLUDED.updated_at`
+	findPetProfilesSQL          = `SELECT profiles FROM pet_profiles WHERE user_id = $1`
+	findPetProfilesForUpdateSQL = findPetProfilesSQL + ` FOR UPDATE`
+	deletePetProfilesSQL        = `DELETE FROM pet_profiles WHERE user_id = $1`
+)
+
+// PetProfileRepository implements core.PetProfileRepository using a PostgreSQL database.
+// Profiles of a user are stored as a single JSON value, in the same format as in Redis.
+type PetProfileRepository struct {
+	db DB
+}
+
+// NewPetProfileRepository creates a new PetProfileRepository storing profiles in the pet_profiles table.
+func NewPetProfileRepository(db DB) *PetProfileRepository {
+	return &PetProfileRepository{
+		db: db,
+	}
+}
+
+// SaveProfile replaces the user's active pet profile, or adds the profile as the first pet if the user has none.
+// Returns an error if serialization or the database update fails.
+func (r *PetProfileRepository) SaveProfile(ctx context.Context, userID string, profile *pet.Profile) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		profiles.ReplaceCurrent(*profile)
+		return nil
+	})
+}
+
+// AddProfile adds a new pet profile for the user and makes it the active one.
+// If the user already has a pet with the same name, that pet's profile is replaced keeping its medical history.
+// Returns an error if serialization or the database update fails.
+func (r *PetProfileRepository) AddProfile(ctx context.Context, userID string, profile *pet.Profile) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		profiles.Add(*profile)
+		return nil
+	})
+}
+
+// GetProfiles returns all pet profiles of the user together with the active pet index.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if reading or unmarshaling fails.
+func (r *PetProfileRepository) GetProfiles(ctx context.Context, userID string) (*pet.Profiles, error) {
+	profiles, err := load(ctx, r.db, findPetProfilesSQL, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profiles.Profiles) == 0 {
+		return nil, core.ErrProfileNotFound
+	}
+
+	return profiles, nil
+}
+
+// GetCurrentProfile returns the user's active pet profile.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if reading or unmarshaling fails.
+func (r *PetProfileRepository) GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error) {
+	profiles, err := r.GetProfiles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles.Current(), nil
+}
+
+// SetActiveProfile makes the pet with the given name the active one for the user.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) SetActiveProfile(ctx context.Context, userID, name string) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Select(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// UpdateProfile applies fn to the profile of the pet with the given name, the active pet is not changed.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) UpdateProfile(ctx context.Context, userID, name string, fn func(profile *pet.Profile)) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Update(name, fn) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveProfile removes the pet with the given name from the user's profiles.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) RemoveProfile(ctx context.Context, userID, name string) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Remove(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveUserProfiles removes all pet profiles of the user.
+// Returns an error if the database update fails.
+func (r *PetProfileRepository) RemoveUserProfiles(ctx context.Context, userID string) error {
+	if _, err := r.db.Exec(ctx, deletePetProfilesSQL, userID); err != nil {
+		return fmt.Errorf("failed to remove pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// Import stores the serialized profiles of the user, replacing the existing ones.
+// It is used to copy profiles from another storage in the same JSON format.
+// Returns an error if the database update fails, e.g. when data is not valid JSON.
+func (r *PetProfileRepository) Import(ctx context.Context, userID string, data []byte) error {
+	if _, err := r.db.Exec(ctx, savePetProfilesSQL, userID, data); err != nil {
+		return fmt.Errorf("failed to save pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// update applies fn to the profiles of the user and stores the result in a single transaction,
+// holding the row lock so concurrent updates of the same user are not lost. Users left without pets are removed.
+// Returns the error of fn, in which case nothing is stored, or an error if the database update fails.
+func (r *PetProfileRepository) update(ctx context.Context, userID string, fn func(profiles *pet.Profiles) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	profiles, err := load(ctx, tx, findPetProfilesForUpdateSQL, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(profiles); err != nil {
+		return err
+	}
+
+	if len(profiles.Profiles) == 0 {
+		_, err = tx.Exec(ctx, deletePetProfilesSQL, userID)
+	} else {
+		var data []byte
+
+		if data, err = json.Marshal(profiles); err != nil {
+			return fmt.Errorf("failed to marshal pet profiles: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, savePetProfilesSQL, userID, data)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to save pet profiles: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to save pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// querier runs queries returning a single row, it is implemented by DB and pgx.Tx
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// load unmarshals the profiles of the user selected with the query.
+// Returns an empty collection if the user has no stored profiles.
+func load(ctx context.Context, q querier, query, userID string) (*pet.Profiles, error) {
+	var (
+		profiles pet.Profiles
+		data     []byte
+	)
+
+	err := q.QueryRow(ctx, query, userID).Scan(&data)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return &profiles, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get pet profiles: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pet profiles: %w", err)
+	}
+
+	return &profiles, nil
+}
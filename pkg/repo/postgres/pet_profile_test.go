@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPetProfileRepository creates a repository backed by a mocked pool.
+func newTestPetProfileRepository(t *testing.T) (*PetProfileRepository, pgxmock.PgxPoolIface) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	t.Cleanup(mock.Close)
+
+	return NewPetProfileRepository(mock), mock
+}
+
+// marshalProfiles serializes the profiles as they are stored in the database.
+func marshalProfiles(t *testing.T, profiles *pet.Profiles) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(profiles)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestPetProfileRepository_GetProfiles(t *testing.T) {
+	stored := &pet.Profiles{Profiles: []pet.Profile{{Name: "Rex", Species: "dog"}, {Name: "Luna", Species: "cat"}}, Active: 1}
+
+	tests := []struct {
+		setup   func(mock pgxmock.PgxPoolIface)
+		want    *pet.Profiles
+		name    string
+		wantErr error
+	}{
+		{
+			name: "stored profiles",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(findPetProfilesSQL).WithArgs("user1").WillReturnRows(pgxmock.NewRows([]string{"profiles"}).AddRow(marshalProfiles(t, stored)))
+			},
+			want: stored,
+		},
+		{
+			name: "no profiles",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(findPetProfilesSQL).WithArgs("user1").WillReturnError(pgx.ErrNoRows)
+			},
+			wantErr: core.ErrProfileNotFound,
+		},
+		{
+			name: "query fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(findPetProfilesSQL).WithArgs("user1").WillReturnError(assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestPetProfileRepository(t)
+			tt.setup(mock)
+
+			profiles, err := repo.GetProfiles(context.Background(), "user1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, profiles)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
+	repo, mock := newTestPetProfileRepository(t)
+
+	stored := &pet.Profiles{Profiles: []pet.Profile{{Name: "Rex", Species: "dog"}, {Name: "Luna", Species: "cat"}}, Active: 1}
+	mock.ExpectQuery(findPetProfilesSQL).WithArgs("user1").WillReturnRows(pgxmock.NewRows([]string{"profiles"}).AddRow(marshalProfiles(t, stored)))
+
+	profile, err := repo.GetCurrentProfile(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "Luna", profile.Name)
+}
+
+func TestPetProfileRepository_Update(t *testing.T) {
+	rex := pet.Profile{Name: "Rex", Species: "dog"}
+	luna := pet.Profile{Name: "Luna", Species: "cat"}
+	stored := &pet.Profiles{Profiles: []pet.Profile{rex}}
+
+	expectLoad := func(mock pgxmock.PgxPoolIface, profiles *pet.Profiles) {
+		mock.ExpectBegin()
+
+		if profiles == nil {
+			mock.ExpectQuery(findPetProfilesForUpdateSQL).WithArgs("user1").WillReturnError(pgx.ErrNoRows)
+			return
+		}
+
+		mock.ExpectQuery(findPetProfilesForUpdateSQL).WithArgs("user1").WillReturnRows(pgxmock.NewRows([]string{"profiles"}).AddRow(marshalProfiles(t, profiles)))
+	}
+
+	tests := []struct {
+		setup   func(mock pgxmock.PgxPoolIface)
+		run     func(repo *PetProfileRepository) error
+		name    string
+		wantErr string
+	}{
+		{
+			name: "save first profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, nil)
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, stored)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.SaveProfile(context.Background(), "user1", &rex)
+			},
+		},
+		{
+			name: "add profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, stored)
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, &pet.Profiles{Profiles: []pet.Profile{rex, luna}, Active: 1})).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectCommit()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.AddProfile(context.Background(), "user1", &luna)
+			},
+		},
+		{
+			name: "set active profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, &pet.Profiles{Profiles: []pet.Profile{rex, luna}, Active: 1})
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, &pet.Profiles{Profiles: []pet.Profile{rex, luna}})).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectCommit()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.SetActiveProfile(context.Background(), "user1", "Rex")
+			},
+		},
+		{
+			name: "set unknown active profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, stored)
+				mock.ExpectRollback()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.SetActiveProfile(context.Background(), "user1", "Max")
+			},
+			wantErr: core.ErrProfileNotFound.Error(),
+		},
+		{
+			name: "update inactive profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, &pet.Profiles{Profiles: []pet.Profile{rex, luna}})
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, &pet.Profiles{Profiles: []pet.Profile{rex, {Name: "Luna", Species: "cat", Weight: "4 kg"}}})).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectCommit()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.UpdateProfile(context.Background(), "user1", "Luna", func(profile *pet.Profile) {
+					profile.Weight = "4 kg"
+				})
+			},
+		},
+		{
+			name: "remove last profile",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, stored)
+				mock.ExpectExec(deletePetProfilesSQL).WithArgs("user1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectCommit()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.RemoveProfile(context.Background(), "user1", "Rex")
+			},
+		},
+		{
+			name: "begin fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin().WillReturnError(assert.AnError)
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.AddProfile(context.Background(), "user1", &luna)
+			},
+			wantErr: "failed to begin transaction: " + assert.AnError.Error(),
+		},
+		{
+			name: "save fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, nil)
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, stored)).WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.AddProfile(context.Background(), "user1", &rex)
+			},
+			wantErr: "failed to save pet profiles: " + assert.AnError.Error(),
+		},
+		{
+			name: "commit fails",
+			setup: func(mock pgxmock.PgxPoolIface) {
+				expectLoad(mock, nil)
+				mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", marshalProfiles(t, stored)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit().WillReturnError(assert.AnError)
+			},
+			run: func(repo *PetProfileRepository) error {
+				return repo.AddProfile(context.Background(), "user1", &rex)
+			},
+			wantErr: "failed to save pet profiles: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestPetProfileRepository(t)
+			tt.setup(mock)
+
+			err := tt.run(repo)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPetProfileRepository_RemoveUserProfiles(t *testing.T) {
+	repo, mock := newTestPetProfileRepository(t)
+	mock.ExpectExec(deletePetProfilesSQL).WithArgs("user1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(deletePetProfilesSQL).WithArgs("user2").WillReturnError(assert.AnError)
+
+	assert.NoError(t, repo.RemoveUserProfiles(context.Background(), "user1"))
+	assert.EqualError(t, repo.RemoveUserProfiles(context.Background(), "user2"), "failed to remove pet profiles: "+assert.AnError.Error())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPetProfileRepository_Import(t *testing.T) {
+	repo, mock := newTestPetProfileRepository(t)
+	data := []byte(`{"profiles":[{"name":"Rex"}]}`)
+	mock.ExpectExec(savePetProfilesSQL).WithArgs("user1", data).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	assert.NoError(t, repo.Import(context.Background(), "user1", data))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveExpired(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	defer mock.Close()
+
+	for _, query := range removeExpiredSQL {
+		mock.ExpectExec(query).WithArgs(now).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	}
+
+	require.NoError(t, RemoveExpired(context.Background(), mock, now))
+
+	mock.ExpectExec(removeExpiredSQL[0]).WithArgs(now).WillReturnError(assert.AnError)
+	assert.EqualError(t, RemoveExpired(context.Background(), mock, now), "failed to remove expired records: "+assert.AnError.Error())
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+)
+
+const (
+	insertReminderSQL = `INSERT INTO reminders (id, user_id, data, due_at, version) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING`
+	updateReminderSQL = `UPDATE reminders SET data = $2, due_at = $3, version = $4 WHERE id = $1 AND version = $5`
+	importReminderSQL = `INSERT INTO reminders (id, user_id, data, due_at, version) VALUES ($1, $2, $3, $4, 1)
+ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, due_at = EXCLUDED.due_at, version = reminders.version + 1`
+	claimRemindersSQL = `UPDATE reminders SET due_at = $2 WHERE id IN (
+    SELECT id FROM reminders WHERE due_at <= $1 ORDER BY due_at LIMIT $3 FOR UPDATE SKIP LOCKED
+) RETURNING data, version`
+	findReminderSQL      = `SELECT data, version FROM reminders WHERE id = $1`
+	findUserRemindersSQL = `SELECT data, version FROM reminders WHERE user_id = $1`
+	deleteReminderSQL    = `DELETE FROM reminders WHERE id = $1`
+)
+
+// ReminderRepository implements core.ReminderRepository using a PostgreSQL database.
+// Reminders are stored as JSON, in the same format as in Redis, together with the time they are due.
+type ReminderRepository struct {
+	db DB
+}
+
+// NewReminderRepository creates a new ReminderRepository storing reminders in the reminders table.
+func NewReminderRepository(db DB) *ReminderRepository {
+	return &ReminderRepository{
+		db: db,
+	}
+}
+
+// SaveReminder creates or updates the reminder and schedules it for the time it is due.
+// The reminder is saved only if the stored version is the one it was loaded with, a new reminder only if
+// there is no stored one. On success the version of the reminder is incremented.
+// Returns core.ErrReminderConflict if the reminder was changed or deleted since it was loaded,
+// or an error if serialization or the database update fails.
+func (r *ReminderRepository) SaveReminder(ctx context.Context, rem *reminder.Reminder) error {
+	version := rem.Version
+	rem.Version++
+
+	data, err := json.Marshal(rem)
+	if err != nil {
+		rem.Version = version
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	var tag pgconn.CommandTag
+
+	if version == 0 {
+		tag, err = r.db.Exec(ctx, insertReminderSQL, rem.ID, rem.UserID, data, rem.DueAt(), rem.Version)
+	} else {
+		tag, err = r.db.Exec(ctx, updateReminderSQL, rem.ID, data, rem.DueAt(), rem.Version, version)
+	}
+
+	switch {
+	case err != nil:
+		rem.Version = version
+		return fmt.Errorf("failed to save reminder: %w", err)
+	case tag.RowsAffected() == 0:
+		rem.Version = version
+		return core.ErrReminderConflict
+	}
+
+	return nil
+}
+
+// Import stores the reminder, replacing the existing one with the same ID and incrementing its version.
+// It is used to copy reminders from another storage.
+// Returns an error if serialization or the database update fails.
+func (r *ReminderRepository) Import(ctx context.Context, rem *reminder.Reminder) error {
+	data, err := json.Marshal(rem)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	if _, err := r.db.Exec(ctx, importReminderSQL, rem.ID, rem.UserID, data, rem.DueAt()); err != nil {
+		return fmt.Errorf("failed to save reminder: %w", err)
+	}
+
+	return nil
+}
+
+// GetReminder retrieves the reminder by its ID.
+// Returns core.ErrReminderNotFound if the reminder doesn't exist, or an error if reading or unmarshaling fails.
+func (r *ReminderRepository) GetReminder(ctx context.Context, id string) (*reminder.Reminder, error) {
+	var (
+		data    []byte
+		version int64
+	)
+
+	err := r.db.QueryRow(ctx, findReminderSQL, id).Scan(&data, &version)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, core.ErrReminderNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	return unmarshalReminder(data, version)
+}
+
+// GetUserReminders retrieves all reminders of the user.
+// Returns the reminders, or an error if reading or unmarshaling fails.
+func (r *ReminderRepository) GetUserReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error) {
+	return r.query(ctx, findUserRemindersSQL, userID)
+}
+
+// ClaimDueReminders retrieves up to limit reminders due at or before now and postpones them by ttl in a single update,
+// skipping reminders locked by other schedulers, so concurrent schedulers never claim the same reminder.
+// Saving a claimed reminder schedules it for its next due time.
+// Returns the reminders, or an error if the update or unmarshaling fails.
+func (r *ReminderRepository) ClaimDueReminders(ctx context.Context, now time.Time, ttl time.Duration, limit int) ([]*reminder.Reminder, error) {
+	return r.query(ctx, claimRemindersSQL, now, now.Add(ttl), limit)
+}
+
+// DeleteReminder removes the reminder, it does nothing if there is no such reminder.
+// Returns an error if the database update fails.
+func (r *ReminderRepository) DeleteReminder(ctx context.Context, rem *reminder.Reminder) error {
+	if _, err := r.db.Exec(ctx, deleteReminderSQL, rem.ID); err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	return nil
+}
+
+// query unmarshals the reminders returned by the query.
+// Returns an error if the query or unmarshaling fails.
+func (r *ReminderRepository) query(ctx context.Context, query string, args ...any) ([]*reminder.Reminder, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	defer rows.Close()
+
+	var reminders []*reminder.Reminder
+
+	for rows.Next() {
+		var (
+			data    []byte
+			version int64
+		)
+
+		if err := rows.Scan(&data, &version); err != nil {
+			return nil, fmt.Errorf("failed to get reminders: %w", err)
+		}
+
+		rem, err := unmarshalReminder(data, version)
+		if err != nil {
+			return nil, err
+		}
+
+		reminders = append(reminders, rem)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// unmarshalReminder deserializes the reminder and sets its stored version.
+func unmarshalReminder(data []byte, version int64) (*reminder.Reminder, error) {
+	var rem reminder.Reminder
+	if err := json.Unmarshal(data, &rem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reminder: %w", err)
+	}
+
+	rem.Version = version
+
+	return &rem, nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestReminderRepository creates a repository backed by a mocked pool.
+func newTestReminderRepository(t *testing.T) (*ReminderRepository, pgxmock.PgxPoolIface) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	t.Cleanup(mock.Close)
+
+	return NewReminderRepository(mock), mock
+}
+
+func testReminder() *reminder.Reminder {
+	return &reminder.Reminder{
+		ID:       "rem1",
+		UserID:   "user1",
+		ChatID:   "chat1",
+		Text:     "give Rimadyl",
+		NextAt:   time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		Schedule: reminder.Schedule{Unit: reminder.UnitHour, Every: 12},
+	}
+}
+
+// marshalReminder serializes the reminder as it is stored after saving it with the given version.
+func marshalReminder(t *testing.T, rem *reminder.Reminder, version int64) []byte {
+	t.Helper()
+
+	saved := *rem
+	saved.Version = version
+
+	data, err := json.Marshal(&saved)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestReminderRepository_SaveReminder(t *testing.T) {
+	tests := []struct {
+		setup       func(mock pgxmock.PgxPoolIface, rem *reminder.Reminder)
+		name        string
+		wantErr     string
+		version     int64
+		wantVersion int64
+	}{
+		{
+			name: "new reminder",
+			setup: func(mock pgxmock.PgxPoolIface, rem *reminder.Reminder) {
+				mock.ExpectExec(insertReminderSQL).WithArgs("rem1", "user1", marshalReminder(t, rem, 1), rem.NextAt, int64(1)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			wantVersion: 1,
+		},
+		{
+			name:    "loaded reminder",
+			version: 3,
+			setup: func(mock pgxmock.PgxPoolIface, rem *reminder.Reminder) {
+				mock.ExpectExec(updateReminderSQL).WithArgs("rem1", marshalReminder(t, rem, 4), rem.NextAt, int64(4), int64(3)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			wantVersion: 4,
+		},
+		{
+			name:    "reminder changed or deleted concurrently",
+			version: 3,
+			setup: func(mock pgxmock.PgxPoolIface, rem *reminder.Reminder) {
+				mock.ExpectExec(updateReminderSQL).WithArgs("rem1", marshalReminder(t, rem, 4), rem.NextAt, int64(4), int64(3)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			wantErr:     core.ErrReminderConflict.Error(),
+			wantVersion: 3,
+		},
+		{
+			name: "database error",
+			setup: func(mock pgxmock.PgxPoolIface, rem *reminder.Reminder) {
+				mock.ExpectExec(insertReminderSQL).WithArgs("rem1", "user1", marshalReminder(t, rem, 1), rem.NextAt, int64(1)).
+					WillReturnError(assert.AnError)
+			},
+			wantErr: "failed to save reminder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestReminderRepository(t)
+			rem := testReminder()
+			rem.Version = tt.version
+
+			tt.setup(mock, rem)
+
+			err := repo.SaveReminder(context.Background(), rem)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantVersion, rem.Version)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReminderRepository_GetReminder(t *testing.T) {
+	repo, mock := newTestReminderRepository(t)
+	rem := testReminder()
+
+	mock.ExpectQuery(findReminderSQL).WithArgs("rem1").
+		WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow(marshalReminder(t, rem, 1), int64(2)))
+
+	got, err := repo.GetReminder(context.Background(), "rem1")
+	require.NoError(t, err)
+	assert.Equal(t, "give Rimadyl", got.Text)
+	assert.Equal(t, int64(2), got.Version)
+
+	mock.ExpectQuery(findReminderSQL).WithArgs("missing").WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.GetReminder(context.Background(), "missing")
+	assert.ErrorIs(t, err, core.ErrReminderNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_GetUserReminders(t *testing.T) {
+	repo, mock := newTestReminderRepository(t)
+	rem := testReminder()
+
+	mock.ExpectQuery(findUserRemindersSQL).WithArgs("user1").
+		WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow(marshalReminder(t, rem, 1), int64(1)))
+
+	got, err := repo.GetUserReminders(context.Background(), "user1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "rem1", got[0].ID)
+
+	mock.ExpectQuery(findUserRemindersSQL).WithArgs("user1").
+		WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow([]byte("invalid"), int64(1)))
+
+	_, err = repo.GetUserReminders(context.Background(), "user1")
+	assert.ErrorContains(t, err, "failed to unmarshal reminder")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_ClaimDueReminders(t *testing.T) {
+	repo, mock := newTestReminderRepository(t)
+	rem := testReminder()
+	now := rem.NextAt.Add(time.Minute)
+
+	mock.ExpectQuery(claimRemindersSQL).WithArgs(now, now.Add(5*time.Minute), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"data", "version"}).AddRow(marshalReminder(t, rem, 1), int64(1)))
+
+	got, err := repo.ClaimDueReminders(context.Background(), now, 5*time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "rem1", got[0].ID)
+
+	mock.ExpectQuery(claimRemindersSQL).WithArgs(now, now.Add(5*time.Minute), 10).WillReturnError(assert.AnError)
+
+	_, err = repo.ClaimDueReminders(context.Background(), now, 5*time.Minute, 10)
+	assert.ErrorContains(t, err, "failed to get reminders")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_DeleteAndImport(t *testing.T) {
+	repo, mock := newTestReminderRepository(t)
+	rem := testReminder()
+
+	mock.ExpectExec(deleteReminderSQL).WithArgs("rem1").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	require.NoError(t, repo.DeleteReminder(context.Background(), rem))
+
+	mock.ExpectExec(importReminderSQL).WithArgs("rem1", "user1", marshalReminder(t, rem, 0), rem.NextAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	require.NoError(t, repo.Import(context.Background(), rem))
+
+	mock.ExpectExec(deleteReminderSQL).WithArgs("rem1").WillReturnError(assert.AnError)
+	assert.ErrorContains(t, repo.DeleteReminder(context.Background(), rem), "failed to delete reminder")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
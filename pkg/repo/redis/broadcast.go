@@ -0,0 +1,306 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	broadcastChatsKey        = "broadcast:chats"
+	broadcastsKey            = "broadcasts"
+	broadcastsPendingKey     = "broadcasts:pending"
+	broadcastQueueKeyPrefix  = "broadcast:queue:"
+	broadcastLeaseKeyPrefix  = "broadcast:lease:"
+	broadcastQueuePushBuffer = 1000
+)
+
+// BroadcastRepository implements core.BroadcastRepository using Redis.
+// Known chats are stored in a hash mapping chat IDs to their languages. Broadcasts are stored as JSON in a hash,
+// pending ones are ordered by creation time in a sorted set, and every broadcast has a list of queued recipients.
+// The worker delivering a broadcast holds its lease, a key with the owner of the lease expiring unless it is renewed.
+type BroadcastRepository struct {
+	client *redis.Client
+}
+
+// NewBroadcastRepository creates a new instance of BroadcastRepository with the provided Redis client.
+// client Redis client used for database operations.
+// Returns a pointer to the BroadcastRepository instance.
+func NewBroadcastRepository(client *redis.Client) *BroadcastRepository {
+	return &BroadcastRepository{
+		client: client,
+	}
+}
+
+// SaveChat adds the chat to the recipients of future broadcasts or updates its language.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if the save operation fails.
+func (r *BroadcastRepository) SaveChat(ctx context.Context, chat broadcast.Chat) error {
+	if err := r.client.HSet(ctx, broadcastChatsKey, chat.ID, chat.Language).Err(); err != nil {
+		return fmt.Errorf("failed to save chat: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveChat removes the chat from the recipients of future broadcasts.
+// Broadcasts already queued for the chat are still attempted, the delivery fails for unavailable chats.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if the delete operation fails.
+func (r *BroadcastRepository) RemoveChat(ctx context.Context, chatID string) error {
+	if err := r.client.HDel(ctx, broadcastChatsKey, chatID).Err(); err != nil {
+		return fmt.Errorf("failed to remove chat: %w", err)
+	}
+
+	return nil
+}
+
+// CountChats returns the number of known chats per language.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if retrieving the chats fails.
+func (r *BroadcastRepository) CountChats(ctx context.Context) (map[string]int, error) {
+	chats, err := r.client.HGetAll(ctx, broadcastChatsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chats: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, language := range chats {
+		counts[language]++
+	}
+
+	return counts, nil
+}
+
+// CreateBroadcast stores the broadcast, marks it as pending and queues all known chats as its recipients.
+// The languages of the chats are captured in the queue, so later language changes don't affect the broadcast.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if retrieving the chats, serialization or the save operation fails.
+func (r *BroadcastRepository) CreateBroadcast(ctx context.Context, b *broadcast.Broadcast) error {
+	chats, err := r.client.HGetAll(ctx, broadcastChatsKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get chats: %w", err)
+	}
+
+	recipients := make([]any, 0, len(chats))
+
+	for id, language := range chats {
+		data, err := json.Marshal(broadcast.Chat{ID: id, Language: language})
+		if err != nil {
+			return fmt.Errorf("failed to marshal recipient: %w", err)
+		}
+
+		recipients = append(recipients, data)
+	}
+
+	b.Total = len(recipients)
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, broadcastsKey, b.ID, data)
+
+		for start := 0; start < len(recipients); start += broadcastQueuePushBuffer {
+			end := min(start+broadcastQueuePushBuffer, len(recipients))
+			pipe.RPush(ctx, broadcastQueueKey(b.ID), recipients[start:end]...)
+		}
+
+		pipe.ZAdd(ctx, broadcastsPendingKey, redis.Z{Score: float64(b.CreatedAt.UnixNano()), Member: b.ID})
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingBroadcast retrieves the oldest broadcast which is not completed.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrBroadcastNotFound if there are no pending broadcasts, or an error if retrieval or unmarshaling fails.
+func (r *BroadcastRepository) GetPendingBroadcast(ctx context.Context) (*broadcast.Broadcast, error) {
+	ids, err := r.client.ZRange(ctx, broadcastsPendingKey, 0, 0).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending broadcasts: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil, core.ErrBroadcastNotFound
+	}
+
+	data, err := r.client.HGet(ctx, broadcastsKey, ids[0]).Bytes()
+	if err == redis.Nil {
+		return nil, core.ErrBroadcastNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broadcast: %w", err)
+	}
+
+	var b broadcast.Broadcast
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal broadcast: %w", err)
+	}
+
+	return &b, nil
+}
+
+// LeaseBroadcast acquires or renews the lease of the broadcast for owner for the duration of ttl
+// and retrieves the current state of the broadcast.
+// The lease is checked and set in a WATCH/MULTI transaction, so only one worker acquires it.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrBroadcastLeased if another owner holds the lease, core.ErrBroadcastNotFound if there is no such
+// broadcast, or an error if retrieval or unmarshaling fails.
+func (r *BroadcastRepository) LeaseBroadcast(ctx context.Context, id, owner string, ttl time.Duration) (*broadcast.Broadcast, error) {
+	key := broadcastLeaseKey(id)
+
+	var data []byte
+
+	err := r.client.Watch(ctx, func(tx *redis.Tx) error {
+		if err := checkLease(ctx, tx, key, owner, true); err != nil {
+			return err
+		}
+
+		var err error
+
+		data, err = tx.HGet(ctx, broadcastsKey, id).Bytes()
+		if err == redis.Nil {
+			return core.ErrBroadcastNotFound
+		} else if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, owner, ttl)
+			return nil
+		})
+
+		return err
+	}, key)
+
+	switch {
+	case errors.Is(err, core.ErrBroadcastLeased), errors.Is(err, redis.TxFailedErr):
+		return nil, core.ErrBroadcastLeased
+	case errors.Is(err, core.ErrBroadcastNotFound):
+		return nil, core.ErrBroadcastNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to lease broadcast: %w", err)
+	}
+
+	var b broadcast.Broadcast
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal broadcast: %w", err)
+	}
+
+	return &b, nil
+}
+
+// GetRecipients retrieves up to limit recipients from the head of the queue of the broadcast without removing them.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns the recipients, or an error if retrieval or unmarshaling fails.
+func (r *BroadcastRepository) GetRecipients(ctx context.Context, id string, limit int) ([]broadcast.Chat, error) {
+	values, err := r.client.LRange(ctx, broadcastQueueKey(id), 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipients: %w", err)
+	}
+
+	chats := make([]broadcast.Chat, 0, len(values))
+
+	for _, v := range values {
+		var chat broadcast.Chat
+		if err := json.Unmarshal([]byte(v), &chat); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal recipient: %w", err)
+		}
+
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
+// UpdateBroadcast removes the first processed recipients from the queue of the broadcast and stores its progress.
+// The lease is checked in a WATCH/MULTI transaction, so a worker which lost the lease doesn't change the queue.
+// Completed broadcasts are removed from the pending ones together with their queues and leases.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrBroadcastLeased if owner doesn't hold the lease, or an error if serialization or the save operation fails.
+func (r *BroadcastRepository) UpdateBroadcast(ctx context.Context, b *broadcast.Broadcast, owner string, processed int) error {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast: %w", err)
+	}
+
+	key := broadcastLeaseKey(b.ID)
+
+	err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+		if err := checkLease(ctx, tx, key, owner, false); err != nil {
+			return err
+		}
+
+		_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, broadcastsKey, b.ID, data)
+
+			if b.Completed() {
+				pipe.ZRem(ctx, broadcastsPendingKey, b.ID)
+				pipe.Del(ctx, broadcastQueueKey(b.ID), key)
+
+				return nil
+			}
+
+			if processed > 0 {
+				pipe.LTrim(ctx, broadcastQueueKey(b.ID), int64(processed), -1)
+			}
+
+			return nil
+		})
+
+		return err
+	}, key)
+
+	switch {
+	case errors.Is(err, core.ErrBroadcastLeased), errors.Is(err, redis.TxFailedErr):
+		return core.ErrBroadcastLeased
+	case err != nil:
+		return fmt.Errorf("failed to update broadcast: %w", err)
+	}
+
+	return nil
+}
+
+// checkLease returns core.ErrBroadcastLeased unless owner holds the lease stored at key,
+// a missing lease is accepted only if free is true.
+func checkLease(ctx context.Context, tx *redis.Tx, key, owner string, free bool) error {
+	holder, err := tx.Get(ctx, key).Result()
+
+	switch {
+	case err == redis.Nil && free:
+		return nil
+	case err == redis.Nil:
+		return core.ErrBroadcastLeased
+	case err != nil:
+		return err
+	case holder != owner:
+		return core.ErrBroadcastLeased
+	}
+
+	return nil
+}
+
+// broadcastLeaseKey returns the key holding the owner of the lease of the broadcast.
+func broadcastLeaseKey(id string) string {
+	return broadcastLeaseKeyPrefix + id
+}
+
+// broadcastQueueKey returns the key of the list with queued recipients of the broadcast.
+func broadcastQueueKey(id string) string {
+	return broadcastQueueKeyPrefix + id
+}
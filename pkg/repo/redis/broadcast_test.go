@@ -0,0 +1,339 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/broadcast"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBroadcast() *broadcast.Broadcast {
+	return &broadcast.Broadcast{
+		ID:        "b1",
+		Text:      "We are back online!",
+		Variants:  map[string]string{"es": "¡Volvemos!"},
+		CreatedAt: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBroadcastRepository_Chats(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewBroadcastRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectHSet(broadcastChatsKey, "123", "es").SetVal(1)
+	require.NoError(t, repo.SaveChat(ctx, broadcast.Chat{ID: "123", Language: "es"}))
+
+	mock.ExpectHDel(broadcastChatsKey, "123").SetVal(1)
+	require.NoError(t, repo.RemoveChat(ctx, "123"))
+
+	mock.ExpectHGetAll(broadcastChatsKey).SetVal(map[string]string{"1": "en", "2": "es", "3": "en", "4": ""})
+
+	counts, err := repo.CountChats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"en": 2, "es": 1, "": 1}, counts)
+
+	mock.ExpectHSet(broadcastChatsKey, "123", "es").SetErr(fmt.Errorf("connection refused"))
+	assert.ErrorContains(t, repo.SaveChat(ctx, broadcast.Chat{ID: "123", Language: "es"}), "failed to save chat")
+
+	mock.ExpectHDel(broadcastChatsKey, "123").SetErr(fmt.Errorf("connection refused"))
+	assert.ErrorContains(t, repo.RemoveChat(ctx, "123"), "failed to remove chat")
+
+	mock.ExpectHGetAll(broadcastChatsKey).SetErr(fmt.Errorf("connection refused"))
+	_, err = repo.CountChats(ctx)
+	assert.ErrorContains(t, err, "failed to get chats")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBroadcastRepository_CreateBroadcast(t *testing.T) {
+	tests := []struct {
+		mockErr error
+		name    string
+		wantErr bool
+	}{
+		{name: "success"},
+		{name: "redis error", mockErr: fmt.Errorf("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewBroadcastRepository(db)
+			b := testBroadcast()
+
+			expected := testBroadcast()
+			expected.Total = 1
+			data, _ := json.Marshal(expected)
+			recipient, _ := json.Marshal(broadcast.Chat{ID: "123", Language: "es"})
+
+			mock.ExpectHGetAll(broadcastChatsKey).SetVal(map[string]string{"123": "es"})
+			mock.ExpectTxPipeline()
+			mock.ExpectHSet(broadcastsKey, b.ID, data).SetVal(1)
+			mock.ExpectRPush(broadcastQueueKey(b.ID), recipient).SetVal(1)
+
+			if tt.mockErr != nil {
+				mock.ExpectZAdd(broadcastsPendingKey, redis.Z{Score: float64(b.CreatedAt.UnixNano()), Member: b.ID}).SetErr(tt.mockErr)
+			} else {
+				mock.ExpectZAdd(broadcastsPendingKey, redis.Z{Score: float64(b.CreatedAt.UnixNano()), Member: b.ID}).SetVal(1)
+			}
+
+			mock.ExpectTxPipelineExec()
+
+			err := repo.CreateBroadcast(context.Background(), b)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, b.Total)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBroadcastRepository_GetPendingBroadcast(t *testing.T) {
+	b := testBroadcast()
+	data, _ := json.Marshal(b)
+
+	tests := []struct {
+		setup   func(mock redismock.ClientMock)
+		want    *broadcast.Broadcast
+		wantErr error
+		name    string
+	}{
+		{
+			name: "pending broadcast",
+			setup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange(broadcastsPendingKey, 0, 0).SetVal([]string{b.ID})
+				mock.ExpectHGet(broadcastsKey, b.ID).SetVal(string(data))
+			},
+			want: b,
+		},
+		{
+			name: "no pending broadcasts",
+			setup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange(broadcastsPendingKey, 0, 0).SetVal(nil)
+			},
+			wantErr: core.ErrBroadcastNotFound,
+		},
+		{
+			name: "missing broadcast",
+			setup: func(mock redismock.ClientMock) {
+				mock.ExpectZRange(broadcastsPendingKey, 0, 0).SetVal([]string{b.ID})
+				mock.ExpectHGet(broadcastsKey, b.ID).RedisNil()
+			},
+			wantErr: core.ErrBroadcastNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewBroadcastRepository(db)
+			tt.setup(mock)
+
+			got, err := repo.GetPendingBroadcast(context.Background())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.ID, got.ID)
+			assert.Equal(t, tt.want.Variants, got.Variants)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBroadcastRepository_GetRecipients(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewBroadcastRepository(db)
+
+	mock.ExpectLRange(broadcastQueueKey("b1"), 0, 1).SetVal([]string{`{"id":"1","language":"en"}`, `{"id":"2"}`})
+
+	chats, err := repo.GetRecipients(context.Background(), "b1", 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, []broadcast.Chat{{ID: "1", Language: "en"}, {ID: "2"}}, chats)
+
+	mock.ExpectLRange(broadcastQueueKey("b1"), 0, 1).SetVal([]string{"invalid"})
+
+	_, err = repo.GetRecipients(context.Background(), "b1", 2)
+	assert.ErrorContains(t, err, "failed to unmarshal recipient")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBroadcastRepository_LeaseBroadcast(t *testing.T) {
+	lease := broadcastLeaseKey("b1")
+	data, _ := json.Marshal(testBroadcast())
+
+	tests := []struct {
+		setupMock  func(mock redismock.ClientMock)
+		wantErr    error
+		name       string
+		wantErrMsg string
+	}{
+		{
+			name: "free lease acquired",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(lease)
+				mock.ExpectGet(lease).RedisNil()
+				mock.ExpectHGet(broadcastsKey, "b1").SetVal(string(data))
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(lease, "w1", time.Minute).SetVal("OK")
+				mock.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name: "own lease renewed",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(lease)
+				mock.ExpectGet(lease).SetVal("w1")
+				mock.ExpectHGet(broadcastsKey, "b1").SetVal(string(data))
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(lease, "w1", time.Minute).SetVal("OK")
+				mock.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name: "leased by another worker",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(lease)
+				mock.ExpectGet(lease).SetVal("w2")
+			},
+			wantErr: core.ErrBroadcastLeased,
+		},
+		{
+			name: "broadcast not found",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(lease)
+				mock.ExpectGet(lease).RedisNil()
+				mock.ExpectHGet(broadcastsKey, "b1").RedisNil()
+			},
+			wantErr: core.ErrBroadcastNotFound,
+		},
+		{
+			name: "redis error",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(lease)
+				mock.ExpectGet(lease).SetErr(fmt.Errorf("connection refused"))
+			},
+			wantErrMsg: "failed to lease broadcast",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewBroadcastRepository(db)
+
+			tt.setupMock(mock)
+
+			b, err := repo.LeaseBroadcast(context.Background(), "b1", "w1", time.Minute)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErrMsg != "":
+				assert.ErrorContains(t, err, tt.wantErrMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, testBroadcast(), b)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBroadcastRepository_UpdateBroadcast(t *testing.T) {
+	lease := broadcastLeaseKey("b1")
+
+	t.Run("progress", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewBroadcastRepository(db)
+		b := testBroadcast()
+		b.Sent = 25
+		data, _ := json.Marshal(b)
+
+		mock.ExpectWatch(lease)
+		mock.ExpectGet(lease).SetVal("w1")
+		mock.ExpectTxPipeline()
+		mock.ExpectHSet(broadcastsKey, b.ID, data).SetVal(0)
+		mock.ExpectLTrim(broadcastQueueKey(b.ID), 25, -1).SetVal("OK")
+		mock.ExpectTxPipelineExec()
+
+		require.NoError(t, repo.UpdateBroadcast(context.Background(), b, "w1", 25))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewBroadcastRepository(db)
+		b := testBroadcast()
+		b.CompletedAt = b.CreatedAt.Add(time.Minute)
+		data, _ := json.Marshal(b)
+
+		mock.ExpectWatch(lease)
+		mock.ExpectGet(lease).SetVal("w1")
+		mock.ExpectTxPipeline()
+		mock.ExpectHSet(broadcastsKey, b.ID, data).SetVal(0)
+		mock.ExpectZRem(broadcastsPendingKey, b.ID).SetVal(1)
+		mock.ExpectDel(broadcastQueueKey(b.ID), lease).SetVal(2)
+		mock.ExpectTxPipelineExec()
+
+		require.NoError(t, repo.UpdateBroadcast(context.Background(), b, "w1", 0))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lease taken over by another worker", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewBroadcastRepository(db)
+
+		// The queue isn't trimmed, so recipients of the batch of the other worker aren't dropped
+		mock.ExpectWatch(lease)
+		mock.ExpectGet(lease).SetVal("w2")
+
+		assert.ErrorIs(t, repo.UpdateBroadcast(context.Background(), testBroadcast(), "w1", 25), core.ErrBroadcastLeased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lease expired", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewBroadcastRepository(db)
+
+		mock.ExpectWatch(lease)
+		mock.ExpectGet(lease).RedisNil()
+
+		assert.ErrorIs(t, repo.UpdateBroadcast(context.Background(), testBroadcast(), "w1", 25), core.ErrBroadcastLeased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("redis error", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewBroadcastRepository(db)
+		b := testBroadcast()
+		data, _ := json.Marshal(b)
+
+		mock.ExpectWatch(lease)
+		mock.ExpectGet(lease).SetVal("w1")
+		mock.ExpectTxPipeline()
+		mock.ExpectHSet(broadcastsKey, b.ID, data).SetErr(fmt.Errorf("connection refused"))
+
+		assert.ErrorContains(t, repo.UpdateBroadcast(context.Background(), b, "w1", 0), "failed to update broadcast")
+	})
+}
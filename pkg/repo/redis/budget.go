@@ -0,0 +1,130 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	userSpendKeyPrefix   = "budget:user:"
+	globalSpendKeyPrefix = "budget:global:"
+
+	// dailySpendTTL defines how long the daily spend counters are kept after the day is over
+	dailySpendTTL = 48 * time.Hour
+	// monthlySpendTTL defines how long the monthly spend counters are kept, it covers the longest month with a margin
+	monthlySpendTTL = 62 * 24 * time.Hour
+)
+
+// BudgetTracker implements core.BudgetTracker using Redis, so spend is shared between instances and survives restarts.
+// Spend is kept in USD in per-day and per-month counters of every user and of all users.
+type BudgetTracker struct {
+	client *redis.Client
+	config *budget.Config
+	now    func() time.Time
+}
+
+// NewBudgetTracker creates a new Redis backed BudgetTracker with the given client and configuration.
+// Returns a pointer to the initialized BudgetTracker.
+func NewBudgetTracker(client *redis.Client, cfg *budget.Config) *BudgetTracker {
+	return &BudgetTracker{
+		client: client,
+		config: cfg,
+		now:    time.Now,
+	}
+}
+
+// GetSpend returns the spend of the user and of all users for the current day and month.
+// Returns an error if Redis queries fail or stored values are invalid.
+func (b *BudgetTracker) GetSpend(ctx context.Context, userID string) (*budget.Spend, error) {
+	now := b.now()
+	day, month := budget.Day(now), budget.Month(now)
+
+	values, err := b.client.MGet(ctx,
+		b.userKey(userID, day),
+		b.userKey(userID, month),
+		b.globalKey(day),
+		b.globalKey(month),
+	).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend: %w", err)
+	}
+
+	spend := b.config.NewSpend()
+	targets := []*float64{&spend.UserDaily, &spend.UserMonthly, &spend.GlobalDaily, &spend.GlobalMonthly}
+
+	for i, value := range values {
+		// Counters of users without spend in the period don't exist
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		if *targets[i], err = strconv.ParseFloat(str, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse spend: %w", err)
+		}
+	}
+
+	return spend, nil
+}
+
+// RecordUsage prices the token usage and atomically adds it to the daily and monthly spend
+// of the user and of all users.
+// Returns an error if the Redis transaction fails.
+func (b *BudgetTracker) RecordUsage(ctx context.Context, userID string, usage []budget.Usage) error {
+	cost := b.config.Cost(usage...)
+	if cost == 0 {
+		return nil
+	}
+
+	now := b.now()
+	day, month := budget.Day(now), budget.Month(now)
+
+	_, err := b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, counter := range []struct {
+			key string
+			ttl time.Duration
+		}{
+			{key: b.userKey(userID, day), ttl: dailySpendTTL},
+			{key: b.userKey(userID, month), ttl: monthlySpendTTL},
+			{key: b.globalKey(day), ttl: dailySpendTTL},
+			{key: b.globalKey(month), ttl: monthlySpendTTL},
+		} {
+			pipe.IncrByFloat(ctx, counter.key, cost)
+			pipe.Expire(ctx, counter.key, counter.ttl)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record spend: %w", err)
+	}
+
+	return nil
+}
+
+// ResetUserSpend clears the spend of the user for the current day and month, the global spend is kept.
+// Returns an error if the Redis query fails.
+func (b *BudgetTracker) ResetUserSpend(ctx context.Context, userID string) error {
+	now := b.now()
+
+	if err := b.client.Del(ctx, b.userKey(userID, budget.Day(now)), b.userKey(userID, budget.Month(now))).Err(); err != nil {
+		return fmt.Errorf("failed to reset spend: %w", err)
+	}
+
+	return nil
+}
+
+// userKey generates a Redis key for the spend counter of the user within the day or month.
+func (b *BudgetTracker) userKey(userID, period string) string {
+	return userSpendKeyPrefix + userID + ":" + period
+}
+
+// globalKey generates a Redis key for the spend counter of all users within the day or month.
+func (b *BudgetTracker) globalKey(period string) string {
+	return globalSpendKeyPrefix + period
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/ksysoev/help-my-pet/pkg/core/budget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetTracker_GetSpend(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+	cfg := &budget.Config{GlobalDailyLimit: 20, UserMonthlyLimit: 2}
+	spendKeys := []string{"budget:user:user1:2025-03-10", "budget:user:user1:2025-03", "budget:global:2025-03-10", "budget:global:2025-03"}
+
+	tests := []struct {
+		mockSetup func(mock redismock.ClientMock)
+		want      *budget.Spend
+		name      string
+		wantErr   string
+	}{
+		{
+			name: "no spend yet",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectMGet(spendKeys...).SetVal([]interface{}{nil, nil, nil, nil})
+			},
+			want: &budget.Spend{GlobalDailyLimit: 20, UserMonthlyLimit: 2},
+		},
+		{
+			name: "existing spend",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectMGet(spendKeys...).SetVal([]interface{}{"0.25", "1.5", "12.75", "140"})
+			},
+			want: &budget.Spend{
+				UserDaily:        0.25,
+				UserMonthly:      1.5,
+				GlobalDaily:      12.75,
+				GlobalMonthly:    140,
+				GlobalDailyLimit: 20,
+				UserMonthlyLimit: 2,
+			},
+		},
+		{
+			name: "redis error",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectMGet(spendKeys...).SetErr(assert.AnError)
+			},
+			wantErr: "failed to get spend: " + assert.AnError.Error(),
+		},
+		{
+			name: "invalid value",
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectMGet(spendKeys...).SetVal([]interface{}{"lots", nil, nil, nil})
+			},
+			wantErr: `failed to parse spend: strconv.ParseFloat: parsing "lots": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			tt.mockSetup(mock)
+
+			tracker := NewBudgetTracker(db, cfg)
+			tracker.now = func() time.Time { return now }
+
+			spend, err := tracker.GetSpend(context.Background(), "user1")
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, spend)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBudgetTracker_RecordUsage(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+	cfg := &budget.Config{Prices: []budget.Price{{Model: "sonnet", Input: 3, Output: 15}}}
+
+	db, mock := redismock.NewClientMock()
+
+	mock.ExpectTxPipeline()
+	mock.ExpectIncrByFloat("budget:user:user1:2025-03-10", 1.5).SetVal(1.5)
+	mock.ExpectExpire("budget:user:user1:2025-03-10", dailySpendTTL).SetVal(true)
+	mock.ExpectIncrByFloat("budget:user:user1:2025-03", 1.5).SetVal(1.5)
+	mock.ExpectExpire("budget:user:user1:2025-03", monthlySpendTTL).SetVal(true)
+	mock.ExpectIncrByFloat("budget:global:2025-03-10", 1.5).SetVal(1.5)
+	mock.ExpectExpire("budget:global:2025-03-10", dailySpendTTL).SetVal(true)
+	mock.ExpectIncrByFloat("budget:global:2025-03", 1.5).SetVal(1.5)
+	mock.ExpectExpire("budget:global:2025-03", monthlySpendTTL).SetVal(true)
+	mock.ExpectTxPipelineExec()
+
+	tracker := NewBudgetTracker(db, cfg)
+	tracker.now = func() time.Time { return now }
+
+	require.NoError(t, tracker.RecordUsage(context.Background(), "user1", []budget.Usage{{Model: "sonnet", OutputTokens: 100_000}}))
+
+	// Usage without a price is not recorded
+	require.NoError(t, tracker.RecordUsage(context.Background(), "user1", []budget.Usage{{Model: "unknown", OutputTokens: 100_000}}))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBudgetTracker_ResetUserSpend(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	db, mock := redismock.NewClientMock()
+	tracker := NewBudgetTracker(db, &budget.Config{})
+	tracker.now = func() time.Time { return now }
+
+	mock.ExpectDel("budget:user:user1:2025-03-10", "budget:user:user1:2025-03").SetVal(2)
+	require.NoError(t, tracker.ResetUserSpend(context.Background(), "user1"))
+
+	mock.ExpectDel("budget:user:user1:2025-03-10", "budget:user:user1:2025-03").SetErr(assert.AnError)
+	assert.ErrorIs(t, tracker.ResetUserSpend(context.Background(), "user1"), assert.AnError)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
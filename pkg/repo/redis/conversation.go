@@ -3,7 +3,9 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
@@ -14,7 +16,10 @@ import (
 
 const (
 	// ConversationTTL defines how long conversations are stored (1 week)
-	ConversationTTL = 7 * 24 * time.Hour
+	ConversationTTL = conversation.TTL
+
+	conversationKeyPrefix = "conversation:"
+	scanBatchSize         = 100
 )
 
 // ConversationRepository implements core.ConversationRepository using Redis
@@ -31,16 +36,52 @@ func NewConversationRepository(client *redis.Client) *ConversationRepository {
 	}
 }
 
-// Save serializes the given conversation and saves it to Redis under a key derived from its ID.
-// It overwrites any existing data for the same key and sets a time-to-live based on ConversationTTL.
-// Returns an error if serialization fails or if the Redis operation encounters an issue.
+// Save serializes the given conversation and saves it to Redis under a key derived from its ID with the next version.
+// The stored version is compared with the version of the conversation in a WATCH/MULTI transaction,
+// so the conversation isn't overwritten if another request saved it since it was loaded.
+// It sets a time-to-live based on ConversationTTL.
+// Returns core.ErrConversationConflict if the stored version differs,
+// or an error if serialization fails or if the Redis operation encounters an issue.
 func (r *ConversationRepository) Save(ctx context.Context, conversation core.Conversation) error {
+	key := r.key(conversation.GetID())
+	version := conversation.GetVersion()
+
+	conversation.SetVersion(version + 1)
+
 	data, err := json.Marshal(conversation)
 	if err != nil {
+		conversation.SetVersion(version)
 		return err
 	}
 
-	return r.client.Set(ctx, r.key(conversation.GetID()), data, ConversationTTL).Err()
+	err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+		stored, err := storedVersion(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+
+		if stored != version {
+			return core.ErrConversationConflict
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, ConversationTTL)
+			return nil
+		})
+
+		return err
+	}, key)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, core.ErrConversationConflict), errors.Is(err, redis.TxFailedErr):
+		conversation.SetVersion(version)
+		return core.ErrConversationConflict
+	default:
+		conversation.SetVersion(version)
+		return fmt.Errorf("failed to save conversation: %w", err)
+	}
 }
 
 // FindByID retrieves a conversation from Redis by its ID.
@@ -90,9 +131,63 @@ func (r *ConversationRepository) Delete(ctx context.Context, id string) error {
 	return r.client.Del(ctx, r.key(id)).Err()
 }
 
+// ForEach calls fn with the ID, the serialized data and the remaining TTL of every stored conversation,
+// the TTL is negative if the conversation doesn't expire. Conversations expiring during the scan are skipped.
+// It is used to copy conversations to another storage.
+// Returns the error of fn, stopping the iteration, or an error if reading from Redis fails.
+func (r *ConversationRepository) ForEach(ctx context.Context, fn func(id string, data []byte, ttl time.Duration) error) error {
+	iter := r.client.Scan(ctx, 0, conversationKeyPrefix+"*", scanBatchSize).Iterator()
+
+	for iter.Next(ctx) {
+		key := iter.Val()
+
+		data, err := r.client.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get conversation: %w", err)
+		}
+
+		ttl, err := r.client.PTTL(ctx, key).Result()
+		if err != nil {
+			return fmt.Errorf("failed to get conversation ttl: %w", err)
+		}
+
+		if err := fn(strings.TrimPrefix(key, conversationKeyPrefix), data, ttl); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan conversations: %w", err)
+	}
+
+	return nil
+}
+
+// storedVersion returns the version of the conversation stored under the key, or 0 if there is none.
+func storedVersion(ctx context.Context, tx *redis.Tx, key string) (int64, error) {
+	data, err := tx.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get conversation: %w", err)
+	}
+
+	var stored struct {
+		Version int64
+	}
+
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal conversation: %w", err)
+	}
+
+	return stored.Version, nil
+}
+
 // key generates a Redis key for a conversation by prefixing the provided conversation ID with "conversation:".
 // Accepts id as the unique identifier for the conversation.
 // Returns the fully constructed Redis key as a string.
 func (r *ConversationRepository) key(id string) string {
-	return "conversation:" + id
+	return conversationKeyPrefix + id
 }
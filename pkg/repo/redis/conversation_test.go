@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-redis/redismock/v9"
 	"github.com/ksysoev/help-my-pet/pkg/core/conversation"
 	"github.com/ksysoev/help-my-pet/pkg/core/message"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,23 +18,113 @@ import (
 )
 
 func TestConversationRepository_Save(t *testing.T) {
-	db, mock := redismock.NewClientMock()
-	repo := NewConversationRepository(db)
 	ctx := context.Background()
 
-	t.Run("save valid conversation", func(t *testing.T) {
+	// newConversation creates a conversation with the version loaded from Redis and its data saved with the next version
+	newConversation := func(t *testing.T, version int64) (*conversation.Conversation, []byte) {
 		conv := conversation.NewConversation("test-id")
 		conv.AddMessage("user", "hello")
+		conv.Version = version + 1
 
 		data, err := json.Marshal(conv)
 		require.NoError(t, err)
 
+		conv.Version = version
+
+		return conv, data
+	}
+
+	t.Run("save new conversation", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, data := newConversation(t, 0)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").RedisNil()
+		mock.ExpectTxPipeline()
+		mock.ExpectSet("conversation:test-id", data, ConversationTTL).SetVal("OK")
+		mock.ExpectTxPipelineExec()
+
+		err := repo.Save(ctx, conv)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), conv.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("save loaded conversation", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, data := newConversation(t, 3)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").SetVal(`{"ID":"test-id","Version":3}`)
+		mock.ExpectTxPipeline()
 		mock.ExpectSet("conversation:test-id", data, ConversationTTL).SetVal("OK")
+		mock.ExpectTxPipelineExec()
 
-		err = repo.Save(ctx, conv)
+		err := repo.Save(ctx, conv)
 		assert.NoError(t, err)
+		assert.Equal(t, int64(4), conv.Version)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("conversation saved by another request", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, _ := newConversation(t, 3)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").SetVal(`{"ID":"test-id","Version":4}`)
+
+		err := repo.Save(ctx, conv)
+		assert.ErrorIs(t, err, core.ErrConversationConflict)
+		assert.Equal(t, int64(3), conv.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("conversation deleted by another request", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, _ := newConversation(t, 3)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").RedisNil()
+
+		err := repo.Save(ctx, conv)
+		assert.ErrorIs(t, err, core.ErrConversationConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("conversation changed during transaction", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, data := newConversation(t, 0)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").RedisNil()
+		mock.ExpectTxPipeline()
+		mock.ExpectSet("conversation:test-id", data, ConversationTTL).SetVal("OK")
+		mock.ExpectTxPipelineExec().SetErr(redis.TxFailedErr)
+
+		err := repo.Save(ctx, conv)
+		assert.ErrorIs(t, err, core.ErrConversationConflict)
+		assert.Equal(t, int64(0), conv.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("redis error", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+		conv, _ := newConversation(t, 0)
+
+		mock.ExpectWatch("conversation:test-id")
+		mock.ExpectGet("conversation:test-id").SetErr(assert.AnError)
+
+		err := repo.Save(ctx, conv)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NotErrorIs(t, err, core.ErrConversationConflict)
+		assert.Equal(t, int64(0), conv.Version)
+	})
 }
 
 func TestConversationRepository_FindByID(t *testing.T) {
@@ -91,10 +183,18 @@ func TestConversationRepository_ComplexConversation(t *testing.T) {
 
 	require.NoError(t, err)
 
+	conv.Version = 1
+
 	data, err := json.Marshal(conv)
 	require.NoError(t, err)
 
+	conv.Version = 0
+
+	mock.ExpectWatch("conversation:test-id")
+	mock.ExpectGet("conversation:test-id").RedisNil()
+	mock.ExpectTxPipeline()
 	mock.ExpectSet("conversation:test-id", data, ConversationTTL).SetVal("OK")
+	mock.ExpectTxPipelineExec()
 	require.NoError(t, repo.Save(ctx, conv))
 
 	mock.ExpectGet("conversation:test-id").SetVal(string(data))
@@ -103,6 +203,7 @@ func TestConversationRepository_ComplexConversation(t *testing.T) {
 	assert.NotNil(t, found)
 	assert.Equal(t, conv.ID, found.GetID())
 	assert.Equal(t, conv.GetState(), found.GetState())
+	assert.Equal(t, int64(1), found.GetVersion())
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -205,3 +306,56 @@ func TestConversationRepository_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestConversationRepository_ForEach(t *testing.T) {
+	t.Run("all conversations", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+
+		mock.ExpectScan(0, "conversation:*", scanBatchSize).SetVal([]string{"conversation:chat1", "conversation:chat2", "conversation:chat3"}, 0)
+		mock.ExpectGet("conversation:chat1").SetVal(`{"id":"chat1"}`)
+		mock.ExpectPTTL("conversation:chat1").SetVal(time.Hour)
+		mock.ExpectGet("conversation:chat2").RedisNil()
+		mock.ExpectGet("conversation:chat3").SetVal(`{"id":"chat3"}`)
+		mock.ExpectPTTL("conversation:chat3").SetVal(-1)
+
+		type stored struct {
+			data string
+			ttl  time.Duration
+		}
+
+		got := make(map[string]stored)
+
+		err := repo.ForEach(context.Background(), func(id string, data []byte, ttl time.Duration) error {
+			got[id] = stored{data: string(data), ttl: ttl}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]stored{
+			"chat1": {data: `{"id":"chat1"}`, ttl: time.Hour},
+			"chat3": {data: `{"id":"chat3"}`, ttl: -1},
+		}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get fails", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+
+		mock.ExpectScan(0, "conversation:*", scanBatchSize).SetVal([]string{"conversation:chat1"}, 0)
+		mock.ExpectGet("conversation:chat1").SetErr(assert.AnError)
+
+		err := repo.ForEach(context.Background(), func(string, []byte, time.Duration) error { return nil })
+		assert.EqualError(t, err, "failed to get conversation: "+assert.AnError.Error())
+	})
+
+	t.Run("scan fails", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		repo := NewConversationRepository(db)
+
+		mock.ExpectScan(0, "conversation:*", scanBatchSize).SetErr(assert.AnError)
+
+		err := repo.ForEach(context.Background(), func(string, []byte, time.Duration) error { return nil })
+		assert.EqualError(t, err, "failed to scan conversations: "+assert.AnError.Error())
+	})
+}
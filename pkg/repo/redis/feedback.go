@@ -0,0 +1,219 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	feedbackKey             = "feedback"
+	feedbackAnswerKeyPrefix = "feedback:answer:"
+	feedbackReasonKeyPrefix = "feedback:reason:"
+)
+
+// FeedbackRepository implements core.FeedbackRepository using Redis.
+// Answers are stored as JSON in keys expiring after feedback.AnswerTTL, rated answers are also stored in a hash
+// without expiration, so they are kept for quality reviews.
+type FeedbackRepository struct {
+	client *redis.Client
+}
+
+// NewFeedbackRepository creates a new instance of FeedbackRepository with the provided Redis client.
+// client Redis client used for database operations.
+// Returns a pointer to the FeedbackRepository instance.
+func NewFeedbackRepository(client *redis.Client) *FeedbackRepository {
+	return &FeedbackRepository{
+		client: client,
+	}
+}
+
+// SaveAnswer stores the unrated answer, it can be rated until it expires.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if serialization or the save operation fails.
+func (r *FeedbackRepository) SaveAnswer(ctx context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal answer: %w", err)
+	}
+
+	if err := r.client.Set(ctx, feedbackAnswerKey(f.ID), data, feedback.AnswerTTL).Err(); err != nil {
+		return fmt.Errorf("failed to save answer: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnswer retrieves the answer with the ID.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrAnswerNotFound if the answer doesn't exist or has expired, or an error if retrieval or unmarshaling fails.
+func (r *FeedbackRepository) GetAnswer(ctx context.Context, id string) (*feedback.Feedback, error) {
+	data, err := r.client.Get(ctx, feedbackAnswerKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, core.ErrAnswerNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get answer: %w", err)
+	}
+
+	var f feedback.Feedback
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal answer: %w", err)
+	}
+
+	return &f, nil
+}
+
+// SaveFeedback stores the rated answer permanently and updates the answer, keeping its expiration.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if serialization or the save operation fails.
+func (r *FeedbackRepository) SaveFeedback(ctx context.Context, f *feedback.Feedback) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal feedback: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, feedbackKey, f.ID, data)
+		pipe.SetArgs(ctx, feedbackAnswerKey(f.ID), data, redis.SetArgs{KeepTTL: true})
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return nil
+}
+
+// SetPendingReason remembers the answer the user is asked to give the reason of the rating for,
+// the reason is awaited for feedback.ReasonTTL.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns an error if the save operation fails.
+func (r *FeedbackRepository) SetPendingReason(ctx context.Context, userID, answerID string) error {
+	if err := r.client.Set(ctx, feedbackReasonKey(userID), answerID, feedback.ReasonTTL).Err(); err != nil {
+		return fmt.Errorf("failed to save pending reason: %w", err)
+	}
+
+	return nil
+}
+
+// PopPendingReason retrieves and removes the answer waiting for the reason of the user.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrAnswerNotFound if no reason is awaited from the user, or an error if retrieval fails.
+func (r *FeedbackRepository) PopPendingReason(ctx context.Context, userID string) (string, error) {
+	answerID, err := r.client.GetDel(ctx, feedbackReasonKey(userID)).Result()
+	if err == redis.Nil {
+		return "", core.ErrAnswerNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get pending reason: %w", err)
+	}
+
+	return answerID, nil
+}
+
+// ListFeedback retrieves all rated answers ordered by the time they were rated.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns the rated answers, or an error if retrieval or unmarshaling fails.
+func (r *FeedbackRepository) ListFeedback(ctx context.Context) ([]*feedback.Feedback, error) {
+	values, err := r.client.HGetAll(ctx, feedbackKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	records := make([]*feedback.Feedback, 0, len(values))
+
+	for _, v := range values {
+		var f feedback.Feedback
+		if err := json.Unmarshal([]byte(v), &f); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal feedback: %w", err)
+		}
+
+		records = append(records, &f)
+	}
+
+	slices.SortFunc(records, func(a, b *feedback.Feedback) int {
+		return a.RatedAt.Compare(b.RatedAt)
+	})
+
+	return records, nil
+}
+
+// ForEachAnswer calls fn with every stored answer and its remaining TTL, the TTL is negative if the answer doesn't expire.
+// Answers expiring during the scan are skipped. It is used to copy answers to another storage.
+// Returns the error of fn, stopping the iteration, or an error if reading from Redis or unmarshaling fails.
+func (r *FeedbackRepository) ForEachAnswer(ctx context.Context, fn func(f *feedback.Feedback, ttl time.Duration) error) error {
+	iter := r.client.Scan(ctx, 0, feedbackAnswerKeyPrefix+"*", scanBatchSize).Iterator()
+
+	for iter.Next(ctx) {
+		f, err := r.GetAnswer(ctx, strings.TrimPrefix(iter.Val(), feedbackAnswerKeyPrefix))
+		if err == core.ErrAnswerNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		ttl, err := r.client.PTTL(ctx, iter.Val()).Result()
+		if err != nil {
+			return fmt.Errorf("failed to get answer ttl: %w", err)
+		}
+
+		if err := fn(f, ttl); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan answers: %w", err)
+	}
+
+	return nil
+}
+
+// ForEachFeedback calls fn with every rated answer, including the ones which can't be rated anymore.
+// It is used to copy feedback to another storage.
+// Returns the error of fn, stopping the iteration, or an error if reading from Redis or unmarshaling fails.
+func (r *FeedbackRepository) ForEachFeedback(ctx context.Context, fn func(f *feedback.Feedback) error) error {
+	iter := r.client.HScan(ctx, feedbackKey, 0, "", scanBatchSize).Iterator()
+
+	for iter.Next(ctx) {
+		if !iter.Next(ctx) {
+			break
+		}
+
+		var f feedback.Feedback
+		if err := json.Unmarshal([]byte(iter.Val()), &f); err != nil {
+			return fmt.Errorf("failed to unmarshal feedback: %w", err)
+		}
+
+		if err := fn(&f); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan feedback: %w", err)
+	}
+
+	return nil
+}
+
+// feedbackAnswerKey returns the key of the answer with the ID.
+func feedbackAnswerKey(id string) string {
+	return feedbackAnswerKeyPrefix + id
+}
+
+// feedbackReasonKey returns the key of the answer waiting for the reason of the user.
+func feedbackReasonKey(userID string) string {
+	return feedbackReasonKeyPrefix + userID
+}
@@ -0,0 +1,203 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/feedback"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFeedback() *feedback.Feedback {
+	return &feedback.Feedback{
+		ID:        "a1",
+		UserID:    "user1",
+		ChatID:    "chat1",
+		Question:  "Is chocolate toxic for dogs?",
+		Answer:    "Yes, it is.",
+		Model:     "sonnet",
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFeedbackRepository_Answers(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewFeedbackRepository(db)
+	ctx := context.Background()
+
+	f := testFeedback()
+	data, _ := json.Marshal(f)
+
+	mock.ExpectSet(feedbackAnswerKey("a1"), data, feedback.AnswerTTL).SetVal("OK")
+	require.NoError(t, repo.SaveAnswer(ctx, f))
+
+	mock.ExpectGet(feedbackAnswerKey("a1")).SetVal(string(data))
+
+	got, err := repo.GetAnswer(ctx, "a1")
+	require.NoError(t, err)
+	assert.Equal(t, f, got)
+
+	mock.ExpectGet(feedbackAnswerKey("a2")).RedisNil()
+
+	_, err = repo.GetAnswer(ctx, "a2")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound)
+
+	mock.ExpectGet(feedbackAnswerKey("a3")).SetVal("invalid")
+
+	_, err = repo.GetAnswer(ctx, "a3")
+	assert.ErrorContains(t, err, "failed to unmarshal answer")
+
+	mock.ExpectSet(feedbackAnswerKey("a1"), data, feedback.AnswerTTL).SetErr(fmt.Errorf("connection refused"))
+	assert.ErrorContains(t, repo.SaveAnswer(ctx, f), "failed to save answer")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedbackRepository_SaveFeedback(t *testing.T) {
+	tests := []struct {
+		mockErr error
+		name    string
+	}{
+		{name: "success"},
+		{name: "redis error", mockErr: fmt.Errorf("connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewFeedbackRepository(db)
+
+			f := testFeedback()
+			f.Rate(feedback.RatingDown, f.CreatedAt.Add(time.Minute))
+			data, _ := json.Marshal(f)
+
+			mock.ExpectTxPipeline()
+			mock.ExpectHSet(feedbackKey, f.ID, data).SetVal(1)
+
+			if tt.mockErr != nil {
+				mock.ExpectSetArgs(feedbackAnswerKey(f.ID), data, redis.SetArgs{KeepTTL: true}).SetErr(tt.mockErr)
+			} else {
+				mock.ExpectSetArgs(feedbackAnswerKey(f.ID), data, redis.SetArgs{KeepTTL: true}).SetVal("OK")
+			}
+
+			mock.ExpectTxPipelineExec()
+
+			err := repo.SaveFeedback(context.Background(), f)
+
+			if tt.mockErr != nil {
+				assert.ErrorContains(t, err, "failed to save feedback")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFeedbackRepository_PendingReason(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewFeedbackRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectSet(feedbackReasonKey("user1"), "a1", feedback.ReasonTTL).SetVal("OK")
+	require.NoError(t, repo.SetPendingReason(ctx, "user1", "a1"))
+
+	mock.ExpectGetDel(feedbackReasonKey("user1")).SetVal("a1")
+
+	answerID, err := repo.PopPendingReason(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "a1", answerID)
+
+	mock.ExpectGetDel(feedbackReasonKey("user1")).RedisNil()
+
+	_, err = repo.PopPendingReason(ctx, "user1")
+	assert.ErrorIs(t, err, core.ErrAnswerNotFound)
+
+	mock.ExpectGetDel(feedbackReasonKey("user1")).SetErr(fmt.Errorf("connection refused"))
+
+	_, err = repo.PopPendingReason(ctx, "user1")
+	assert.ErrorContains(t, err, "failed to get pending reason")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedbackRepository_ListFeedback(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewFeedbackRepository(db)
+
+	first := testFeedback()
+	first.Rate(feedback.RatingUp, first.CreatedAt.Add(time.Minute))
+
+	second := testFeedback()
+	second.ID = "a2"
+	second.Rate(feedback.RatingDown, first.CreatedAt.Add(time.Hour))
+
+	firstData, _ := json.Marshal(first)
+	secondData, _ := json.Marshal(second)
+
+	mock.ExpectHGetAll(feedbackKey).SetVal(map[string]string{"a2": string(secondData), "a1": string(firstData)})
+
+	records, err := repo.ListFeedback(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*feedback.Feedback{first, second}, records)
+
+	mock.ExpectHGetAll(feedbackKey).SetVal(map[string]string{"a1": "invalid"})
+
+	_, err = repo.ListFeedback(context.Background())
+	assert.ErrorContains(t, err, "failed to unmarshal feedback")
+
+	mock.ExpectHGetAll(feedbackKey).SetErr(fmt.Errorf("connection refused"))
+
+	_, err = repo.ListFeedback(context.Background())
+	assert.ErrorContains(t, err, "failed to get feedback")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFeedbackRepository_ForEach(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := NewFeedbackRepository(client)
+	ctx := context.Background()
+
+	rated := testFeedback()
+	rated.Rate(feedback.RatingUp, rated.CreatedAt.Add(time.Minute))
+	data, _ := json.Marshal(rated)
+
+	mock.ExpectHScan(feedbackKey, 0, "", scanBatchSize).SetVal([]string{"a1", string(data)}, 0)
+
+	var ids []string
+
+	err := repo.ForEachFeedback(ctx, func(f *feedback.Feedback) error {
+		ids = append(ids, f.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1"}, ids)
+
+	mock.ExpectScan(0, feedbackAnswerKeyPrefix+"*", scanBatchSize).SetVal([]string{feedbackAnswerKey("a1"), feedbackAnswerKey("expired")}, 0)
+	mock.ExpectGet(feedbackAnswerKey("a1")).SetVal(string(data))
+	mock.ExpectPTTL(feedbackAnswerKey("a1")).SetVal(time.Hour)
+	mock.ExpectGet(feedbackAnswerKey("expired")).RedisNil()
+
+	ttls := make(map[string]time.Duration)
+
+	err = repo.ForEachAnswer(ctx, func(f *feedback.Feedback, ttl time.Duration) error {
+		ttls[f.ID] = ttl
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"a1": time.Hour}, ttls)
+
+	mock.ExpectHScan(feedbackKey, 0, "", scanBatchSize).SetErr(assert.AnError)
+	assert.ErrorContains(t, repo.ForEachFeedback(ctx, func(*feedback.Feedback) error { return nil }), "failed to scan feedback")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,16 +3,32 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ksysoev/help-my-pet/pkg/core"
 	"github.com/ksysoev/help-my-pet/pkg/core/pet"
 	"github.com/redis/go-redis/v9"
 )
 
-const petProfilesKey = "pet_profiles"
+const (
+	petProfilesKeyPrefix = "pet_profiles:"
 
-// PetProfileRepository implements core.PetProfileRepository using Redis
+	// legacyPetProfilesKey is the hash profiles of all users were kept in before they got a key per user,
+	// its entries are moved to the keys of their users when the profiles are updated
+	legacyPetProfilesKey = "pet_profiles"
+
+	// profileUpdateAttempts defines how many times an update of pet profiles is retried when the profiles of the user
+	// are changed concurrently
+	profileUpdateAttempts = 5
+)
+
+// PetProfileRepository implements core.PetProfileRepository using Redis.
+// Profiles of every user are stored as JSON under their own key and are changed in WATCH/MULTI transactions
+// watching only that key, so concurrent changes of the same user are not lost and changes of other users don't interfere.
+// Profiles stored in the legacy hash of all users are read until they are migrated to the key of the user
+// by the next update.
 type PetProfileRepository struct {
 	client *redis.Client
 }
@@ -28,62 +44,264 @@ func NewPetProfileRepository(client *redis.Client) *PetProfileRepository {
 }
 
 // SaveProfile stores a pet profile for a specified user in the database.
-// It serializes the pet profile data and saves it under the user's ID in Redis.
+// It replaces the user's active pet profile, or adds the profile as the first pet if the user has none.
 // ctx is the context for the operation, allowing cancellation and timeouts.
 // userID is the unique identifier for the user owning the pet profile.
 // profile is the pet profile information to be saved.
 // Returns an error if data serialization fails or if the save operation encounters an issue.
 func (r *PetProfileRepository) SaveProfile(ctx context.Context, userID string, profile *pet.Profile) error {
-	allProfiles := pet.Profiles{Profiles: []pet.Profile{*profile}}
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		profiles.ReplaceCurrent(*profile)
+		return nil
+	})
+}
+
+// AddProfile adds a new pet profile for a specified user and makes it the active one.
+// If the user already has a pet with the same name, that pet's profile is replaced keeping its medical history.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// userID is the unique identifier for the user owning the pet profile.
+// profile is the pet profile information to be added.
+// Returns an error if data serialization fails or if the save operation encounters an issue.
+func (r *PetProfileRepository) AddProfile(ctx context.Context, userID string, profile *pet.Profile) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		profiles.Add(*profile)
+		return nil
+	})
+}
 
-	data, err := json.Marshal(allProfiles)
+// GetProfiles retrieves all pet profiles associated with a given user together with the active pet index.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// userID is the unique identifier for the user whose pet profiles are being retrieved.
+// Returns core.ErrProfileNotFound if the user has no pets, or an error if data retrieval or unmarshaling fails.
+func (r *PetProfileRepository) GetProfiles(ctx context.Context, userID string) (*pet.Profiles, error) {
+	profiles, err := loadProfiles(ctx, r.client, userID)
 	if err != nil {
-		return fmt.Errorf("failed to marshal pet profiles: %w", err)
+		return nil, err
 	}
 
-	if err := r.client.HSet(ctx, petProfilesKey, userID, data).Err(); err != nil {
-		return fmt.Errorf("failed to save pet profiles: %w", err)
+	if len(profiles.Profiles) == 0 {
+		return nil, core.ErrProfileNotFound
 	}
 
-	return nil
+	return profiles, nil
 }
 
-// GetCurrentProfile retrieves the most recent pet profile associated with a given user from the database.
+// GetCurrentProfile retrieves the active pet profile associated with a given user from the database.
 // It fetches and deserializes the profile data stored under the specified userID in Redis.
 // ctx is the context for the operation, supporting cancellation and timeouts.
 // userID is the unique identifier for the user whose pet profile is being retrieved.
-// Returns the first pet.Profile if profiles exist or core.ErrProfileNotFound if no profiles are available.
+// Returns the active pet.Profile if profiles exist or core.ErrProfileNotFound if no profiles are available.
 // Returns an error if data retrieval or unmarshaling fails.
 func (r *PetProfileRepository) GetCurrentProfile(ctx context.Context, userID string) (*pet.Profile, error) {
-	data, err := r.client.HGet(ctx, petProfilesKey, userID).Bytes()
-	if err == redis.Nil {
-		return nil, core.ErrProfileNotFound
-	}
+	profiles, err := r.GetProfiles(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pet profiles: %w", err)
+		return nil, err
 	}
 
-	var profiles pet.Profiles
-	if err := json.Unmarshal(data, &profiles); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal pet profiles: %w", err)
-	}
+	return profiles.Current(), nil
+}
 
-	if len(profiles.Profiles) == 0 {
-		return nil, core.ErrProfileNotFound
-	}
+// SetActiveProfile makes the pet with the given name the active one for the specified user.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// userID is the unique identifier for the user owning the pets; name is the name of the pet to select.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) SetActiveProfile(ctx context.Context, userID, name string) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Select(name) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// UpdateProfile applies fn to the profile of the pet with the given name for the specified user,
+// the active pet is not changed.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// userID is the unique identifier for the user owning the pets; name is the name of the pet to update.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) UpdateProfile(ctx context.Context, userID, name string, fn func(profile *pet.Profile)) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Update(name, fn) {
+			return core.ErrProfileNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveProfile deletes the pet with the given name from the specified user's profiles.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// userID is the unique identifier for the user owning the pets; name is the name of the pet to remove.
+// Returns core.ErrProfileNotFound if the user has no pet with such name, or an error if the update fails.
+func (r *PetProfileRepository) RemoveProfile(ctx context.Context, userID, name string) error {
+	return r.update(ctx, userID, func(profiles *pet.Profiles) error {
+		if !profiles.Remove(name) {
+			return core.ErrProfileNotFound
+		}
 
-	return &profiles.Profiles[0], nil
+		return nil
+	})
 }
 
 // RemoveUserProfiles deletes all pet profiles associated with a specific user ID from the database.
-// It removes the entry identified by userID from the Redis hash key storing pet profile data.
+// It removes the key of the user together with the entry of the user in the legacy hash.
 // ctx is the context for the operation, supporting cancellation and timeouts.
 // userID is the unique identifier for the user whose profiles should be removed.
 // Returns an error if the delete operation encounters an issue.
 func (r *PetProfileRepository) RemoveUserProfiles(ctx context.Context, userID string) error {
-	if err := r.client.HDel(ctx, petProfilesKey, userID).Err(); err != nil {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, petProfilesKey(userID))
+		pipe.HDel(ctx, legacyPetProfilesKey, userID)
+
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed to remove pet profiles: %w", err)
 	}
 
 	return nil
 }
+
+// ForEach calls fn with the ID of every user having pet profiles and their profiles serialized as JSON.
+// Profiles of the legacy hash are included unless the user already has profiles under their own key.
+// It is used to copy profiles to another storage.
+// Returns the error of fn, stopping the iteration, or an error if reading from Redis fails.
+func (r *PetProfileRepository) ForEach(ctx context.Context, fn func(userID string, data []byte) error) error {
+	iter := r.client.Scan(ctx, 0, petProfilesKeyPrefix+"*", scanBatchSize).Iterator()
+
+	for iter.Next(ctx) {
+		key := iter.Val()
+
+		data, err := r.client.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get pet profiles: %w", err)
+		}
+
+		if err := fn(strings.TrimPrefix(key, petProfilesKeyPrefix), data); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan pet profiles: %w", err)
+	}
+
+	legacy := r.client.HScan(ctx, legacyPetProfilesKey, 0, "", scanBatchSize).Iterator()
+
+	for legacy.Next(ctx) {
+		userID := legacy.Val()
+
+		if !legacy.Next(ctx) {
+			break
+		}
+
+		migrated, err := r.client.Exists(ctx, petProfilesKey(userID)).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check pet profiles: %w", err)
+		}
+
+		if migrated > 0 {
+			continue
+		}
+
+		if err := fn(userID, []byte(legacy.Val())); err != nil {
+			return err
+		}
+	}
+
+	if err := legacy.Err(); err != nil {
+		return fmt.Errorf("failed to scan legacy pet profiles: %w", err)
+	}
+
+	return nil
+}
+
+// update applies fn to the pet profiles of the user and saves them under the key of the user in a WATCH/MULTI transaction,
+// the profiles are removed if none are left. The update is retried if the key is changed concurrently.
+// The entry of the user in the legacy hash is removed in the same transaction, so the profiles are migrated
+// to the key of the user.
+// Returns the error of fn, or an error if retrieval, serialization or the save operation fails.
+func (r *PetProfileRepository) update(ctx context.Context, userID string, fn func(profiles *pet.Profiles) error) error {
+	key := petProfilesKey(userID)
+
+	var err error
+
+	for range profileUpdateAttempts {
+		err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+			profiles, err := loadProfiles(ctx, tx, userID)
+			if err != nil {
+				return err
+			}
+
+			if err := fn(profiles); err != nil {
+				return err
+			}
+
+			var data []byte
+
+			if len(profiles.Profiles) > 0 {
+				if data, err = json.Marshal(profiles); err != nil {
+					return fmt.Errorf("failed to marshal pet profiles: %w", err)
+				}
+			}
+
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				if data == nil {
+					pipe.Del(ctx, key)
+				} else {
+					pipe.Set(ctx, key, data, 0)
+				}
+
+				pipe.HDel(ctx, legacyPetProfilesKey, userID)
+
+				return nil
+			})
+
+			return err
+		}, key)
+
+		if !errors.Is(err, redis.TxFailedErr) {
+			break
+		}
+	}
+
+	switch {
+	case err == nil, errors.Is(err, core.ErrProfileNotFound):
+		return err
+	default:
+		return fmt.Errorf("failed to save pet profiles: %w", err)
+	}
+}
+
+// loadProfiles fetches and deserializes all pet profiles of the specified user,
+// the legacy hash is read if the user has no profiles under their own key.
+// Returns an empty collection if the user has no stored profiles, or an error if retrieval or unmarshaling fails.
+func loadProfiles(ctx context.Context, c redis.Cmdable, userID string) (*pet.Profiles, error) {
+	data, err := c.Get(ctx, petProfilesKey(userID)).Bytes()
+	if err == redis.Nil {
+		data, err = c.HGet(ctx, legacyPetProfilesKey, userID).Bytes()
+	}
+
+	if err == redis.Nil {
+		return &pet.Profiles{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet profiles: %w", err)
+	}
+
+	var profiles pet.Profiles
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pet profiles: %w", err)
+	}
+
+	return &profiles, nil
+}
+
+// petProfilesKey generates the Redis key of the pet profiles of the user.
+func petProfilesKey(userID string) string {
+	return petProfilesKeyPrefix + userID
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/go-redis/redismock/v9"
 	"github.com/ksysoev/help-my-pet/pkg/core"
 	"github.com/ksysoev/help-my-pet/pkg/core/pet"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPetProfileRepository_SaveProfiles(t *testing.T) {
@@ -34,7 +36,13 @@ func TestPetProfileRepository_SaveProfiles(t *testing.T) {
 			},
 			mockSetup: func(mock redismock.ClientMock, userID string, profile *pet.Profile) []byte {
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{*profile}})
-				mock.ExpectHSet(petProfilesKey, userID, data).SetVal(1)
+				mock.ExpectWatch(petProfilesKey(userID))
+				mock.ExpectGet(petProfilesKey(userID)).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, userID).RedisNil()
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey(userID), data, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec()
 				return data
 			},
 		},
@@ -55,7 +63,13 @@ func TestPetProfileRepository_SaveProfiles(t *testing.T) {
 			profile: &pet.Profile{},
 			mockSetup: func(mock redismock.ClientMock, userID string, profile *pet.Profile) []byte {
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{*profile}})
-				mock.ExpectHSet(petProfilesKey, userID, data).SetVal(1)
+				mock.ExpectWatch(petProfilesKey(userID))
+				mock.ExpectGet(petProfilesKey(userID)).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, userID).RedisNil()
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey(userID), data, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec()
 				return data
 			},
 		},
@@ -72,7 +86,13 @@ func TestPetProfileRepository_SaveProfiles(t *testing.T) {
 			},
 			mockSetup: func(mock redismock.ClientMock, userID string, profile *pet.Profile) []byte {
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{*profile}})
-				mock.ExpectHSet(petProfilesKey, userID, data).SetErr(fmt.Errorf("redis unavailable"))
+				mock.ExpectWatch(petProfilesKey(userID))
+				mock.ExpectGet(petProfilesKey(userID)).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, userID).RedisNil()
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey(userID), data, 0).SetErr(fmt.Errorf("redis unavailable"))
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec()
 				return data
 			},
 			wantErr:     true,
@@ -123,7 +143,7 @@ func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
 					Weight:      "30.5",
 				}
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{profile}})
-				mock.ExpectHGet(petProfilesKey, userID).SetVal(string(data))
+				mock.ExpectGet(petProfilesKey(userID)).SetVal(string(data))
 				return string(data)
 			},
 			expected: &pet.Profile{
@@ -140,7 +160,8 @@ func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
 			name:   "redis nil error",
 			userID: "user404",
 			mockSetup: func(mock redismock.ClientMock, userID string) string {
-				mock.ExpectHGet(petProfilesKey, userID).RedisNil()
+				mock.ExpectGet(petProfilesKey(userID)).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, userID).RedisNil()
 				return ""
 			},
 			expected:    nil,
@@ -150,7 +171,7 @@ func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
 			name:   "redis failure",
 			userID: "user500",
 			mockSetup: func(mock redismock.ClientMock, userID string) string {
-				mock.ExpectHGet(petProfilesKey, userID).SetErr(assert.AnError)
+				mock.ExpectGet(petProfilesKey(userID)).SetErr(assert.AnError)
 				return ""
 			},
 			expected:    nil,
@@ -161,7 +182,7 @@ func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
 			userID: "user123",
 			mockSetup: func(mock redismock.ClientMock, userID string) string {
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{}})
-				mock.ExpectHGet(petProfilesKey, userID).SetVal(string(data))
+				mock.ExpectGet(petProfilesKey(userID)).SetVal(string(data))
 				return string(data)
 			},
 			expected:    nil,
@@ -188,7 +209,7 @@ func TestPetProfileRepository_GetCurrentProfile(t *testing.T) {
 					Weight:      "4.0",
 				}
 				data, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{profile1, profile2}})
-				mock.ExpectHGet(petProfilesKey, userID).SetVal(string(data))
+				mock.ExpectGet(petProfilesKey(userID)).SetVal(string(data))
 				return string(data)
 			},
 			expected: &pet.Profile{
@@ -228,7 +249,9 @@ func TestPetProfileRepository_GetProfiles_Empty(t *testing.T) {
 	client, mock := redismock.NewClientMock()
 	repo := NewPetProfileRepository(client)
 
-	mock.ExpectHGet(petProfilesKey, "user456").RedisNil()
+	mock.ExpectGet(petProfilesKey("user456")).RedisNil()
+
+	mock.ExpectHGet(legacyPetProfilesKey, "user456").RedisNil()
 
 	result, err := repo.GetCurrentProfile(context.Background(), "user456")
 	assert.ErrorIs(t, err, core.ErrProfileNotFound)
@@ -248,15 +271,31 @@ func TestPetProfileRepository_RemoveUserProfiles(t *testing.T) {
 			name:   "successful removal",
 			userID: "user123",
 			mockSetup: func(mock redismock.ClientMock, userID string) {
-				mock.ExpectHDel(petProfilesKey, userID).SetVal(1) // Simulating Redis HDel success
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(petProfilesKey(userID)).SetVal(1)
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec()
 			},
 			expectedErr: nil,
 		},
+		{
+			name:   "legacy profiles are removed",
+			userID: "user123",
+			mockSetup: func(mock redismock.ClientMock, userID string) {
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(petProfilesKey(userID)).SetVal(0)
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(1)
+				mock.ExpectTxPipelineExec()
+			},
+		},
 		{
 			name:   "profile does not exist",
 			userID: "user404",
 			mockSetup: func(mock redismock.ClientMock, userID string) {
-				mock.ExpectHDel(petProfilesKey, userID).SetVal(0) // Simulating Redis HDel success with no key deleted
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(petProfilesKey(userID)).SetVal(0)
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec()
 			},
 			expectedErr: nil,
 		},
@@ -264,7 +303,10 @@ func TestPetProfileRepository_RemoveUserProfiles(t *testing.T) {
 			name:   "redis error",
 			userID: "user500",
 			mockSetup: func(mock redismock.ClientMock, userID string) {
-				mock.ExpectHDel(petProfilesKey, userID).SetErr(fmt.Errorf("redis unavailable")) // Simulating Redis error
+				mock.ExpectTxPipeline()
+				mock.ExpectDel(petProfilesKey(userID)).SetVal(1)
+				mock.ExpectHDel(legacyPetProfilesKey, userID).SetVal(0)
+				mock.ExpectTxPipelineExec().SetErr(fmt.Errorf("redis unavailable"))
 			},
 			expectedErr: fmt.Errorf("failed to remove pet profiles: redis unavailable"),
 		},
@@ -290,3 +332,327 @@ func TestPetProfileRepository_RemoveUserProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestPetProfileRepository_SaveProfile_ReplacesActive(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := NewPetProfileRepository(client)
+
+	stored, _ := json.Marshal(pet.Profiles{
+		Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}},
+		Active:   1,
+	})
+	expected, _ := json.Marshal(pet.Profiles{
+		Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella", Weight: "4 kg"}},
+		Active:   1,
+	})
+
+	mock.ExpectWatch(petProfilesKey("user123"))
+	mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+	mock.ExpectTxPipeline()
+	mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+	mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+	mock.ExpectTxPipelineExec()
+
+	err := repo.SaveProfile(context.Background(), "user123", &pet.Profile{Name: "Bella", Weight: "4 kg"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPetProfileRepository_AddProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *pet.Profile
+		mockSetup func(mock redismock.ClientMock)
+		wantErr   string
+	}{
+		{
+			name:    "first pet",
+			profile: &pet.Profile{Name: "Max"},
+			mockSetup: func(mock redismock.ClientMock) {
+				expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}})
+				mock.ExpectWatch(petProfilesKey("user123"))
+				mock.ExpectGet(petProfilesKey("user123")).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, "user123").RedisNil()
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+				mock.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name:    "additional pet becomes active",
+			profile: &pet.Profile{Name: "Bella"},
+			mockSetup: func(mock redismock.ClientMock) {
+				stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}})
+				expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1})
+				mock.ExpectWatch(petProfilesKey("user123"))
+				mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+				mock.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name:    "retried when profiles change concurrently",
+			profile: &pet.Profile{Name: "Bella"},
+			mockSetup: func(mock redismock.ClientMock) {
+				expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Bella"}}})
+				mock.ExpectWatch(petProfilesKey("user123"))
+				mock.ExpectGet(petProfilesKey("user123")).RedisNil()
+				mock.ExpectHGet(legacyPetProfilesKey, "user123").RedisNil()
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+				mock.ExpectTxPipelineExec().SetErr(redis.TxFailedErr)
+
+				stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}})
+				expected, _ = json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1})
+				mock.ExpectWatch(petProfilesKey("user123"))
+				mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+				mock.ExpectTxPipeline()
+				mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+				mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+				mock.ExpectTxPipelineExec()
+			},
+		},
+		{
+			name:    "redis failure on load",
+			profile: &pet.Profile{Name: "Bella"},
+			mockSetup: func(mock redismock.ClientMock) {
+				mock.ExpectWatch(petProfilesKey("user123"))
+				mock.ExpectGet(petProfilesKey("user123")).SetErr(fmt.Errorf("redis unavailable"))
+			},
+			wantErr: "failed to save pet profiles: failed to get pet profiles: redis unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repo := NewPetProfileRepository(client)
+
+			tt.mockSetup(mock)
+
+			err := repo.AddProfile(context.Background(), "user123", tt.profile)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPetProfileRepository_SetActiveProfile(t *testing.T) {
+	stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}})
+
+	t.Run("existing pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1})
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+		mock.ExpectTxPipeline()
+		mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+		mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+		mock.ExpectTxPipelineExec()
+
+		assert.NoError(t, repo.SetActiveProfile(context.Background(), "user123", "bella"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+
+		assert.ErrorIs(t, repo.SetActiveProfile(context.Background(), "user123", "Rex"), core.ErrProfileNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPetProfileRepository_UpdateProfile(t *testing.T) {
+	stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}})
+	addWeight := func(profile *pet.Profile) {
+		profile.Weight = "4 kg"
+	}
+
+	t.Run("existing pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella", Weight: "4 kg"}}})
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+		mock.ExpectTxPipeline()
+		mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+		mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+		mock.ExpectTxPipelineExec()
+
+		assert.NoError(t, repo.UpdateProfile(context.Background(), "user123", "bella", addWeight))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+
+		assert.ErrorIs(t, repo.UpdateProfile(context.Background(), "user123", "Rex", addWeight), core.ErrProfileNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPetProfileRepository_RemoveProfile(t *testing.T) {
+	t.Run("remove one of several pets", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1})
+		expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Bella"}}})
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+		mock.ExpectTxPipeline()
+		mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+		mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+		mock.ExpectTxPipelineExec()
+
+		assert.NoError(t, repo.RemoveProfile(context.Background(), "user123", "Max"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("remove last pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}})
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).SetVal(string(stored))
+		mock.ExpectTxPipeline()
+		mock.ExpectDel(petProfilesKey("user123")).SetVal(1)
+		mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(0)
+		mock.ExpectTxPipelineExec()
+
+		assert.NoError(t, repo.RemoveProfile(context.Background(), "user123", "Max"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown pet", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).RedisNil()
+		mock.ExpectHGet(legacyPetProfilesKey, "user123").RedisNil()
+
+		assert.ErrorIs(t, repo.RemoveProfile(context.Background(), "user123", "Max"), core.ErrProfileNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPetProfileRepository_LegacyProfiles(t *testing.T) {
+	stored, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}}})
+
+	t.Run("read from legacy hash", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectGet(petProfilesKey("user123")).RedisNil()
+		mock.ExpectHGet(legacyPetProfilesKey, "user123").SetVal(string(stored))
+
+		profile, err := repo.GetCurrentProfile(context.Background(), "user123")
+		require.NoError(t, err)
+		assert.Equal(t, "Max", profile.Name)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("migrated to key of user on update", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		expected, _ := json.Marshal(pet.Profiles{Profiles: []pet.Profile{{Name: "Max"}, {Name: "Bella"}}, Active: 1})
+		mock.ExpectWatch(petProfilesKey("user123"))
+		mock.ExpectGet(petProfilesKey("user123")).RedisNil()
+		mock.ExpectHGet(legacyPetProfilesKey, "user123").SetVal(string(stored))
+		mock.ExpectTxPipeline()
+		mock.ExpectSet(petProfilesKey("user123"), expected, 0).SetVal("OK")
+		mock.ExpectHDel(legacyPetProfilesKey, "user123").SetVal(1)
+		mock.ExpectTxPipelineExec()
+
+		assert.NoError(t, repo.AddProfile(context.Background(), "user123", &pet.Profile{Name: "Bella"}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPetProfileRepository_ForEach(t *testing.T) {
+	t.Run("all users", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectScan(0, petProfilesKeyPrefix+"*", scanBatchSize).SetVal([]string{"pet_profiles:user1", "pet_profiles:removed"}, 0)
+		mock.ExpectGet("pet_profiles:user1").SetVal(`{"profiles":[{"name":"Max"}]}`)
+		mock.ExpectGet("pet_profiles:removed").RedisNil()
+		mock.ExpectHScan(legacyPetProfilesKey, 0, "", scanBatchSize).SetVal([]string{"user1", `{"profiles":[{"name":"Old"}]}`}, 7)
+		mock.ExpectExists("pet_profiles:user1").SetVal(1)
+		mock.ExpectHScan(legacyPetProfilesKey, 7, "", scanBatchSize).SetVal([]string{"user2", `{"profiles":[{"name":"Luna"}]}`}, 0)
+		mock.ExpectExists("pet_profiles:user2").SetVal(0)
+
+		got := make(map[string]string)
+
+		err := repo.ForEach(context.Background(), func(userID string, data []byte) error {
+			got[userID] = string(data)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"user1": `{"profiles":[{"name":"Max"}]}`, "user2": `{"profiles":[{"name":"Luna"}]}`}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("callback fails", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectScan(0, petProfilesKeyPrefix+"*", scanBatchSize).SetVal([]string{"pet_profiles:user1", "pet_profiles:user2"}, 0)
+		mock.ExpectGet("pet_profiles:user1").SetVal("{}")
+
+		calls := 0
+
+		err := repo.ForEach(context.Background(), func(string, []byte) error {
+			calls++
+			return assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("scan fails", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectScan(0, petProfilesKeyPrefix+"*", scanBatchSize).SetErr(assert.AnError)
+
+		err := repo.ForEach(context.Background(), func(string, []byte) error { return nil })
+		assert.EqualError(t, err, "failed to scan pet profiles: "+assert.AnError.Error())
+	})
+
+	t.Run("legacy scan fails", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		repo := NewPetProfileRepository(client)
+
+		mock.ExpectScan(0, petProfilesKeyPrefix+"*", scanBatchSize).SetVal(nil, 0)
+		mock.ExpectHScan(legacyPetProfilesKey, 0, "", scanBatchSize).SetErr(assert.AnError)
+
+		err := repo.ForEach(context.Background(), func(string, []byte) error { return nil })
+		assert.EqualError(t, err, "failed to scan legacy pet profiles: "+assert.AnError.Error())
+	})
+}
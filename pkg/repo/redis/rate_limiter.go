@@ -0,0 +1,240 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	userRequestsKeyPrefix   = "rate_limit:user:"
+	globalRequestsKeyPrefix = "rate_limit:global:"
+	activeUsersKeyPrefix    = "rate_limit:users:"
+	whitelistKey            = "rate_limit:whitelist"
+
+	// userRequestsTTL defines how long user request timestamps are kept, it covers the longest user window (1 day)
+	userRequestsTTL = 24 * time.Hour
+	// globalRequestsTTL defines how long the global daily counter is kept after the day is over
+	globalRequestsTTL = 48 * time.Hour
+)
+
+// Results of allowQuestionScript
+const (
+	questionAllowed = iota
+	questionUserLimited
+	questionGlobalLimited
+)
+
+// allowQuestionScript drops user requests outside of the longest window, checks the hourly and daily limits of the user
+// and the global daily limit unless the user is whitelisted, and records the request if it is allowed.
+// The request is added to the user's sorted set, the global counter of the day and the active users of the day.
+// Returns questionAllowed, questionUserLimited or questionGlobalLimited.
+var allowQuestionScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[4])
+if redis.call('SISMEMBER', KEYS[4], ARGV[1]) == 0 then
+	if redis.call('ZCOUNT', KEYS[1], ARGV[5], '+inf') >= tonumber(ARGV[7]) then
+		return 1
+	end
+	if redis.call('ZCOUNT', KEYS[1], ARGV[6], '+inf') >= tonumber(ARGV[8]) then
+		return 1
+	end
+	local limit = tonumber(ARGV[9])
+	if limit > 0 and tonumber(redis.call('GET', KEYS[2]) or '0') >= limit then
+		return 2
+	end
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[10])
+redis.call('INCR', KEYS[2])
+redis.call('EXPIRE', KEYS[2], ARGV[11])
+redis.call('PFADD', KEYS[3], ARGV[1])
+redis.call('EXPIRE', KEYS[3], ARGV[11])
+return 0
+`)
+
+// RateLimiter implements core.RateLimiter using Redis, so limits are shared between instances and survive restarts.
+// User requests are stored in sorted sets scored by request time, the global limit uses a per-day counter.
+// Distinct users of the day are estimated with a HyperLogLog for usage statistics.
+// The whitelist is a set shared between instances, users whitelisted in the configuration are added to it
+// when an instance first uses it.
+type RateLimiter struct {
+	client *redis.Client
+	config *memory.RateLimitConfig
+	now    func() time.Time
+	mu     sync.Mutex
+	seeded bool
+}
+
+// NewRateLimiter creates a new Redis backed RateLimiter with the given client and configuration.
+// It uses the same configuration as the in-memory implementation, including the whitelist of user IDs.
+// Returns a pointer to the initialized RateLimiter.
+func NewRateLimiter(client *redis.Client, cfg *memory.RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		client: client,
+		config: cfg,
+		now:    time.Now,
+	}
+}
+
+// AllowNewQuestion checks if a user is allowed to ask a new question and records the question if so.
+// The user's requests within the last hour and since the start of the day, and the global requests of the day
+// are counted and the question is recorded in a single Lua script, so concurrent questions can't exceed the limits.
+// Questions of whitelisted users aren't limited, but they are recorded for usage statistics.
+// Returns core.ErrRateLimit if user limits are exceeded, core.ErrGlobalLimit if the global limit is exceeded,
+// or an error if the Redis script fails.
+func (r *RateLimiter) AllowNewQuestion(ctx context.Context, userID string) (bool, error) {
+	if err := r.seedWhitelist(ctx); err != nil {
+		return false, err
+	}
+
+	now := r.now()
+	keys := []string{r.userKey(userID), r.globalKey(now), r.activeUsersKey(now), whitelistKey}
+
+	result, err := allowQuestionScript.Run(ctx, r.client, keys,
+		userID,
+		now.UnixMilli(),
+		strconv.FormatInt(now.UnixNano(), 10),
+		score(now.Add(-userRequestsTTL)),
+		score(now.Add(-time.Hour)),
+		score(now.Truncate(24*time.Hour)),
+		r.config.UserHourlyLimit,
+		r.config.UserDailyLimit,
+		r.config.GlobalDailyLimit,
+		int64(userRequestsTTL.Seconds()),
+		int64(globalRequestsTTL.Seconds()),
+	).Int()
+
+	switch {
+	case err != nil:
+		return false, fmt.Errorf("failed to check and record request: %w", err)
+	case result == questionUserLimited:
+		return false, core.ErrRateLimit
+	case result == questionGlobalLimited:
+		return false, core.ErrGlobalLimit
+	}
+
+	return true, nil
+}
+
+// IsWhitelisted checks if a user is whitelisted.
+// Returns an error if the Redis query fails.
+func (r *RateLimiter) IsWhitelisted(ctx context.Context, userID string) (bool, error) {
+	if err := r.seedWhitelist(ctx); err != nil {
+		return false, err
+	}
+
+	whitelisted, err := r.client.SIsMember(ctx, whitelistKey, userID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check whitelist: %w", err)
+	}
+
+	return whitelisted, nil
+}
+
+// DailyUsage returns the estimated number of distinct users who asked questions today and the number of their questions.
+// Returns an error if Redis queries fail.
+func (r *RateLimiter) DailyUsage(ctx context.Context) (users, questions int, err error) {
+	now := r.now()
+
+	pipe := r.client.Pipeline()
+	usersCmd := pipe.PFCount(ctx, r.activeUsersKey(now))
+	questionsCmd := pipe.Get(ctx, r.globalKey(now))
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, 0, fmt.Errorf("failed to get daily usage: %w", err)
+	}
+
+	count, err := questionsCmd.Int()
+	if err != nil && err != redis.Nil {
+		return 0, 0, fmt.Errorf("failed to parse global requests count: %w", err)
+	}
+
+	return int(usersCmd.Val()), count, nil
+}
+
+// ResetQuota removes the question history of the user, so the limits of the user start over.
+// The global counter of the day is kept.
+// Returns an error if the Redis query fails.
+func (r *RateLimiter) ResetQuota(ctx context.Context, userID string) error {
+	if err := r.client.Del(ctx, r.userKey(userID)).Err(); err != nil {
+		return fmt.Errorf("failed to reset user requests: %w", err)
+	}
+
+	return nil
+}
+
+// SetWhitelisted adds the user to the whitelist shared between instances or removes the user from it.
+// Users whitelisted in the configuration are added back when an instance starts.
+// Returns an error if the Redis query fails.
+func (r *RateLimiter) SetWhitelisted(ctx context.Context, userID string, whitelisted bool) error {
+	if err := r.seedWhitelist(ctx); err != nil {
+		return err
+	}
+
+	var err error
+
+	if whitelisted {
+		err = r.client.SAdd(ctx, whitelistKey, userID).Err()
+	} else {
+		err = r.client.SRem(ctx, whitelistKey, userID).Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update whitelist: %w", err)
+	}
+
+	return nil
+}
+
+// seedWhitelist adds the users whitelisted in the configuration to the whitelist once per instance,
+// it is retried on the next use if Redis fails.
+// Returns an error if the Redis query fails.
+func (r *RateLimiter) seedWhitelist(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seeded {
+		return nil
+	}
+
+	if len(r.config.WhitelistIDs) > 0 {
+		ids := make([]any, 0, len(r.config.WhitelistIDs))
+		for _, id := range r.config.WhitelistIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+
+		if err := r.client.SAdd(ctx, whitelistKey, ids...).Err(); err != nil {
+			return fmt.Errorf("failed to seed whitelist: %w", err)
+		}
+	}
+
+	r.seeded = true
+
+	return nil
+}
+
+// userKey generates a Redis key for the sorted set holding request timestamps of the user.
+func (r *RateLimiter) userKey(userID string) string {
+	return userRequestsKeyPrefix + userID
+}
+
+// globalKey generates a Redis key for the global request counter of the day the given time belongs to.
+func (r *RateLimiter) globalKey(t time.Time) string {
+	return globalRequestsKeyPrefix + t.UTC().Format("2006-01-02")
+}
+
+// activeUsersKey generates a Redis key for the HyperLogLog of users who asked questions on the day the given time belongs to.
+func (r *RateLimiter) activeUsersKey(t time.Time) string {
+	return activeUsersKeyPrefix + t.UTC().Format("2006-01-02")
+}
+
+// score converts time to the sorted set score representation used for request timestamps.
+func score(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/repo/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_AllowNewQuestion(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+	keys := []string{"rate_limit:user:user1", "rate_limit:global:2025-03-10", "rate_limit:users:2025-03-10", "rate_limit:whitelist"}
+	args := []any{
+		"user1",
+		now.UnixMilli(),
+		fmt.Sprintf("%d", now.UnixNano()),
+		fmt.Sprintf("%d", now.Add(-24*time.Hour).UnixMilli()),
+		fmt.Sprintf("%d", now.Add(-time.Hour).UnixMilli()),
+		fmt.Sprintf("%d", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC).UnixMilli()),
+		2,
+		5,
+		100,
+		int64(86400),
+		int64(172800),
+	}
+
+	cfg := &memory.RateLimitConfig{
+		WhitelistIDs:     []int64{999},
+		UserHourlyLimit:  2,
+		UserDailyLimit:   5,
+		GlobalDailyLimit: 100,
+	}
+
+	tests := []struct {
+		mockErr     error
+		wantErr     error
+		name        string
+		result      int64
+		wantAllowed bool
+	}{
+		{
+			name:        "allowed and recorded",
+			result:      questionAllowed,
+			wantAllowed: true,
+		},
+		{
+			name:    "user limit exceeded",
+			result:  questionUserLimited,
+			wantErr: core.ErrRateLimit,
+		},
+		{
+			name:    "global limit exceeded",
+			result:  questionGlobalLimited,
+			wantErr: core.ErrGlobalLimit,
+		},
+		{
+			name:    "redis failure",
+			mockErr: fmt.Errorf("redis unavailable"),
+			wantErr: fmt.Errorf("failed to check and record request: redis unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			rl := NewRateLimiter(client, cfg)
+			rl.now = func() time.Time { return now }
+
+			mock.ExpectSAdd("rate_limit:whitelist", "999").SetVal(0)
+			expect := mock.ExpectEvalSha(allowQuestionScript.Hash(), keys, args...)
+
+			if tt.mockErr != nil {
+				expect.SetErr(tt.mockErr)
+			} else {
+				expect.SetVal(tt.result)
+			}
+
+			allowed, err := rl.AllowNewQuestion(context.Background(), "user1")
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRateLimiter_IsWhitelisted(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	rl := NewRateLimiter(client, &memory.RateLimitConfig{WhitelistIDs: []int64{123, 789}})
+
+	// The whitelist is seeded from the configuration once, a failed seed is retried
+	mock.ExpectSAdd("rate_limit:whitelist", "123", "789").SetErr(fmt.Errorf("redis unavailable"))
+
+	_, err := rl.IsWhitelisted(context.Background(), "123")
+	assert.ErrorContains(t, err, "failed to seed whitelist")
+
+	mock.ExpectSAdd("rate_limit:whitelist", "123", "789").SetVal(2)
+	mock.ExpectSIsMember("rate_limit:whitelist", "123").SetVal(true)
+	mock.ExpectSIsMember("rate_limit:whitelist", "456").SetVal(false)
+	mock.ExpectSIsMember("rate_limit:whitelist", "456").SetErr(fmt.Errorf("redis unavailable"))
+
+	whitelisted, err := rl.IsWhitelisted(context.Background(), "123")
+	assert.NoError(t, err)
+	assert.True(t, whitelisted)
+
+	whitelisted, err = rl.IsWhitelisted(context.Background(), "456")
+	assert.NoError(t, err)
+	assert.False(t, whitelisted)
+
+	_, err = rl.IsWhitelisted(context.Background(), "456")
+	assert.ErrorContains(t, err, "failed to check whitelist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateLimiter_DailyUsage(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		rl := NewRateLimiter(client, &memory.RateLimitConfig{})
+		rl.now = func() time.Time { return now }
+
+		mock.ExpectPFCount("rate_limit:users:2025-03-10").SetVal(4)
+		mock.ExpectGet("rate_limit:global:2025-03-10").SetVal("9")
+
+		users, questions, err := rl.DailyUsage(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 4, users)
+		assert.Equal(t, 9, questions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no questions today", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		rl := NewRateLimiter(client, &memory.RateLimitConfig{})
+		rl.now = func() time.Time { return now }
+
+		mock.ExpectPFCount("rate_limit:users:2025-03-10").SetVal(0)
+		mock.ExpectGet("rate_limit:global:2025-03-10").RedisNil()
+
+		users, questions, err := rl.DailyUsage(context.Background())
+		assert.NoError(t, err)
+		assert.Zero(t, users)
+		assert.Zero(t, questions)
+	})
+
+	t.Run("redis failure", func(t *testing.T) {
+		client, mock := redismock.NewClientMock()
+		rl := NewRateLimiter(client, &memory.RateLimitConfig{})
+		rl.now = func() time.Time { return now }
+
+		mock.ExpectPFCount("rate_limit:users:2025-03-10").SetErr(fmt.Errorf("redis unavailable"))
+
+		_, _, err := rl.DailyUsage(context.Background())
+		assert.ErrorContains(t, err, "failed to get daily usage")
+	})
+}
+
+func TestRateLimiter_ResetQuota(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	rl := NewRateLimiter(client, &memory.RateLimitConfig{})
+
+	mock.ExpectDel("rate_limit:user:user1").SetVal(1)
+	assert.NoError(t, rl.ResetQuota(context.Background(), "user1"))
+
+	mock.ExpectDel("rate_limit:user:user1").SetErr(fmt.Errorf("redis unavailable"))
+	assert.ErrorContains(t, rl.ResetQuota(context.Background(), "user1"), "failed to reset user requests")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateLimiter_SetWhitelisted(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	rl := NewRateLimiter(client, &memory.RateLimitConfig{WhitelistIDs: []int64{123}})
+
+	mock.ExpectSAdd("rate_limit:whitelist", "123").SetVal(1)
+	mock.ExpectSAdd("rate_limit:whitelist", "456").SetVal(1)
+	mock.ExpectSRem("rate_limit:whitelist", "123").SetVal(1)
+	mock.ExpectSRem("rate_limit:whitelist", "123").SetErr(fmt.Errorf("redis unavailable"))
+
+	assert.NoError(t, rl.SetWhitelisted(context.Background(), "456", true))
+	assert.NoError(t, rl.SetWhitelisted(context.Background(), "123", false))
+	assert.ErrorContains(t, rl.SetWhitelisted(context.Background(), "123", false), "failed to update whitelist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,220 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	remindersKey           = "reminders"
+	remindersDueKey        = "reminders:due"
+	userRemindersKeyPrefix = "reminders:user:"
+)
+
+// saveReminderScript saves the reminder only if its stored version matches the expected one,
+// a missing reminder has version 0. Returns 1 if the reminder is saved and 0 on a version conflict.
+var saveReminderScript = redis.NewScript(`
+local stored = redis.call('HGET', KEYS[1], ARGV[1])
+local version = 0
+if stored then
+	version = tonumber(cjson.decode(stored).version) or 0
+end
+if version ~= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+redis.call('SADD', KEYS[3], ARGV[1])
+return 1
+`)
+
+// claimRemindersScript postpones up to ARGV[2] reminders due at or before ARGV[1] to ARGV[3]
+// and returns their IDs, so concurrent schedulers never claim the same reminder.
+var claimRemindersScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, id in ipairs(ids) do
+	redis.call('ZADD', KEYS[1], ARGV[3], id)
+end
+return ids
+`)
+
+// ReminderRepository implements core.ReminderRepository using Redis.
+// Reminders are stored as JSON in a hash, scheduled in a sorted set scored by their due time
+// and indexed per user in a set of reminder IDs.
+type ReminderRepository struct {
+	client *redis.Client
+}
+
+// NewReminderRepository creates a new instance of ReminderRepository with the provided Redis client.
+// client Redis client used for database operations.
+// Returns a pointer to the ReminderRepository instance.
+func NewReminderRepository(client *redis.Client) *ReminderRepository {
+	return &ReminderRepository{
+		client: client,
+	}
+}
+
+// SaveReminder creates or updates the reminder and schedules it for the time it is due.
+// The stored version is compared with the version of the reminder in a Lua script,
+// so the reminder isn't overwritten or recreated if it was changed or deleted since it was loaded.
+// ctx is the context for the operation, allowing cancellation and timeouts.
+// Returns core.ErrReminderConflict if the stored version differs, or an error if serialization or the save operation fails.
+func (r *ReminderRepository) SaveReminder(ctx context.Context, rem *reminder.Reminder) error {
+	version := rem.Version
+	rem.Version++
+
+	data, err := json.Marshal(rem)
+	if err != nil {
+		rem.Version = version
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+
+	keys := []string{remindersKey, remindersDueKey, userRemindersKey(rem.UserID)}
+
+	saved, err := saveReminderScript.Run(ctx, r.client, keys, rem.ID, version, data, rem.DueAt().Unix()).Int()
+
+	switch {
+	case err != nil:
+		rem.Version = version
+		return fmt.Errorf("failed to save reminder: %w", err)
+	case saved == 0:
+		rem.Version = version
+		return core.ErrReminderConflict
+	}
+
+	return nil
+}
+
+// GetReminder retrieves the reminder by its ID.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// Returns core.ErrReminderNotFound if the reminder doesn't exist, or an error if retrieval or unmarshaling fails.
+func (r *ReminderRepository) GetReminder(ctx context.Context, id string) (*reminder.Reminder, error) {
+	data, err := r.client.HGet(ctx, remindersKey, id).Bytes()
+	if err == redis.Nil {
+		return nil, core.ErrReminderNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminder: %w", err)
+	}
+
+	var rem reminder.Reminder
+	if err := json.Unmarshal(data, &rem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reminder: %w", err)
+	}
+
+	return &rem, nil
+}
+
+// GetUserReminders retrieves all reminders of the user.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// Returns the reminders, or an error if retrieval or unmarshaling fails.
+func (r *ReminderRepository) GetUserReminders(ctx context.Context, userID string) ([]*reminder.Reminder, error) {
+	ids, err := r.client.SMembers(ctx, userRemindersKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reminder ids: %w", err)
+	}
+
+	return r.load(ctx, ids)
+}
+
+// ClaimDueReminders retrieves up to limit reminders due at or before now, the earliest first,
+// and atomically postpones them in the schedule by ttl, so other schedulers don't claim them.
+// Saving a claimed reminder schedules it for its next due time.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// Returns the reminders, or an error if claiming, retrieval or unmarshaling fails.
+func (r *ReminderRepository) ClaimDueReminders(ctx context.Context, now time.Time, ttl time.Duration, limit int) ([]*reminder.Reminder, error) {
+	ids, err := claimRemindersScript.Run(ctx, r.client, []string{remindersDueKey}, now.Unix(), limit, now.Add(ttl).Unix()).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+
+	return r.load(ctx, ids)
+}
+
+// DeleteReminder removes the reminder together with its schedule and user index entries.
+// ctx is the context for the operation, supporting cancellation and timeouts.
+// Returns an error if the delete operation fails.
+func (r *ReminderRepository) DeleteReminder(ctx context.Context, rem *reminder.Reminder) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, remindersKey, rem.ID)
+		pipe.ZRem(ctx, remindersDueKey, rem.ID)
+		pipe.SRem(ctx, userRemindersKey(rem.UserID), rem.ID)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+
+	return nil
+}
+
+// ForEach calls fn with every stored reminder. It is used to copy reminders to another storage.
+// Returns the error of fn, stopping the iteration, or an error if reading from Redis or unmarshaling fails.
+func (r *ReminderRepository) ForEach(ctx context.Context, fn func(rem *reminder.Reminder) error) error {
+	iter := r.client.HScan(ctx, remindersKey, 0, "", scanBatchSize).Iterator()
+
+	for iter.Next(ctx) {
+		if !iter.Next(ctx) {
+			break
+		}
+
+		var rem reminder.Reminder
+		if err := json.Unmarshal([]byte(iter.Val()), &rem); err != nil {
+			return fmt.Errorf("failed to unmarshal reminder: %w", err)
+		}
+
+		if err := fn(&rem); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan reminders: %w", err)
+	}
+
+	return nil
+}
+
+// load fetches and deserializes reminders by their IDs, skipping IDs without stored reminders.
+// Returns an error if retrieval or unmarshaling fails.
+func (r *ReminderRepository) load(ctx context.Context, ids []string) ([]*reminder.Reminder, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.HMGet(ctx, remindersKey, ids...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	reminders := make([]*reminder.Reminder, 0, len(values))
+
+	for _, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		var rem reminder.Reminder
+		if err := json.Unmarshal([]byte(data), &rem); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal reminder: %w", err)
+		}
+
+		reminders = append(reminders, &rem)
+	}
+
+	return reminders, nil
+}
+
+// userRemindersKey returns the key of the set with reminder IDs of the user.
+func userRemindersKey(userID string) string {
+	return userRemindersKeyPrefix + userID
+}
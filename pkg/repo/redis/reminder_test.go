@@ -0,0 +1,201 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/ksysoev/help-my-pet/pkg/core"
+	"github.com/ksysoev/help-my-pet/pkg/core/reminder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReminder() *reminder.Reminder {
+	return &reminder.Reminder{
+		ID:       "rem1",
+		UserID:   "user123",
+		ChatID:   "chat123",
+		PetName:  "Max",
+		Text:     "give Rimadyl",
+		NextAt:   time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		Schedule: reminder.Schedule{Unit: reminder.UnitHour, Every: 12},
+	}
+}
+
+func TestReminderRepository_SaveReminder(t *testing.T) {
+	tests := []struct {
+		mockErr     error
+		wantErr     error
+		name        string
+		saved       int64
+		wantVersion int64
+	}{
+		{name: "success", saved: 1, wantVersion: 4},
+		{name: "changed concurrently", saved: 0, wantErr: core.ErrReminderConflict, wantVersion: 3},
+		{name: "redis error", mockErr: fmt.Errorf("connection refused"), wantErr: fmt.Errorf("failed to save reminder"), wantVersion: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewReminderRepository(db)
+			rem := testReminder()
+			rem.Version = 3
+
+			saved := *rem
+			saved.Version = 4
+			data, _ := json.Marshal(&saved)
+
+			keys := []string{remindersKey, remindersDueKey, userRemindersKey(rem.UserID)}
+			expect := mock.ExpectEvalSha(saveReminderScript.Hash(), keys, rem.ID, int64(3), data, rem.NextAt.Unix())
+
+			if tt.mockErr != nil {
+				expect.SetErr(tt.mockErr)
+			} else {
+				expect.SetVal(tt.saved)
+			}
+
+			err := repo.SaveReminder(context.Background(), rem)
+
+			switch {
+			case errors.Is(tt.wantErr, core.ErrReminderConflict):
+				assert.ErrorIs(t, err, core.ErrReminderConflict)
+			case tt.wantErr != nil:
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantVersion, rem.Version)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReminderRepository_GetReminder(t *testing.T) {
+	rem := testReminder()
+	data, _ := json.Marshal(rem)
+
+	tests := []struct {
+		setup   func(mock redismock.ClientMock)
+		want    *reminder.Reminder
+		wantErr error
+		name    string
+	}{
+		{
+			name:  "found",
+			setup: func(mock redismock.ClientMock) { mock.ExpectHGet(remindersKey, rem.ID).SetVal(string(data)) },
+			want:  rem,
+		},
+		{
+			name:    "not found",
+			setup:   func(mock redismock.ClientMock) { mock.ExpectHGet(remindersKey, rem.ID).RedisNil() },
+			wantErr: core.ErrReminderNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := redismock.NewClientMock()
+			repo := NewReminderRepository(db)
+			tt.setup(mock)
+
+			got, err := repo.GetReminder(context.Background(), rem.ID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.ID, got.ID)
+			assert.True(t, tt.want.NextAt.Equal(got.NextAt))
+			assert.Equal(t, tt.want.Schedule, got.Schedule)
+		})
+	}
+}
+
+func TestReminderRepository_ClaimDueReminders(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewReminderRepository(db)
+	rem := testReminder()
+	data, _ := json.Marshal(rem)
+	now := rem.NextAt.Add(time.Minute)
+
+	mock.ExpectEvalSha(claimRemindersScript.Hash(), []string{remindersDueKey}, now.Unix(), 10, now.Add(5*time.Minute).Unix()).
+		SetVal([]any{rem.ID, "deleted"})
+	mock.ExpectHMGet(remindersKey, rem.ID, "deleted").SetVal([]any{string(data), nil})
+
+	got, err := repo.ClaimDueReminders(context.Background(), now, 5*time.Minute, 10)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, rem.ID, got[0].ID)
+
+	mock.ExpectEvalSha(claimRemindersScript.Hash(), []string{remindersDueKey}, now.Unix(), 10, now.Add(5*time.Minute).Unix()).
+		SetErr(fmt.Errorf("connection refused"))
+
+	_, err = repo.ClaimDueReminders(context.Background(), now, 5*time.Minute, 10)
+	assert.ErrorContains(t, err, "failed to claim due reminders")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_GetUserReminders_Empty(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewReminderRepository(db)
+
+	mock.ExpectSMembers(userRemindersKey("user123")).SetVal([]string{})
+
+	got, err := repo.GetUserReminders(context.Background(), "user123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_DeleteReminder(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	repo := NewReminderRepository(db)
+	rem := testReminder()
+
+	mock.ExpectTxPipeline()
+	mock.ExpectHDel(remindersKey, rem.ID).SetVal(1)
+	mock.ExpectZRem(remindersDueKey, rem.ID).SetVal(1)
+	mock.ExpectSRem(userRemindersKey(rem.UserID), rem.ID).SetVal(1)
+	mock.ExpectTxPipelineExec()
+
+	err := repo.DeleteReminder(context.Background(), rem)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_ForEach(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := NewReminderRepository(client)
+	rem := testReminder()
+	data, _ := json.Marshal(rem)
+
+	mock.ExpectHScan(remindersKey, 0, "", scanBatchSize).SetVal([]string{rem.ID, string(data)}, 0)
+
+	var got []*reminder.Reminder
+
+	err := repo.ForEach(context.Background(), func(rem *reminder.Reminder) error {
+		got = append(got, rem)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, rem.ID, got[0].ID)
+
+	mock.ExpectHScan(remindersKey, 0, "", scanBatchSize).SetVal([]string{rem.ID, "invalid"}, 0)
+	assert.ErrorContains(t, repo.ForEach(context.Background(), func(*reminder.Reminder) error { return nil }), "failed to unmarshal reminder")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}